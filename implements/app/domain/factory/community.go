@@ -2,11 +2,12 @@ package factory
 
 import (
 	"app/domain/model"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-func NewCommunity(id string, name string, invitation bool) (*model.Community, error) {
+func NewCommunity(id string, name string, invitation bool, visibility string, status string, ownerID *string) (*model.Community, error) {
 	parsedID, err := uuid.Parse(id)
 
 	if err != nil {
@@ -19,9 +20,57 @@ func NewCommunity(id string, name string, invitation bool) (*model.Community, er
 		return nil, err
 	}
 
+	parsedVisibility, err := model.NewCommunityVisibility(visibility)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedStatus, err := model.NewCommunityStatus(status)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var parsedOwnerID *uuid.UUID
+	if ownerID != nil {
+		v, err := uuid.Parse(*ownerID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		parsedOwnerID = &v
+	}
+
 	return &model.Community{
 		ID:         parsedID,
 		Name:       *parsedName,
 		Invitation: invitation,
+		Visibility: *parsedVisibility,
+		Status:     *parsedStatus,
+		OwnerID:    parsedOwnerID,
+	}, nil
+}
+
+func NewCommunityDeletion(communityID string, step string, attempts int, at time.Time, lastError *string) (*model.CommunityDeletion, error) {
+	parsedCommunityID, err := uuid.Parse(communityID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedStep, err := model.NewCommunityDeletionStep(step)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CommunityDeletion{
+		CommunityID: parsedCommunityID,
+		Step:        *parsedStep,
+		Attempts:    attempts,
+		At:          at,
+		LastError:   lastError,
 	}, nil
 }
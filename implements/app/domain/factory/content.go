@@ -20,10 +20,10 @@ func NewContent(id string, contentType string, value []byte) (*model.Content, er
 		return nil, fmt.Errorf("invalid argument. v=%v", contentType)
 	}
 
-	parsedContentValue, err := model.NewContentValue(value)
+	parsedContentValue, err := model.NewContentValue(*parsedContentType, value)
 
 	if err != nil {
-		return nil, fmt.Errorf("invalid argument. v=%v", value)
+		return nil, fmt.Errorf("invalid argument. type=%v err=%v", contentType, err)
 	}
 
 	return &model.Content{
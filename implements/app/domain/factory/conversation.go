@@ -0,0 +1,102 @@
+package factory
+
+import (
+	"app/domain/model"
+
+	"github.com/google/uuid"
+)
+
+func NewConversation(id string, users []string, at int) (*model.Conversation, error) {
+	parsedID, err := uuid.Parse(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedUserIDs := []uuid.UUID{}
+	for _, user := range users {
+		parsedUserID, err := uuid.Parse(user)
+		if err != nil {
+			return nil, err
+		}
+
+		parsedUserIDs = append(parsedUserIDs, parsedUserID)
+	}
+
+	parsedUsers, err := model.NewConversationUsers(parsedUserIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAt, err := model.NewUnixTime(at)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Conversation{
+		ID:    parsedID,
+		Users: parsedUsers,
+		At:    *parsedAt,
+	}, nil
+}
+
+func NewMessage(id string, from string, at int) (*model.Message, error) {
+	parsedID, err := uuid.Parse(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedFrom, err := uuid.Parse(from)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAt, err := model.NewUnixTime(at)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Message{
+		ID:   parsedID,
+		At:   *parsedAt,
+		From: parsedFrom,
+	}, nil
+}
+
+func NewMessageReadMarker(conversationID string, userID string, messageID string, at int) (*model.MessageReadMarker, error) {
+	parsedConversationID, err := uuid.Parse(conversationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedUserID, err := uuid.Parse(userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedMessageID, err := uuid.Parse(messageID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAt, err := model.NewUnixTime(at)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.MessageReadMarker{
+		ConversationID: parsedConversationID,
+		UserID:         parsedUserID,
+		MessageID:      parsedMessageID,
+		At:             *parsedAt,
+	}, nil
+}
@@ -2,12 +2,13 @@ package factory
 
 import (
 	"app/domain/model"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-func NewInvite(id string, roleID string, message *string, at time.Time, users []string) (*model.Invite, error) {
+func NewInvite(id string, roleID string, message *string, at time.Time, expireAt *time.Time, users []string, emails []string) (*model.Invite, error) {
 	parsedID, err := uuid.Parse(id)
 
 	if err != nil {
@@ -31,6 +32,10 @@ func NewInvite(id string, roleID string, message *string, at time.Time, users []
 		parsedMessage = nil
 	}
 
+	if expireAt != nil && !expireAt.After(at) {
+		return nil, fmt.Errorf("invalid argument. expire_at=%v", expireAt)
+	}
+
 	parsedUsers := []uuid.UUID{}
 	for _, userID := range users {
 		parsedUserID, err := uuid.Parse(userID)
@@ -42,11 +47,73 @@ func NewInvite(id string, roleID string, message *string, at time.Time, users []
 		parsedUsers = append(parsedUsers, parsedUserID)
 	}
 
+	parsedEmails := []model.EmailAddress{}
+	for _, email := range emails {
+		parsedEmail, err := model.NewEmailAddress(email)
+
+		if err != nil {
+			return nil, err
+		}
+
+		parsedEmails = append(parsedEmails, *parsedEmail)
+	}
+
 	return &model.Invite{
-		ID:      parsedID,
-		RoleID:  parsedRoleID,
-		Message: parsedMessage,
-		At:      at,
-		Users:   parsedUsers,
+		ID:       parsedID,
+		RoleID:   parsedRoleID,
+		Message:  parsedMessage,
+		At:       at,
+		ExpireAt: expireAt,
+		Users:    parsedUsers,
+		Emails:   parsedEmails,
+	}, nil
+}
+
+func NewInviteLink(id string, roleID string, token string, at time.Time, expireAt *time.Time, maxUses *int, uses int) (*model.InviteLink, error) {
+	parsedID, err := uuid.Parse(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedRoleID, err := uuid.Parse(roleID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedToken, err := model.NewInviteToken(token)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if expireAt != nil && !expireAt.After(at) {
+		return nil, fmt.Errorf("invalid argument. expire_at=%v", expireAt)
+	}
+
+	var parsedMaxUses *model.InviteUses
+	if maxUses != nil {
+		parsedMaxUses, err = model.NewInviteUses(*maxUses)
+
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		parsedMaxUses = nil
+	}
+
+	if uses < 0 {
+		return nil, fmt.Errorf("invalid argument. uses=%v", uses)
+	}
+
+	return &model.InviteLink{
+		ID:       parsedID,
+		RoleID:   parsedRoleID,
+		Token:    *parsedToken,
+		At:       at,
+		ExpireAt: expireAt,
+		MaxUses:  parsedMaxUses,
+		Uses:     uses,
 	}, nil
 }
@@ -0,0 +1,27 @@
+package factory
+
+import (
+	"app/domain/model"
+	"fmt"
+	"strings"
+)
+
+func NewMail(to string, subject string, body string, htmlBody string) (*model.Mail, error) {
+	parsedTo, err := model.NewEmailAddress(to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// ヘッダーインジェクションを防ぐ
+	if strings.ContainsAny(subject, "\r\n") {
+		return nil, fmt.Errorf("invalid argument. subject=%v", subject)
+	}
+
+	return &model.Mail{
+		To:       *parsedTo,
+		Subject:  subject,
+		Body:     body,
+		HTMLBody: htmlBody,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package factory
+
+import (
+	"app/domain/model"
+
+	"github.com/google/uuid"
+)
+
+func NewThreadReadMarker(memberID string, threadID string, postID string, at int) (*model.ThreadReadMarker, error) {
+	parsedMemberID, err := uuid.Parse(memberID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedThreadID, err := uuid.Parse(threadID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedPostID, err := uuid.Parse(postID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAt, err := model.NewUnixTime(at)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ThreadReadMarker{
+		MemberID: parsedMemberID,
+		ThreadID: parsedThreadID,
+		PostID:   parsedPostID,
+		At:       *parsedAt,
+	}, nil
+}
+
+func NewTopicReadMarker(memberID string, topicID string, threadID string, at int) (*model.TopicReadMarker, error) {
+	parsedMemberID, err := uuid.Parse(memberID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTopicID, err := uuid.Parse(topicID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedThreadID, err := uuid.Parse(threadID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedAt, err := model.NewUnixTime(at)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TopicReadMarker{
+		MemberID: parsedMemberID,
+		TopicID:  parsedTopicID,
+		ThreadID: parsedThreadID,
+		At:       *parsedAt,
+	}, nil
+}
@@ -2,6 +2,8 @@ package factory
 
 import (
 	"app/domain/model"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -31,3 +33,40 @@ func NewMember(id string, userID string, roleID string) (*model.Member, error) {
 		RoleID: parsedRoleID,
 	}, nil
 }
+
+func NewBan(communityID string, userID string, reason *string, at time.Time, expireAt *time.Time) (*model.Ban, error) {
+	parsedCommunityID, err := uuid.Parse(communityID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedUserID, err := uuid.Parse(userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var parsedReason *model.Text
+	if reason != nil {
+		parsedReason, err = model.NewText(*reason)
+
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		parsedReason = nil
+	}
+
+	if expireAt != nil && !expireAt.After(at) {
+		return nil, fmt.Errorf("invalid argument. expire_at=%v", expireAt)
+	}
+
+	return &model.Ban{
+		CommunityID: parsedCommunityID,
+		UserID:      parsedUserID,
+		Reason:      parsedReason,
+		At:          at,
+		ExpireAt:    expireAt,
+	}, nil
+}
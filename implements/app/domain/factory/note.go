@@ -2,6 +2,7 @@ package factory
 
 import (
 	"app/domain/model"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -59,3 +60,31 @@ func NewLine(id string, noteID string, order int, propertyType *string) (*model.
 		Property: property,
 	}, nil
 }
+
+func NewNoteVersion(id string, noteID string, reason string, lines []model.NoteVersionLine, createdAt time.Time) (*model.NoteVersion, error) {
+	parsedID, err := uuid.Parse(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedNoteID, err := uuid.Parse(noteID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedReason, err := model.NewNoteVersionReason(reason)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.NoteVersion{
+		ID:        parsedID,
+		NoteID:    parsedNoteID,
+		Reason:    *parsedReason,
+		Lines:     lines,
+		CreatedAt: createdAt,
+	}, nil
+}
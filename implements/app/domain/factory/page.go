@@ -0,0 +1,55 @@
+package factory
+
+import (
+	"app/domain/model"
+
+	"github.com/google/uuid"
+)
+
+func NewPage(id string, parentID *string, title string, order int, created *string) (*model.Page, error) {
+	parsedID, err := uuid.Parse(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var dParentID *uuid.UUID
+	if parentID != nil {
+		parsedParentID, err := uuid.Parse(*parentID)
+		if err != nil {
+			return nil, err
+		}
+
+		dParentID = &parsedParentID
+	}
+
+	parsedTitle, err := model.NewName(title)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedOrder, err := model.NewOrderNumber(order)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var dCreated *uuid.UUID
+	if created != nil {
+		parsedCreated, err := uuid.Parse(*created)
+		if err != nil {
+			return nil, err
+		}
+
+		dCreated = &parsedCreated
+	}
+
+	return &model.Page{
+		ID:       parsedID,
+		ParentID: dParentID,
+		Title:    *parsedTitle,
+		Order:    *parsedOrder,
+		Created:  dCreated,
+	}, nil
+}
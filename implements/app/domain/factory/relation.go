@@ -0,0 +1,33 @@
+package factory
+
+import (
+	"app/domain/model"
+
+	"github.com/google/uuid"
+)
+
+func NewUserRelation(userID string, targetID string, relationType string) (*model.UserRelation, error) {
+	parsedUserID, err := uuid.Parse(userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTargetID, err := uuid.Parse(targetID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedType, err := model.NewUserRelationType(relationType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UserRelation{
+		UserID:   parsedUserID,
+		TargetID: parsedTargetID,
+		Type:     *parsedType,
+	}, nil
+}
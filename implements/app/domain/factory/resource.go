@@ -28,3 +28,44 @@ func NewResourceSearchIndex(resourceID string, resource string, keyword string)
 		Keyword:    *parsedText,
 	}, nil
 }
+
+// NewContentSearchIndex は本文を持つリソースの索引を作る
+func NewContentSearchIndex(resourceID string, resource string, keyword string, communityID string, topicID *string, threadID *string, authorID *string) (*model.ResourceSearchIndex, error) {
+	index, err := NewResourceSearchIndex(resourceID, resource, keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedCommunityID, err := uuid.Parse(communityID)
+	if err != nil {
+		return nil, err
+	}
+	index.CommunityID = &parsedCommunityID
+
+	if index.TopicID, err = parseOptionalID(topicID); err != nil {
+		return nil, err
+	}
+
+	if index.ThreadID, err = parseOptionalID(threadID); err != nil {
+		return nil, err
+	}
+
+	if index.AuthorID, err = parseOptionalID(authorID); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+func parseOptionalID(v *string) (*uuid.UUID, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(*v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
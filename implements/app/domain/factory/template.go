@@ -0,0 +1,101 @@
+package factory
+
+import (
+	"app/domain/model"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+func NewTemplate(id string, name string, kind string, title *string, fields []model.TemplateField, lines []model.TemplateLine, created *string) (*model.Template, error) {
+	parsedID, err := uuid.Parse(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedName, err := model.NewName(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKind, err := model.NewTemplateKind(kind)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var dTitle *model.Name
+	if title != nil {
+		if *parsedKind == model.TemplateKindPost {
+			return nil, fmt.Errorf("post template cannot have title")
+		}
+
+		dTitle, err = model.NewName(*title)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	names := map[model.TemplateFieldName]bool{}
+	for _, field := range fields {
+		if names[field.Name] {
+			return nil, fmt.Errorf("duplicate field. name=%v", field.Name.String())
+		}
+		names[field.Name] = true
+	}
+
+	if *parsedKind != model.TemplateKindNote {
+		if len(lines) != 1 || lines[0].Property != nil {
+			return nil, fmt.Errorf("%v template must have a single line without property", parsedKind.String())
+		}
+	}
+
+	var dCreated *uuid.UUID
+	if created != nil {
+		parsedCreated, err := uuid.Parse(*created)
+		if err != nil {
+			return nil, err
+		}
+
+		dCreated = &parsedCreated
+	}
+
+	template := model.Template{
+		ID:      parsedID,
+		Name:    *parsedName,
+		Kind:    *parsedKind,
+		Title:   dTitle,
+		Fields:  fields,
+		Lines:   lines,
+		Created: dCreated,
+	}
+
+	placeholders, err := template.Placeholders()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, placeholder := range placeholders {
+		if !names[model.TemplateFieldName(placeholder)] {
+			return nil, fmt.Errorf("undeclared field. name=%v", placeholder)
+		}
+	}
+
+	return &template, nil
+}
+
+func NewTemplateField(name string, required bool, defaultValue *string) (*model.TemplateField, error) {
+	parsedName, err := model.NewTemplateFieldName(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TemplateField{
+		Name:     *parsedName,
+		Required: required,
+		Default:  defaultValue,
+	}, nil
+}
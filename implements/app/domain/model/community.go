@@ -1,9 +1,191 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CommunityVisibility string
+
+func (m CommunityVisibility) String() string {
+	return string(m)
+}
+
+func NewCommunityVisibility(v string) (*CommunityVisibility, error) {
+	t := CommunityVisibility(v)
+	for _, visibility := range CommunityVisibilities {
+		if t == visibility {
+			return &t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid argument. v=%v", v)
+}
+
+const (
+	// ディレクトリに掲載され、誰でも参照できる
+	CommunityVisibilityPublic CommunityVisibility = "public"
+	// ディレクトリには掲載されないが、IDを知っていれば参照できる
+	CommunityVisibilityUnlisted CommunityVisibility = "unlisted"
+	// メンバーのみ参照できる
+	CommunityVisibilityPrivate CommunityVisibility = "private"
+)
+
+var (
+	CommunityVisibilities = []CommunityVisibility{
+		CommunityVisibilityPublic,
+		CommunityVisibilityUnlisted,
+		CommunityVisibilityPrivate,
+	}
+)
+
+type CommunityStatus string
+
+func (m CommunityStatus) String() string {
+	return string(m)
+}
+
+func NewCommunityStatus(v string) (*CommunityStatus, error) {
+	t := CommunityStatus(v)
+	for _, status := range CommunityStatuses {
+		if t == status {
+			return &t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid argument. v=%v", v)
+}
+
+const (
+	CommunityStatusActive CommunityStatus = "active"
+	// 読み取り専用。ディレクトリには掲載しない
+	CommunityStatusArchived CommunityStatus = "archived"
+	// 削除ジョブの完了待ち。どこからも参照できない
+	CommunityStatusDeleting CommunityStatus = "deleting"
+)
+
+var (
+	CommunityStatuses = []CommunityStatus{
+		CommunityStatusActive,
+		CommunityStatusArchived,
+		CommunityStatusDeleting,
+	}
+)
 
 type Community struct {
 	ID         uuid.UUID
 	Name       Name
 	Invitation bool
+	Visibility CommunityVisibility
+	Status     CommunityStatus
+	// オーナーのメンバーID（所有者の概念を導入する前に作成されたコミュニティはnil）
+	OwnerID *uuid.UUID
+}
+
+// メンバー以外のユーザーが参照できるか
+func (m *Community) Visible() bool {
+	return m.Visibility != CommunityVisibilityPrivate
+}
+
+// 投稿や設定の変更を受け付けるか
+func (m *Community) Writable() bool {
+	return m.Status == CommunityStatusActive
+}
+
+func (m *Community) Deleting() bool {
+	return m.Status == CommunityStatusDeleting
+}
+
+func (m *Community) IsOwner(memberID uuid.UUID) bool {
+	return m.OwnerID != nil && *m.OwnerID == memberID
+}
+
+// アーカイブ・削除・オーナーの譲渡ができるか
+// オーナーが未設定のコミュニティでは、コミュニティを更新できるロールのメンバーに許可する
+func (m *Community) CanAdminister(member Member, role Role) bool {
+	if m.OwnerID == nil {
+		return role.CanUpdate(ResourceCommunity)
+	}
+
+	return m.IsOwner(member.ID)
+}
+
+type CommunityDeletionStep string
+
+func (m CommunityDeletionStep) String() string {
+	return string(m)
+}
+
+func NewCommunityDeletionStep(v string) (*CommunityDeletionStep, error) {
+	t := CommunityDeletionStep(v)
+	for _, step := range CommunityDeletionSteps {
+		if t == step {
+			return &t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid argument. v=%v", v)
+}
+
+// 後続のステップが参照するIDを先に消さないよう、依存される側のデータほど後に削除する
+const (
+	CommunityDeletionStepSearchIndex CommunityDeletionStep = "search_index"
+	CommunityDeletionStepActivity    CommunityDeletionStep = "activity"
+	CommunityDeletionStepReadMarker  CommunityDeletionStep = "read_marker"
+	CommunityDeletionStepContent     CommunityDeletionStep = "content"
+	CommunityDeletionStepPost        CommunityDeletionStep = "post"
+	CommunityDeletionStepThread      CommunityDeletionStep = "thread"
+	CommunityDeletionStepTopic       CommunityDeletionStep = "topic"
+	CommunityDeletionStepPage        CommunityDeletionStep = "page"
+	CommunityDeletionStepTemplate    CommunityDeletionStep = "template"
+	CommunityDeletionStepNote        CommunityDeletionStep = "note"
+	CommunityDeletionStepInvite      CommunityDeletionStep = "invite"
+	CommunityDeletionStepMember      CommunityDeletionStep = "member"
+	CommunityDeletionStepRole        CommunityDeletionStep = "role"
+	CommunityDeletionStepCommunity   CommunityDeletionStep = "community"
+)
+
+var (
+	CommunityDeletionSteps = []CommunityDeletionStep{
+		CommunityDeletionStepSearchIndex,
+		CommunityDeletionStepActivity,
+		CommunityDeletionStepReadMarker,
+		CommunityDeletionStepContent,
+		CommunityDeletionStepPost,
+		CommunityDeletionStepThread,
+		CommunityDeletionStepTopic,
+		CommunityDeletionStepPage,
+		CommunityDeletionStepTemplate,
+		CommunityDeletionStepNote,
+		CommunityDeletionStepInvite,
+		CommunityDeletionStepMember,
+		CommunityDeletionStepRole,
+		CommunityDeletionStepCommunity,
+	}
+)
+
+// コミュニティの削除ジョブの進捗。Stepは次に実行するステップで、失敗した場合はそのステップから再開する
+type CommunityDeletion struct {
+	CommunityID uuid.UUID
+	Step        CommunityDeletionStep
+	Attempts    int
+	At          time.Time
+	LastError   *string
+}
+
+// 次のステップに進める。最後のステップの場合はfalseを返す
+func (m *CommunityDeletion) Next() bool {
+	index := slices.Index(CommunityDeletionSteps, m.Step)
+	if index < 0 || index+1 >= len(CommunityDeletionSteps) {
+		return false
+	}
+
+	m.Step = CommunityDeletionSteps[index+1]
+	m.Attempts = 0
+	m.LastError = nil
+
+	return true
 }
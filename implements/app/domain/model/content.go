@@ -1,7 +1,9 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
@@ -16,12 +18,16 @@ type ContentType string
 
 const (
 	ContentTypeCheckbox    ContentType = "checkbox"
+	ContentTypeCode        ContentType = "code"
 	ContentTypeHeading     ContentType = "heading"
 	ContentTypeImg         ContentType = "img"
 	ContentTypeLine        ContentType = "line"
 	ContentTypeList        ContentType = "list"
 	ContentTypeMention     ContentType = "mention"
+	ContentTypeMentions    ContentType = "mentions"
+	ContentTypeQuote       ContentType = "quote"
 	ContentTypeRadiobutton ContentType = "radiobutton"
+	ContentTypeTable       ContentType = "table"
 	ContentTypeText        ContentType = "text"
 )
 
@@ -35,12 +41,16 @@ func NewContentType(v string) (*ContentType, error) {
 	switch t {
 	case
 		ContentTypeCheckbox,
+		ContentTypeCode,
 		ContentTypeHeading,
 		ContentTypeImg,
 		ContentTypeLine,
 		ContentTypeList,
 		ContentTypeMention,
+		ContentTypeMentions,
+		ContentTypeQuote,
 		ContentTypeRadiobutton,
+		ContentTypeTable,
 		ContentTypeText:
 		return &t, nil
 	}
@@ -48,10 +58,74 @@ func NewContentType(v string) (*ContentType, error) {
 	return nil, fmt.Errorf("invalid argument. v=%v", v)
 }
 
+const (
+	MaxCodeLength   = 16384
+	MaxTableColumns = 20
+	MaxTableRows    = 100
+)
+
 type ContentValue []byte
 
-func NewContentValue(b []byte) (*ContentValue, error) {
+// コードと表は種類ごとの上限を超えるものを受け付けない
+func NewContentValue(contentType ContentType, b []byte) (*ContentValue, error) {
+	switch contentType {
+	case ContentTypeCode:
+		var code struct {
+			Source string `json:"source"`
+		}
+		if err := json.Unmarshal(b, &code); err != nil {
+			return nil, err
+		}
+
+		if utf8.RuneCountInString(code.Source) > MaxCodeLength {
+			return nil, fmt.Errorf("code is too long. length=%v", utf8.RuneCountInString(code.Source))
+		}
+	case ContentTypeTable:
+		var table struct {
+			Header []json.RawMessage   `json:"header"`
+			Rows   [][]json.RawMessage `json:"rows"`
+		}
+		if err := json.Unmarshal(b, &table); err != nil {
+			return nil, err
+		}
+
+		if len(table.Header) > MaxTableColumns {
+			return nil, fmt.Errorf("too many table columns. columns=%v", len(table.Header))
+		}
+
+		if len(table.Rows) > MaxTableRows {
+			return nil, fmt.Errorf("too many table rows. rows=%v", len(table.Rows))
+		}
+
+		for _, row := range table.Rows {
+			if len(row) > MaxTableColumns {
+				return nil, fmt.Errorf("too many table columns. columns=%v", len(row))
+			}
+		}
+	}
+
 	result := ContentValue(b)
 
 	return &result, nil
 }
+
+// QuotedPostID は引用の場合に引用元のポストを返す
+func (m *Content) QuotedPostID() (*uuid.UUID, error) {
+	if m.Type != ContentTypeQuote {
+		return nil, nil
+	}
+
+	var quote struct {
+		PostID string `json:"post_id"`
+	}
+	if err := json.Unmarshal(m.Value, &quote); err != nil {
+		return nil, err
+	}
+
+	postID, err := uuid.Parse(quote.PostID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid argument. v=%v", quote.PostID)
+	}
+
+	return &postID, nil
+}
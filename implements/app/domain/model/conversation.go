@@ -0,0 +1,46 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// 会話に参加できるユーザー数の上限（作成者を含む）
+const MaxConversationUsers = 10
+
+type ConversationUsers []uuid.UUID
+
+func NewConversationUsers(v []uuid.UUID) (ConversationUsers, error) {
+	users := lo.Uniq(v)
+	if len(users) < 2 || len(users) > MaxConversationUsers {
+		return nil, fmt.Errorf("invalid argument. v=%v", v)
+	}
+
+	return ConversationUsers(users), nil
+}
+
+type Conversation struct {
+	ID    uuid.UUID
+	Users ConversationUsers
+	At    UnixTime
+}
+
+func (m *Conversation) Joined(userID uuid.UUID) bool {
+	return lo.Contains(m.Users, userID)
+}
+
+type Message struct {
+	ID   uuid.UUID
+	At   UnixTime
+	From uuid.UUID
+}
+
+// 会話で最後に読んだメッセージ
+type MessageReadMarker struct {
+	ConversationID uuid.UUID
+	UserID         uuid.UUID
+	MessageID      uuid.UUID
+	At             UnixTime
+}
@@ -1,15 +1,90 @@
 package model
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Invite struct {
-	ID      uuid.UUID
-	RoleID  uuid.UUID
-	Message *Text
-	At      time.Time
-	Users   []uuid.UUID
+	ID       uuid.UUID
+	RoleID   uuid.UUID
+	Message  *Text
+	At       time.Time
+	ExpireAt *time.Time
+	Users    []uuid.UUID
+	Emails   []EmailAddress // まだログインしたことがないユーザーへの招待（初回ログイン時にUsersへ移る）
+}
+
+func (m *Invite) Expired(now time.Time) bool {
+	return m.ExpireAt != nil && !now.Before(*m.ExpireAt)
+}
+
+type InviteToken string
+
+func (m *InviteToken) String() string {
+	return string(*m)
+}
+
+func NewInviteToken(v string) (*InviteToken, error) {
+	re, err := regexp.Compile(`^[a-zA-Z0-9_\-]{43}$`)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !re.Match([]byte(v)) {
+		return nil, fmt.Errorf("invalid argument. v=%v", v)
+	}
+
+	result := InviteToken(v)
+	return &result, nil
+}
+
+// 推測されないように32バイトの乱数から生成する
+func GenerateInviteToken() (*InviteToken, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	return NewInviteToken(base64.RawURLEncoding.EncodeToString(b))
+}
+
+type InviteUses int
+
+func (m *InviteUses) Int() int {
+	return int(*m)
+}
+
+func NewInviteUses(v int) (*InviteUses, error) {
+	if v < 1 {
+		return nil, fmt.Errorf("invalid argument. v=%v", v)
+	}
+
+	result := InviteUses(v)
+	return &result, nil
+}
+
+// ロールに紐づく招待リンク（ExpireAtとMaxUsesがnilの場合は無制限）
+type InviteLink struct {
+	ID       uuid.UUID
+	RoleID   uuid.UUID
+	Token    InviteToken
+	At       time.Time
+	ExpireAt *time.Time
+	MaxUses  *InviteUses
+	Uses     int
+}
+
+func (m *InviteLink) Available(now time.Time) bool {
+	if m.ExpireAt != nil && !now.Before(*m.ExpireAt) {
+		return false
+	}
+
+	return m.MaxUses == nil || m.Uses < m.MaxUses.Int()
 }
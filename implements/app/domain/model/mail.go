@@ -0,0 +1,9 @@
+package model
+
+type Mail struct {
+	To      EmailAddress
+	Subject string
+	Body    string
+	// 空でなければテキストの代替として HTML も送る
+	HTMLBody string
+}
@@ -0,0 +1,19 @@
+package model
+
+import "github.com/google/uuid"
+
+// スレッドで最後に読んだポスト
+type ThreadReadMarker struct {
+	MemberID uuid.UUID
+	ThreadID uuid.UUID
+	PostID   uuid.UUID
+	At       UnixTime
+}
+
+// トピックで最後に読んだスレッド（Atは読んだポストの日時で、最後のポストがこれより後のスレッドを未読とする）
+type TopicReadMarker struct {
+	MemberID uuid.UUID
+	TopicID  uuid.UUID
+	ThreadID uuid.UUID
+	At       UnixTime
+}
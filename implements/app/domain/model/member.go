@@ -1,9 +1,26 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Member struct {
 	ID     uuid.UUID
 	UserID uuid.UUID
 	RoleID uuid.UUID
 }
+
+// コミュニティへの参加を禁止されたユーザー（ExpireAtがnilの場合は無期限）
+type Ban struct {
+	CommunityID uuid.UUID
+	UserID      uuid.UUID
+	Reason      *Text
+	At          time.Time
+	ExpireAt    *time.Time
+}
+
+func (m *Ban) Active(now time.Time) bool {
+	return m.ExpireAt == nil || now.Before(*m.ExpireAt)
+}
@@ -1,7 +1,9 @@
 package model
 
 import (
+	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -46,3 +48,130 @@ func NewLinePropertyType(v string) (*LinePropertyType, error) {
 
 	return nil, fmt.Errorf("invalid argument. v=%v", v)
 }
+
+// NoteVersion はある時点の全行の写し
+type NoteVersion struct {
+	ID        uuid.UUID
+	NoteID    uuid.UUID
+	Reason    NoteVersionReason
+	Lines     []NoteVersionLine
+	CreatedAt time.Time
+}
+
+// 1 つのノートに残す版の数。超えた分は古い版から消す
+const MaxNoteVersions = 100
+
+// NoteVersionLine の並び順が行番号を表す
+type NoteVersionLine struct {
+	Property *LineProperty
+	Contents []Content
+}
+
+// Equal は内容の ID を除いて比較する
+func (m NoteVersionLine) Equal(other NoteVersionLine) bool {
+	if (m.Property == nil) != (other.Property == nil) {
+		return false
+	}
+	if m.Property != nil && m.Property.Type != other.Property.Type {
+		return false
+	}
+	if len(m.Contents) != len(other.Contents) {
+		return false
+	}
+
+	for i, content := range m.Contents {
+		if content.Type != other.Contents[i].Type || !bytes.Equal(content.Value, other.Contents[i].Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type NoteVersionReason string
+
+const (
+	NoteVersionReasonPeriodic      NoteVersionReason = "periodic"
+	NoteVersionReasonSessionClosed NoteVersionReason = "session_closed"
+	NoteVersionReasonBeforeRestore NoteVersionReason = "before_restore"
+)
+
+func (m *NoteVersionReason) String() string {
+	return string(*m)
+}
+
+func NewNoteVersionReason(v string) (*NoteVersionReason, error) {
+	t := NoteVersionReason(v)
+
+	switch t {
+	case
+		NoteVersionReasonPeriodic,
+		NoteVersionReasonSessionClosed,
+		NoteVersionReasonBeforeRestore:
+		return &t, nil
+	}
+
+	return nil, fmt.Errorf("invalid argument. v=%v", v)
+}
+
+type LineDiffOperation string
+
+const (
+	LineDiffOperationUnchanged LineDiffOperation = "unchanged"
+	LineDiffOperationAdded     LineDiffOperation = "added"
+	LineDiffOperationRemoved   LineDiffOperation = "removed"
+)
+
+func (m *LineDiffOperation) String() string {
+	return string(*m)
+}
+
+// LineDiff の行番号は該当しない側を nil にする
+type LineDiff struct {
+	Operation LineDiffOperation
+	From      *OrderNumber
+	To        *OrderNumber
+	Line      NoteVersionLine
+}
+
+// DiffLines は最長共通部分列で行単位の差分を求める
+func DiffLines(from []NoteVersionLine, to []NoteVersionLine) []LineDiff {
+	lengths := make([][]int, len(from)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(to)+1)
+	}
+
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i].Equal(to[j]) {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	order := func(i int) *OrderNumber {
+		result := OrderNumber(i + 1)
+		return &result
+	}
+
+	diffs := []LineDiff{}
+	i, j := 0, 0
+	for i < len(from) || j < len(to) {
+		switch {
+		case i < len(from) && j < len(to) && from[i].Equal(to[j]):
+			diffs = append(diffs, LineDiff{Operation: LineDiffOperationUnchanged, From: order(i), To: order(j), Line: to[j]})
+			i++
+			j++
+		case j < len(to) && (i == len(from) || lengths[i][j+1] >= lengths[i+1][j]):
+			diffs = append(diffs, LineDiff{Operation: LineDiffOperationAdded, To: order(j), Line: to[j]})
+			j++
+		default:
+			diffs = append(diffs, LineDiff{Operation: LineDiffOperationRemoved, From: order(i), Line: from[i]})
+			i++
+		}
+	}
+
+	return diffs
+}
@@ -0,0 +1,30 @@
+package model
+
+import "github.com/google/uuid"
+
+// Page はコミュニティの Wiki のページで、本文はノートに持つ
+// Order は同じ親を持つページの中での並び順
+type Page struct {
+	ID       uuid.UUID
+	ParentID *uuid.UUID
+	Title    Name
+	Order    OrderNumber
+	Created  *uuid.UUID
+}
+
+// PageDescendantIDs は id のページと、その下にあるすべてのページの ID を返す
+func PageDescendantIDs(pages []Page, id uuid.UUID) []uuid.UUID {
+	children := map[uuid.UUID][]uuid.UUID{}
+	for _, page := range pages {
+		if page.ParentID != nil {
+			children[*page.ParentID] = append(children[*page.ParentID], page.ID)
+		}
+	}
+
+	ids := []uuid.UUID{id}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, children[ids[i]]...)
+	}
+
+	return ids
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type UserRelationType string
+
+func (m UserRelationType) String() string {
+	return string(m)
+}
+
+func NewUserRelationType(v string) (*UserRelationType, error) {
+	t := UserRelationType(v)
+	for _, relationType := range UserRelationTypes {
+		if t == relationType {
+			return &t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invalid argument. v=%v", v)
+}
+
+const (
+	UserRelationBlock UserRelationType = "block"
+	UserRelationMute  UserRelationType = "mute"
+)
+
+var (
+	UserRelationTypes = []UserRelationType{
+		UserRelationBlock,
+		UserRelationMute,
+	}
+)
+
+// UserIDのユーザーがTargetIDのユーザーをブロック/ミュートしている
+type UserRelation struct {
+	UserID   uuid.UUID
+	TargetID uuid.UUID
+	Type     UserRelationType
+}
+
+// どちらか一方が他方をブロックしているか
+func (m *UserRelation) Blocks(userID uuid.UUID, targetID uuid.UUID) bool {
+	if m.Type != UserRelationBlock {
+		return false
+	}
+
+	return (m.UserID == userID && m.TargetID == targetID) || (m.UserID == targetID && m.TargetID == userID)
+}
+
+// userIDのユーザーがtargetIDのユーザーをミュートしているか
+func (m *UserRelation) Mutes(userID uuid.UUID, targetID uuid.UUID) bool {
+	return m.Type == UserRelationMute && m.UserID == userID && m.TargetID == targetID
+}
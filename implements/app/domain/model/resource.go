@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 )
@@ -37,11 +38,14 @@ const (
 	ResourceTag       Resource = "tag"
 	ResourceElection  Resource = "election"
 	ResourceChoose    Resource = "choose"
+	ResourcePage      Resource = "page"
+	ResourceTemplate  Resource = "template"
 
 	// internal
 	ResourceLine    Resource = "line"
 	ResourceLike    Resource = "like"
 	ResourceDislike Resource = "dislike"
+	ResourceMessage Resource = "message"
 )
 
 var (
@@ -59,6 +63,8 @@ var (
 		ResourceTag,
 		ResourceElection,
 		ResourceChoose,
+		ResourcePage,
+		ResourceTemplate,
 	}
 )
 
@@ -66,4 +72,35 @@ type ResourceSearchIndex struct {
 	ResourceID uuid.UUID
 	Type       Resource
 	Keyword    Text
+	// ポストとトピックのみ、どこに誰が書いたかを持つ
+	CommunityID *uuid.UUID
+	TopicID     *uuid.UUID
+	ThreadID    *uuid.UUID
+	AuthorID    *uuid.UUID
+	// 本文は索引を作るときに内容から取り出す
+	Contents []Content
+	Body     SearchBody
+	// 検索結果のみ
+	Highlights []string
 }
+
+type SearchBody string
+
+func (m *SearchBody) String() string {
+	return string(*m)
+}
+
+// 長すぎる本文は切り詰めて索引する
+func NewSearchBody(v string) (*SearchBody, error) {
+	t := v
+	if len(t) > maxSearchBodyLength {
+		t = strings.ToValidUTF8(t[:maxSearchBodyLength], "")
+	}
+
+	result := SearchBody(t)
+	return &result, nil
+}
+
+const (
+	maxSearchBodyLength = 65536
+)
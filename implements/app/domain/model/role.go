@@ -30,6 +30,19 @@ func (m *Role) CanDelete(resource Resource) bool {
 	return m.can(resource, OperationDelete)
 }
 
+// Includes は other のすべての行動をこのロールでもできるかを返す
+func (m *Role) Includes(other Role) bool {
+	for resource, operations := range other.Action {
+		for _, operation := range operations {
+			if !m.can(resource, operation) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func (m *Role) can(resource Resource, operation Operation) bool {
 	for r, o := range m.Action {
 		if r == resource {
@@ -126,6 +139,8 @@ var (
 		ResourceElection:  []Operation{OperationCreate, OperationUpdate, OperationDelete},
 		ResourceChoose:    []Operation{OperationCreate, OperationUpdate, OperationDelete},
 		ResourceProject:   []Operation{OperationCreate, OperationUpdate, OperationDelete},
+		ResourcePage:      []Operation{OperationCreate, OperationUpdate, OperationDelete},
+		ResourceTemplate:  []Operation{OperationCreate, OperationUpdate, OperationDelete},
 	}
 
 	ProjectMemberAction = Action{
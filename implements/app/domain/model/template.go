@@ -0,0 +1,212 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// Template はトピック・ポスト・ノートのひな形
+// 内容に書いた {{name}} を作成時に Fields の値で置き換える
+type Template struct {
+	ID      uuid.UUID
+	Name    Name
+	Kind    TemplateKind
+	Title   *Name
+	Fields  []TemplateField
+	Lines   []TemplateLine
+	Created *uuid.UUID
+}
+
+// Resolve は置き換える値を決める
+// 省略した値は既定値か空文字にし、必須の値の省略と宣言していない値は受け付けない
+func (m Template) Resolve(values map[string]string) (map[string]string, error) {
+	for name := range values {
+		if _, ok := m.field(name); !ok {
+			return nil, fmt.Errorf("unknown field. name=%v", name)
+		}
+	}
+
+	resolved := map[string]string{}
+	for _, field := range m.Fields {
+		value, ok := values[field.Name.String()]
+		switch {
+		case ok:
+			resolved[field.Name.String()] = value
+		case field.Required:
+			return nil, fmt.Errorf("required field. name=%v", field.Name.String())
+		case field.Default != nil:
+			resolved[field.Name.String()] = *field.Default
+		default:
+			resolved[field.Name.String()] = ""
+		}
+	}
+
+	return resolved, nil
+}
+
+// Placeholders はタイトルと内容に含まれる置き換え先の名前を返す
+func (m Template) Placeholders() ([]string, error) {
+	names := []string{}
+	collect := func(v string) string {
+		for _, match := range templatePlaceholderPattern.FindAllStringSubmatch(v, -1) {
+			names = append(names, match[1])
+		}
+
+		return v
+	}
+
+	if _, err := m.replace(collect); err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// Fill は置き換えた値でタイトルと内容を作り直す
+func (m Template) Fill(values map[string]string) (*Template, error) {
+	return m.replace(func(v string) string {
+		return templatePlaceholderPattern.ReplaceAllStringFunc(v, func(placeholder string) string {
+			name := templatePlaceholderPattern.FindStringSubmatch(placeholder)[1]
+			if value, ok := values[name]; ok {
+				return value
+			}
+
+			return placeholder
+		})
+	})
+}
+
+// 内容は JSON の文字列の値のみを置き換え、構造は変えない
+func (m Template) replace(fn func(string) string) (*Template, error) {
+	result := m
+
+	if m.Title != nil {
+		title, err := NewName(fn(m.Title.String()))
+		if err != nil {
+			return nil, err
+		}
+
+		result.Title = title
+	}
+
+	result.Lines = []TemplateLine{}
+	for _, line := range m.Lines {
+		contents := []Content{}
+		for _, content := range line.Contents {
+			decoder := json.NewDecoder(bytes.NewReader(content.Value))
+			decoder.UseNumber()
+
+			var value any
+			if err := decoder.Decode(&value); err != nil {
+				return nil, fmt.Errorf("invalid content. type=%v err=%w", content.Type.String(), err)
+			}
+
+			var buf bytes.Buffer
+			encoder := json.NewEncoder(&buf)
+			encoder.SetEscapeHTML(false)
+			if err := encoder.Encode(replaceStrings(value, fn)); err != nil {
+				return nil, err
+			}
+
+			content.Value = bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+			contents = append(contents, content)
+		}
+
+		result.Lines = append(result.Lines, TemplateLine{
+			Property: line.Property,
+			Contents: contents,
+		})
+	}
+
+	return &result, nil
+}
+
+func replaceStrings(value any, fn func(string) string) any {
+	switch v := value.(type) {
+	case string:
+		return fn(v)
+	case []any:
+		for i := range v {
+			v[i] = replaceStrings(v[i], fn)
+		}
+	case map[string]any:
+		for key := range v {
+			v[key] = replaceStrings(v[key], fn)
+		}
+	}
+
+	return value
+}
+
+func (m Template) field(name string) (*TemplateField, bool) {
+	for _, field := range m.Fields {
+		if field.Name.String() == name {
+			return &field, true
+		}
+	}
+
+	return nil, false
+}
+
+type TemplateKind string
+
+const (
+	TemplateKindTopic TemplateKind = "topic"
+	TemplateKindPost  TemplateKind = "post"
+	TemplateKindNote  TemplateKind = "note"
+)
+
+func (m TemplateKind) String() string {
+	return string(m)
+}
+
+func NewTemplateKind(v string) (*TemplateKind, error) {
+	t := TemplateKind(v)
+
+	switch t {
+	case
+		TemplateKindTopic,
+		TemplateKindPost,
+		TemplateKindNote:
+		return &t, nil
+	}
+
+	return nil, fmt.Errorf("invalid argument. v=%v", v)
+}
+
+type TemplateField struct {
+	Name     TemplateFieldName
+	Required bool
+	Default  *string
+}
+
+type TemplateFieldName string
+
+func (m TemplateFieldName) String() string {
+	return string(m)
+}
+
+var (
+	templateFieldNamePattern   = regexp.MustCompile(`^[A-Za-z0-9_]{1,64}$`)
+	templatePlaceholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]{1,64})\s*\}\}`)
+)
+
+func NewTemplateFieldName(v string) (*TemplateFieldName, error) {
+	if !templateFieldNamePattern.MatchString(v) {
+		return nil, fmt.Errorf("invalid argument. v=%v", v)
+	}
+
+	result := TemplateFieldName(v)
+	return &result, nil
+}
+
+// TemplateLine の並び順が行番号を表す
+// トピックとポストのひな形は属性のない 1 行のみを持つ
+type TemplateLine struct {
+	Property *LineProperty
+	Contents []Content
+}
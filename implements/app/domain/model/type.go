@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -169,6 +170,7 @@ func NewText(v string) (*Text, error) {
 type Range struct {
 	Limit  int
 	Offset int
+	Cursor *Cursor
 }
 
 func NewRange(limit int, offset int) (*Range, error) {
@@ -186,6 +188,30 @@ func NewRange(limit int, offset int) (*Range, error) {
 	}, nil
 }
 
+// 前ページ末尾の要素を指し、以降の要素を取得する（指定時はOffsetを無視する）
+type Cursor struct {
+	At time.Time
+	ID uuid.UUID
+	// ID が一意でない一覧で、同じ時刻と ID の行の並び順を決める（一意な一覧では空）
+	Key string
+}
+
+func NewCursor(at time.Time, id string) (*Cursor, error) {
+	if at.IsZero() {
+		return nil, fmt.Errorf("invalid argument. v=%v", at)
+	}
+
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid argument. v=%v", id)
+	}
+
+	return &Cursor{
+		At: at,
+		ID: parsedID,
+	}, nil
+}
+
 type Mention struct {
 	ID       uuid.UUID
 	Resource Resource
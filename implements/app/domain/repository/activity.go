@@ -11,10 +11,12 @@ type ActivityRepository interface {
 	SaveUserLoginActivity(c context.Context, activity model.UserLoginActivity) error
 	SaveMemberActivity(c context.Context, activity model.MemberActivity) error
 	SaveMemberLikeActivity(c context.Context, activity model.MemberLikeActivity) error
-	ListMemberLikeActivity(c context.Context, target model.Mention, like bool, page model.Range) ([]model.MemberLikeActivity, error)
+	ListMemberLikeActivity(c context.Context, target model.Mention, like bool, page model.Range) ([]model.MemberLikeActivity, *model.Cursor, error)
 	CountMemberLikeActivity(c context.Context, target model.Mention, like bool) (*int, error)
-	ListUserLoginActivity(c context.Context, userID uuid.UUID, page model.Range) ([]model.UserLoginActivity, error)
-	ListMembersActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberActivity, error)
-	ListMembersLikeActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberLikeActivity, error)
+	CountMemberLikeActivityByTargets(c context.Context, targets []model.Mention, like bool) (map[uuid.UUID]int, error)
+	ListUserLoginActivity(c context.Context, userID uuid.UUID, page model.Range) ([]model.UserLoginActivity, *model.Cursor, error)
+	ListMembersActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberActivity, *model.Cursor, error)
+	ListMembersLikeActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberLikeActivity, *model.Cursor, error)
 	ListRecentMemberActivity(c context.Context, memberID uuid.UUID, page model.Range) ([]model.MemberActivity, error)
+	DeleteMemberActivities(c context.Context, memberIDs []uuid.UUID) error
 }
@@ -10,5 +10,14 @@ import (
 type CommunityRepository interface {
 	Create(c context.Context, community model.Community) error
 	Get(c context.Context, id uuid.UUID) (*model.Community, error)
+	List(c context.Context, ids []uuid.UUID) ([]model.Community, error)
+	ListPublic(c context.Context, name *string, page model.Range) ([]model.Community, *model.Cursor, error)
 	Update(c context.Context, community model.Community) error
+	Delete(c context.Context, id uuid.UUID) error
+}
+
+type CommunityDeletionRepository interface {
+	Save(c context.Context, deletion model.CommunityDeletion) error
+	ListPending(c context.Context, limit int) ([]model.CommunityDeletion, error)
+	Delete(c context.Context, communityID uuid.UUID) error
 }
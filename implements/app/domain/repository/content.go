@@ -14,5 +14,10 @@ type ContentRepository interface {
 	ListByTopic(c context.Context, topicID uuid.UUID) ([]model.Content, error)
 	ListByLine(c context.Context, lineID uuid.UUID) ([]model.Content, error)
 	ListByPost(c context.Context, postID uuid.UUID) ([]model.Content, error)
+	ListByTopics(c context.Context, topicIDs []uuid.UUID) (map[uuid.UUID][]model.Content, error)
+	ListByPosts(c context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]model.Content, error)
+	ListByMessages(c context.Context, messageIDs []uuid.UUID) (map[uuid.UUID][]model.Content, error)
 	DeleteByResource(c context.Context, mention model.Mention) error
+	ReplaceByLines(c context.Context, contents map[uuid.UUID][]model.Content) error
+	DeleteByLines(c context.Context, lineIDs []uuid.UUID) error
 }
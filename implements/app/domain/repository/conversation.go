@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"app/domain/model"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ConversationRepository interface {
+	Create(c context.Context, conversation model.Conversation) error
+	Get(c context.Context, id uuid.UUID) (*model.Conversation, error)
+	GetByUsers(c context.Context, userIDs []uuid.UUID) (*model.Conversation, error)
+	ListByUser(c context.Context, userID uuid.UUID, page model.Range) ([]model.Conversation, *model.Cursor, error)
+}
+
+type MessageRepository interface {
+	Create(c context.Context, message model.Message, conversationID uuid.UUID) error
+	GetByConversation(c context.Context, conversationID uuid.UUID, id uuid.UUID) (*model.Message, error)
+	ListByConversation(c context.Context, conversationID uuid.UUID, page model.Range) ([]model.Message, *model.Cursor, error)
+	CountAfterByConversations(c context.Context, userID uuid.UUID, after map[uuid.UUID]model.UnixTime) (map[uuid.UUID]int, error)
+}
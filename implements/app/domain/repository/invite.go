@@ -3,6 +3,7 @@ package repository
 import (
 	"app/domain/model"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,8 +12,24 @@ type InviteRepository interface {
 	Create(c context.Context, invite model.Invite) error
 	Get(c context.Context, id uuid.UUID) (*model.Invite, error)
 	GetByRoleAndUser(c context.Context, roleID uuid.UUID, userID uuid.UUID) (*model.Invite, error)
-	ListByRole(c context.Context, roleIDs []uuid.UUID, page model.Range) ([]model.Invite, error)
-	ListByUser(c context.Context, userID uuid.UUID, page model.Range) ([]model.Invite, error)
+	GetByRoleAndEmail(c context.Context, roleID uuid.UUID, email model.EmailAddress) (*model.Invite, error)
+	ListByRole(c context.Context, roleIDs []uuid.UUID, page model.Range) ([]model.Invite, *model.Cursor, error)
+	ListByUser(c context.Context, userID uuid.UUID, page model.Range) ([]model.Invite, *model.Cursor, error)
 	Delete(c context.Context, id uuid.UUID) error
 	DeleteInvitedUser(c context.Context, id uuid.UUID, userID uuid.UUID) error
+	Accept(c context.Context, id uuid.UUID, userID uuid.UUID, join func() error) error
+	DeleteExpired(c context.Context, now time.Time) (int, error)
+	AttachEmail(c context.Context, email model.EmailAddress, userID uuid.UUID) error
+	DeleteByRoles(c context.Context, roleIDs []uuid.UUID) error
+}
+
+type InviteLinkRepository interface {
+	Create(c context.Context, link model.InviteLink) error
+	Get(c context.Context, id uuid.UUID) (*model.InviteLink, error)
+	GetByToken(c context.Context, token model.InviteToken) (*model.InviteLink, error)
+	ListByRole(c context.Context, roleIDs []uuid.UUID, page model.Range) ([]model.InviteLink, *model.Cursor, error)
+	Use(c context.Context, id uuid.UUID, now time.Time, join func() error) (bool, error)
+	Delete(c context.Context, id uuid.UUID) error
+	DeleteExpired(c context.Context, now time.Time) (int, error)
+	DeleteByRoles(c context.Context, roleIDs []uuid.UUID) error
 }
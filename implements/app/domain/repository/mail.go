@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"app/domain/model"
+	"context"
+)
+
+type MailRepository interface {
+	Send(c context.Context, mail model.Mail) error
+}
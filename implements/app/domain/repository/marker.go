@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"app/domain/model"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ReadMarkerRepository interface {
+	SaveThread(c context.Context, marker model.ThreadReadMarker) error
+	SaveTopic(c context.Context, marker model.TopicReadMarker) error
+	ListThreadByMember(c context.Context, memberID uuid.UUID, threadIDs []uuid.UUID) (map[uuid.UUID]model.ThreadReadMarker, error)
+	ListTopicByMember(c context.Context, memberID uuid.UUID, topicIDs []uuid.UUID) (map[uuid.UUID]model.TopicReadMarker, error)
+	SaveMessage(c context.Context, marker model.MessageReadMarker) error
+	ListMessageByConversation(c context.Context, conversationID uuid.UUID) ([]model.MessageReadMarker, error)
+	ListMessageByUser(c context.Context, userID uuid.UUID, conversationIDs []uuid.UUID) (map[uuid.UUID]model.MessageReadMarker, error)
+	DeleteByMembers(c context.Context, memberIDs []uuid.UUID) error
+}
@@ -10,8 +10,22 @@ import (
 type MemberRepository interface {
 	Create(c context.Context, member model.Member, mention model.Mention) error
 	Get(c context.Context, id uuid.UUID) (*model.Member, error)
+	List(c context.Context, ids []uuid.UUID) ([]model.Member, error)
 	GetJoinedCommunityID(c context.Context, memberID uuid.UUID) (*uuid.UUID, error)
 	GetByCommunityAndUser(c context.Context, communityID uuid.UUID, userID uuid.UUID) (*model.Member, error)
-	ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Member, error)
+	ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Member, *model.Cursor, error)
 	ListByUser(c context.Context, userID uuid.UUID) ([]model.Member, error)
+	ListJoinedCommunityIDByUsers(c context.Context, userIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+	UpdateRole(c context.Context, id uuid.UUID, roleID uuid.UUID, managerRoleIDs []uuid.UUID) (bool, error)
+	Delete(c context.Context, id uuid.UUID, managerRoleIDs []uuid.UUID) (bool, error)
+	CountByCommunities(c context.Context, communityIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error)
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
+}
+
+type BanRepository interface {
+	Save(c context.Context, ban model.Ban) error
+	Get(c context.Context, communityID uuid.UUID, userID uuid.UUID) (*model.Ban, error)
+	Delete(c context.Context, communityID uuid.UUID, userID uuid.UUID) error
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
 }
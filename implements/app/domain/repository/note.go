@@ -17,4 +17,13 @@ type NoteRepository interface {
 	MoveLine(c context.Context, noteID uuid.UUID, src model.OrderNumber, dst model.OrderNumber) error
 	UpdateLine(c context.Context, line model.Line) error
 	DeleteLine(c context.Context, noteID uuid.UUID, order model.OrderNumber) (*model.Line, error)
+	InsertLines(c context.Context, noteID uuid.UUID, order model.OrderNumber, lines []model.Line, write func() error) ([]model.OrderNumber, error)
+	UpdateLines(c context.Context, noteID uuid.UUID, lines []model.Line, write func() error) (bool, error)
+	DeleteLines(c context.Context, noteID uuid.UUID, from model.OrderNumber, to model.OrderNumber, check func(count int) error) ([]model.Line, error)
+	ReplaceLines(c context.Context, noteID uuid.UUID, lines []model.Line) ([]model.Line, error)
+	Delete(c context.Context, id uuid.UUID) error
+	CreateVersion(c context.Context, version model.NoteVersion) error
+	GetVersion(c context.Context, noteID uuid.UUID, id uuid.UUID) (*model.NoteVersion, error)
+	GetLatestVersion(c context.Context, noteID uuid.UUID) (*model.NoteVersion, error)
+	ListVersions(c context.Context, noteID uuid.UUID, page model.Range) ([]model.NoteVersion, *model.Cursor, error)
 }
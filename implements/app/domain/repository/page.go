@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"app/domain/model"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type PageRepository interface {
+	Create(c context.Context, page model.Page, communityID uuid.UUID) error
+	Get(c context.Context, communityID uuid.UUID, id uuid.UUID) (*model.Page, error)
+	ListByCommunity(c context.Context, communityID uuid.UUID) ([]model.Page, error)
+	ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error)
+	Rename(c context.Context, id uuid.UUID, title model.Name) error
+	Move(c context.Context, communityID uuid.UUID, id uuid.UUID, parentID *uuid.UUID, order model.OrderNumber) error
+	Delete(c context.Context, communityID uuid.UUID, ids []uuid.UUID) error
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
+	SaveLinks(c context.Context, id uuid.UUID, targetIDs []uuid.UUID) error
+	ListBacklinks(c context.Context, id uuid.UUID) ([]model.Page, error)
+}
@@ -10,6 +10,13 @@ import (
 type PostRepository interface {
 	Create(c context.Context, post model.Post, topicID uuid.UUID, threadID uuid.UUID) error
 	Get(c context.Context, id uuid.UUID) (*model.Post, error)
+	GetInThread(c context.Context, topicID uuid.UUID, threadID uuid.UUID, id uuid.UUID) (*model.Post, error)
 	Last(c context.Context, topicID uuid.UUID) (*model.Post, error)
-	ListByThread(c context.Context, threadID uuid.UUID, page model.Range) ([]model.Post, error)
+	LastByTopics(c context.Context, topicIDs []uuid.UUID) (map[uuid.UUID]model.Post, error)
+	ListByThread(c context.Context, threadID uuid.UUID, page model.Range) ([]model.Post, *model.Cursor, error)
+	ListByThreads(c context.Context, threadIDs []uuid.UUID, limit int) (map[uuid.UUID][]model.Post, error)
+	CountAfterByThreads(c context.Context, after map[uuid.UUID]model.UnixTime) (map[uuid.UUID]int, error)
+	CountThreadAfterByTopics(c context.Context, after map[uuid.UUID]model.UnixTime) (map[uuid.UUID]int, error)
+	ListIDsByTopics(c context.Context, topicIDs []uuid.UUID) ([]uuid.UUID, error)
+	DeleteByTopics(c context.Context, topicIDs []uuid.UUID) error
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"app/domain/model"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type UserRelationRepository interface {
+	Save(c context.Context, relation model.UserRelation) error
+	Delete(c context.Context, userID uuid.UUID, targetID uuid.UUID) error
+	Get(c context.Context, userID uuid.UUID, targetID uuid.UUID) (*model.UserRelation, error)
+	ListByUser(c context.Context, userID uuid.UUID) ([]model.UserRelation, error)
+	ListInvolving(c context.Context, userID uuid.UUID) ([]model.UserRelation, error)
+	ListBetween(c context.Context, userIDs []uuid.UUID) ([]model.UserRelation, error)
+}
@@ -12,4 +12,20 @@ type ResourceSearchIndexRepository interface {
 	List(c context.Context, resourceTypes []model.Resource, freeword string, page model.Range) ([]model.ResourceSearchIndex, error)
 	Update(c context.Context, index model.ResourceSearchIndex) error
 	Delete(c context.Context, id uuid.UUID) error
+	// ListIDs は索引済みの ID を昇順に after の次から返す
+	ListIDs(c context.Context, resourceType model.Resource, after *uuid.UUID, limit int) ([]uuid.UUID, error)
+}
+
+// ResourceSearchIndexSourceRepository は索引の元になるリソースを RDB から ID の昇順に読む
+// 続きがある場合は次に渡す after を返す
+type ResourceSearchIndexSourceRepository interface {
+	List(c context.Context, resourceType model.Resource, after *uuid.UUID, limit int) ([]model.ResourceSearchIndex, *uuid.UUID, error)
+}
+
+// ResourceSearchIndexRebuildRepository は新しいインデックスを作り、書き終えてからエイリアスを切り替える
+type ResourceSearchIndexRebuildRepository interface {
+	Begin(c context.Context) (string, error)
+	Save(c context.Context, name string, indexes []model.ResourceSearchIndex) error
+	Commit(c context.Context, name string) error
+	Abort(c context.Context, name string) error
 }
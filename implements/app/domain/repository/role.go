@@ -16,4 +16,5 @@ type RoleRepository interface {
 	ListByCommunity(c context.Context, communityID uuid.UUID) ([]model.Role, error)
 	Update(c context.Context, role model.Role) error
 	Delete(c context.Context, id uuid.UUID) error
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"app/domain/model"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type TemplateRepository interface {
+	Create(c context.Context, template model.Template, communityID uuid.UUID) error
+	Get(c context.Context, communityID uuid.UUID, id uuid.UUID) (*model.Template, error)
+	ListByCommunity(c context.Context, communityID uuid.UUID) ([]model.Template, error)
+	Update(c context.Context, template model.Template) error
+	Delete(c context.Context, id uuid.UUID) error
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
+}
@@ -10,5 +10,7 @@ import (
 type ThreadRepository interface {
 	Create(c context.Context, thread model.Thread, topicID uuid.UUID) error
 	Get(c context.Context, id uuid.UUID) (*model.Thread, error)
-	ListByTopic(c context.Context, topicID uuid.UUID, page model.Range) ([]model.Thread, error)
+	ListByTopic(c context.Context, topicID uuid.UUID, page model.Range) ([]model.Thread, *model.Cursor, error)
+	ListLatestByTopic(c context.Context, topicID uuid.UUID, limit int) ([]model.Thread, error)
+	DeleteByTopics(c context.Context, topicIDs []uuid.UUID) error
 }
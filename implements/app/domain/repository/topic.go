@@ -10,5 +10,8 @@ import (
 type TopicRepository interface {
 	Create(c context.Context, topic model.Topic, communityID uuid.UUID) error
 	Get(c context.Context, id uuid.UUID) (*model.Topic, error)
-	ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Topic, error)
+	GetCommunityID(c context.Context, id uuid.UUID) (*uuid.UUID, error)
+	ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Topic, *model.Cursor, error)
+	ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error)
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
 }
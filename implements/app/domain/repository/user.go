@@ -11,5 +11,6 @@ type UserRepository interface {
 	Save(c context.Context, user model.User) error
 	Get(c context.Context, id uuid.UUID) (*model.User, error)
 	GetBySubject(c context.Context, subject model.Subject) (*model.User, error)
+	GetByEmail(c context.Context, email model.EmailAddress) (*model.User, error)
 	List(c context.Context, ids []uuid.UUID) ([]model.User, error)
 }
@@ -13,12 +13,14 @@ type ActivityService interface {
 	SaveUserLoginActivity(c context.Context, activity model.UserLoginActivity) error
 	SaveMemberActivity(c context.Context, activity model.MemberActivity) error
 	SaveMemberLikeActivity(c context.Context, activity model.MemberLikeActivity) error
-	ListMemberLikeActivity(c context.Context, target model.Mention, like bool, page model.Range) ([]model.MemberLikeActivity, error)
+	ListMemberLikeActivity(c context.Context, target model.Mention, like bool, page model.Range) ([]model.MemberLikeActivity, *model.Cursor, error)
 	CountMemberLikeActivity(c context.Context, target model.Mention, like bool) (*int, error)
-	ListUserLoginActivity(c context.Context, userID uuid.UUID, page model.Range) ([]model.UserLoginActivity, error)
-	ListMembersActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberActivity, error)
-	ListMembersLikeActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberLikeActivity, error)
+	CountMemberLikeActivityByTargets(c context.Context, targets []model.Mention, like bool) (map[uuid.UUID]int, error)
+	ListUserLoginActivity(c context.Context, userID uuid.UUID, page model.Range) ([]model.UserLoginActivity, *model.Cursor, error)
+	ListMembersActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberActivity, *model.Cursor, error)
+	ListMembersLikeActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberLikeActivity, *model.Cursor, error)
 	ListRecentMemberActivity(c context.Context, memberID uuid.UUID, page model.Range) ([]model.MemberActivity, error)
+	DeleteMemberActivities(c context.Context, memberIDs []uuid.UUID) error
 }
 
 type activityService struct {
@@ -31,22 +33,22 @@ func (a *activityService) ListRecentMemberActivity(c context.Context, memberID u
 }
 
 // ListMembersLikeActivity implements ActivityService.
-func (a *activityService) ListMembersLikeActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberLikeActivity, error) {
+func (a *activityService) ListMembersLikeActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberLikeActivity, *model.Cursor, error) {
 	return a.activityRepository.ListMembersLikeActivity(c, memberIDs, page)
 }
 
 // ListMembersActivity implements ActivityService.
-func (a *activityService) ListMembersActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberActivity, error) {
+func (a *activityService) ListMembersActivity(c context.Context, memberIDs []uuid.UUID, page model.Range) ([]model.MemberActivity, *model.Cursor, error) {
 	return a.activityRepository.ListMembersActivity(c, memberIDs, page)
 }
 
 // ListUserLoginActivity implements ActivityService.
-func (a *activityService) ListUserLoginActivity(c context.Context, userID uuid.UUID, page model.Range) ([]model.UserLoginActivity, error) {
+func (a *activityService) ListUserLoginActivity(c context.Context, userID uuid.UUID, page model.Range) ([]model.UserLoginActivity, *model.Cursor, error) {
 	return a.activityRepository.ListUserLoginActivity(c, userID, page)
 }
 
 // ListMemberLikeActivity implements ActivityService.
-func (a *activityService) ListMemberLikeActivity(c context.Context, target model.Mention, like bool, page model.Range) ([]model.MemberLikeActivity, error) {
+func (a *activityService) ListMemberLikeActivity(c context.Context, target model.Mention, like bool, page model.Range) ([]model.MemberLikeActivity, *model.Cursor, error) {
 	return a.activityRepository.ListMemberLikeActivity(c, target, like, page)
 }
 
@@ -55,6 +57,11 @@ func (a *activityService) CountMemberLikeActivity(c context.Context, target mode
 	return a.activityRepository.CountMemberLikeActivity(c, target, like)
 }
 
+// CountMemberLikeActivityByTargets implements ActivityService.
+func (a *activityService) CountMemberLikeActivityByTargets(c context.Context, targets []model.Mention, like bool) (map[uuid.UUID]int, error) {
+	return a.activityRepository.CountMemberLikeActivityByTargets(c, targets, like)
+}
+
 // SaveMemberLikeActivity implements ActivityService.
 func (a *activityService) SaveMemberLikeActivity(c context.Context, activity model.MemberLikeActivity) error {
 	return a.activityRepository.SaveMemberLikeActivity(c, activity)
@@ -70,6 +77,11 @@ func (a *activityService) SaveUserLoginActivity(c context.Context, activity mode
 	return a.activityRepository.SaveUserLoginActivity(c, activity)
 }
 
+// DeleteMemberActivities implements ActivityService.
+func (a *activityService) DeleteMemberActivities(c context.Context, memberIDs []uuid.UUID) error {
+	return a.activityRepository.DeleteMemberActivities(c, memberIDs)
+}
+
 func NewActivityService(i *do.Injector) (ActivityService, error) {
 	activityRepository := do.MustInvoke[repository.ActivityRepository](i)
 	return &activityService{activityRepository: activityRepository}, nil
@@ -12,7 +12,10 @@ import (
 type CommunityService interface {
 	Create(c context.Context, community model.Community) error
 	Get(c context.Context, id uuid.UUID) (*model.Community, error)
+	List(c context.Context, ids []uuid.UUID) ([]model.Community, error)
+	ListPublic(c context.Context, name *string, page model.Range) ([]model.Community, *model.Cursor, error)
 	Update(c context.Context, community model.Community) error
+	Delete(c context.Context, id uuid.UUID) error
 }
 
 type communityService struct {
@@ -29,12 +32,57 @@ func (co *communityService) Get(c context.Context, id uuid.UUID) (*model.Communi
 	return co.communityRepository.Get(c, id)
 }
 
+// List implements CommunityService.
+func (co *communityService) List(c context.Context, ids []uuid.UUID) ([]model.Community, error) {
+	return co.communityRepository.List(c, ids)
+}
+
+// ListPublic implements CommunityService.
+func (co *communityService) ListPublic(c context.Context, name *string, page model.Range) ([]model.Community, *model.Cursor, error) {
+	return co.communityRepository.ListPublic(c, name, page)
+}
+
 // Create implements CommunityService.
 func (co *communityService) Create(c context.Context, community model.Community) error {
 	return co.communityRepository.Create(c, community)
 }
 
+// Delete implements CommunityService.
+func (co *communityService) Delete(c context.Context, id uuid.UUID) error {
+	return co.communityRepository.Delete(c, id)
+}
+
 func NewCommunityService(i *do.Injector) (CommunityService, error) {
 	communityRepository := do.MustInvoke[repository.CommunityRepository](i)
 	return &communityService{communityRepository: communityRepository}, nil
 }
+
+type CommunityDeletionService interface {
+	Save(c context.Context, deletion model.CommunityDeletion) error
+	ListPending(c context.Context, limit int) ([]model.CommunityDeletion, error)
+	Delete(c context.Context, communityID uuid.UUID) error
+}
+
+type communityDeletionService struct {
+	communityDeletionRepository repository.CommunityDeletionRepository
+}
+
+// Save implements CommunityDeletionService.
+func (co *communityDeletionService) Save(c context.Context, deletion model.CommunityDeletion) error {
+	return co.communityDeletionRepository.Save(c, deletion)
+}
+
+// ListPending implements CommunityDeletionService.
+func (co *communityDeletionService) ListPending(c context.Context, limit int) ([]model.CommunityDeletion, error) {
+	return co.communityDeletionRepository.ListPending(c, limit)
+}
+
+// Delete implements CommunityDeletionService.
+func (co *communityDeletionService) Delete(c context.Context, communityID uuid.UUID) error {
+	return co.communityDeletionRepository.Delete(c, communityID)
+}
+
+func NewCommunityDeletionService(i *do.Injector) (CommunityDeletionService, error) {
+	communityDeletionRepository := do.MustInvoke[repository.CommunityDeletionRepository](i)
+	return &communityDeletionService{communityDeletionRepository: communityDeletionRepository}, nil
+}
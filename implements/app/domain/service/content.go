@@ -16,7 +16,12 @@ type ContentService interface {
 	ListByTopic(c context.Context, topicID uuid.UUID) ([]model.Content, error)
 	ListByLine(c context.Context, lineID uuid.UUID) ([]model.Content, error)
 	ListByPost(c context.Context, postID uuid.UUID) ([]model.Content, error)
+	ListByTopics(c context.Context, topicIDs []uuid.UUID) (map[uuid.UUID][]model.Content, error)
+	ListByPosts(c context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]model.Content, error)
+	ListByMessages(c context.Context, messageIDs []uuid.UUID) (map[uuid.UUID][]model.Content, error)
 	DeleteByResource(c context.Context, mention model.Mention) error
+	ReplaceByLines(c context.Context, contents map[uuid.UUID][]model.Content) error
+	DeleteByLines(c context.Context, lineIDs []uuid.UUID) error
 }
 
 type contentService struct {
@@ -48,6 +53,21 @@ func (co *contentService) ListByTopic(c context.Context, topicID uuid.UUID) ([]m
 	return co.contentRepository.ListByTopic(c, topicID)
 }
 
+// ListByTopics implements ContentService.
+func (co *contentService) ListByTopics(c context.Context, topicIDs []uuid.UUID) (map[uuid.UUID][]model.Content, error) {
+	return co.contentRepository.ListByTopics(c, topicIDs)
+}
+
+// ListByPosts implements ContentService.
+func (co *contentService) ListByPosts(c context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]model.Content, error) {
+	return co.contentRepository.ListByPosts(c, postIDs)
+}
+
+// ListByMessages implements ContentService.
+func (co *contentService) ListByMessages(c context.Context, messageIDs []uuid.UUID) (map[uuid.UUID][]model.Content, error) {
+	return co.contentRepository.ListByMessages(c, messageIDs)
+}
+
 // DeleteAndCreate implements ContentService.
 func (co *contentService) DeleteAndCreate(c context.Context, contents []model.Content, mention model.Mention) error {
 	return co.contentRepository.DeleteAndCreate(c, contents, mention)
@@ -58,6 +78,16 @@ func (co *contentService) List(c context.Context, ids []uuid.UUID) ([]model.Cont
 	return co.contentRepository.List(c, ids)
 }
 
+// ReplaceByLines implements ContentService.
+func (co *contentService) ReplaceByLines(c context.Context, contents map[uuid.UUID][]model.Content) error {
+	return co.contentRepository.ReplaceByLines(c, contents)
+}
+
+// DeleteByLines implements ContentService.
+func (co *contentService) DeleteByLines(c context.Context, lineIDs []uuid.UUID) error {
+	return co.contentRepository.DeleteByLines(c, lineIDs)
+}
+
 func NewContentService(i *do.Injector) (ContentService, error) {
 	contentRepository := do.MustInvoke[repository.ContentRepository](i)
 	return &contentService{contentRepository: contentRepository}, nil
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"app/domain/model"
+	"app/domain/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type ConversationService interface {
+	Create(c context.Context, conversation model.Conversation) error
+	Get(c context.Context, id uuid.UUID) (*model.Conversation, error)
+	GetByUsers(c context.Context, userIDs []uuid.UUID) (*model.Conversation, error)
+	ListByUser(c context.Context, userID uuid.UUID, page model.Range) ([]model.Conversation, *model.Cursor, error)
+}
+
+type conversationService struct {
+	conversationRepository repository.ConversationRepository
+}
+
+// Create implements ConversationService.
+func (co *conversationService) Create(c context.Context, conversation model.Conversation) error {
+	return co.conversationRepository.Create(c, conversation)
+}
+
+// Get implements ConversationService.
+func (co *conversationService) Get(c context.Context, id uuid.UUID) (*model.Conversation, error) {
+	return co.conversationRepository.Get(c, id)
+}
+
+// GetByUsers implements ConversationService.
+func (co *conversationService) GetByUsers(c context.Context, userIDs []uuid.UUID) (*model.Conversation, error) {
+	return co.conversationRepository.GetByUsers(c, userIDs)
+}
+
+// ListByUser implements ConversationService.
+func (co *conversationService) ListByUser(c context.Context, userID uuid.UUID, page model.Range) ([]model.Conversation, *model.Cursor, error) {
+	return co.conversationRepository.ListByUser(c, userID, page)
+}
+
+func NewConversationService(i *do.Injector) (ConversationService, error) {
+	conversationRepository := do.MustInvoke[repository.ConversationRepository](i)
+	return &conversationService{conversationRepository: conversationRepository}, nil
+}
+
+type MessageService interface {
+	Create(c context.Context, message model.Message, conversationID uuid.UUID) error
+	GetByConversation(c context.Context, conversationID uuid.UUID, id uuid.UUID) (*model.Message, error)
+	ListByConversation(c context.Context, conversationID uuid.UUID, page model.Range) ([]model.Message, *model.Cursor, error)
+	CountAfterByConversations(c context.Context, userID uuid.UUID, after map[uuid.UUID]model.UnixTime) (map[uuid.UUID]int, error)
+}
+
+type messageService struct {
+	messageRepository repository.MessageRepository
+}
+
+// Create implements MessageService.
+func (m *messageService) Create(c context.Context, message model.Message, conversationID uuid.UUID) error {
+	return m.messageRepository.Create(c, message, conversationID)
+}
+
+// GetByConversation implements MessageService.
+func (m *messageService) GetByConversation(c context.Context, conversationID uuid.UUID, id uuid.UUID) (*model.Message, error) {
+	return m.messageRepository.GetByConversation(c, conversationID, id)
+}
+
+// ListByConversation implements MessageService.
+func (m *messageService) ListByConversation(c context.Context, conversationID uuid.UUID, page model.Range) ([]model.Message, *model.Cursor, error) {
+	return m.messageRepository.ListByConversation(c, conversationID, page)
+}
+
+// CountAfterByConversations implements MessageService.
+func (m *messageService) CountAfterByConversations(c context.Context, userID uuid.UUID, after map[uuid.UUID]model.UnixTime) (map[uuid.UUID]int, error) {
+	return m.messageRepository.CountAfterByConversations(c, userID, after)
+}
+
+func NewMessageService(i *do.Injector) (MessageService, error) {
+	messageRepository := do.MustInvoke[repository.MessageRepository](i)
+	return &messageService{messageRepository: messageRepository}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"app/domain/model"
 	"app/domain/repository"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/do"
@@ -13,10 +14,15 @@ type InviteService interface {
 	Create(c context.Context, invite model.Invite) error
 	Get(c context.Context, id uuid.UUID) (*model.Invite, error)
 	GetByRoleAndUser(c context.Context, roleID uuid.UUID, userID uuid.UUID) (*model.Invite, error)
-	ListByRole(c context.Context, roleIDs []uuid.UUID, page model.Range) ([]model.Invite, error)
-	ListByUser(c context.Context, userID uuid.UUID, page model.Range) ([]model.Invite, error)
+	GetByRoleAndEmail(c context.Context, roleID uuid.UUID, email model.EmailAddress) (*model.Invite, error)
+	ListByRole(c context.Context, roleIDs []uuid.UUID, page model.Range) ([]model.Invite, *model.Cursor, error)
+	ListByUser(c context.Context, userID uuid.UUID, page model.Range) ([]model.Invite, *model.Cursor, error)
 	Delete(c context.Context, id uuid.UUID) error
 	DeleteInvitedUser(c context.Context, id uuid.UUID, userID uuid.UUID) error
+	Accept(c context.Context, id uuid.UUID, userID uuid.UUID, join func() error) error
+	DeleteExpired(c context.Context, now time.Time) (int, error)
+	AttachEmail(c context.Context, email model.EmailAddress, userID uuid.UUID) error
+	DeleteByRoles(c context.Context, roleIDs []uuid.UUID) error
 }
 
 type inviteService struct {
@@ -28,6 +34,11 @@ func (i *inviteService) GetByRoleAndUser(c context.Context, roleID uuid.UUID, us
 	return i.inviteRepository.GetByRoleAndUser(c, roleID, userID)
 }
 
+// GetByRoleAndEmail implements InviteService.
+func (i *inviteService) GetByRoleAndEmail(c context.Context, roleID uuid.UUID, email model.EmailAddress) (*model.Invite, error) {
+	return i.inviteRepository.GetByRoleAndEmail(c, roleID, email)
+}
+
 // Get implements InviteService.
 func (i *inviteService) Get(c context.Context, id uuid.UUID) (*model.Invite, error) {
 	return i.inviteRepository.Get(c, id)
@@ -48,17 +59,97 @@ func (i *inviteService) DeleteInvitedUser(c context.Context, id uuid.UUID, userI
 	return i.inviteRepository.DeleteInvitedUser(c, id, userID)
 }
 
+// Accept implements InviteService.
+func (i *inviteService) Accept(c context.Context, id uuid.UUID, userID uuid.UUID, join func() error) error {
+	return i.inviteRepository.Accept(c, id, userID, join)
+}
+
 // ListByRole implements InviteService.
-func (i *inviteService) ListByRole(c context.Context, roleIDs []uuid.UUID, page model.Range) ([]model.Invite, error) {
+func (i *inviteService) ListByRole(c context.Context, roleIDs []uuid.UUID, page model.Range) ([]model.Invite, *model.Cursor, error) {
 	return i.inviteRepository.ListByRole(c, roleIDs, page)
 }
 
 // ListByUser implements InviteService.
-func (i *inviteService) ListByUser(c context.Context, userID uuid.UUID, page model.Range) ([]model.Invite, error) {
+func (i *inviteService) ListByUser(c context.Context, userID uuid.UUID, page model.Range) ([]model.Invite, *model.Cursor, error) {
 	return i.inviteRepository.ListByUser(c, userID, page)
 }
 
+// DeleteExpired implements InviteService.
+func (i *inviteService) DeleteExpired(c context.Context, now time.Time) (int, error) {
+	return i.inviteRepository.DeleteExpired(c, now)
+}
+
+// AttachEmail implements InviteService.
+func (i *inviteService) AttachEmail(c context.Context, email model.EmailAddress, userID uuid.UUID) error {
+	return i.inviteRepository.AttachEmail(c, email, userID)
+}
+
+// DeleteByRoles implements InviteService.
+func (i *inviteService) DeleteByRoles(c context.Context, roleIDs []uuid.UUID) error {
+	return i.inviteRepository.DeleteByRoles(c, roleIDs)
+}
+
 func NewInviteService(i *do.Injector) (InviteService, error) {
 	inviteRepository := do.MustInvoke[repository.InviteRepository](i)
 	return &inviteService{inviteRepository: inviteRepository}, nil
 }
+
+type InviteLinkService interface {
+	Create(c context.Context, link model.InviteLink) error
+	Get(c context.Context, id uuid.UUID) (*model.InviteLink, error)
+	GetByToken(c context.Context, token model.InviteToken) (*model.InviteLink, error)
+	ListByRole(c context.Context, roleIDs []uuid.UUID, page model.Range) ([]model.InviteLink, *model.Cursor, error)
+	Use(c context.Context, id uuid.UUID, now time.Time, join func() error) (bool, error)
+	Delete(c context.Context, id uuid.UUID) error
+	DeleteExpired(c context.Context, now time.Time) (int, error)
+	DeleteByRoles(c context.Context, roleIDs []uuid.UUID) error
+}
+
+type inviteLinkService struct {
+	inviteLinkRepository repository.InviteLinkRepository
+}
+
+// Create implements InviteLinkService.
+func (i *inviteLinkService) Create(c context.Context, link model.InviteLink) error {
+	return i.inviteLinkRepository.Create(c, link)
+}
+
+// Get implements InviteLinkService.
+func (i *inviteLinkService) Get(c context.Context, id uuid.UUID) (*model.InviteLink, error) {
+	return i.inviteLinkRepository.Get(c, id)
+}
+
+// GetByToken implements InviteLinkService.
+func (i *inviteLinkService) GetByToken(c context.Context, token model.InviteToken) (*model.InviteLink, error) {
+	return i.inviteLinkRepository.GetByToken(c, token)
+}
+
+// ListByRole implements InviteLinkService.
+func (i *inviteLinkService) ListByRole(c context.Context, roleIDs []uuid.UUID, page model.Range) ([]model.InviteLink, *model.Cursor, error) {
+	return i.inviteLinkRepository.ListByRole(c, roleIDs, page)
+}
+
+// Use implements InviteLinkService.
+func (i *inviteLinkService) Use(c context.Context, id uuid.UUID, now time.Time, join func() error) (bool, error) {
+	return i.inviteLinkRepository.Use(c, id, now, join)
+}
+
+// Delete implements InviteLinkService.
+func (i *inviteLinkService) Delete(c context.Context, id uuid.UUID) error {
+	return i.inviteLinkRepository.Delete(c, id)
+}
+
+// DeleteExpired implements InviteLinkService.
+func (i *inviteLinkService) DeleteExpired(c context.Context, now time.Time) (int, error) {
+	return i.inviteLinkRepository.DeleteExpired(c, now)
+}
+
+// DeleteByRoles implements InviteLinkService.
+func (i *inviteLinkService) DeleteByRoles(c context.Context, roleIDs []uuid.UUID) error {
+	return i.inviteLinkRepository.DeleteByRoles(c, roleIDs)
+}
+
+func NewInviteLinkService(i *do.Injector) (InviteLinkService, error) {
+	inviteLinkRepository := do.MustInvoke[repository.InviteLinkRepository](i)
+	return &inviteLinkService{inviteLinkRepository: inviteLinkRepository}, nil
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"app/domain/model"
+	"app/domain/repository"
+	"context"
+
+	"github.com/samber/do"
+)
+
+type MailService interface {
+	Send(c context.Context, mail model.Mail) error
+}
+
+type mailService struct {
+	mailRepository repository.MailRepository
+}
+
+// Send implements MailService.
+func (m *mailService) Send(c context.Context, mail model.Mail) error {
+	return m.mailRepository.Send(c, mail)
+}
+
+func NewMailService(i *do.Injector) (MailService, error) {
+	mailRepository := do.MustInvoke[repository.MailRepository](i)
+	return &mailService{mailRepository: mailRepository}, nil
+}
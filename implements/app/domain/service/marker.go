@@ -0,0 +1,70 @@
+package service
+
+import (
+	"app/domain/model"
+	"app/domain/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type ReadMarkerService interface {
+	SaveThread(c context.Context, marker model.ThreadReadMarker) error
+	SaveTopic(c context.Context, marker model.TopicReadMarker) error
+	ListThreadByMember(c context.Context, memberID uuid.UUID, threadIDs []uuid.UUID) (map[uuid.UUID]model.ThreadReadMarker, error)
+	ListTopicByMember(c context.Context, memberID uuid.UUID, topicIDs []uuid.UUID) (map[uuid.UUID]model.TopicReadMarker, error)
+	SaveMessage(c context.Context, marker model.MessageReadMarker) error
+	ListMessageByConversation(c context.Context, conversationID uuid.UUID) ([]model.MessageReadMarker, error)
+	ListMessageByUser(c context.Context, userID uuid.UUID, conversationIDs []uuid.UUID) (map[uuid.UUID]model.MessageReadMarker, error)
+	DeleteByMembers(c context.Context, memberIDs []uuid.UUID) error
+}
+
+type readMarkerService struct {
+	readMarkerRepository repository.ReadMarkerRepository
+}
+
+// SaveThread implements ReadMarkerService.
+func (r *readMarkerService) SaveThread(c context.Context, marker model.ThreadReadMarker) error {
+	return r.readMarkerRepository.SaveThread(c, marker)
+}
+
+// SaveTopic implements ReadMarkerService.
+func (r *readMarkerService) SaveTopic(c context.Context, marker model.TopicReadMarker) error {
+	return r.readMarkerRepository.SaveTopic(c, marker)
+}
+
+// ListThreadByMember implements ReadMarkerService.
+func (r *readMarkerService) ListThreadByMember(c context.Context, memberID uuid.UUID, threadIDs []uuid.UUID) (map[uuid.UUID]model.ThreadReadMarker, error) {
+	return r.readMarkerRepository.ListThreadByMember(c, memberID, threadIDs)
+}
+
+// ListTopicByMember implements ReadMarkerService.
+func (r *readMarkerService) ListTopicByMember(c context.Context, memberID uuid.UUID, topicIDs []uuid.UUID) (map[uuid.UUID]model.TopicReadMarker, error) {
+	return r.readMarkerRepository.ListTopicByMember(c, memberID, topicIDs)
+}
+
+// SaveMessage implements ReadMarkerService.
+func (r *readMarkerService) SaveMessage(c context.Context, marker model.MessageReadMarker) error {
+	return r.readMarkerRepository.SaveMessage(c, marker)
+}
+
+// ListMessageByConversation implements ReadMarkerService.
+func (r *readMarkerService) ListMessageByConversation(c context.Context, conversationID uuid.UUID) ([]model.MessageReadMarker, error) {
+	return r.readMarkerRepository.ListMessageByConversation(c, conversationID)
+}
+
+// ListMessageByUser implements ReadMarkerService.
+func (r *readMarkerService) ListMessageByUser(c context.Context, userID uuid.UUID, conversationIDs []uuid.UUID) (map[uuid.UUID]model.MessageReadMarker, error) {
+	return r.readMarkerRepository.ListMessageByUser(c, userID, conversationIDs)
+}
+
+// DeleteByMembers implements ReadMarkerService.
+func (r *readMarkerService) DeleteByMembers(c context.Context, memberIDs []uuid.UUID) error {
+	return r.readMarkerRepository.DeleteByMembers(c, memberIDs)
+}
+
+func NewReadMarkerService(i *do.Injector) (ReadMarkerService, error) {
+	readMarkerRepository := do.MustInvoke[repository.ReadMarkerRepository](i)
+	return &readMarkerService{readMarkerRepository: readMarkerRepository}, nil
+}
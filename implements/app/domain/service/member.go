@@ -12,10 +12,17 @@ import (
 type MemberService interface {
 	Create(c context.Context, member model.Member, mention model.Mention) error
 	Get(c context.Context, id uuid.UUID) (*model.Member, error)
+	List(c context.Context, ids []uuid.UUID) ([]model.Member, error)
 	GetJoinedCommunityID(c context.Context, memberID uuid.UUID) (*uuid.UUID, error)
 	GetByCommunityAndUser(c context.Context, communityID uuid.UUID, userID uuid.UUID) (*model.Member, error)
-	ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Member, error)
+	ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Member, *model.Cursor, error)
 	ListByUser(c context.Context, userID uuid.UUID) ([]model.Member, error)
+	ListJoinedCommunityIDByUsers(c context.Context, userIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+	UpdateRole(c context.Context, id uuid.UUID, roleID uuid.UUID, managerRoleIDs []uuid.UUID) (bool, error)
+	Delete(c context.Context, id uuid.UUID, managerRoleIDs []uuid.UUID) (bool, error)
+	CountByCommunities(c context.Context, communityIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error)
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
 }
 
 type memberService struct {
@@ -27,6 +34,11 @@ func (m *memberService) GetJoinedCommunityID(c context.Context, memberID uuid.UU
 	return m.memberRepository.GetJoinedCommunityID(c, memberID)
 }
 
+// ListJoinedCommunityIDByUsers implements MemberService.
+func (m *memberService) ListJoinedCommunityIDByUsers(c context.Context, userIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	return m.memberRepository.ListJoinedCommunityIDByUsers(c, userIDs)
+}
+
 // ListByUser implements MemberService.
 func (m *memberService) ListByUser(c context.Context, userID uuid.UUID) ([]model.Member, error) {
 	return m.memberRepository.ListByUser(c, userID)
@@ -42,8 +54,13 @@ func (m *memberService) Get(c context.Context, id uuid.UUID) (*model.Member, err
 	return m.memberRepository.Get(c, id)
 }
 
+// List implements MemberService.
+func (m *memberService) List(c context.Context, ids []uuid.UUID) ([]model.Member, error) {
+	return m.memberRepository.List(c, ids)
+}
+
 // ListByCommunity implements MemberService.
-func (m *memberService) ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Member, error) {
+func (m *memberService) ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Member, *model.Cursor, error) {
 	return m.memberRepository.ListByCommunity(c, communityID, page)
 }
 
@@ -52,7 +69,68 @@ func (m *memberService) Create(c context.Context, member model.Member, mention m
 	return m.memberRepository.Create(c, member, mention)
 }
 
+// UpdateRole implements MemberService.
+func (m *memberService) UpdateRole(c context.Context, id uuid.UUID, roleID uuid.UUID, managerRoleIDs []uuid.UUID) (bool, error) {
+	return m.memberRepository.UpdateRole(c, id, roleID, managerRoleIDs)
+}
+
+// Delete implements MemberService.
+func (m *memberService) Delete(c context.Context, id uuid.UUID, managerRoleIDs []uuid.UUID) (bool, error) {
+	return m.memberRepository.Delete(c, id, managerRoleIDs)
+}
+
+// CountByCommunities implements MemberService.
+func (m *memberService) CountByCommunities(c context.Context, communityIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	return m.memberRepository.CountByCommunities(c, communityIDs)
+}
+
+// ListIDsByCommunity implements MemberService.
+func (m *memberService) ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error) {
+	return m.memberRepository.ListIDsByCommunity(c, communityID)
+}
+
+// DeleteByCommunity implements MemberService.
+func (m *memberService) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	return m.memberRepository.DeleteByCommunity(c, communityID)
+}
+
 func NewMemberService(i *do.Injector) (MemberService, error) {
 	memberRepository := do.MustInvoke[repository.MemberRepository](i)
 	return &memberService{memberRepository: memberRepository}, nil
 }
+
+type BanService interface {
+	Save(c context.Context, ban model.Ban) error
+	Get(c context.Context, communityID uuid.UUID, userID uuid.UUID) (*model.Ban, error)
+	Delete(c context.Context, communityID uuid.UUID, userID uuid.UUID) error
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
+}
+
+type banService struct {
+	banRepository repository.BanRepository
+}
+
+// Save implements BanService.
+func (b *banService) Save(c context.Context, ban model.Ban) error {
+	return b.banRepository.Save(c, ban)
+}
+
+// Get implements BanService.
+func (b *banService) Get(c context.Context, communityID uuid.UUID, userID uuid.UUID) (*model.Ban, error) {
+	return b.banRepository.Get(c, communityID, userID)
+}
+
+// Delete implements BanService.
+func (b *banService) Delete(c context.Context, communityID uuid.UUID, userID uuid.UUID) error {
+	return b.banRepository.Delete(c, communityID, userID)
+}
+
+// DeleteByCommunity implements BanService.
+func (b *banService) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	return b.banRepository.DeleteByCommunity(c, communityID)
+}
+
+func NewBanService(i *do.Injector) (BanService, error) {
+	banRepository := do.MustInvoke[repository.BanRepository](i)
+	return &banService{banRepository: banRepository}, nil
+}
@@ -19,6 +19,15 @@ type NoteService interface {
 	MoveLine(c context.Context, noteID uuid.UUID, src model.OrderNumber, dst model.OrderNumber) error
 	UpdateLine(c context.Context, line model.Line) error
 	DeleteLine(c context.Context, noteID uuid.UUID, order model.OrderNumber) (*model.Line, error)
+	InsertLines(c context.Context, noteID uuid.UUID, order model.OrderNumber, lines []model.Line, write func() error) ([]model.OrderNumber, error)
+	UpdateLines(c context.Context, noteID uuid.UUID, lines []model.Line, write func() error) (bool, error)
+	DeleteLines(c context.Context, noteID uuid.UUID, from model.OrderNumber, to model.OrderNumber, check func(count int) error) ([]model.Line, error)
+	ReplaceLines(c context.Context, noteID uuid.UUID, lines []model.Line) ([]model.Line, error)
+	Delete(c context.Context, id uuid.UUID) error
+	CreateVersion(c context.Context, version model.NoteVersion) error
+	GetVersion(c context.Context, noteID uuid.UUID, id uuid.UUID) (*model.NoteVersion, error)
+	GetLatestVersion(c context.Context, noteID uuid.UUID) (*model.NoteVersion, error)
+	ListVersions(c context.Context, noteID uuid.UUID, page model.Range) ([]model.NoteVersion, *model.Cursor, error)
 }
 
 type noteService struct {
@@ -70,6 +79,51 @@ func (n *noteService) Create(c context.Context, note model.Note, mention model.M
 	return n.noteRepository.Create(c, note, mention)
 }
 
+// Delete implements NoteService.
+func (n *noteService) Delete(c context.Context, id uuid.UUID) error {
+	return n.noteRepository.Delete(c, id)
+}
+
+// InsertLines implements NoteService.
+func (n *noteService) InsertLines(c context.Context, noteID uuid.UUID, order model.OrderNumber, lines []model.Line, write func() error) ([]model.OrderNumber, error) {
+	return n.noteRepository.InsertLines(c, noteID, order, lines, write)
+}
+
+// UpdateLines implements NoteService.
+func (n *noteService) UpdateLines(c context.Context, noteID uuid.UUID, lines []model.Line, write func() error) (bool, error) {
+	return n.noteRepository.UpdateLines(c, noteID, lines, write)
+}
+
+// DeleteLines implements NoteService.
+func (n *noteService) DeleteLines(c context.Context, noteID uuid.UUID, from model.OrderNumber, to model.OrderNumber, check func(count int) error) ([]model.Line, error) {
+	return n.noteRepository.DeleteLines(c, noteID, from, to, check)
+}
+
+// ReplaceLines implements NoteService.
+func (n *noteService) ReplaceLines(c context.Context, noteID uuid.UUID, lines []model.Line) ([]model.Line, error) {
+	return n.noteRepository.ReplaceLines(c, noteID, lines)
+}
+
+// CreateVersion implements NoteService.
+func (n *noteService) CreateVersion(c context.Context, version model.NoteVersion) error {
+	return n.noteRepository.CreateVersion(c, version)
+}
+
+// GetVersion implements NoteService.
+func (n *noteService) GetVersion(c context.Context, noteID uuid.UUID, id uuid.UUID) (*model.NoteVersion, error) {
+	return n.noteRepository.GetVersion(c, noteID, id)
+}
+
+// GetLatestVersion implements NoteService.
+func (n *noteService) GetLatestVersion(c context.Context, noteID uuid.UUID) (*model.NoteVersion, error) {
+	return n.noteRepository.GetLatestVersion(c, noteID)
+}
+
+// ListVersions implements NoteService.
+func (n *noteService) ListVersions(c context.Context, noteID uuid.UUID, page model.Range) ([]model.NoteVersion, *model.Cursor, error) {
+	return n.noteRepository.ListVersions(c, noteID, page)
+}
+
 func NewNoteService(i *do.Injector) (NoteService, error) {
 	noteRepository := do.MustInvoke[repository.NoteRepository](i)
 	return &noteService{noteRepository: noteRepository}, nil
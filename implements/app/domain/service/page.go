@@ -0,0 +1,82 @@
+package service
+
+import (
+	"app/domain/model"
+	"app/domain/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type PageService interface {
+	Create(c context.Context, page model.Page, communityID uuid.UUID) error
+	Get(c context.Context, communityID uuid.UUID, id uuid.UUID) (*model.Page, error)
+	ListByCommunity(c context.Context, communityID uuid.UUID) ([]model.Page, error)
+	ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error)
+	Rename(c context.Context, id uuid.UUID, title model.Name) error
+	Move(c context.Context, communityID uuid.UUID, id uuid.UUID, parentID *uuid.UUID, order model.OrderNumber) error
+	Delete(c context.Context, communityID uuid.UUID, ids []uuid.UUID) error
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
+	SaveLinks(c context.Context, id uuid.UUID, targetIDs []uuid.UUID) error
+	ListBacklinks(c context.Context, id uuid.UUID) ([]model.Page, error)
+}
+
+type pageService struct {
+	pageRepository repository.PageRepository
+}
+
+// Create implements PageService.
+func (p *pageService) Create(c context.Context, page model.Page, communityID uuid.UUID) error {
+	return p.pageRepository.Create(c, page, communityID)
+}
+
+// Get implements PageService.
+func (p *pageService) Get(c context.Context, communityID uuid.UUID, id uuid.UUID) (*model.Page, error) {
+	return p.pageRepository.Get(c, communityID, id)
+}
+
+// ListByCommunity implements PageService.
+func (p *pageService) ListByCommunity(c context.Context, communityID uuid.UUID) ([]model.Page, error) {
+	return p.pageRepository.ListByCommunity(c, communityID)
+}
+
+// ListIDsByCommunity implements PageService.
+func (p *pageService) ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error) {
+	return p.pageRepository.ListIDsByCommunity(c, communityID)
+}
+
+// Rename implements PageService.
+func (p *pageService) Rename(c context.Context, id uuid.UUID, title model.Name) error {
+	return p.pageRepository.Rename(c, id, title)
+}
+
+// Move implements PageService.
+func (p *pageService) Move(c context.Context, communityID uuid.UUID, id uuid.UUID, parentID *uuid.UUID, order model.OrderNumber) error {
+	return p.pageRepository.Move(c, communityID, id, parentID, order)
+}
+
+// Delete implements PageService.
+func (p *pageService) Delete(c context.Context, communityID uuid.UUID, ids []uuid.UUID) error {
+	return p.pageRepository.Delete(c, communityID, ids)
+}
+
+// DeleteByCommunity implements PageService.
+func (p *pageService) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	return p.pageRepository.DeleteByCommunity(c, communityID)
+}
+
+// SaveLinks implements PageService.
+func (p *pageService) SaveLinks(c context.Context, id uuid.UUID, targetIDs []uuid.UUID) error {
+	return p.pageRepository.SaveLinks(c, id, targetIDs)
+}
+
+// ListBacklinks implements PageService.
+func (p *pageService) ListBacklinks(c context.Context, id uuid.UUID) ([]model.Page, error) {
+	return p.pageRepository.ListBacklinks(c, id)
+}
+
+func NewPageService(i *do.Injector) (PageService, error) {
+	pageRepository := do.MustInvoke[repository.PageRepository](i)
+	return &pageService{pageRepository: pageRepository}, nil
+}
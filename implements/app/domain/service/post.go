@@ -12,8 +12,15 @@ import (
 type PostService interface {
 	Create(c context.Context, post model.Post, topicID uuid.UUID, threadID uuid.UUID) error
 	Get(c context.Context, id uuid.UUID) (*model.Post, error)
+	GetInThread(c context.Context, topicID uuid.UUID, threadID uuid.UUID, id uuid.UUID) (*model.Post, error)
 	Last(c context.Context, topicID uuid.UUID) (*model.Post, error)
-	ListByThread(c context.Context, threadID uuid.UUID, page model.Range) ([]model.Post, error)
+	LastByTopics(c context.Context, topicIDs []uuid.UUID) (map[uuid.UUID]model.Post, error)
+	ListByThread(c context.Context, threadID uuid.UUID, page model.Range) ([]model.Post, *model.Cursor, error)
+	ListByThreads(c context.Context, threadIDs []uuid.UUID, limit int) (map[uuid.UUID][]model.Post, error)
+	CountAfterByThreads(c context.Context, after map[uuid.UUID]model.UnixTime) (map[uuid.UUID]int, error)
+	CountThreadAfterByTopics(c context.Context, after map[uuid.UUID]model.UnixTime) (map[uuid.UUID]int, error)
+	ListIDsByTopics(c context.Context, topicIDs []uuid.UUID) ([]uuid.UUID, error)
+	DeleteByTopics(c context.Context, topicIDs []uuid.UUID) error
 }
 
 type postService struct {
@@ -25,6 +32,11 @@ func (p *postService) Last(c context.Context, topicID uuid.UUID) (*model.Post, e
 	return p.postRepository.Last(c, topicID)
 }
 
+// LastByTopics implements PostService.
+func (p *postService) LastByTopics(c context.Context, topicIDs []uuid.UUID) (map[uuid.UUID]model.Post, error) {
+	return p.postRepository.LastByTopics(c, topicIDs)
+}
+
 // Create implements PostService.
 func (p *postService) Create(c context.Context, post model.Post, topicID uuid.UUID, threadID uuid.UUID) error {
 	return p.postRepository.Create(c, post, topicID, threadID)
@@ -35,11 +47,41 @@ func (p *postService) Get(c context.Context, id uuid.UUID) (*model.Post, error)
 	return p.postRepository.Get(c, id)
 }
 
+// GetInThread implements PostService.
+func (p *postService) GetInThread(c context.Context, topicID uuid.UUID, threadID uuid.UUID, id uuid.UUID) (*model.Post, error) {
+	return p.postRepository.GetInThread(c, topicID, threadID, id)
+}
+
 // ListByThread implements PostService.
-func (p *postService) ListByThread(c context.Context, threadID uuid.UUID, page model.Range) ([]model.Post, error) {
+func (p *postService) ListByThread(c context.Context, threadID uuid.UUID, page model.Range) ([]model.Post, *model.Cursor, error) {
 	return p.postRepository.ListByThread(c, threadID, page)
 }
 
+// ListByThreads implements PostService.
+func (p *postService) ListByThreads(c context.Context, threadIDs []uuid.UUID, limit int) (map[uuid.UUID][]model.Post, error) {
+	return p.postRepository.ListByThreads(c, threadIDs, limit)
+}
+
+// CountAfterByThreads implements PostService.
+func (p *postService) CountAfterByThreads(c context.Context, after map[uuid.UUID]model.UnixTime) (map[uuid.UUID]int, error) {
+	return p.postRepository.CountAfterByThreads(c, after)
+}
+
+// CountThreadAfterByTopics implements PostService.
+func (p *postService) CountThreadAfterByTopics(c context.Context, after map[uuid.UUID]model.UnixTime) (map[uuid.UUID]int, error) {
+	return p.postRepository.CountThreadAfterByTopics(c, after)
+}
+
+// ListIDsByTopics implements PostService.
+func (p *postService) ListIDsByTopics(c context.Context, topicIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return p.postRepository.ListIDsByTopics(c, topicIDs)
+}
+
+// DeleteByTopics implements PostService.
+func (p *postService) DeleteByTopics(c context.Context, topicIDs []uuid.UUID) error {
+	return p.postRepository.DeleteByTopics(c, topicIDs)
+}
+
 func NewPostService(i *do.Injector) (PostService, error) {
 	postRepository := do.MustInvoke[repository.PostRepository](i)
 	return &postService{postRepository: postRepository}, nil
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"app/domain/model"
+	"app/domain/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type UserRelationService interface {
+	Save(c context.Context, relation model.UserRelation) error
+	Delete(c context.Context, userID uuid.UUID, targetID uuid.UUID) error
+	Get(c context.Context, userID uuid.UUID, targetID uuid.UUID) (*model.UserRelation, error)
+	ListByUser(c context.Context, userID uuid.UUID) ([]model.UserRelation, error)
+	ListInvolving(c context.Context, userID uuid.UUID) ([]model.UserRelation, error)
+	ListBetween(c context.Context, userIDs []uuid.UUID) ([]model.UserRelation, error)
+}
+
+type userRelationService struct {
+	userRelationRepository repository.UserRelationRepository
+}
+
+// Save implements UserRelationService.
+func (u *userRelationService) Save(c context.Context, relation model.UserRelation) error {
+	return u.userRelationRepository.Save(c, relation)
+}
+
+// Delete implements UserRelationService.
+func (u *userRelationService) Delete(c context.Context, userID uuid.UUID, targetID uuid.UUID) error {
+	return u.userRelationRepository.Delete(c, userID, targetID)
+}
+
+// Get implements UserRelationService.
+func (u *userRelationService) Get(c context.Context, userID uuid.UUID, targetID uuid.UUID) (*model.UserRelation, error) {
+	return u.userRelationRepository.Get(c, userID, targetID)
+}
+
+// ListByUser implements UserRelationService.
+func (u *userRelationService) ListByUser(c context.Context, userID uuid.UUID) ([]model.UserRelation, error) {
+	return u.userRelationRepository.ListByUser(c, userID)
+}
+
+// ListInvolving implements UserRelationService.
+func (u *userRelationService) ListInvolving(c context.Context, userID uuid.UUID) ([]model.UserRelation, error) {
+	return u.userRelationRepository.ListInvolving(c, userID)
+}
+
+// ListBetween implements UserRelationService.
+func (u *userRelationService) ListBetween(c context.Context, userIDs []uuid.UUID) ([]model.UserRelation, error) {
+	return u.userRelationRepository.ListBetween(c, userIDs)
+}
+
+func NewUserRelationService(i *do.Injector) (UserRelationService, error) {
+	userRelationRepository := do.MustInvoke[repository.UserRelationRepository](i)
+	return &userRelationService{userRelationRepository: userRelationRepository}, nil
+}
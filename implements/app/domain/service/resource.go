@@ -14,6 +14,7 @@ type ResourceSearchIndexService interface {
 	List(c context.Context, resourceTypes []model.Resource, freeword string, page model.Range) ([]model.ResourceSearchIndex, error)
 	Update(c context.Context, index model.ResourceSearchIndex) error
 	Delete(c context.Context, id uuid.UUID) error
+	ListIDs(c context.Context, resourceType model.Resource, after *uuid.UUID, limit int) ([]uuid.UUID, error)
 }
 
 type resourceSearchIndexService struct {
@@ -40,7 +41,59 @@ func (r *resourceSearchIndexService) List(c context.Context, resourceTypes []mod
 	return r.resourceSearchIndexRepository.List(c, resourceTypes, freeword, page)
 }
 
+// ListIDs implements ResourceSearchIndexService.
+func (r *resourceSearchIndexService) ListIDs(c context.Context, resourceType model.Resource, after *uuid.UUID, limit int) ([]uuid.UUID, error) {
+	return r.resourceSearchIndexRepository.ListIDs(c, resourceType, after, limit)
+}
+
 func NewResourceSearchIndexService(i *do.Injector) (ResourceSearchIndexService, error) {
 	resourceSearchIndexRepository := do.MustInvoke[repository.ResourceSearchIndexRepository](i)
 	return &resourceSearchIndexService{resourceSearchIndexRepository: resourceSearchIndexRepository}, nil
 }
+
+type ResourceSearchIndexRebuildService interface {
+	ListSource(c context.Context, resourceType model.Resource, after *uuid.UUID, limit int) ([]model.ResourceSearchIndex, *uuid.UUID, error)
+	Begin(c context.Context) (string, error)
+	Save(c context.Context, name string, indexes []model.ResourceSearchIndex) error
+	Commit(c context.Context, name string) error
+	Abort(c context.Context, name string) error
+}
+
+type resourceSearchIndexRebuildService struct {
+	resourceSearchIndexSourceRepository  repository.ResourceSearchIndexSourceRepository
+	resourceSearchIndexRebuildRepository repository.ResourceSearchIndexRebuildRepository
+}
+
+// ListSource implements ResourceSearchIndexRebuildService.
+func (r *resourceSearchIndexRebuildService) ListSource(c context.Context, resourceType model.Resource, after *uuid.UUID, limit int) ([]model.ResourceSearchIndex, *uuid.UUID, error) {
+	return r.resourceSearchIndexSourceRepository.List(c, resourceType, after, limit)
+}
+
+// Begin implements ResourceSearchIndexRebuildService.
+func (r *resourceSearchIndexRebuildService) Begin(c context.Context) (string, error) {
+	return r.resourceSearchIndexRebuildRepository.Begin(c)
+}
+
+// Save implements ResourceSearchIndexRebuildService.
+func (r *resourceSearchIndexRebuildService) Save(c context.Context, name string, indexes []model.ResourceSearchIndex) error {
+	return r.resourceSearchIndexRebuildRepository.Save(c, name, indexes)
+}
+
+// Commit implements ResourceSearchIndexRebuildService.
+func (r *resourceSearchIndexRebuildService) Commit(c context.Context, name string) error {
+	return r.resourceSearchIndexRebuildRepository.Commit(c, name)
+}
+
+// Abort implements ResourceSearchIndexRebuildService.
+func (r *resourceSearchIndexRebuildService) Abort(c context.Context, name string) error {
+	return r.resourceSearchIndexRebuildRepository.Abort(c, name)
+}
+
+func NewResourceSearchIndexRebuildService(i *do.Injector) (ResourceSearchIndexRebuildService, error) {
+	resourceSearchIndexSourceRepository := do.MustInvoke[repository.ResourceSearchIndexSourceRepository](i)
+	resourceSearchIndexRebuildRepository := do.MustInvoke[repository.ResourceSearchIndexRebuildRepository](i)
+	return &resourceSearchIndexRebuildService{
+		resourceSearchIndexSourceRepository:  resourceSearchIndexSourceRepository,
+		resourceSearchIndexRebuildRepository: resourceSearchIndexRebuildRepository,
+	}, nil
+}
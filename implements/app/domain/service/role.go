@@ -18,6 +18,7 @@ type RoleService interface {
 	ListByCommunity(c context.Context, communityID uuid.UUID) ([]model.Role, error)
 	Update(c context.Context, role model.Role) error
 	Delete(c context.Context, id uuid.UUID) error
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
 }
 
 type roleService struct {
@@ -64,6 +65,11 @@ func (r *roleService) Create(c context.Context, role model.Role, mention model.M
 	return r.roleRepository.Create(c, role, mention)
 }
 
+// DeleteByCommunity implements RoleService.
+func (r *roleService) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	return r.roleRepository.DeleteByCommunity(c, communityID)
+}
+
 func NewRoleService(i *do.Injector) (RoleService, error) {
 	roleRepository := do.MustInvoke[repository.RoleRepository](i)
 	return &roleService{roleRepository: roleRepository}, nil
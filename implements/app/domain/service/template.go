@@ -0,0 +1,58 @@
+package service
+
+import (
+	"app/domain/model"
+	"app/domain/repository"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/do"
+)
+
+type TemplateService interface {
+	Create(c context.Context, template model.Template, communityID uuid.UUID) error
+	Get(c context.Context, communityID uuid.UUID, id uuid.UUID) (*model.Template, error)
+	ListByCommunity(c context.Context, communityID uuid.UUID) ([]model.Template, error)
+	Update(c context.Context, template model.Template) error
+	Delete(c context.Context, id uuid.UUID) error
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
+}
+
+type templateService struct {
+	templateRepository repository.TemplateRepository
+}
+
+// Create implements TemplateService.
+func (t *templateService) Create(c context.Context, template model.Template, communityID uuid.UUID) error {
+	return t.templateRepository.Create(c, template, communityID)
+}
+
+// Get implements TemplateService.
+func (t *templateService) Get(c context.Context, communityID uuid.UUID, id uuid.UUID) (*model.Template, error) {
+	return t.templateRepository.Get(c, communityID, id)
+}
+
+// ListByCommunity implements TemplateService.
+func (t *templateService) ListByCommunity(c context.Context, communityID uuid.UUID) ([]model.Template, error) {
+	return t.templateRepository.ListByCommunity(c, communityID)
+}
+
+// Update implements TemplateService.
+func (t *templateService) Update(c context.Context, template model.Template) error {
+	return t.templateRepository.Update(c, template)
+}
+
+// Delete implements TemplateService.
+func (t *templateService) Delete(c context.Context, id uuid.UUID) error {
+	return t.templateRepository.Delete(c, id)
+}
+
+// DeleteByCommunity implements TemplateService.
+func (t *templateService) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	return t.templateRepository.DeleteByCommunity(c, communityID)
+}
+
+func NewTemplateService(i *do.Injector) (TemplateService, error) {
+	templateRepository := do.MustInvoke[repository.TemplateRepository](i)
+	return &templateService{templateRepository: templateRepository}, nil
+}
@@ -12,7 +12,9 @@ import (
 type ThreadService interface {
 	Create(c context.Context, thread model.Thread, topicID uuid.UUID) error
 	Get(c context.Context, id uuid.UUID) (*model.Thread, error)
-	ListByTopic(c context.Context, topicID uuid.UUID, page model.Range) ([]model.Thread, error)
+	ListByTopic(c context.Context, topicID uuid.UUID, page model.Range) ([]model.Thread, *model.Cursor, error)
+	ListLatestByTopic(c context.Context, topicID uuid.UUID, limit int) ([]model.Thread, error)
+	DeleteByTopics(c context.Context, topicIDs []uuid.UUID) error
 }
 
 type threadService struct {
@@ -30,10 +32,20 @@ func (t *threadService) Get(c context.Context, id uuid.UUID) (*model.Thread, err
 }
 
 // ListByTopic implements ThreadService.
-func (t *threadService) ListByTopic(c context.Context, topicID uuid.UUID, page model.Range) ([]model.Thread, error) {
+func (t *threadService) ListByTopic(c context.Context, topicID uuid.UUID, page model.Range) ([]model.Thread, *model.Cursor, error) {
 	return t.threadRepository.ListByTopic(c, topicID, page)
 }
 
+// ListLatestByTopic implements ThreadService.
+func (t *threadService) ListLatestByTopic(c context.Context, topicID uuid.UUID, limit int) ([]model.Thread, error) {
+	return t.threadRepository.ListLatestByTopic(c, topicID, limit)
+}
+
+// DeleteByTopics implements ThreadService.
+func (t *threadService) DeleteByTopics(c context.Context, topicIDs []uuid.UUID) error {
+	return t.threadRepository.DeleteByTopics(c, topicIDs)
+}
+
 func NewThreadService(i *do.Injector) (ThreadService, error) {
 	threadRepository := do.MustInvoke[repository.ThreadRepository](i)
 	return &threadService{threadRepository: threadRepository}, nil
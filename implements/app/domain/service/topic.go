@@ -12,7 +12,10 @@ import (
 type TopicService interface {
 	Create(c context.Context, topic model.Topic, communityID uuid.UUID) error
 	Get(c context.Context, id uuid.UUID) (*model.Topic, error)
-	ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Topic, error)
+	GetCommunityID(c context.Context, id uuid.UUID) (*uuid.UUID, error)
+	ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Topic, *model.Cursor, error)
+	ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error)
+	DeleteByCommunity(c context.Context, communityID uuid.UUID) error
 }
 
 type topicService struct {
@@ -29,11 +32,26 @@ func (t *topicService) Get(c context.Context, id uuid.UUID) (*model.Topic, error
 	return t.topicRepository.Get(c, id)
 }
 
+// GetCommunityID implements TopicService.
+func (t *topicService) GetCommunityID(c context.Context, id uuid.UUID) (*uuid.UUID, error) {
+	return t.topicRepository.GetCommunityID(c, id)
+}
+
 // ListByCommunity implements TopicService.
-func (t *topicService) ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Topic, error) {
+func (t *topicService) ListByCommunity(c context.Context, communityID uuid.UUID, page model.Range) ([]model.Topic, *model.Cursor, error) {
 	return t.topicRepository.ListByCommunity(c, communityID, page)
 }
 
+// ListIDsByCommunity implements TopicService.
+func (t *topicService) ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error) {
+	return t.topicRepository.ListIDsByCommunity(c, communityID)
+}
+
+// DeleteByCommunity implements TopicService.
+func (t *topicService) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	return t.topicRepository.DeleteByCommunity(c, communityID)
+}
+
 func NewTopicService(i *do.Injector) (TopicService, error) {
 	topicRepository := do.MustInvoke[repository.TopicRepository](i)
 	return &topicService{topicRepository: topicRepository}, nil
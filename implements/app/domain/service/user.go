@@ -13,6 +13,7 @@ type UserService interface {
 	Save(c context.Context, user model.User) error
 	Get(c context.Context, id uuid.UUID) (*model.User, error)
 	GetBySubject(c context.Context, subject model.Subject) (*model.User, error)
+	GetByEmail(c context.Context, email model.EmailAddress) (*model.User, error)
 	List(c context.Context, ids []uuid.UUID) ([]model.User, error)
 }
 
@@ -35,6 +36,11 @@ func (u *userService) GetBySubject(c context.Context, subject model.Subject) (*m
 	return u.userRepository.GetBySubject(c, subject)
 }
 
+// GetByEmail implements UserService.
+func (u *userService) GetByEmail(c context.Context, email model.EmailAddress) (*model.User, error) {
+	return u.userRepository.GetByEmail(c, email)
+}
+
 // Save implements UserService.
 func (u *userService) Save(c context.Context, user model.User) error {
 	return u.userRepository.Save(c, user)
@@ -24,18 +24,42 @@ const (
 	SessionScopes = "Session.Scopes"
 )
 
+// Defines values for CommunityStatus.
+const (
+	Active   CommunityStatus = "active"
+	Archived CommunityStatus = "archived"
+	Deleting CommunityStatus = "deleting"
+)
+
+// Defines values for CommunityVisibility.
+const (
+	Private  CommunityVisibility = "private"
+	Public   CommunityVisibility = "public"
+	Unlisted CommunityVisibility = "unlisted"
+)
+
 // Defines values for ContentType.
 const (
 	ContentTypeCheckbox    ContentType = "checkbox"
+	ContentTypeCode        ContentType = "code"
 	ContentTypeHeading     ContentType = "heading"
 	ContentTypeImg         ContentType = "img"
 	ContentTypeLine        ContentType = "line"
 	ContentTypeList        ContentType = "list"
 	ContentTypeMentions    ContentType = "mentions"
+	ContentTypeQuote       ContentType = "quote"
 	ContentTypeRadiobutton ContentType = "radiobutton"
+	ContentTypeTable       ContentType = "table"
 	ContentTypeText        ContentType = "text"
 )
 
+// Defines values for LineDiffOperation.
+const (
+	Added     LineDiffOperation = "added"
+	Removed   LineDiffOperation = "removed"
+	Unchanged LineDiffOperation = "unchanged"
+)
+
 // Defines values for LinePropertyType.
 const (
 	Blockquote LinePropertyType = "blockquote"
@@ -49,6 +73,13 @@ const (
 	Midpoint ListType = "midpoint"
 )
 
+// Defines values for NoteVersionReason.
+const (
+	BeforeRestore NoteVersionReason = "before_restore"
+	Periodic      NoteVersionReason = "periodic"
+	SessionClosed NoteVersionReason = "session_closed"
+)
+
 // Defines values for Operation.
 const (
 	OperationCreate Operation = "create"
@@ -58,10 +89,13 @@ const (
 
 // Defines values for RecieveType.
 const (
-	RecieveTypeDelete RecieveType = "delete"
-	RecieveTypeInsert RecieveType = "insert"
-	RecieveTypeMove   RecieveType = "move"
-	RecieveTypeUpdate RecieveType = "update"
+	RecieveTypeDelete       RecieveType = "delete"
+	RecieveTypeDeleteRange  RecieveType = "delete_range"
+	RecieveTypeInsert       RecieveType = "insert"
+	RecieveTypeInsertBatch  RecieveType = "insert_batch"
+	RecieveTypeMove         RecieveType = "move"
+	RecieveTypeReplaceBatch RecieveType = "replace_batch"
+	RecieveTypeUpdate       RecieveType = "update"
 )
 
 // Defines values for Resource.
@@ -72,11 +106,13 @@ const (
 	ResourceLike      Resource = "like"
 	ResourceMember    Resource = "member"
 	ResourceMilestone Resource = "milestone"
+	ResourcePage      Resource = "page"
 	ResourcePost      Resource = "post"
 	ResourceProject   Resource = "project"
 	ResourceRole      Resource = "role"
 	ResourceTag       Resource = "tag"
 	ResourceTask      Resource = "task"
+	ResourceTemplate  Resource = "template"
 	ResourceThread    Resource = "thread"
 	ResourceTopic     Resource = "topic"
 	ResourceUser      Resource = "user"
@@ -84,9 +120,46 @@ const (
 
 // Defines values for SendType.
 const (
-	Current SendType = "current"
+	Ack             SendType = "ack"
+	ConflictError   SendType = "conflict_error"
+	Current         SendType = "current"
+	NotFoundError   SendType = "not_found_error"
+	PermissionError SendType = "permission_error"
+	ValidationError SendType = "validation_error"
+)
+
+// Defines values for TemplateKind.
+const (
+	TemplateKindNote  TemplateKind = "note"
+	TemplateKindPost  TemplateKind = "post"
+	TemplateKindTopic TemplateKind = "topic"
+)
+
+// Defines values for UserRelationType.
+const (
+	Block UserRelationType = "block"
+	Mute  UserRelationType = "mute"
 )
 
+// AckMessage 受け取ったメッセージを適用した結果
+type AckMessage struct {
+	// Orders 複数行の操作で挿入・更新・削除した行の適用後の行番号（削除の場合は削除前の行番号、1 行の操作では空）
+	Orders []OrderNumber `json:"orders"`
+
+	// Seq クライアントが接続ごとに 1 から振る連番（最後に処理した番号と同じ場合はその応答を送り直し、それより前の番号はエラーにする）
+	Seq SequenceNumber `json:"seq"`
+
+	// Type 受け取るメッセージの種類
+	// * insert - 挿入
+	// * move - 移動
+	// * update - 更新
+	// * delete - 削除
+	// * insert_batch - 複数行の挿入
+	// * replace_batch - 複数行の更新
+	// * delete_range - 範囲の削除
+	Type RecieveType `json:"type"`
+}
+
 // Action 行動
 type Action struct {
 	Operations []Operation `json:"operations"`
@@ -106,6 +179,8 @@ type Action struct {
 	// * election - 投票
 	// * choose - 投票の選択肢
 	// * like - 支持/不支持
+	// * page - Wiki のページ
+	// * template - ひな形
 	Resource Resource `json:"resource"`
 }
 
@@ -148,6 +223,13 @@ type CheckBoxElement struct {
 	Value Text `json:"value"`
 }
 
+// Code コード
+type Code struct {
+	// Language 構文の強調に使う言語名
+	Language *string `json:"language,omitempty"`
+	Source   string  `json:"source"`
+}
+
 // ColorCode 16進数の色コード
 type ColorCode = string
 
@@ -156,20 +238,57 @@ type Community struct {
 	Id         ID   `json:"id"`
 	Invitation bool `json:"invitation"`
 	Name       Name `json:"name"`
+
+	// Status コミュニティの状態
+	// * active - 通常
+	// * archived - 読み取り専用
+	// * deleting - 削除中
+	Status *CommunityStatus `json:"status,omitempty"`
+
+	// Visibility コミュニティの公開範囲
+	// * public - ディレクトリに掲載する
+	// * unlisted - ディレクトリに掲載しないが、IDを知っていれば参照できる
+	// * private - メンバーのみ参照できる
+	Visibility *CommunityVisibility `json:"visibility,omitempty"`
 }
 
 // CommunityInvite コミュニティによる招待
 type CommunityInvite struct {
 	// At UNIX時間（秒単位）
-	At      UnixTime      `json:"at"`
-	Id      ID            `json:"id"`
-	Message *ShortMessage `json:"message,omitempty"`
+	At UnixTime `json:"at"`
+
+	// Emails まだログインしたことがないユーザーのメールアドレス
+	Emails []EmailAddress `json:"emails"`
+
+	// ExpireAt UNIX時間（秒単位）
+	ExpireAt *UnixTime     `json:"expire_at,omitempty"`
+	Id       ID            `json:"id"`
+	Message  *ShortMessage `json:"message,omitempty"`
 
 	// Role ロール
 	Role  Role   `json:"role"`
 	Users []User `json:"users"`
 }
 
+// CommunityStatus コミュニティの状態
+// * active - 通常
+// * archived - 読み取り専用
+// * deleting - 削除中
+type CommunityStatus string
+
+// CommunitySummary コミュニティの概要
+type CommunitySummary struct {
+	// Community コミュニティ
+	Community   Community `json:"community"`
+	MemberCount int       `json:"member_count"`
+}
+
+// CommunityVisibility コミュニティの公開範囲
+// * public - ディレクトリに掲載する
+// * unlisted - ディレクトリに掲載しないが、IDを知っていれば参照できる
+// * private - メンバーのみ参照できる
+type CommunityVisibility string
+
 // Content 内容
 type Content struct {
 	Entity Content_Entity `json:"entity"`
@@ -183,6 +302,9 @@ type Content struct {
 	// * line - 水平線
 	// * img - 画像
 	// * mentions - メンション
+	// * code - コード
+	// * table - 表
+	// * quote - ポストの引用
 	Type ContentType `json:"type"`
 }
 
@@ -200,20 +322,81 @@ type Content_Entity struct {
 // * line - 水平線
 // * img - 画像
 // * mentions - メンション
+// * code - コード
+// * table - 表
+// * quote - ポストの引用
 type ContentType string
 
+// Conversation ユーザー間の会話
+type Conversation struct {
+	// At UNIX時間（秒単位）
+	At UnixTime `json:"at"`
+	Id ID       `json:"id"`
+
+	// Unread 最後に読んだメッセージより後の、他の参加者からのメッセージの数
+	Unread int    `json:"unread"`
+	Users  []User `json:"users"`
+}
+
 // CurrentLinesMessage 現在の全行
 type CurrentLinesMessage = []Line
 
+// Cursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+type Cursor = string
+
 // DeletedLineMessage 削除した行
 type DeletedLineMessage struct {
 	// To 連番
 	To OrderNumber `json:"to"`
 }
 
+// DeletedLinesMessage 削除した範囲（両端を含む。すべての行は削除できず、存在しない行を含む場合はエラーにする）
+type DeletedLinesMessage struct {
+	// From 連番
+	From OrderNumber `json:"from"`
+
+	// To 連番
+	To OrderNumber `json:"to"`
+}
+
+// DirectMessage 会話のメッセージ
+type DirectMessage struct {
+	// At UNIX時間（秒単位）
+	At       UnixTime  `json:"at"`
+	Contents []Content `json:"contents"`
+
+	// From ユーザー
+	From *User `json:"from,omitempty"`
+	Id   ID    `json:"id"`
+
+	// ReadBy このメッセージを既読にした送信者以外の参加者
+	ReadBy []User `json:"read_by"`
+}
+
 // EditedLineMessage 行
 type EditedLineMessage = Line
 
+// EmailAddress defines model for EmailAddress.
+type EmailAddress = openapi_types.Email
+
+// ErrorMessage 受け取ったメッセージを適用できなかった理由（接続は維持する）
+type ErrorMessage struct {
+	Message string `json:"message"`
+
+	// Seq メッセージを解釈できなかった場合は省略
+	Seq *SequenceNumber `json:"seq,omitempty"`
+
+	// Type 受け取るメッセージの種類
+	// * insert - 挿入
+	// * move - 移動
+	// * update - 更新
+	// * delete - 削除
+	// * insert_batch - 複数行の挿入
+	// * replace_batch - 複数行の更新
+	// * delete_range - 範囲の削除
+	Type *RecieveType `json:"type,omitempty"`
+}
+
 // Experience 体験
 type Experience struct {
 	// Operation 操作
@@ -229,6 +412,9 @@ type Experience_Resource struct {
 	union json.RawMessage
 }
 
+// HTML 許可した要素と属性だけを含む HTML の断片
+type HTML = string
+
 // Heading 見出し
 type Heading struct {
 	// Level 見出しレベル
@@ -265,6 +451,35 @@ type InsertedLineMessage struct {
 	To OrderNumber `json:"to"`
 }
 
+// InsertedLinesMessage 指定した行から連続して挿入した行
+type InsertedLinesMessage struct {
+	Lines []LineBody `json:"lines"`
+
+	// To 連番
+	To OrderNumber `json:"to"`
+}
+
+// InviteLink コミュニティのロールへの招待リンク
+type InviteLink struct {
+	// At UNIX時間（秒単位）
+	At UnixTime `json:"at"`
+
+	// ExpireAt UNIX時間（秒単位）
+	ExpireAt *UnixTime `json:"expire_at,omitempty"`
+	Id       ID        `json:"id"`
+	MaxUses  *int      `json:"max_uses,omitempty"`
+
+	// Role ロール
+	Role Role `json:"role"`
+
+	// Token 招待リンクのトークン
+	Token InviteToken `json:"token"`
+	Uses  int         `json:"uses"`
+}
+
+// InviteToken 招待リンクのトークン
+type InviteToken = string
+
 // Like 支持/不支持
 type Like struct {
 	// By メンバー
@@ -287,6 +502,38 @@ type Line struct {
 	Property *LineProperty `json:"property,omitempty"`
 }
 
+// LineBody 行番号を持たない行
+type LineBody struct {
+	Contents []Content `json:"contents"`
+
+	// Property 行の属性
+	Property *LineProperty `json:"property,omitempty"`
+}
+
+// LineDiff 行の差分
+type LineDiff struct {
+	// From 比較元の行番号（追加の場合は省略）
+	From *OrderNumber `json:"from,omitempty"`
+
+	// Line 行
+	Line Line `json:"line"`
+
+	// Operation 行の差分の種類
+	// * unchanged - 変更なし
+	// * added - 追加
+	// * removed - 削除
+	Operation LineDiffOperation `json:"operation"`
+
+	// To 比較先の行番号（削除の場合は省略）
+	To *OrderNumber `json:"to,omitempty"`
+}
+
+// LineDiffOperation 行の差分の種類
+// * unchanged - 変更なし
+// * added - 追加
+// * removed - 削除
+type LineDiffOperation string
+
 // LineProperty 行の属性
 type LineProperty struct {
 	// Type 行の属性の種類
@@ -335,6 +582,9 @@ type Login struct {
 // LongMessage defines model for LongMessage.
 type LongMessage = string
 
+// Markdown CommonMark とタスクリストの記法で書かれた文書
+type Markdown = string
+
 // Member メンバー
 type Member struct {
 	Id ID `json:"id"`
@@ -365,6 +615,8 @@ type Mention struct {
 	// * election - 投票
 	// * choose - 投票の選択肢
 	// * like - 支持/不支持
+	// * page - Wiki のページ
+	// * template - ひな形
 	Resource Resource `json:"resource"`
 }
 
@@ -375,11 +627,17 @@ type Mentions = []Mention
 type MessagesToRecieve struct {
 	Entity MessagesToRecieve_Entity `json:"entity"`
 
+	// Seq クライアントが接続ごとに 1 から振る連番（最後に処理した番号と同じ場合はその応答を送り直し、それより前の番号はエラーにする）
+	Seq SequenceNumber `json:"seq"`
+
 	// Type 受け取るメッセージの種類
 	// * insert - 挿入
 	// * move - 移動
 	// * update - 更新
 	// * delete - 削除
+	// * insert_batch - 複数行の挿入
+	// * replace_batch - 複数行の更新
+	// * delete_range - 範囲の削除
 	Type RecieveType `json:"type"`
 }
 
@@ -394,6 +652,11 @@ type MessagesToSend struct {
 
 	// Type 送信されるメッセージの種類
 	// * current - 現在の全行
+	// * ack - 受け取ったメッセージを適用した
+	// * validation_error - メッセージが不正
+	// * not_found_error - 対象の行が存在しない
+	// * permission_error - 認可しない
+	// * conflict_error - 処理済みのメッセージや他の編集と競合する
 	Type SendType `json:"type"`
 }
 
@@ -414,6 +677,25 @@ type MovedLineMessage struct {
 // Name defines model for Name.
 type Name = string
 
+// NoteVersion ノートの版
+type NoteVersion struct {
+	// CreatedAt UNIX時間（秒単位）
+	CreatedAt UnixTime `json:"created_at"`
+	Id        ID       `json:"id"`
+
+	// Reason 版を保存した契機
+	// * periodic - 編集中の定期保存
+	// * session_closed - 編集の終了
+	// * before_restore - 以前の版に戻す直前
+	Reason NoteVersionReason `json:"reason"`
+}
+
+// NoteVersionReason 版を保存した契機
+// * periodic - 編集中の定期保存
+// * session_closed - 編集の終了
+// * before_restore - 以前の版に戻す直前
+type NoteVersionReason string
+
 // Offset defines model for Offset.
 type Offset = int
 
@@ -426,11 +708,38 @@ type Operation string
 // OrderNumber 連番
 type OrderNumber = int
 
+// Page Wiki のページ
+type Page struct {
+	CreatedBy *ID `json:"created_by,omitempty"`
+	Id        ID  `json:"id"`
+
+	// Order 同じ親を持つページの中での並び順（1 始まり）
+	Order    PageOrder `json:"order"`
+	ParentId *ID       `json:"parent_id,omitempty"`
+	Title    Name      `json:"title"`
+}
+
+// PageOrder 同じ親を持つページの中での並び順（1 始まり）
+type PageOrder = int
+
+// PageTree Wiki のページと、その子のページ（並び順）
+type PageTree struct {
+	Children []PageTree `json:"children"`
+	Id       ID         `json:"id"`
+
+	// Order 同じ親を持つページの中での並び順（1 始まり）
+	Order PageOrder `json:"order"`
+	Title Name      `json:"title"`
+}
+
 // Post ポスト
 type Post struct {
 	// At UNIX時間（秒単位）
-	At       UnixTime  `json:"at"`
-	Contents []Content `json:"contents"`
+	At UnixTime `json:"at"`
+
+	// Collapsed ミュートしているユーザーのポスト（折りたたんで表示する）
+	Collapsed bool      `json:"collapsed"`
+	Contents  []Content `json:"contents"`
 
 	// Created メンバー
 	Created *Member `json:"created,omitempty"`
@@ -440,6 +749,12 @@ type Post struct {
 	Reaction Reaction `json:"reaction"`
 }
 
+// Quote ポストの引用
+type Quote struct {
+	PostId ID          `json:"post_id"`
+	Value  LongMessage `json:"value"`
+}
+
 // RadioButton ラジオボタン
 type RadioButton struct {
 	Values []RadioButtonElement `json:"values"`
@@ -467,8 +782,16 @@ type Reaction struct {
 // * move - 移動
 // * update - 更新
 // * delete - 削除
+// * insert_batch - 複数行の挿入
+// * replace_batch - 複数行の更新
+// * delete_range - 範囲の削除
 type RecieveType string
 
+// ReplacedLinesMessage 内容を編集した行
+type ReplacedLinesMessage struct {
+	Lines []Line `json:"lines"`
+}
+
 // Resource リソース
 // * user - ユーザー
 // * community - コミュニティ
@@ -484,6 +807,8 @@ type RecieveType string
 // * election - 投票
 // * choose - 投票の選択肢
 // * like - 支持/不支持
+// * page - Wiki のページ
+// * template - ひな形
 type Resource string
 
 // Role ロール
@@ -495,11 +820,78 @@ type Role struct {
 
 // SendType 送信されるメッセージの種類
 // * current - 現在の全行
+// * ack - 受け取ったメッセージを適用した
+// * validation_error - メッセージが不正
+// * not_found_error - 対象の行が存在しない
+// * permission_error - 認可しない
+// * conflict_error - 処理済みのメッセージや他の編集と競合する
 type SendType string
 
+// SequenceNumber クライアントが接続ごとに 1 から振る連番（最後に処理した番号と同じ場合はその応答を送り直し、それより前の番号はエラーにする）
+type SequenceNumber = int
+
 // ShortMessage defines model for ShortMessage.
 type ShortMessage = string
 
+// Table 表（各行のセルの数は見出しの行と同じにする）
+type Table struct {
+	Header []Text   `json:"header"`
+	Rows   [][]Text `json:"rows"`
+}
+
+// Template ひな形
+type Template struct {
+	CreatedBy *ID             `json:"created_by,omitempty"`
+	Fields    []TemplateField `json:"fields"`
+	Id        ID              `json:"id"`
+
+	// Kind ひな形の種類
+	// * topic - トピック
+	// * post - ポスト
+	// * note - ノート
+	Kind TemplateKind `json:"kind"`
+
+	// Lines topic と post は 1 行のみ
+	Lines []LineBody `json:"lines"`
+	Name  Name       `json:"name"`
+	Title *Name      `json:"title,omitempty"`
+}
+
+// TemplateBody 作成・置き換えるひな形
+type TemplateBody struct {
+	Fields []TemplateField `json:"fields"`
+
+	// Kind ひな形の種類
+	// * topic - トピック
+	// * post - ポスト
+	// * note - ノート
+	Kind TemplateKind `json:"kind"`
+
+	// Lines topic と post は 1 行のみ
+	Lines []LineBody `json:"lines"`
+	Name  Name       `json:"name"`
+	Title *Name      `json:"title,omitempty"`
+}
+
+// TemplateField ひな形の置き換える値
+type TemplateField struct {
+	// Default 省略した場合の値（指定しない場合は空文字）
+	Default *string `json:"default,omitempty"`
+	Name    string  `json:"name"`
+
+	// Required 省略できない
+	Required bool `json:"required"`
+}
+
+// TemplateKind ひな形の種類
+// * topic - トピック
+// * post - ポスト
+// * note - ノート
+type TemplateKind string
+
+// TemplateValues ひな形の置き換える値（フィールド名と値）
+type TemplateValues map[string]string
+
 // Text テキスト
 type Text struct {
 	// Option テキストの属性
@@ -532,6 +924,9 @@ type Thread struct {
 	FirstPost Post `json:"first_post"`
 	Id        ID   `json:"id"`
 	Reply     bool `json:"reply"`
+
+	// Unread 最後に読んだポストより後のポストの数（メンバーでない場合は省略）
+	Unread *int `json:"unread,omitempty"`
 }
 
 // Topic 話題
@@ -545,6 +940,9 @@ type Topic struct {
 	// LastPost ポスト
 	LastPost *Post `json:"last_post,omitempty"`
 	Name     Name  `json:"name"`
+
+	// Unread 最後に読んだポストより後にポストがあるスレッドの数（メンバーでない場合は省略）
+	Unread *int `json:"unread,omitempty"`
 }
 
 // URL defines model for URL.
@@ -566,24 +964,69 @@ type UserInvite struct {
 	At UnixTime `json:"at"`
 
 	// Community コミュニティ
-	Community Community     `json:"community"`
-	Id        ID            `json:"id"`
-	Message   *ShortMessage `json:"message,omitempty"`
+	Community Community `json:"community"`
+
+	// ExpireAt UNIX時間（秒単位）
+	ExpireAt *UnixTime     `json:"expire_at,omitempty"`
+	Id       ID            `json:"id"`
+	Message  *ShortMessage `json:"message,omitempty"`
 
 	// Role ロール
 	Role Role `json:"role"`
 }
 
+// UserRelation ブロック/ミュートしているユーザー
+type UserRelation struct {
+	// Type ユーザーとの関係
+	// * block - ブロック
+	// * mute - ミュート
+	Type UserRelationType `json:"type"`
+
+	// User ユーザー
+	User User `json:"user"`
+}
+
+// UserRelationType ユーザーとの関係
+// * block - ブロック
+// * mute - ミュート
+type UserRelationType string
+
+// CreateCommunityInviteLinkResponse defines model for CreateCommunityInviteLinkResponse.
+type CreateCommunityInviteLinkResponse struct {
+	// Link コミュニティのロールへの招待リンク
+	Link InviteLink `json:"link"`
+}
+
 // CreateCommunityResponse defines model for CreateCommunityResponse.
 type CreateCommunityResponse struct {
 	Id ID `json:"id"`
 }
 
+// CreateConversationResponse defines model for CreateConversationResponse.
+type CreateConversationResponse struct {
+	Id ID `json:"id"`
+}
+
+// CreatePageResponse defines model for CreatePageResponse.
+type CreatePageResponse struct {
+	Id ID `json:"id"`
+}
+
+// CreateTemplateResponse defines model for CreateTemplateResponse.
+type CreateTemplateResponse struct {
+	Id ID `json:"id"`
+}
+
 // CreateTopicResponse defines model for CreateTopicResponse.
 type CreateTopicResponse struct {
 	Id ID `json:"id"`
 }
 
+// DiffNoteVersionResponse defines model for DiffNoteVersionResponse.
+type DiffNoteVersionResponse struct {
+	Lines []LineDiff `json:"lines"`
+}
+
 // GetCommunityMemberResponse defines model for GetCommunityMemberResponse.
 type GetCommunityMemberResponse struct {
 	// Member メンバー
@@ -591,20 +1034,55 @@ type GetCommunityMemberResponse struct {
 	RecentActivities []Activity `json:"recent_activities"`
 }
 
+// GetCommunityResponse defines model for GetCommunityResponse.
+type GetCommunityResponse struct {
+	// Community コミュニティの概要
+	Community CommunitySummary `json:"community"`
+}
+
+// GetPageResponse defines model for GetPageResponse.
+type GetPageResponse struct {
+	// Backlinks このページにリンクしているページ
+	Backlinks []Page `json:"backlinks"`
+
+	// Page Wiki のページ
+	Page Page `json:"page"`
+}
+
+// GetTemplateResponse defines model for GetTemplateResponse.
+type GetTemplateResponse struct {
+	// Template ひな形
+	Template Template `json:"template"`
+}
+
 // ListActionResponse defines model for ListActionResponse.
 type ListActionResponse struct {
 	Operations []Operation `json:"operations"`
 	Resources  []Resource  `json:"resources"`
 }
 
+// ListCommunityInviteLinkResponse defines model for ListCommunityInviteLinkResponse.
+type ListCommunityInviteLinkResponse struct {
+	Links []InviteLink `json:"links"`
+
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
+}
+
 // ListCommunityInviteResponse defines model for ListCommunityInviteResponse.
 type ListCommunityInviteResponse struct {
 	Invites []CommunityInvite `json:"invites"`
+
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
 }
 
 // ListCommunityMemberResponse defines model for ListCommunityMemberResponse.
 type ListCommunityMemberResponse struct {
 	Members []Member `json:"members"`
+
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
 }
 
 // ListCommunityRoleResponse defines model for ListCommunityRoleResponse.
@@ -612,45 +1090,147 @@ type ListCommunityRoleResponse struct {
 	Roles []Role `json:"roles"`
 }
 
+// ListConversationResponse defines model for ListConversationResponse.
+type ListConversationResponse struct {
+	Conversations []Conversation `json:"conversations"`
+
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
+}
+
+// ListDirectMessageResponse defines model for ListDirectMessageResponse.
+type ListDirectMessageResponse struct {
+	Messages []DirectMessage `json:"messages"`
+
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
+}
+
+// ListJoinedCommunityResponse defines model for ListJoinedCommunityResponse.
+type ListJoinedCommunityResponse struct {
+	Communities []CommunitySummary `json:"communities"`
+}
+
+// ListNoteVersionResponse defines model for ListNoteVersionResponse.
+type ListNoteVersionResponse struct {
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor       `json:"next_cursor,omitempty"`
+	Versions   []NoteVersion `json:"versions"`
+}
+
+// ListPageResponse defines model for ListPageResponse.
+type ListPageResponse struct {
+	Pages []PageTree `json:"pages"`
+}
+
 // ListPostLikeResponse defines model for ListPostLikeResponse.
 type ListPostLikeResponse struct {
 	Likes []Like `json:"likes"`
+
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
 }
 
 // ListPostResponse defines model for ListPostResponse.
 type ListPostResponse struct {
-	Posts []Post `json:"posts"`
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
+	Posts      []Post  `json:"posts"`
+}
+
+// ListPublicCommunityResponse defines model for ListPublicCommunityResponse.
+type ListPublicCommunityResponse struct {
+	Communities []CommunitySummary `json:"communities"`
+
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
+}
+
+// ListTemplateResponse defines model for ListTemplateResponse.
+type ListTemplateResponse struct {
+	Templates []Template `json:"templates"`
 }
 
 // ListThreadResponse defines model for ListThreadResponse.
 type ListThreadResponse struct {
-	Threads []Thread `json:"threads"`
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor  `json:"next_cursor,omitempty"`
+	Threads    []Thread `json:"threads"`
 }
 
 // ListTopicResponse defines model for ListTopicResponse.
 type ListTopicResponse struct {
-	Topics []Topic `json:"topics"`
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
+	Topics     []Topic `json:"topics"`
 }
 
 // ListUserActivityResponse defines model for ListUserActivityResponse.
 type ListUserActivityResponse struct {
 	Activities []Activity `json:"activities"`
+
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
 }
 
 // ListUserInviteResponse defines model for ListUserInviteResponse.
 type ListUserInviteResponse struct {
 	Invites []UserInvite `json:"invites"`
+
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
 }
 
 // ListUserLoginActivityResponse defines model for ListUserLoginActivityResponse.
 type ListUserLoginActivityResponse struct {
 	Activities []Activity `json:"activities"`
+
+	// NextCursor 一覧の続きを示す署名付きカーソル（指定時はoffsetを無視する）
+	NextCursor *Cursor `json:"next_cursor,omitempty"`
+}
+
+// ListUserRelationResponse defines model for ListUserRelationResponse.
+type ListUserRelationResponse struct {
+	Relations []UserRelation `json:"relations"`
+}
+
+// RedeemInviteLinkResponse defines model for RedeemInviteLinkResponse.
+type RedeemInviteLinkResponse struct {
+	// Community コミュニティ
+	Community Community `json:"community"`
+}
+
+// SendDirectMessageResponse defines model for SendDirectMessageResponse.
+type SendDirectMessageResponse struct {
+	Id ID `json:"id"`
+}
+
+// BanCommunityMemberRequest defines model for BanCommunityMemberRequest.
+type BanCommunityMemberRequest struct {
+	// ExpireAt UNIX時間（秒単位）
+	ExpireAt *UnixTime     `json:"expire_at,omitempty"`
+	Reason   *ShortMessage `json:"reason,omitempty"`
+}
+
+// CreateCommunityInviteLinkRequest defines model for CreateCommunityInviteLinkRequest.
+type CreateCommunityInviteLinkRequest struct {
+	// ExpireAt UNIX時間（秒単位）
+	ExpireAt *UnixTime `json:"expire_at,omitempty"`
+
+	// MaxUses 指定しない場合は無制限
+	MaxUses *int `json:"max_uses,omitempty"`
 }
 
 // CreateCommunityRequest defines model for CreateCommunityRequest.
 type CreateCommunityRequest struct {
 	Invitation bool `json:"invitation"`
 	Name       Name `json:"name"`
+
+	// Visibility コミュニティの公開範囲
+	// * public - ディレクトリに掲載する
+	// * unlisted - ディレクトリに掲載しないが、IDを知っていれば参照できる
+	// * private - メンバーのみ参照できる
+	Visibility *CommunityVisibility `json:"visibility,omitempty"`
 }
 
 // CreateCommunityRoleRequest defines model for CreateCommunityRoleRequest.
@@ -659,16 +1239,58 @@ type CreateCommunityRoleRequest struct {
 	Name    Name     `json:"name"`
 }
 
+// CreateConversationRequest defines model for CreateConversationRequest.
+type CreateConversationRequest struct {
+	// Users 自分以外の参加者
+	Users []ID `json:"users"`
+}
+
+// CreatePageFromTemplateRequest defines model for CreatePageFromTemplateRequest.
+type CreatePageFromTemplateRequest struct {
+	// Order 同じ親を持つページの中での並び順（1 始まり）
+	Order    *PageOrder `json:"order,omitempty"`
+	ParentId *ID        `json:"parent_id,omitempty"`
+	Title    *Name      `json:"title,omitempty"`
+
+	// Values ひな形の置き換える値（フィールド名と値）
+	Values TemplateValues `json:"values"`
+}
+
+// CreatePageRequest defines model for CreatePageRequest.
+type CreatePageRequest struct {
+	// Order 同じ親を持つページの中での並び順（1 始まり）
+	Order    *PageOrder `json:"order,omitempty"`
+	ParentId *ID        `json:"parent_id,omitempty"`
+	Title    Name       `json:"title"`
+}
+
+// CreatePostFromTemplateRequest defines model for CreatePostFromTemplateRequest.
+type CreatePostFromTemplateRequest struct {
+	// Values ひな形の置き換える値（フィールド名と値）
+	Values TemplateValues `json:"values"`
+}
+
 // CreatePostRequest defines model for CreatePostRequest.
 type CreatePostRequest struct {
 	Contents []Content `json:"contents"`
 }
 
+// CreateTemplateRequest 作成・置き換えるひな形
+type CreateTemplateRequest = TemplateBody
+
 // CreateThreadRequest defines model for CreateThreadRequest.
 type CreateThreadRequest struct {
 	Contents []Content `json:"contents"`
 }
 
+// CreateTopicFromTemplateRequest defines model for CreateTopicFromTemplateRequest.
+type CreateTopicFromTemplateRequest struct {
+	Name *Name `json:"name,omitempty"`
+
+	// Values ひな形の置き換える値（フィールド名と値）
+	Values TemplateValues `json:"values"`
+}
+
 // CreateTopicRequest defines model for CreateTopicRequest.
 type CreateTopicRequest struct {
 	Contents []Content `json:"contents"`
@@ -677,8 +1299,13 @@ type CreateTopicRequest struct {
 
 // InviteCommunityRoleRequest defines model for InviteCommunityRoleRequest.
 type InviteCommunityRoleRequest struct {
-	Mention Mentions     `json:"mention"`
-	Message ShortMessage `json:"message"`
+	// Emails メールアドレスで招待する（ログインしたことがないユーザーも招待できる。有効な招待がある場合は招待メールを送り直す）
+	Emails *[]EmailAddress `json:"emails,omitempty"`
+
+	// ExpireAt UNIX時間（秒単位）
+	ExpireAt *UnixTime    `json:"expire_at,omitempty"`
+	Mention  Mentions     `json:"mention"`
+	Message  ShortMessage `json:"message"`
 }
 
 // LikeRequest defines model for LikeRequest.
@@ -687,6 +1314,13 @@ type LikeRequest struct {
 	Like    bool          `json:"like"`
 }
 
+// MovePageRequest defines model for MovePageRequest.
+type MovePageRequest struct {
+	// Order 同じ親を持つページの中での並び順（1 始まり）
+	Order    PageOrder `json:"order"`
+	ParentId *ID       `json:"parent_id,omitempty"`
+}
+
 // ReplyUserInviteRequest defines model for ReplyUserInviteRequest.
 type ReplyUserInviteRequest struct {
 	// Agree 合意
@@ -695,9 +1329,38 @@ type ReplyUserInviteRequest struct {
 	Agree Agreement `json:"agree"`
 }
 
+// SaveUserRelationRequest defines model for SaveUserRelationRequest.
+type SaveUserRelationRequest struct {
+	// Type ユーザーとの関係
+	// * block - ブロック
+	// * mute - ミュート
+	Type UserRelationType `json:"type"`
+}
+
+// SendDirectMessageRequest defines model for SendDirectMessageRequest.
+type SendDirectMessageRequest struct {
+	Contents []Content `json:"contents"`
+}
+
+// TransferCommunityOwnershipRequest defines model for TransferCommunityOwnershipRequest.
+type TransferCommunityOwnershipRequest struct {
+	MemberId ID `json:"member_id"`
+}
+
+// UpdateCommunityMemberRequest defines model for UpdateCommunityMemberRequest.
+type UpdateCommunityMemberRequest struct {
+	RoleId ID `json:"role_id"`
+}
+
 // UpdateCommunityRequest defines model for UpdateCommunityRequest.
 type UpdateCommunityRequest struct {
 	Name Name `json:"name"`
+
+	// Visibility コミュニティの公開範囲
+	// * public - ディレクトリに掲載する
+	// * unlisted - ディレクトリに掲載しないが、IDを知っていれば参照できる
+	// * private - メンバーのみ参照できる
+	Visibility *CommunityVisibility `json:"visibility,omitempty"`
 }
 
 // UpdateCommunityRoleRequest defines model for UpdateCommunityRoleRequest.
@@ -706,27 +1369,77 @@ type UpdateCommunityRoleRequest struct {
 	Name    Name     `json:"name"`
 }
 
+// UpdatePageRequest defines model for UpdatePageRequest.
+type UpdatePageRequest struct {
+	Title Name `json:"title"`
+}
+
+// UpdateTemplateRequest 作成・置き換えるひな形
+type UpdateTemplateRequest = TemplateBody
+
+// ListPublicCommunityParams defines parameters for ListPublicCommunity.
+type ListPublicCommunityParams struct {
+	// Name コミュニティ名の部分一致で絞り込む
+	Name   *string `form:"name,omitempty" json:"name,omitempty"`
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
 // CreateCommunityJSONBody defines parameters for CreateCommunity.
 type CreateCommunityJSONBody struct {
 	Invitation bool `json:"invitation"`
 	Name       Name `json:"name"`
+
+	// Visibility コミュニティの公開範囲
+	// * public - ディレクトリに掲載する
+	// * unlisted - ディレクトリに掲載しないが、IDを知っていれば参照できる
+	// * private - メンバーのみ参照できる
+	Visibility *CommunityVisibility `json:"visibility,omitempty"`
 }
 
 // UpdateCommunityJSONBody defines parameters for UpdateCommunity.
 type UpdateCommunityJSONBody struct {
 	Name Name `json:"name"`
+
+	// Visibility コミュニティの公開範囲
+	// * public - ディレクトリに掲載する
+	// * unlisted - ディレクトリに掲載しないが、IDを知っていれば参照できる
+	// * private - メンバーのみ参照できる
+	Visibility *CommunityVisibility `json:"visibility,omitempty"`
 }
 
 // ListCommunityInviteParams defines parameters for ListCommunityInvite.
 type ListCommunityInviteParams struct {
-	Limit  Limit  `form:"limit" json:"limit"`
-	Offset Offset `form:"offset" json:"offset"`
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListCommunityInviteLinkParams defines parameters for ListCommunityInviteLink.
+type ListCommunityInviteLinkParams struct {
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // ListCommunityMemberParams defines parameters for ListCommunityMember.
 type ListCommunityMemberParams struct {
-	Limit  Limit  `form:"limit" json:"limit"`
-	Offset Offset `form:"offset" json:"offset"`
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// UpdateCommunityMemberJSONBody defines parameters for UpdateCommunityMember.
+type UpdateCommunityMemberJSONBody struct {
+	RoleId ID `json:"role_id"`
+}
+
+// BanCommunityMemberJSONBody defines parameters for BanCommunityMember.
+type BanCommunityMemberJSONBody struct {
+	// ExpireAt UNIX時間（秒単位）
+	ExpireAt *UnixTime     `json:"expire_at,omitempty"`
+	Reason   *ShortMessage `json:"reason,omitempty"`
 }
 
 // EditCommunityDescriptionParams defines parameters for EditCommunityDescription.
@@ -738,28 +1451,138 @@ type EditCommunityDescriptionParams struct {
 	SecWebSocketExtensions string `json:"Sec-WebSocket-Extensions"`
 }
 
-// CreateCommunityRoleJSONBody defines parameters for CreateCommunityRole.
-type CreateCommunityRoleJSONBody struct {
-	Actions []Action `json:"actions"`
-	Name    Name     `json:"name"`
+// ListCommunityDescriptionVersionParams defines parameters for ListCommunityDescriptionVersion.
+type ListCommunityDescriptionVersionParams struct {
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
-// UpdateCommunityRoleJSONBody defines parameters for UpdateCommunityRole.
-type UpdateCommunityRoleJSONBody struct {
-	Actions []Action `json:"actions"`
+// DiffCommunityDescriptionVersionParams defines parameters for DiffCommunityDescriptionVersion.
+type DiffCommunityDescriptionVersionParams struct {
+	From ID `form:"from" json:"from"`
+
+	// To 省略した場合は現在の内容と比較する
+	To *ID `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// TransferCommunityOwnershipJSONBody defines parameters for TransferCommunityOwnership.
+type TransferCommunityOwnershipJSONBody struct {
+	MemberId ID `json:"member_id"`
+}
+
+// CreateCommunityPageJSONBody defines parameters for CreateCommunityPage.
+type CreateCommunityPageJSONBody struct {
+	// Order 同じ親を持つページの中での並び順（1 始まり）
+	Order    *PageOrder `json:"order,omitempty"`
+	ParentId *ID        `json:"parent_id,omitempty"`
+	Title    Name       `json:"title"`
+}
+
+// UpdateCommunityPageJSONBody defines parameters for UpdateCommunityPage.
+type UpdateCommunityPageJSONBody struct {
+	Title Name `json:"title"`
+}
+
+// EditCommunityPageParams defines parameters for EditCommunityPage.
+type EditCommunityPageParams struct {
+	Connection             string `json:"Connection"`
+	Upgrade                string `json:"Upgrade"`
+	SecWebSocketKey        string `json:"Sec-WebSocket-Key"`
+	SecWebSocketVersion    string `json:"Sec-WebSocket-Version"`
+	SecWebSocketExtensions string `json:"Sec-WebSocket-Extensions"`
+}
+
+// ListCommunityPageVersionParams defines parameters for ListCommunityPageVersion.
+type ListCommunityPageVersionParams struct {
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// DiffCommunityPageVersionParams defines parameters for DiffCommunityPageVersion.
+type DiffCommunityPageVersionParams struct {
+	From ID `form:"from" json:"from"`
+
+	// To 省略した場合は現在の内容と比較する
+	To *ID `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// MoveCommunityPageJSONBody defines parameters for MoveCommunityPage.
+type MoveCommunityPageJSONBody struct {
+	// Order 同じ親を持つページの中での並び順（1 始まり）
+	Order    PageOrder `json:"order"`
+	ParentId *ID       `json:"parent_id,omitempty"`
+}
+
+// CreateCommunityRoleJSONBody defines parameters for CreateCommunityRole.
+type CreateCommunityRoleJSONBody struct {
+	Actions []Action `json:"actions"`
+	Name    Name     `json:"name"`
+}
+
+// UpdateCommunityRoleJSONBody defines parameters for UpdateCommunityRole.
+type UpdateCommunityRoleJSONBody struct {
+	Actions []Action `json:"actions"`
 	Name    Name     `json:"name"`
 }
 
 // InviteCommunityRoleJSONBody defines parameters for InviteCommunityRole.
 type InviteCommunityRoleJSONBody struct {
-	Mention Mentions     `json:"mention"`
-	Message ShortMessage `json:"message"`
+	// Emails メールアドレスで招待する（ログインしたことがないユーザーも招待できる。有効な招待がある場合は招待メールを送り直す）
+	Emails *[]EmailAddress `json:"emails,omitempty"`
+
+	// ExpireAt UNIX時間（秒単位）
+	ExpireAt *UnixTime    `json:"expire_at,omitempty"`
+	Mention  Mentions     `json:"mention"`
+	Message  ShortMessage `json:"message"`
+}
+
+// CreateCommunityInviteLinkJSONBody defines parameters for CreateCommunityInviteLink.
+type CreateCommunityInviteLinkJSONBody struct {
+	// ExpireAt UNIX時間（秒単位）
+	ExpireAt *UnixTime `json:"expire_at,omitempty"`
+
+	// MaxUses 指定しない場合は無制限
+	MaxUses *int `json:"max_uses,omitempty"`
+}
+
+// CreateCommunityPageFromTemplateJSONBody defines parameters for CreateCommunityPageFromTemplate.
+type CreateCommunityPageFromTemplateJSONBody struct {
+	// Order 同じ親を持つページの中での並び順（1 始まり）
+	Order    *PageOrder `json:"order,omitempty"`
+	ParentId *ID        `json:"parent_id,omitempty"`
+	Title    *Name      `json:"title,omitempty"`
+
+	// Values ひな形の置き換える値（フィールド名と値）
+	Values TemplateValues `json:"values"`
+}
+
+// CreateCommunityTopicFromTemplateJSONBody defines parameters for CreateCommunityTopicFromTemplate.
+type CreateCommunityTopicFromTemplateJSONBody struct {
+	Name *Name `json:"name,omitempty"`
+
+	// Values ひな形の置き換える値（フィールド名と値）
+	Values TemplateValues `json:"values"`
+}
+
+// CreateCommunityThreadFromTemplateJSONBody defines parameters for CreateCommunityThreadFromTemplate.
+type CreateCommunityThreadFromTemplateJSONBody struct {
+	// Values ひな形の置き換える値（フィールド名と値）
+	Values TemplateValues `json:"values"`
+}
+
+// CreateCommunityPostFromTemplateJSONBody defines parameters for CreateCommunityPostFromTemplate.
+type CreateCommunityPostFromTemplateJSONBody struct {
+	// Values ひな形の置き換える値（フィールド名と値）
+	Values TemplateValues `json:"values"`
 }
 
 // ListCommunityTopicParams defines parameters for ListCommunityTopic.
 type ListCommunityTopicParams struct {
-	Limit  Limit  `form:"limit" json:"limit"`
-	Offset Offset `form:"offset" json:"offset"`
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // CreateCommunityTopicJSONBody defines parameters for CreateCommunityTopic.
@@ -770,8 +1593,9 @@ type CreateCommunityTopicJSONBody struct {
 
 // ListCommunityThreadParams defines parameters for ListCommunityThread.
 type ListCommunityThreadParams struct {
-	Limit  Limit  `form:"limit" json:"limit"`
-	Offset Offset `form:"offset" json:"offset"`
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // CreateCommunityThreadJSONBody defines parameters for CreateCommunityThread.
@@ -781,8 +1605,9 @@ type CreateCommunityThreadJSONBody struct {
 
 // ListCommunityPostParams defines parameters for ListCommunityPost.
 type ListCommunityPostParams struct {
-	Limit  Limit  `form:"limit" json:"limit"`
-	Offset Offset `form:"offset" json:"offset"`
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // CreateCommunityPostJSONBody defines parameters for CreateCommunityPost.
@@ -792,9 +1617,10 @@ type CreateCommunityPostJSONBody struct {
 
 // ListPostLikeParams defines parameters for ListPostLike.
 type ListPostLikeParams struct {
-	Limit  Limit  `form:"limit" json:"limit"`
-	Offset Offset `form:"offset" json:"offset"`
-	Like   bool   `form:"like" json:"like"`
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Like   bool    `form:"like" json:"like"`
 }
 
 // LikePostJSONBody defines parameters for LikePost.
@@ -803,10 +1629,36 @@ type LikePostJSONBody struct {
 	Like    bool          `json:"like"`
 }
 
+// ListUserConversationParams defines parameters for ListUserConversation.
+type ListUserConversationParams struct {
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// CreateUserConversationJSONBody defines parameters for CreateUserConversation.
+type CreateUserConversationJSONBody struct {
+	// Users 自分以外の参加者
+	Users []ID `json:"users"`
+}
+
+// ListConversationMessageParams defines parameters for ListConversationMessage.
+type ListConversationMessageParams struct {
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// SendConversationMessageJSONBody defines parameters for SendConversationMessage.
+type SendConversationMessageJSONBody struct {
+	Contents []Content `json:"contents"`
+}
+
 // ListUserInviteParams defines parameters for ListUserInvite.
 type ListUserInviteParams struct {
-	Limit  Limit  `form:"limit" json:"limit"`
-	Offset Offset `form:"offset" json:"offset"`
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // ReplyInviteJSONBody defines parameters for ReplyInvite.
@@ -819,8 +1671,9 @@ type ReplyInviteJSONBody struct {
 
 // ListUserLoginActivityParams defines parameters for ListUserLoginActivity.
 type ListUserLoginActivityParams struct {
-	Limit  Limit  `form:"limit" json:"limit"`
-	Offset Offset `form:"offset" json:"offset"`
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // EditUserProfileParams defines parameters for EditUserProfile.
@@ -832,10 +1685,34 @@ type EditUserProfileParams struct {
 	SecWebSocketExtensions string `json:"Sec-WebSocket-Extensions"`
 }
 
+// ListUserProfileVersionParams defines parameters for ListUserProfileVersion.
+type ListUserProfileVersionParams struct {
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// DiffUserProfileVersionParams defines parameters for DiffUserProfileVersion.
+type DiffUserProfileVersionParams struct {
+	From ID `form:"from" json:"from"`
+
+	// To 省略した場合は現在の内容と比較する
+	To *ID `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// SaveUserRelationJSONBody defines parameters for SaveUserRelation.
+type SaveUserRelationJSONBody struct {
+	// Type ユーザーとの関係
+	// * block - ブロック
+	// * mute - ミュート
+	Type UserRelationType `json:"type"`
+}
+
 // ListUserActivityParams defines parameters for ListUserActivity.
 type ListUserActivityParams struct {
-	Limit  Limit  `form:"limit" json:"limit"`
-	Offset Offset `form:"offset" json:"offset"`
+	Limit  Limit   `form:"limit" json:"limit"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // CreateCommunityJSONRequestBody defines body for CreateCommunity for application/json ContentType.
@@ -844,6 +1721,24 @@ type CreateCommunityJSONRequestBody CreateCommunityJSONBody
 // UpdateCommunityJSONRequestBody defines body for UpdateCommunity for application/json ContentType.
 type UpdateCommunityJSONRequestBody UpdateCommunityJSONBody
 
+// UpdateCommunityMemberJSONRequestBody defines body for UpdateCommunityMember for application/json ContentType.
+type UpdateCommunityMemberJSONRequestBody UpdateCommunityMemberJSONBody
+
+// BanCommunityMemberJSONRequestBody defines body for BanCommunityMember for application/json ContentType.
+type BanCommunityMemberJSONRequestBody BanCommunityMemberJSONBody
+
+// TransferCommunityOwnershipJSONRequestBody defines body for TransferCommunityOwnership for application/json ContentType.
+type TransferCommunityOwnershipJSONRequestBody TransferCommunityOwnershipJSONBody
+
+// CreateCommunityPageJSONRequestBody defines body for CreateCommunityPage for application/json ContentType.
+type CreateCommunityPageJSONRequestBody CreateCommunityPageJSONBody
+
+// UpdateCommunityPageJSONRequestBody defines body for UpdateCommunityPage for application/json ContentType.
+type UpdateCommunityPageJSONRequestBody UpdateCommunityPageJSONBody
+
+// MoveCommunityPageJSONRequestBody defines body for MoveCommunityPage for application/json ContentType.
+type MoveCommunityPageJSONRequestBody MoveCommunityPageJSONBody
+
 // CreateCommunityRoleJSONRequestBody defines body for CreateCommunityRole for application/json ContentType.
 type CreateCommunityRoleJSONRequestBody CreateCommunityRoleJSONBody
 
@@ -853,6 +1748,27 @@ type UpdateCommunityRoleJSONRequestBody UpdateCommunityRoleJSONBody
 // InviteCommunityRoleJSONRequestBody defines body for InviteCommunityRole for application/json ContentType.
 type InviteCommunityRoleJSONRequestBody InviteCommunityRoleJSONBody
 
+// CreateCommunityInviteLinkJSONRequestBody defines body for CreateCommunityInviteLink for application/json ContentType.
+type CreateCommunityInviteLinkJSONRequestBody CreateCommunityInviteLinkJSONBody
+
+// CreateCommunityTemplateJSONRequestBody defines body for CreateCommunityTemplate for application/json ContentType.
+type CreateCommunityTemplateJSONRequestBody = TemplateBody
+
+// UpdateCommunityTemplateJSONRequestBody defines body for UpdateCommunityTemplate for application/json ContentType.
+type UpdateCommunityTemplateJSONRequestBody = TemplateBody
+
+// CreateCommunityPageFromTemplateJSONRequestBody defines body for CreateCommunityPageFromTemplate for application/json ContentType.
+type CreateCommunityPageFromTemplateJSONRequestBody CreateCommunityPageFromTemplateJSONBody
+
+// CreateCommunityTopicFromTemplateJSONRequestBody defines body for CreateCommunityTopicFromTemplate for application/json ContentType.
+type CreateCommunityTopicFromTemplateJSONRequestBody CreateCommunityTopicFromTemplateJSONBody
+
+// CreateCommunityThreadFromTemplateJSONRequestBody defines body for CreateCommunityThreadFromTemplate for application/json ContentType.
+type CreateCommunityThreadFromTemplateJSONRequestBody CreateCommunityThreadFromTemplateJSONBody
+
+// CreateCommunityPostFromTemplateJSONRequestBody defines body for CreateCommunityPostFromTemplate for application/json ContentType.
+type CreateCommunityPostFromTemplateJSONRequestBody CreateCommunityPostFromTemplateJSONBody
+
 // CreateCommunityTopicJSONRequestBody defines body for CreateCommunityTopic for application/json ContentType.
 type CreateCommunityTopicJSONRequestBody CreateCommunityTopicJSONBody
 
@@ -865,9 +1781,18 @@ type CreateCommunityPostJSONRequestBody CreateCommunityPostJSONBody
 // LikePostJSONRequestBody defines body for LikePost for application/json ContentType.
 type LikePostJSONRequestBody LikePostJSONBody
 
+// CreateUserConversationJSONRequestBody defines body for CreateUserConversation for application/json ContentType.
+type CreateUserConversationJSONRequestBody CreateUserConversationJSONBody
+
+// SendConversationMessageJSONRequestBody defines body for SendConversationMessage for application/json ContentType.
+type SendConversationMessageJSONRequestBody SendConversationMessageJSONBody
+
 // ReplyInviteJSONRequestBody defines body for ReplyInvite for application/json ContentType.
 type ReplyInviteJSONRequestBody ReplyInviteJSONBody
 
+// SaveUserRelationJSONRequestBody defines body for SaveUserRelation for application/json ContentType.
+type SaveUserRelationJSONRequestBody SaveUserRelationJSONBody
+
 // AsCommunity returns the union data inside the Activity_Where as a Community
 func (t Activity_Where) AsCommunity() (Community, error) {
 	var body Community
@@ -1200,6 +2125,84 @@ func (t *Content_Entity) MergeMentions(v Mentions) error {
 	return err
 }
 
+// AsCode returns the union data inside the Content_Entity as a Code
+func (t Content_Entity) AsCode() (Code, error) {
+	var body Code
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCode overwrites any union data inside the Content_Entity as the provided Code
+func (t *Content_Entity) FromCode(v Code) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCode performs a merge with any union data inside the Content_Entity, using the provided Code
+func (t *Content_Entity) MergeCode(v Code) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsTable returns the union data inside the Content_Entity as a Table
+func (t Content_Entity) AsTable() (Table, error) {
+	var body Table
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTable overwrites any union data inside the Content_Entity as the provided Table
+func (t *Content_Entity) FromTable(v Table) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTable performs a merge with any union data inside the Content_Entity, using the provided Table
+func (t *Content_Entity) MergeTable(v Table) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsQuote returns the union data inside the Content_Entity as a Quote
+func (t Content_Entity) AsQuote() (Quote, error) {
+	var body Quote
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromQuote overwrites any union data inside the Content_Entity as the provided Quote
+func (t *Content_Entity) FromQuote(v Quote) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeQuote performs a merge with any union data inside the Content_Entity, using the provided Quote
+func (t *Content_Entity) MergeQuote(v Quote) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t Content_Entity) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1392,6 +2395,32 @@ func (t *Experience_Resource) MergeLike(v Like) error {
 	return err
 }
 
+// AsPage returns the union data inside the Experience_Resource as a Page
+func (t Experience_Resource) AsPage() (Page, error) {
+	var body Page
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPage overwrites any union data inside the Experience_Resource as the provided Page
+func (t *Experience_Resource) FromPage(v Page) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePage performs a merge with any union data inside the Experience_Resource, using the provided Page
+func (t *Experience_Resource) MergePage(v Page) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t Experience_Resource) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1506,6 +2535,84 @@ func (t *MessagesToRecieve_Entity) MergeDeletedLineMessage(v DeletedLineMessage)
 	return err
 }
 
+// AsInsertedLinesMessage returns the union data inside the MessagesToRecieve_Entity as a InsertedLinesMessage
+func (t MessagesToRecieve_Entity) AsInsertedLinesMessage() (InsertedLinesMessage, error) {
+	var body InsertedLinesMessage
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromInsertedLinesMessage overwrites any union data inside the MessagesToRecieve_Entity as the provided InsertedLinesMessage
+func (t *MessagesToRecieve_Entity) FromInsertedLinesMessage(v InsertedLinesMessage) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeInsertedLinesMessage performs a merge with any union data inside the MessagesToRecieve_Entity, using the provided InsertedLinesMessage
+func (t *MessagesToRecieve_Entity) MergeInsertedLinesMessage(v InsertedLinesMessage) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsReplacedLinesMessage returns the union data inside the MessagesToRecieve_Entity as a ReplacedLinesMessage
+func (t MessagesToRecieve_Entity) AsReplacedLinesMessage() (ReplacedLinesMessage, error) {
+	var body ReplacedLinesMessage
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromReplacedLinesMessage overwrites any union data inside the MessagesToRecieve_Entity as the provided ReplacedLinesMessage
+func (t *MessagesToRecieve_Entity) FromReplacedLinesMessage(v ReplacedLinesMessage) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeReplacedLinesMessage performs a merge with any union data inside the MessagesToRecieve_Entity, using the provided ReplacedLinesMessage
+func (t *MessagesToRecieve_Entity) MergeReplacedLinesMessage(v ReplacedLinesMessage) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDeletedLinesMessage returns the union data inside the MessagesToRecieve_Entity as a DeletedLinesMessage
+func (t MessagesToRecieve_Entity) AsDeletedLinesMessage() (DeletedLinesMessage, error) {
+	var body DeletedLinesMessage
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDeletedLinesMessage overwrites any union data inside the MessagesToRecieve_Entity as the provided DeletedLinesMessage
+func (t *MessagesToRecieve_Entity) FromDeletedLinesMessage(v DeletedLinesMessage) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDeletedLinesMessage performs a merge with any union data inside the MessagesToRecieve_Entity, using the provided DeletedLinesMessage
+func (t *MessagesToRecieve_Entity) MergeDeletedLinesMessage(v DeletedLinesMessage) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t MessagesToRecieve_Entity) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1542,6 +2649,58 @@ func (t *MessagesToSend_Entity) MergeCurrentLinesMessage(v CurrentLinesMessage)
 	return err
 }
 
+// AsAckMessage returns the union data inside the MessagesToSend_Entity as a AckMessage
+func (t MessagesToSend_Entity) AsAckMessage() (AckMessage, error) {
+	var body AckMessage
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromAckMessage overwrites any union data inside the MessagesToSend_Entity as the provided AckMessage
+func (t *MessagesToSend_Entity) FromAckMessage(v AckMessage) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeAckMessage performs a merge with any union data inside the MessagesToSend_Entity, using the provided AckMessage
+func (t *MessagesToSend_Entity) MergeAckMessage(v AckMessage) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsErrorMessage returns the union data inside the MessagesToSend_Entity as a ErrorMessage
+func (t MessagesToSend_Entity) AsErrorMessage() (ErrorMessage, error) {
+	var body ErrorMessage
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorMessage overwrites any union data inside the MessagesToSend_Entity as the provided ErrorMessage
+func (t *MessagesToSend_Entity) FromErrorMessage(v ErrorMessage) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorMessage performs a merge with any union data inside the MessagesToSend_Entity, using the provided ErrorMessage
+func (t *MessagesToSend_Entity) MergeErrorMessage(v ErrorMessage) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t MessagesToSend_Entity) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -1557,27 +2716,114 @@ type ServerInterface interface {
 	// サービスの利用者が制御可能なアクションを取得する
 	// (GET /action)
 	ListAction(ctx echo.Context) error
+	// 公開コミュニティのディレクトリを取得する
+	// (GET /community)
+	ListPublicCommunity(ctx echo.Context, params ListPublicCommunityParams) error
 	// コミュニティを作成する
 	// (POST /community)
 	CreateCommunity(ctx echo.Context) error
+	// コミュニティを削除する
+	// (DELETE /community/{community_id})
+	DeleteCommunity(ctx echo.Context, communityId ID) error
+	// コミュニティの概要を取得する
+	// (GET /community/{community_id})
+	GetCommunity(ctx echo.Context, communityId ID) error
 	// コミュニティを更新する
 	// (PATCH /community/{community_id})
 	UpdateCommunity(ctx echo.Context, communityId ID) error
+	// コミュニティのアーカイブを解除する
+	// (DELETE /community/{community_id}/archive)
+	UnarchiveCommunity(ctx echo.Context, communityId ID) error
+	// コミュニティをアーカイブする
+	// (PUT /community/{community_id}/archive)
+	ArchiveCommunity(ctx echo.Context, communityId ID) error
+	// コミュニティへの参加禁止を解除する
+	// (DELETE /community/{community_id}/ban/{user_id})
+	DeleteCommunityBan(ctx echo.Context, communityId ID, userId ID) error
 	// コミュニティの招待を取得する
 	// (GET /community/{community_id}/invite)
 	ListCommunityInvite(ctx echo.Context, communityId ID, params ListCommunityInviteParams) error
 	// コミュニティの招待を削除する
 	// (DELETE /community/{community_id}/invite/{invite_id})
 	DeleteCommunityInvite(ctx echo.Context, communityId ID, inviteId ID) error
+	// コミュニティから抜ける
+	// (DELETE /community/{community_id}/join)
+	LeaveCommunity(ctx echo.Context, communityId ID) error
+	// コミュニティに参加（または申請）する
+	// (POST /community/{community_id}/join)
+	JoinCommunity(ctx echo.Context, communityId ID) error
+	// コミュニティの招待リンクを取得する
+	// (GET /community/{community_id}/link)
+	ListCommunityInviteLink(ctx echo.Context, communityId ID, params ListCommunityInviteLinkParams) error
+	// コミュニティの招待リンクを無効にする
+	// (DELETE /community/{community_id}/link/{link_id})
+	DeleteCommunityInviteLink(ctx echo.Context, communityId ID, linkId ID) error
 	// コミュニティのメンバーを取得する
 	// (GET /community/{community_id}/member)
 	ListCommunityMember(ctx echo.Context, communityId ID, params ListCommunityMemberParams) error
+	// コミュニティからメンバーを削除する
+	// (DELETE /community/{community_id}/member/{member_id})
+	DeleteCommunityMember(ctx echo.Context, communityId ID, memberId ID) error
 	// コミュニティのメンバーの詳細を取得する
 	// (GET /community/{community_id}/member/{member_id})
 	GetCommunityMember(ctx echo.Context, communityId ID, memberId ID) error
+	// コミュニティのメンバーのロールを変更する
+	// (PUT /community/{community_id}/member/{member_id})
+	UpdateCommunityMember(ctx echo.Context, communityId ID, memberId ID) error
+	// コミュニティからメンバーを削除し、参加を禁止する
+	// (POST /community/{community_id}/member/{member_id}/ban)
+	BanCommunityMember(ctx echo.Context, communityId ID, memberId ID) error
 	// コミュニティの説明を編集する
 	// (GET /community/{community_id}/note)
 	EditCommunityDescription(ctx echo.Context, communityId ID, params EditCommunityDescriptionParams) error
+	// コミュニティの説明を Markdown で取得する
+	// (GET /community/{community_id}/note/export)
+	ExportCommunityDescription(ctx echo.Context, communityId ID) error
+	// コミュニティの説明の版を取得する
+	// (GET /community/{community_id}/note/version)
+	ListCommunityDescriptionVersion(ctx echo.Context, communityId ID, params ListCommunityDescriptionVersionParams) error
+	// コミュニティの説明の版の差分を取得する
+	// (GET /community/{community_id}/note/version/diff)
+	DiffCommunityDescriptionVersion(ctx echo.Context, communityId ID, params DiffCommunityDescriptionVersionParams) error
+	// コミュニティの説明を指定した版に戻す
+	// (POST /community/{community_id}/note/version/{version_id}/restore)
+	RestoreCommunityDescriptionVersion(ctx echo.Context, communityId ID, versionId ID) error
+	// コミュニティのオーナーを移譲する
+	// (PUT /community/{community_id}/owner)
+	TransferCommunityOwnership(ctx echo.Context, communityId ID) error
+	// コミュニティの Wiki のページを木構造で取得する
+	// (GET /community/{community_id}/page)
+	ListCommunityPage(ctx echo.Context, communityId ID) error
+	// コミュニティの Wiki にページを作成する
+	// (POST /community/{community_id}/page)
+	CreateCommunityPage(ctx echo.Context, communityId ID) error
+	// コミュニティの Wiki のページを削除する
+	// (DELETE /community/{community_id}/page/{page_id})
+	DeleteCommunityPage(ctx echo.Context, communityId ID, pageId ID) error
+	// コミュニティの Wiki のページを取得する
+	// (GET /community/{community_id}/page/{page_id})
+	GetCommunityPage(ctx echo.Context, communityId ID, pageId ID) error
+	// コミュニティの Wiki のページの題名を変更する
+	// (PATCH /community/{community_id}/page/{page_id})
+	UpdateCommunityPage(ctx echo.Context, communityId ID, pageId ID) error
+	// コミュニティの Wiki のページの本文を編集する
+	// (GET /community/{community_id}/page/{page_id}/note)
+	EditCommunityPage(ctx echo.Context, communityId ID, pageId ID, params EditCommunityPageParams) error
+	// コミュニティの Wiki のページの本文を Markdown で取得する
+	// (GET /community/{community_id}/page/{page_id}/note/export)
+	ExportCommunityPage(ctx echo.Context, communityId ID, pageId ID) error
+	// コミュニティの Wiki のページの版を取得する
+	// (GET /community/{community_id}/page/{page_id}/note/version)
+	ListCommunityPageVersion(ctx echo.Context, communityId ID, pageId ID, params ListCommunityPageVersionParams) error
+	// コミュニティの Wiki のページの版の差分を取得する
+	// (GET /community/{community_id}/page/{page_id}/note/version/diff)
+	DiffCommunityPageVersion(ctx echo.Context, communityId ID, pageId ID, params DiffCommunityPageVersionParams) error
+	// コミュニティの Wiki のページを指定した版に戻す
+	// (POST /community/{community_id}/page/{page_id}/note/version/{version_id}/restore)
+	RestoreCommunityPageVersion(ctx echo.Context, communityId ID, pageId ID, versionId ID) error
+	// コミュニティの Wiki のページを移動する
+	// (PUT /community/{community_id}/page/{page_id}/position)
+	MoveCommunityPage(ctx echo.Context, communityId ID, pageId ID) error
 	// コミュニティのロールを取得する
 	// (GET /community/{community_id}/role)
 	ListCommunityRole(ctx echo.Context, communityId ID) error
@@ -1593,6 +2839,36 @@ type ServerInterface interface {
 	// コミュニティのロールに招待する
 	// (POST /community/{community_id}/role/{role_id}/invite)
 	InviteCommunityRole(ctx echo.Context, communityId ID, roleId ID) error
+	// コミュニティのロールへの招待リンクを作成する
+	// (POST /community/{community_id}/role/{role_id}/link)
+	CreateCommunityInviteLink(ctx echo.Context, communityId ID, roleId ID) error
+	// コミュニティのひな形を取得する
+	// (GET /community/{community_id}/template)
+	ListCommunityTemplate(ctx echo.Context, communityId ID) error
+	// コミュニティのひな形を作成する
+	// (POST /community/{community_id}/template)
+	CreateCommunityTemplate(ctx echo.Context, communityId ID) error
+	// コミュニティのひな形を削除する
+	// (DELETE /community/{community_id}/template/{template_id})
+	DeleteCommunityTemplate(ctx echo.Context, communityId ID, templateId ID) error
+	// コミュニティのひな形を 1 つ取得する
+	// (GET /community/{community_id}/template/{template_id})
+	GetCommunityTemplate(ctx echo.Context, communityId ID, templateId ID) error
+	// コミュニティのひな形を置き換える
+	// (PUT /community/{community_id}/template/{template_id})
+	UpdateCommunityTemplate(ctx echo.Context, communityId ID, templateId ID) error
+	// ひな形から Wiki のページを作成する
+	// (POST /community/{community_id}/template/{template_id}/page)
+	CreateCommunityPageFromTemplate(ctx echo.Context, communityId ID, templateId ID) error
+	// ひな形からトピックを作成する
+	// (POST /community/{community_id}/template/{template_id}/topic)
+	CreateCommunityTopicFromTemplate(ctx echo.Context, communityId ID, templateId ID) error
+	// ひな形からスレッドを作成する
+	// (POST /community/{community_id}/template/{template_id}/topic/{topic_id})
+	CreateCommunityThreadFromTemplate(ctx echo.Context, communityId ID, templateId ID, topicId ID) error
+	// ひな形からポストを作成する
+	// (POST /community/{community_id}/template/{template_id}/topic/{topic_id}/thread/{thread_id})
+	CreateCommunityPostFromTemplate(ctx echo.Context, communityId ID, templateId ID, topicId ID, threadId ID) error
 	// コミュニティのトピックを取得する
 	// (GET /community/{community_id}/topic)
 	ListCommunityTopic(ctx echo.Context, communityId ID, params ListCommunityTopicParams) error
@@ -1605,33 +2881,87 @@ type ServerInterface interface {
 	// トピックにスレッドを作成する（ポストする）
 	// (POST /community/{community_id}/topic/{topic_id})
 	CreateCommunityThread(ctx echo.Context, communityId ID, topicId ID) error
+	// トピックとすべてのスレッドを Markdown で取得する
+	// (GET /community/{community_id}/topic/{topic_id}/export)
+	ExportCommunityTopic(ctx echo.Context, communityId ID, topicId ID) error
+	// トピックのスレッドを RSS で取得する
+	// (GET /community/{community_id}/topic/{topic_id}/feed)
+	FeedCommunityTopic(ctx echo.Context, communityId ID, topicId ID) error
 	// スレッドのポストを取得する
 	// (GET /community/{community_id}/topic/{topic_id}/thread/{thread_id})
 	ListCommunityPost(ctx echo.Context, communityId ID, topicId ID, threadId ID, params ListCommunityPostParams) error
 	// スレッドにポストを作成する（リプライする）
 	// (POST /community/{community_id}/topic/{topic_id}/thread/{thread_id})
 	CreateCommunityPost(ctx echo.Context, communityId ID, topicId ID, threadId ID) error
+	// スレッドのすべてのポストを Markdown で取得する
+	// (GET /community/{community_id}/topic/{topic_id}/thread/{thread_id}/export)
+	ExportCommunityThread(ctx echo.Context, communityId ID, topicId ID, threadId ID) error
 	// ポストの支持/不支持の内容を取得する
 	// (GET /community/{community_id}/topic/{topic_id}/thread/{thread_id}/post/{post_id}/like)
 	ListPostLike(ctx echo.Context, communityId ID, topicId ID, threadId ID, postId ID, params ListPostLikeParams) error
 	// ポストに対し支持/不支持を表明する
 	// (POST /community/{community_id}/topic/{topic_id}/thread/{thread_id}/post/{post_id}/like)
 	LikePost(ctx echo.Context, communityId ID, topicId ID, threadId ID, postId ID) error
+	// ポストまでを既読にする
+	// (PUT /community/{community_id}/topic/{topic_id}/thread/{thread_id}/post/{post_id}/read)
+	ReadPost(ctx echo.Context, communityId ID, topicId ID, threadId ID, postId ID) error
+	// 認証済みユーザーが参加しているコミュニティを取得する
+	// (GET /user/community)
+	ListJoinedCommunity(ctx echo.Context) error
+	// 認証済みユーザーが参加している会話を取得する
+	// (GET /user/conversation)
+	ListUserConversation(ctx echo.Context, params ListUserConversationParams) error
+	// 同じコミュニティに参加しているユーザーとの会話を作成する（同じ参加者の会話があればそれを返す）
+	// (POST /user/conversation)
+	CreateUserConversation(ctx echo.Context) error
+	// 会話のメッセージを取得する
+	// (GET /user/conversation/{conversation_id})
+	ListConversationMessage(ctx echo.Context, conversationId ID, params ListConversationMessageParams) error
+	// 会話にメッセージを送信する
+	// (POST /user/conversation/{conversation_id})
+	SendConversationMessage(ctx echo.Context, conversationId ID) error
+	// 会話のメッセージまでを既読にする
+	// (PUT /user/conversation/{conversation_id}/message/{message_id}/read)
+	ReadConversationMessage(ctx echo.Context, conversationId ID, messageId ID) error
 	// 認証済みユーザーの招待を取得する
 	// (GET /user/invite)
 	ListUserInvite(ctx echo.Context, params ListUserInviteParams) error
 	// 認証済みユーザーの招待に応答する
 	// (DELETE /user/invite/{invite_id})
 	ReplyInvite(ctx echo.Context, inviteId ID) error
+	// 招待リンクを使ってコミュニティに参加する
+	// (PUT /user/link/{token})
+	RedeemInviteLink(ctx echo.Context, token InviteToken) error
 	// 認証済みユーザーのログイン履歴を取得する
 	// (GET /user/login)
 	ListUserLoginActivity(ctx echo.Context, params ListUserLoginActivityParams) error
 	// 認証済みユーザーのプロフィールを編集する
 	// (GET /user/note)
 	EditUserProfile(ctx echo.Context, params EditUserProfileParams) error
+	// 認証済みユーザーのプロフィールの版を取得する
+	// (GET /user/note/version)
+	ListUserProfileVersion(ctx echo.Context, params ListUserProfileVersionParams) error
+	// 認証済みユーザーのプロフィールの版の差分を取得する
+	// (GET /user/note/version/diff)
+	DiffUserProfileVersion(ctx echo.Context, params DiffUserProfileVersionParams) error
+	// 認証済みユーザーのプロフィールを指定した版に戻す
+	// (POST /user/note/version/{version_id}/restore)
+	RestoreUserProfileVersion(ctx echo.Context, versionId ID) error
+	// 認証済みユーザーがブロック/ミュートしているユーザーを取得する
+	// (GET /user/relation)
+	ListUserRelation(ctx echo.Context) error
+	// ユーザーのブロック/ミュートを解除する
+	// (DELETE /user/relation/{user_id})
+	DeleteUserRelation(ctx echo.Context, userId ID) error
+	// ユーザーをブロック/ミュートする
+	// (PUT /user/relation/{user_id})
+	SaveUserRelation(ctx echo.Context, userId ID) error
 	// ユーザーアクティビティを取得する
 	// (GET /user/{user_id}/activity)
 	ListUserActivity(ctx echo.Context, userId ID, params ListUserActivityParams) error
+	// ユーザーのプロフィールを Markdown で取得する
+	// (GET /user/{user_id}/note/export)
+	ExportUserProfile(ctx echo.Context, userId ID) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -1650,16 +2980,93 @@ func (w *ServerInterfaceWrapper) ListAction(ctx echo.Context) error {
 	return err
 }
 
-// CreateCommunity converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateCommunity(ctx echo.Context) error {
+// ListPublicCommunity converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPublicCommunity(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(SessionScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateCommunity(ctx)
-	return err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPublicCommunityParams
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Required query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPublicCommunity(ctx, params)
+	return err
+}
+
+// CreateCommunity converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunity(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCommunity(ctx)
+	return err
+}
+
+// DeleteCommunity converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCommunity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCommunity(ctx, communityId)
+	return err
+}
+
+// GetCommunity converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCommunity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCommunity(ctx, communityId)
+	return err
+}
 
 // UpdateCommunity converts echo context to params.
 func (w *ServerInterfaceWrapper) UpdateCommunity(ctx echo.Context) error {
@@ -1679,6 +3086,68 @@ func (w *ServerInterfaceWrapper) UpdateCommunity(ctx echo.Context) error {
 	return err
 }
 
+// UnarchiveCommunity converts echo context to params.
+func (w *ServerInterfaceWrapper) UnarchiveCommunity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnarchiveCommunity(ctx, communityId)
+	return err
+}
+
+// ArchiveCommunity converts echo context to params.
+func (w *ServerInterfaceWrapper) ArchiveCommunity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ArchiveCommunity(ctx, communityId)
+	return err
+}
+
+// DeleteCommunityBan converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCommunityBan(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCommunityBan(ctx, communityId, userId)
+	return err
+}
+
 // ListCommunityInvite converts echo context to params.
 func (w *ServerInterfaceWrapper) ListCommunityInvite(ctx echo.Context) error {
 	var err error
@@ -1701,13 +3170,20 @@ func (w *ServerInterfaceWrapper) ListCommunityInvite(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Required query parameter "offset" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ListCommunityInvite(ctx, communityId, params)
 	return err
@@ -1729,18 +3205,1300 @@ func (w *ServerInterfaceWrapper) DeleteCommunityInvite(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameterWithLocation("simple", false, "invite_id", runtime.ParamLocationPath, ctx.Param("invite_id"), &inviteId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter invite_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter invite_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCommunityInvite(ctx, communityId, inviteId)
+	return err
+}
+
+// LeaveCommunity converts echo context to params.
+func (w *ServerInterfaceWrapper) LeaveCommunity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LeaveCommunity(ctx, communityId)
+	return err
+}
+
+// JoinCommunity converts echo context to params.
+func (w *ServerInterfaceWrapper) JoinCommunity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.JoinCommunity(ctx, communityId)
+	return err
+}
+
+// ListCommunityInviteLink converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCommunityInviteLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCommunityInviteLinkParams
+	// ------------- Required query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListCommunityInviteLink(ctx, communityId, params)
+	return err
+}
+
+// DeleteCommunityInviteLink converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCommunityInviteLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "link_id" -------------
+	var linkId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "link_id", runtime.ParamLocationPath, ctx.Param("link_id"), &linkId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter link_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCommunityInviteLink(ctx, communityId, linkId)
+	return err
+}
+
+// ListCommunityMember converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCommunityMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCommunityMemberParams
+	// ------------- Required query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListCommunityMember(ctx, communityId, params)
+	return err
+}
+
+// DeleteCommunityMember converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCommunityMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "member_id", runtime.ParamLocationPath, ctx.Param("member_id"), &memberId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCommunityMember(ctx, communityId, memberId)
+	return err
+}
+
+// GetCommunityMember converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCommunityMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "member_id", runtime.ParamLocationPath, ctx.Param("member_id"), &memberId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCommunityMember(ctx, communityId, memberId)
+	return err
+}
+
+// UpdateCommunityMember converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCommunityMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "member_id", runtime.ParamLocationPath, ctx.Param("member_id"), &memberId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCommunityMember(ctx, communityId, memberId)
+	return err
+}
+
+// BanCommunityMember converts echo context to params.
+func (w *ServerInterfaceWrapper) BanCommunityMember(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "member_id" -------------
+	var memberId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "member_id", runtime.ParamLocationPath, ctx.Param("member_id"), &memberId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BanCommunityMember(ctx, communityId, memberId)
+	return err
+}
+
+// EditCommunityDescription converts echo context to params.
+func (w *ServerInterfaceWrapper) EditCommunityDescription(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditCommunityDescriptionParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "Connection" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Connection")]; found {
+		var Connection string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Connection, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Connection", runtime.ParamLocationHeader, valueList[0], &Connection)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Connection: %s", err))
+		}
+
+		params.Connection = Connection
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Connection is required, but not found"))
+	}
+	// ------------- Required header parameter "Upgrade" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upgrade")]; found {
+		var Upgrade string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Upgrade, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Upgrade", runtime.ParamLocationHeader, valueList[0], &Upgrade)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Upgrade: %s", err))
+		}
+
+		params.Upgrade = Upgrade
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Upgrade is required, but not found"))
+	}
+	// ------------- Required header parameter "Sec-WebSocket-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Sec-WebSocket-Key")]; found {
+		var SecWebSocketKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Sec-WebSocket-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Sec-WebSocket-Key", runtime.ParamLocationHeader, valueList[0], &SecWebSocketKey)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Sec-WebSocket-Key: %s", err))
+		}
+
+		params.SecWebSocketKey = SecWebSocketKey
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Sec-WebSocket-Key is required, but not found"))
+	}
+	// ------------- Required header parameter "Sec-WebSocket-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Sec-WebSocket-Version")]; found {
+		var SecWebSocketVersion string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Sec-WebSocket-Version, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Sec-WebSocket-Version", runtime.ParamLocationHeader, valueList[0], &SecWebSocketVersion)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Sec-WebSocket-Version: %s", err))
+		}
+
+		params.SecWebSocketVersion = SecWebSocketVersion
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Sec-WebSocket-Version is required, but not found"))
+	}
+	// ------------- Required header parameter "Sec-WebSocket-Extensions" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Sec-WebSocket-Extensions")]; found {
+		var SecWebSocketExtensions string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Sec-WebSocket-Extensions, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Sec-WebSocket-Extensions", runtime.ParamLocationHeader, valueList[0], &SecWebSocketExtensions)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Sec-WebSocket-Extensions: %s", err))
+		}
+
+		params.SecWebSocketExtensions = SecWebSocketExtensions
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Sec-WebSocket-Extensions is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EditCommunityDescription(ctx, communityId, params)
+	return err
+}
+
+// ExportCommunityDescription converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportCommunityDescription(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportCommunityDescription(ctx, communityId)
+	return err
+}
+
+// ListCommunityDescriptionVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCommunityDescriptionVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCommunityDescriptionVersionParams
+	// ------------- Required query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListCommunityDescriptionVersion(ctx, communityId, params)
+	return err
+}
+
+// DiffCommunityDescriptionVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) DiffCommunityDescriptionVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffCommunityDescriptionVersionParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DiffCommunityDescriptionVersion(ctx, communityId, params)
+	return err
+}
+
+// RestoreCommunityDescriptionVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreCommunityDescriptionVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "version_id" -------------
+	var versionId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version_id", runtime.ParamLocationPath, ctx.Param("version_id"), &versionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreCommunityDescriptionVersion(ctx, communityId, versionId)
+	return err
+}
+
+// TransferCommunityOwnership converts echo context to params.
+func (w *ServerInterfaceWrapper) TransferCommunityOwnership(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TransferCommunityOwnership(ctx, communityId)
+	return err
+}
+
+// ListCommunityPage converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCommunityPage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListCommunityPage(ctx, communityId)
+	return err
+}
+
+// CreateCommunityPage converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunityPage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCommunityPage(ctx, communityId)
+	return err
+}
+
+// DeleteCommunityPage converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCommunityPage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "page_id" -------------
+	var pageId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "page_id", runtime.ParamLocationPath, ctx.Param("page_id"), &pageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCommunityPage(ctx, communityId, pageId)
+	return err
+}
+
+// GetCommunityPage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCommunityPage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "page_id" -------------
+	var pageId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "page_id", runtime.ParamLocationPath, ctx.Param("page_id"), &pageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCommunityPage(ctx, communityId, pageId)
+	return err
+}
+
+// UpdateCommunityPage converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCommunityPage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "page_id" -------------
+	var pageId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "page_id", runtime.ParamLocationPath, ctx.Param("page_id"), &pageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCommunityPage(ctx, communityId, pageId)
+	return err
+}
+
+// EditCommunityPage converts echo context to params.
+func (w *ServerInterfaceWrapper) EditCommunityPage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "page_id" -------------
+	var pageId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "page_id", runtime.ParamLocationPath, ctx.Param("page_id"), &pageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditCommunityPageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "Connection" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Connection")]; found {
+		var Connection string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Connection, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Connection", runtime.ParamLocationHeader, valueList[0], &Connection)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Connection: %s", err))
+		}
+
+		params.Connection = Connection
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Connection is required, but not found"))
+	}
+	// ------------- Required header parameter "Upgrade" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Upgrade")]; found {
+		var Upgrade string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Upgrade, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Upgrade", runtime.ParamLocationHeader, valueList[0], &Upgrade)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Upgrade: %s", err))
+		}
+
+		params.Upgrade = Upgrade
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Upgrade is required, but not found"))
+	}
+	// ------------- Required header parameter "Sec-WebSocket-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Sec-WebSocket-Key")]; found {
+		var SecWebSocketKey string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Sec-WebSocket-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Sec-WebSocket-Key", runtime.ParamLocationHeader, valueList[0], &SecWebSocketKey)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Sec-WebSocket-Key: %s", err))
+		}
+
+		params.SecWebSocketKey = SecWebSocketKey
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Sec-WebSocket-Key is required, but not found"))
+	}
+	// ------------- Required header parameter "Sec-WebSocket-Version" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Sec-WebSocket-Version")]; found {
+		var SecWebSocketVersion string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Sec-WebSocket-Version, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Sec-WebSocket-Version", runtime.ParamLocationHeader, valueList[0], &SecWebSocketVersion)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Sec-WebSocket-Version: %s", err))
+		}
+
+		params.SecWebSocketVersion = SecWebSocketVersion
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Sec-WebSocket-Version is required, but not found"))
+	}
+	// ------------- Required header parameter "Sec-WebSocket-Extensions" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Sec-WebSocket-Extensions")]; found {
+		var SecWebSocketExtensions string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Sec-WebSocket-Extensions, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Sec-WebSocket-Extensions", runtime.ParamLocationHeader, valueList[0], &SecWebSocketExtensions)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Sec-WebSocket-Extensions: %s", err))
+		}
+
+		params.SecWebSocketExtensions = SecWebSocketExtensions
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Sec-WebSocket-Extensions is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EditCommunityPage(ctx, communityId, pageId, params)
+	return err
+}
+
+// ExportCommunityPage converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportCommunityPage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "page_id" -------------
+	var pageId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "page_id", runtime.ParamLocationPath, ctx.Param("page_id"), &pageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportCommunityPage(ctx, communityId, pageId)
+	return err
+}
+
+// ListCommunityPageVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCommunityPageVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "page_id" -------------
+	var pageId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "page_id", runtime.ParamLocationPath, ctx.Param("page_id"), &pageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCommunityPageVersionParams
+	// ------------- Required query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListCommunityPageVersion(ctx, communityId, pageId, params)
+	return err
+}
+
+// DiffCommunityPageVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) DiffCommunityPageVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "page_id" -------------
+	var pageId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "page_id", runtime.ParamLocationPath, ctx.Param("page_id"), &pageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffCommunityPageVersionParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DiffCommunityPageVersion(ctx, communityId, pageId, params)
+	return err
+}
+
+// RestoreCommunityPageVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreCommunityPageVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "page_id" -------------
+	var pageId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "page_id", runtime.ParamLocationPath, ctx.Param("page_id"), &pageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_id: %s", err))
+	}
+
+	// ------------- Path parameter "version_id" -------------
+	var versionId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version_id", runtime.ParamLocationPath, ctx.Param("version_id"), &versionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreCommunityPageVersion(ctx, communityId, pageId, versionId)
+	return err
+}
+
+// MoveCommunityPage converts echo context to params.
+func (w *ServerInterfaceWrapper) MoveCommunityPage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "page_id" -------------
+	var pageId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "page_id", runtime.ParamLocationPath, ctx.Param("page_id"), &pageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MoveCommunityPage(ctx, communityId, pageId)
+	return err
+}
+
+// ListCommunityRole converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCommunityRole(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListCommunityRole(ctx, communityId)
+	return err
+}
+
+// CreateCommunityRole converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunityRole(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCommunityRole(ctx, communityId)
+	return err
+}
+
+// DeleteCommunityRole converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCommunityRole(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "role_id", runtime.ParamLocationPath, ctx.Param("role_id"), &roleId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCommunityRole(ctx, communityId, roleId)
+	return err
+}
+
+// UpdateCommunityRole converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCommunityRole(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "role_id", runtime.ParamLocationPath, ctx.Param("role_id"), &roleId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCommunityRole(ctx, communityId, roleId)
+	return err
+}
+
+// InviteCommunityRole converts echo context to params.
+func (w *ServerInterfaceWrapper) InviteCommunityRole(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "role_id", runtime.ParamLocationPath, ctx.Param("role_id"), &roleId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.InviteCommunityRole(ctx, communityId, roleId)
+	return err
+}
+
+// CreateCommunityInviteLink converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunityInviteLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "role_id", runtime.ParamLocationPath, ctx.Param("role_id"), &roleId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCommunityInviteLink(ctx, communityId, roleId)
+	return err
+}
+
+// ListCommunityTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCommunityTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListCommunityTemplate(ctx, communityId)
+	return err
+}
+
+// CreateCommunityTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunityTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCommunityTemplate(ctx, communityId)
+	return err
+}
+
+// DeleteCommunityTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCommunityTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, ctx.Param("template_id"), &templateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCommunityTemplate(ctx, communityId, templateId)
+	return err
+}
+
+// GetCommunityTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCommunityTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, ctx.Param("template_id"), &templateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCommunityTemplate(ctx, communityId, templateId)
+	return err
+}
+
+// UpdateCommunityTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCommunityTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, ctx.Param("template_id"), &templateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCommunityTemplate(ctx, communityId, templateId)
+	return err
+}
+
+// CreateCommunityPageFromTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunityPageFromTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, ctx.Param("template_id"), &templateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCommunityPageFromTemplate(ctx, communityId, templateId)
+	return err
+}
+
+// CreateCommunityTopicFromTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunityTopicFromTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, ctx.Param("template_id"), &templateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCommunityTopicFromTemplate(ctx, communityId, templateId)
+	return err
+}
+
+// CreateCommunityThreadFromTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunityThreadFromTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, ctx.Param("template_id"), &templateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	// ------------- Path parameter "topic_id" -------------
+	var topicId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "topic_id", runtime.ParamLocationPath, ctx.Param("topic_id"), &topicId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCommunityThreadFromTemplate(ctx, communityId, templateId, topicId)
+	return err
+}
+
+// CreateCommunityPostFromTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunityPostFromTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "community_id" -------------
+	var communityId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+	}
+
+	// ------------- Path parameter "template_id" -------------
+	var templateId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, ctx.Param("template_id"), &templateId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter template_id: %s", err))
+	}
+
+	// ------------- Path parameter "topic_id" -------------
+	var topicId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "topic_id", runtime.ParamLocationPath, ctx.Param("topic_id"), &topicId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic_id: %s", err))
+	}
+
+	// ------------- Path parameter "thread_id" -------------
+	var threadId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "thread_id", runtime.ParamLocationPath, ctx.Param("thread_id"), &threadId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter thread_id: %s", err))
 	}
 
 	ctx.Set(SessionScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCommunityInvite(ctx, communityId, inviteId)
+	err = w.Handler.CreateCommunityPostFromTemplate(ctx, communityId, templateId, topicId, threadId)
 	return err
 }
 
-// ListCommunityMember converts echo context to params.
-func (w *ServerInterfaceWrapper) ListCommunityMember(ctx echo.Context) error {
+// ListCommunityTopic converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCommunityTopic(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -1753,7 +4511,7 @@ func (w *ServerInterfaceWrapper) ListCommunityMember(ctx echo.Context) error {
 	ctx.Set(SessionScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCommunityMemberParams
+	var params ListCommunityTopicParams
 	// ------------- Required query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
@@ -1761,20 +4519,27 @@ func (w *ServerInterfaceWrapper) ListCommunityMember(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Required query parameter "offset" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListCommunityMember(ctx, communityId, params)
+	err = w.Handler.ListCommunityTopic(ctx, communityId, params)
 	return err
 }
 
-// GetCommunityMember converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCommunityMember(ctx echo.Context) error {
+// CreateCommunityTopic converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunityTopic(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -1784,23 +4549,15 @@ func (w *ServerInterfaceWrapper) GetCommunityMember(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
 	}
 
-	// ------------- Path parameter "member_id" -------------
-	var memberId ID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "member_id", runtime.ParamLocationPath, ctx.Param("member_id"), &memberId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter member_id: %s", err))
-	}
-
 	ctx.Set(SessionScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCommunityMember(ctx, communityId, memberId)
+	err = w.Handler.CreateCommunityTopic(ctx, communityId)
 	return err
 }
 
-// EditCommunityDescription converts echo context to params.
-func (w *ServerInterfaceWrapper) EditCommunityDescription(ctx echo.Context) error {
+// ListCommunityThread converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCommunityThread(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -1810,105 +4567,46 @@ func (w *ServerInterfaceWrapper) EditCommunityDescription(ctx echo.Context) erro
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
 	}
 
-	ctx.Set(SessionScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params EditCommunityDescriptionParams
-
-	headers := ctx.Request().Header
-	// ------------- Required header parameter "Connection" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Connection")]; found {
-		var Connection string
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Connection, got %d", n))
-		}
-
-		err = runtime.BindStyledParameterWithLocation("simple", false, "Connection", runtime.ParamLocationHeader, valueList[0], &Connection)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Connection: %s", err))
-		}
+	// ------------- Path parameter "topic_id" -------------
+	var topicId ID
 
-		params.Connection = Connection
-	} else {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Connection is required, but not found"))
+	err = runtime.BindStyledParameterWithLocation("simple", false, "topic_id", runtime.ParamLocationPath, ctx.Param("topic_id"), &topicId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic_id: %s", err))
 	}
-	// ------------- Required header parameter "Upgrade" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Upgrade")]; found {
-		var Upgrade string
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Upgrade, got %d", n))
-		}
-
-		err = runtime.BindStyledParameterWithLocation("simple", false, "Upgrade", runtime.ParamLocationHeader, valueList[0], &Upgrade)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Upgrade: %s", err))
-		}
 
-		params.Upgrade = Upgrade
-	} else {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Upgrade is required, but not found"))
-	}
-	// ------------- Required header parameter "Sec-WebSocket-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Sec-WebSocket-Key")]; found {
-		var SecWebSocketKey string
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Sec-WebSocket-Key, got %d", n))
-		}
+	ctx.Set(SessionScopes, []string{})
 
-		err = runtime.BindStyledParameterWithLocation("simple", false, "Sec-WebSocket-Key", runtime.ParamLocationHeader, valueList[0], &SecWebSocketKey)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Sec-WebSocket-Key: %s", err))
-		}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCommunityThreadParams
+	// ------------- Required query parameter "limit" -------------
 
-		params.SecWebSocketKey = SecWebSocketKey
-	} else {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Sec-WebSocket-Key is required, but not found"))
+	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	// ------------- Required header parameter "Sec-WebSocket-Version" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Sec-WebSocket-Version")]; found {
-		var SecWebSocketVersion string
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Sec-WebSocket-Version, got %d", n))
-		}
 
-		err = runtime.BindStyledParameterWithLocation("simple", false, "Sec-WebSocket-Version", runtime.ParamLocationHeader, valueList[0], &SecWebSocketVersion)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Sec-WebSocket-Version: %s", err))
-		}
+	// ------------- Optional query parameter "offset" -------------
 
-		params.SecWebSocketVersion = SecWebSocketVersion
-	} else {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Sec-WebSocket-Version is required, but not found"))
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
-	// ------------- Required header parameter "Sec-WebSocket-Extensions" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Sec-WebSocket-Extensions")]; found {
-		var SecWebSocketExtensions string
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Sec-WebSocket-Extensions, got %d", n))
-		}
 
-		err = runtime.BindStyledParameterWithLocation("simple", false, "Sec-WebSocket-Extensions", runtime.ParamLocationHeader, valueList[0], &SecWebSocketExtensions)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Sec-WebSocket-Extensions: %s", err))
-		}
+	// ------------- Optional query parameter "cursor" -------------
 
-		params.SecWebSocketExtensions = SecWebSocketExtensions
-	} else {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Sec-WebSocket-Extensions is required, but not found"))
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.EditCommunityDescription(ctx, communityId, params)
+	err = w.Handler.ListCommunityThread(ctx, communityId, topicId, params)
 	return err
 }
 
-// ListCommunityRole converts echo context to params.
-func (w *ServerInterfaceWrapper) ListCommunityRole(ctx echo.Context) error {
+// CreateCommunityThread converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunityThread(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -1918,33 +4616,23 @@ func (w *ServerInterfaceWrapper) ListCommunityRole(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
 	}
 
-	ctx.Set(SessionScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListCommunityRole(ctx, communityId)
-	return err
-}
-
-// CreateCommunityRole converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateCommunityRole(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "community_id" -------------
-	var communityId ID
+	// ------------- Path parameter "topic_id" -------------
+	var topicId ID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "topic_id", runtime.ParamLocationPath, ctx.Param("topic_id"), &topicId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic_id: %s", err))
 	}
 
 	ctx.Set(SessionScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateCommunityRole(ctx, communityId)
+	err = w.Handler.CreateCommunityThread(ctx, communityId, topicId)
 	return err
 }
 
-// DeleteCommunityRole converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCommunityRole(ctx echo.Context) error {
+// ExportCommunityTopic converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportCommunityTopic(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -1954,23 +4642,23 @@ func (w *ServerInterfaceWrapper) DeleteCommunityRole(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
 	}
 
-	// ------------- Path parameter "role_id" -------------
-	var roleId ID
+	// ------------- Path parameter "topic_id" -------------
+	var topicId ID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "role_id", runtime.ParamLocationPath, ctx.Param("role_id"), &roleId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "topic_id", runtime.ParamLocationPath, ctx.Param("topic_id"), &topicId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic_id: %s", err))
 	}
 
 	ctx.Set(SessionScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCommunityRole(ctx, communityId, roleId)
+	err = w.Handler.ExportCommunityTopic(ctx, communityId, topicId)
 	return err
 }
 
-// UpdateCommunityRole converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateCommunityRole(ctx echo.Context) error {
+// FeedCommunityTopic converts echo context to params.
+func (w *ServerInterfaceWrapper) FeedCommunityTopic(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -1980,23 +4668,23 @@ func (w *ServerInterfaceWrapper) UpdateCommunityRole(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
 	}
 
-	// ------------- Path parameter "role_id" -------------
-	var roleId ID
+	// ------------- Path parameter "topic_id" -------------
+	var topicId ID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "role_id", runtime.ParamLocationPath, ctx.Param("role_id"), &roleId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "topic_id", runtime.ParamLocationPath, ctx.Param("topic_id"), &topicId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic_id: %s", err))
 	}
 
 	ctx.Set(SessionScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateCommunityRole(ctx, communityId, roleId)
+	err = w.Handler.FeedCommunityTopic(ctx, communityId, topicId)
 	return err
 }
 
-// InviteCommunityRole converts echo context to params.
-func (w *ServerInterfaceWrapper) InviteCommunityRole(ctx echo.Context) error {
+// ListCommunityPost converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCommunityPost(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -2006,36 +4694,26 @@ func (w *ServerInterfaceWrapper) InviteCommunityRole(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
 	}
 
-	// ------------- Path parameter "role_id" -------------
-	var roleId ID
+	// ------------- Path parameter "topic_id" -------------
+	var topicId ID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "role_id", runtime.ParamLocationPath, ctx.Param("role_id"), &roleId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "topic_id", runtime.ParamLocationPath, ctx.Param("topic_id"), &topicId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic_id: %s", err))
 	}
 
-	ctx.Set(SessionScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.InviteCommunityRole(ctx, communityId, roleId)
-	return err
-}
-
-// ListCommunityTopic converts echo context to params.
-func (w *ServerInterfaceWrapper) ListCommunityTopic(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "community_id" -------------
-	var communityId ID
+	// ------------- Path parameter "thread_id" -------------
+	var threadId ID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "thread_id", runtime.ParamLocationPath, ctx.Param("thread_id"), &threadId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter thread_id: %s", err))
 	}
 
 	ctx.Set(SessionScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCommunityTopicParams
+	var params ListCommunityPostParams
 	// ------------- Required query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
@@ -2043,38 +4721,27 @@ func (w *ServerInterfaceWrapper) ListCommunityTopic(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Required query parameter "offset" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListCommunityTopic(ctx, communityId, params)
-	return err
-}
-
-// CreateCommunityTopic converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateCommunityTopic(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "community_id" -------------
-	var communityId ID
+	// ------------- Optional query parameter "cursor" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
 
-	ctx.Set(SessionScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateCommunityTopic(ctx, communityId)
+	err = w.Handler.ListCommunityPost(ctx, communityId, topicId, threadId, params)
 	return err
 }
 
-// ListCommunityThread converts echo context to params.
-func (w *ServerInterfaceWrapper) ListCommunityThread(ctx echo.Context) error {
+// CreateCommunityPost converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCommunityPost(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -2092,31 +4759,23 @@ func (w *ServerInterfaceWrapper) ListCommunityThread(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic_id: %s", err))
 	}
 
-	ctx.Set(SessionScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCommunityThreadParams
-	// ------------- Required query parameter "limit" -------------
+	// ------------- Path parameter "thread_id" -------------
+	var threadId ID
 
-	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "thread_id", runtime.ParamLocationPath, ctx.Param("thread_id"), &threadId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter thread_id: %s", err))
 	}
 
-	// ------------- Required query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, true, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
+	ctx.Set(SessionScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListCommunityThread(ctx, communityId, topicId, params)
+	err = w.Handler.CreateCommunityPost(ctx, communityId, topicId, threadId)
 	return err
 }
 
-// CreateCommunityThread converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateCommunityThread(ctx echo.Context) error {
+// ExportCommunityThread converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportCommunityThread(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -2129,20 +4788,28 @@ func (w *ServerInterfaceWrapper) CreateCommunityThread(ctx echo.Context) error {
 	// ------------- Path parameter "topic_id" -------------
 	var topicId ID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "topic_id", runtime.ParamLocationPath, ctx.Param("topic_id"), &topicId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "topic_id", runtime.ParamLocationPath, ctx.Param("topic_id"), &topicId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic_id: %s", err))
+	}
+
+	// ------------- Path parameter "thread_id" -------------
+	var threadId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "thread_id", runtime.ParamLocationPath, ctx.Param("thread_id"), &threadId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter thread_id: %s", err))
 	}
 
 	ctx.Set(SessionScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateCommunityThread(ctx, communityId, topicId)
+	err = w.Handler.ExportCommunityThread(ctx, communityId, topicId, threadId)
 	return err
 }
 
-// ListCommunityPost converts echo context to params.
-func (w *ServerInterfaceWrapper) ListCommunityPost(ctx echo.Context) error {
+// ListPostLike converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPostLike(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -2168,10 +4835,18 @@ func (w *ServerInterfaceWrapper) ListCommunityPost(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter thread_id: %s", err))
 	}
 
+	// ------------- Path parameter "post_id" -------------
+	var postId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "post_id", runtime.ParamLocationPath, ctx.Param("post_id"), &postId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter post_id: %s", err))
+	}
+
 	ctx.Set(SessionScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCommunityPostParams
+	var params ListPostLikeParams
 	// ------------- Required query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
@@ -2179,20 +4854,34 @@ func (w *ServerInterfaceWrapper) ListCommunityPost(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Required query parameter "offset" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Required query parameter "like" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "like", ctx.QueryParams(), &params.Like)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter like: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListCommunityPost(ctx, communityId, topicId, threadId, params)
+	err = w.Handler.ListPostLike(ctx, communityId, topicId, threadId, postId, params)
 	return err
 }
 
-// CreateCommunityPost converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateCommunityPost(ctx echo.Context) error {
+// LikePost converts echo context to params.
+func (w *ServerInterfaceWrapper) LikePost(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -2218,15 +4907,23 @@ func (w *ServerInterfaceWrapper) CreateCommunityPost(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter thread_id: %s", err))
 	}
 
+	// ------------- Path parameter "post_id" -------------
+	var postId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "post_id", runtime.ParamLocationPath, ctx.Param("post_id"), &postId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter post_id: %s", err))
+	}
+
 	ctx.Set(SessionScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateCommunityPost(ctx, communityId, topicId, threadId)
+	err = w.Handler.LikePost(ctx, communityId, topicId, threadId, postId)
 	return err
 }
 
-// ListPostLike converts echo context to params.
-func (w *ServerInterfaceWrapper) ListPostLike(ctx echo.Context) error {
+// ReadPost converts echo context to params.
+func (w *ServerInterfaceWrapper) ReadPost(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "community_id" -------------
 	var communityId ID
@@ -2262,8 +4959,30 @@ func (w *ServerInterfaceWrapper) ListPostLike(ctx echo.Context) error {
 
 	ctx.Set(SessionScopes, []string{})
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReadPost(ctx, communityId, topicId, threadId, postId)
+	return err
+}
+
+// ListJoinedCommunity converts echo context to params.
+func (w *ServerInterfaceWrapper) ListJoinedCommunity(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListJoinedCommunity(ctx)
+	return err
+}
+
+// ListUserConversation converts echo context to params.
+func (w *ServerInterfaceWrapper) ListUserConversation(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SessionScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPostLikeParams
+	var params ListUserConversationParams
 	// ------------- Required query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
@@ -2271,64 +4990,118 @@ func (w *ServerInterfaceWrapper) ListPostLike(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Required query parameter "offset" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Required query parameter "like" -------------
+	// ------------- Optional query parameter "cursor" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "like", ctx.QueryParams(), &params.Like)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter like: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListPostLike(ctx, communityId, topicId, threadId, postId, params)
+	err = w.Handler.ListUserConversation(ctx, params)
 	return err
 }
 
-// LikePost converts echo context to params.
-func (w *ServerInterfaceWrapper) LikePost(ctx echo.Context) error {
+// CreateUserConversation converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUserConversation(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "community_id" -------------
-	var communityId ID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "community_id", runtime.ParamLocationPath, ctx.Param("community_id"), &communityId)
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateUserConversation(ctx)
+	return err
+}
+
+// ListConversationMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) ListConversationMessage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, ctx.Param("conversation_id"), &conversationId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter community_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter conversation_id: %s", err))
 	}
 
-	// ------------- Path parameter "topic_id" -------------
-	var topicId ID
+	ctx.Set(SessionScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "topic_id", runtime.ParamLocationPath, ctx.Param("topic_id"), &topicId)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListConversationMessageParams
+	// ------------- Required query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter topic_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Path parameter "thread_id" -------------
-	var threadId ID
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "thread_id", runtime.ParamLocationPath, ctx.Param("thread_id"), &threadId)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter thread_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	// ------------- Path parameter "post_id" -------------
-	var postId ID
+	// ------------- Optional query parameter "cursor" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "post_id", runtime.ParamLocationPath, ctx.Param("post_id"), &postId)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter post_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListConversationMessage(ctx, conversationId, params)
+	return err
+}
+
+// SendConversationMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) SendConversationMessage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, ctx.Param("conversation_id"), &conversationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter conversation_id: %s", err))
 	}
 
 	ctx.Set(SessionScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.LikePost(ctx, communityId, topicId, threadId, postId)
+	err = w.Handler.SendConversationMessage(ctx, conversationId)
+	return err
+}
+
+// ReadConversationMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) ReadConversationMessage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "conversation_id" -------------
+	var conversationId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "conversation_id", runtime.ParamLocationPath, ctx.Param("conversation_id"), &conversationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter conversation_id: %s", err))
+	}
+
+	// ------------- Path parameter "message_id" -------------
+	var messageId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "message_id", runtime.ParamLocationPath, ctx.Param("message_id"), &messageId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter message_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReadConversationMessage(ctx, conversationId, messageId)
 	return err
 }
 
@@ -2347,13 +5120,20 @@ func (w *ServerInterfaceWrapper) ListUserInvite(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Required query parameter "offset" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ListUserInvite(ctx, params)
 	return err
@@ -2377,6 +5157,24 @@ func (w *ServerInterfaceWrapper) ReplyInvite(ctx echo.Context) error {
 	return err
 }
 
+// RedeemInviteLink converts echo context to params.
+func (w *ServerInterfaceWrapper) RedeemInviteLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token" -------------
+	var token InviteToken
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "token", runtime.ParamLocationPath, ctx.Param("token"), &token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RedeemInviteLink(ctx, token)
+	return err
+}
+
 // ListUserLoginActivity converts echo context to params.
 func (w *ServerInterfaceWrapper) ListUserLoginActivity(ctx echo.Context) error {
 	var err error
@@ -2392,13 +5190,20 @@ func (w *ServerInterfaceWrapper) ListUserLoginActivity(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Required query parameter "offset" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ListUserLoginActivity(ctx, params)
 	return err
@@ -2505,6 +5310,132 @@ func (w *ServerInterfaceWrapper) EditUserProfile(ctx echo.Context) error {
 	return err
 }
 
+// ListUserProfileVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) ListUserProfileVersion(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserProfileVersionParams
+	// ------------- Required query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListUserProfileVersion(ctx, params)
+	return err
+}
+
+// DiffUserProfileVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) DiffUserProfileVersion(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffUserProfileVersionParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DiffUserProfileVersion(ctx, params)
+	return err
+}
+
+// RestoreUserProfileVersion converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreUserProfileVersion(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "version_id" -------------
+	var versionId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version_id", runtime.ParamLocationPath, ctx.Param("version_id"), &versionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreUserProfileVersion(ctx, versionId)
+	return err
+}
+
+// ListUserRelation converts echo context to params.
+func (w *ServerInterfaceWrapper) ListUserRelation(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListUserRelation(ctx)
+	return err
+}
+
+// DeleteUserRelation converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUserRelation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteUserRelation(ctx, userId)
+	return err
+}
+
+// SaveUserRelation converts echo context to params.
+func (w *ServerInterfaceWrapper) SaveUserRelation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SaveUserRelation(ctx, userId)
+	return err
+}
+
 // ListUserActivity converts echo context to params.
 func (w *ServerInterfaceWrapper) ListUserActivity(ctx echo.Context) error {
 	var err error
@@ -2527,18 +5458,43 @@ func (w *ServerInterfaceWrapper) ListUserActivity(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	// ------------- Required query parameter "offset" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ListUserActivity(ctx, userId, params)
 	return err
 }
 
+// ExportUserProfile converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportUserProfile(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "user_id" -------------
+	var userId ID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, ctx.Param("user_id"), &userId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(SessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportUserProfile(ctx, userId)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -2568,117 +5524,275 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/action", wrapper.ListAction)
+	router.GET(baseURL+"/community", wrapper.ListPublicCommunity)
 	router.POST(baseURL+"/community", wrapper.CreateCommunity)
+	router.DELETE(baseURL+"/community/:community_id", wrapper.DeleteCommunity)
+	router.GET(baseURL+"/community/:community_id", wrapper.GetCommunity)
 	router.PATCH(baseURL+"/community/:community_id", wrapper.UpdateCommunity)
+	router.DELETE(baseURL+"/community/:community_id/archive", wrapper.UnarchiveCommunity)
+	router.PUT(baseURL+"/community/:community_id/archive", wrapper.ArchiveCommunity)
+	router.DELETE(baseURL+"/community/:community_id/ban/:user_id", wrapper.DeleteCommunityBan)
 	router.GET(baseURL+"/community/:community_id/invite", wrapper.ListCommunityInvite)
 	router.DELETE(baseURL+"/community/:community_id/invite/:invite_id", wrapper.DeleteCommunityInvite)
+	router.DELETE(baseURL+"/community/:community_id/join", wrapper.LeaveCommunity)
+	router.POST(baseURL+"/community/:community_id/join", wrapper.JoinCommunity)
+	router.GET(baseURL+"/community/:community_id/link", wrapper.ListCommunityInviteLink)
+	router.DELETE(baseURL+"/community/:community_id/link/:link_id", wrapper.DeleteCommunityInviteLink)
 	router.GET(baseURL+"/community/:community_id/member", wrapper.ListCommunityMember)
+	router.DELETE(baseURL+"/community/:community_id/member/:member_id", wrapper.DeleteCommunityMember)
 	router.GET(baseURL+"/community/:community_id/member/:member_id", wrapper.GetCommunityMember)
+	router.PUT(baseURL+"/community/:community_id/member/:member_id", wrapper.UpdateCommunityMember)
+	router.POST(baseURL+"/community/:community_id/member/:member_id/ban", wrapper.BanCommunityMember)
 	router.GET(baseURL+"/community/:community_id/note", wrapper.EditCommunityDescription)
+	router.GET(baseURL+"/community/:community_id/note/export", wrapper.ExportCommunityDescription)
+	router.GET(baseURL+"/community/:community_id/note/version", wrapper.ListCommunityDescriptionVersion)
+	router.GET(baseURL+"/community/:community_id/note/version/diff", wrapper.DiffCommunityDescriptionVersion)
+	router.POST(baseURL+"/community/:community_id/note/version/:version_id/restore", wrapper.RestoreCommunityDescriptionVersion)
+	router.PUT(baseURL+"/community/:community_id/owner", wrapper.TransferCommunityOwnership)
+	router.GET(baseURL+"/community/:community_id/page", wrapper.ListCommunityPage)
+	router.POST(baseURL+"/community/:community_id/page", wrapper.CreateCommunityPage)
+	router.DELETE(baseURL+"/community/:community_id/page/:page_id", wrapper.DeleteCommunityPage)
+	router.GET(baseURL+"/community/:community_id/page/:page_id", wrapper.GetCommunityPage)
+	router.PATCH(baseURL+"/community/:community_id/page/:page_id", wrapper.UpdateCommunityPage)
+	router.GET(baseURL+"/community/:community_id/page/:page_id/note", wrapper.EditCommunityPage)
+	router.GET(baseURL+"/community/:community_id/page/:page_id/note/export", wrapper.ExportCommunityPage)
+	router.GET(baseURL+"/community/:community_id/page/:page_id/note/version", wrapper.ListCommunityPageVersion)
+	router.GET(baseURL+"/community/:community_id/page/:page_id/note/version/diff", wrapper.DiffCommunityPageVersion)
+	router.POST(baseURL+"/community/:community_id/page/:page_id/note/version/:version_id/restore", wrapper.RestoreCommunityPageVersion)
+	router.PUT(baseURL+"/community/:community_id/page/:page_id/position", wrapper.MoveCommunityPage)
 	router.GET(baseURL+"/community/:community_id/role", wrapper.ListCommunityRole)
 	router.POST(baseURL+"/community/:community_id/role", wrapper.CreateCommunityRole)
 	router.DELETE(baseURL+"/community/:community_id/role/:role_id", wrapper.DeleteCommunityRole)
 	router.PATCH(baseURL+"/community/:community_id/role/:role_id", wrapper.UpdateCommunityRole)
 	router.POST(baseURL+"/community/:community_id/role/:role_id/invite", wrapper.InviteCommunityRole)
+	router.POST(baseURL+"/community/:community_id/role/:role_id/link", wrapper.CreateCommunityInviteLink)
+	router.GET(baseURL+"/community/:community_id/template", wrapper.ListCommunityTemplate)
+	router.POST(baseURL+"/community/:community_id/template", wrapper.CreateCommunityTemplate)
+	router.DELETE(baseURL+"/community/:community_id/template/:template_id", wrapper.DeleteCommunityTemplate)
+	router.GET(baseURL+"/community/:community_id/template/:template_id", wrapper.GetCommunityTemplate)
+	router.PUT(baseURL+"/community/:community_id/template/:template_id", wrapper.UpdateCommunityTemplate)
+	router.POST(baseURL+"/community/:community_id/template/:template_id/page", wrapper.CreateCommunityPageFromTemplate)
+	router.POST(baseURL+"/community/:community_id/template/:template_id/topic", wrapper.CreateCommunityTopicFromTemplate)
+	router.POST(baseURL+"/community/:community_id/template/:template_id/topic/:topic_id", wrapper.CreateCommunityThreadFromTemplate)
+	router.POST(baseURL+"/community/:community_id/template/:template_id/topic/:topic_id/thread/:thread_id", wrapper.CreateCommunityPostFromTemplate)
 	router.GET(baseURL+"/community/:community_id/topic", wrapper.ListCommunityTopic)
 	router.POST(baseURL+"/community/:community_id/topic", wrapper.CreateCommunityTopic)
 	router.GET(baseURL+"/community/:community_id/topic/:topic_id", wrapper.ListCommunityThread)
 	router.POST(baseURL+"/community/:community_id/topic/:topic_id", wrapper.CreateCommunityThread)
+	router.GET(baseURL+"/community/:community_id/topic/:topic_id/export", wrapper.ExportCommunityTopic)
+	router.GET(baseURL+"/community/:community_id/topic/:topic_id/feed", wrapper.FeedCommunityTopic)
 	router.GET(baseURL+"/community/:community_id/topic/:topic_id/thread/:thread_id", wrapper.ListCommunityPost)
 	router.POST(baseURL+"/community/:community_id/topic/:topic_id/thread/:thread_id", wrapper.CreateCommunityPost)
+	router.GET(baseURL+"/community/:community_id/topic/:topic_id/thread/:thread_id/export", wrapper.ExportCommunityThread)
 	router.GET(baseURL+"/community/:community_id/topic/:topic_id/thread/:thread_id/post/:post_id/like", wrapper.ListPostLike)
 	router.POST(baseURL+"/community/:community_id/topic/:topic_id/thread/:thread_id/post/:post_id/like", wrapper.LikePost)
+	router.PUT(baseURL+"/community/:community_id/topic/:topic_id/thread/:thread_id/post/:post_id/read", wrapper.ReadPost)
+	router.GET(baseURL+"/user/community", wrapper.ListJoinedCommunity)
+	router.GET(baseURL+"/user/conversation", wrapper.ListUserConversation)
+	router.POST(baseURL+"/user/conversation", wrapper.CreateUserConversation)
+	router.GET(baseURL+"/user/conversation/:conversation_id", wrapper.ListConversationMessage)
+	router.POST(baseURL+"/user/conversation/:conversation_id", wrapper.SendConversationMessage)
+	router.PUT(baseURL+"/user/conversation/:conversation_id/message/:message_id/read", wrapper.ReadConversationMessage)
 	router.GET(baseURL+"/user/invite", wrapper.ListUserInvite)
 	router.DELETE(baseURL+"/user/invite/:invite_id", wrapper.ReplyInvite)
+	router.PUT(baseURL+"/user/link/:token", wrapper.RedeemInviteLink)
 	router.GET(baseURL+"/user/login", wrapper.ListUserLoginActivity)
 	router.GET(baseURL+"/user/note", wrapper.EditUserProfile)
+	router.GET(baseURL+"/user/note/version", wrapper.ListUserProfileVersion)
+	router.GET(baseURL+"/user/note/version/diff", wrapper.DiffUserProfileVersion)
+	router.POST(baseURL+"/user/note/version/:version_id/restore", wrapper.RestoreUserProfileVersion)
+	router.GET(baseURL+"/user/relation", wrapper.ListUserRelation)
+	router.DELETE(baseURL+"/user/relation/:user_id", wrapper.DeleteUserRelation)
+	router.PUT(baseURL+"/user/relation/:user_id", wrapper.SaveUserRelation)
 	router.GET(baseURL+"/user/:user_id/activity", wrapper.ListUserActivity)
+	router.GET(baseURL+"/user/:user_id/note/export", wrapper.ExportUserProfile)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9bXMTR5p/xTXJVd3dDsgGjtrzVWorIdSt64BQBnYvhXyusaYtzXo0o8yMjL0uXUWa",
-	"JREYlhxgDAdJzrtJMPbakIVLnMXgH9OWbH/KX9jqp3s0bz2aGUm2eTEfkDzqebqfl37ep2dGyOnFkq4h",
-	"zTKFwRnBQJ+UkWl9oMsKggsnDCRZ6IReLJY1xZoepr+TX3K6ZiENvkqlkqrkJEvRtczvTF0j18xcARUl",
-	"8q1k6CVkWAygok0qFgwlf8loXCqrljA4LqkmEgVruoSEQWFM11UkaUJFFDSpiMjIdw00LgwK72TcBWfo",
-	"HGbmDBlTqYiwfMVAsjB4kd4oeucbacHXx36HcpZQqZCbgijqKuoeTSlHhlGMLVQ041B4H8YThNkSJcOQ",
-	"pntBAGclbbE/q5tW90iz25JjfYLNUxGFojQ1RG/5F1EoKhr7YyBIkACSrSnbone+YCBJfpMR1EtK7lXB",
-	"b6C/LYLdi3QcTYbInu/1ji4izdFa7dZ9mg4zgSTINKV8LKrnCrphnWZjgyg707rQIpA+pUygXkhAschu",
-	"S75kUVCVCUAzqL8DyMCwiPUPo5I6fcFEBuVeD1Rw3kCxtH+fDAKMg2ult0cs9kJJ7q1Z7G5LJFzl22TZ",
-	"4BazpGtmhCNDf+vGk5HjFj/0YWjpisxdrijIyMwZSomqGGHzxYNm/QtcncfVr3HtKba/xva32J7F9me4",
-	"9mchqPdfH1zsOrZvY9vGtccEi39HVoslp1FxDBk9QKYIgOIVNYwCnHJIs0aJOE0qLYokle1JxZoO27+2",
-	"Np0tkDdzEoI2btxpvJx3CLqA7afY/gLb6wJYAdOiO64HhCTfpXS7/SPnFt6GN5Cpl41cCgIPszvC0AIk",
-	"dUGL3mWnJmftT7j2GNd+xPZDbD/9eb2O7SVce4HtdVz7CVcX/X8uNx6/xNV7uDbbvHV988WDn9evOExo",
-	"ibVjzrrfowAojW/mW0EsCR34aWnWnL3feHk5hHePt3NyvN2NndzLduboxfYL2Nuu8Td0Nc2O0VWUDncK",
-	"Pz3mK2QX2MsO2iSEpD5o1xgTNzE5xmTSWOGmIFOL9u3HzWvVzObadfrNi2sP8CzpZopYi0yajrMUfnrO",
-	"fkmUm113sHWi567xtQBQcozpxLG8dcCmV/Y/YfsvxBmxW2q7Vw6VReCkwJQMj0eUAk3PUL/TRfAkgZbj",
-	"vfQA3S59p7ZYd+MdUXMOTjO2b7nes0OCfTPO7uR7ZJfJhKf0vKK9BVy3V3DtCa59g+2nje+/ba48A+hs",
-	"CWSFLCaFBLQXyvbCtcbsnCDukR+c3PuN8HbjnF1RaNE7hCt/bwRR/20Bxaa8LmjK1HmlCJIsx0eSJ6dK",
-	"yFCQRr36SwVkACF0DX00LgxeTOjUCpURuFtPfi/ZAkJFTOg9JnGzRoKMuUTIRalAludmlzi1joAAf1Fv",
-	"/uFGVvvnPssoo75DffQCjS7IZbjLc30eV5dw9Q9ZMl+4bHKigHITH+hTHL7bVVx7SK0Bth+wL7WfQqyf",
-	"lNRymnCDzXhSZfm0NJ4Km4snwUG4iRHC1dXt76pbz/4vhFmOgERysgIULC3WfqMpi4+UILam42Knq7px",
-	"QpdRGK+B4zuf/rU594TgceWvkAVaJ56KKKApqVhSCaB3+uGfKwKmZShankJ2dgpn64cySmJHCSFx78p5",
-	"iiyIiWp6HsyZgU2AP64u41qdxPHUaAbJIVlplGBS4nVUHxAhYEsaBpbNNKEz05Ax/gjhBKzBAS8S+vA5",
-	"0fIrAtrus8uN1bDKQZrFBDaZRqfbLkZV/xpJMt0TcXGkGQ+spVdjDYQkK/oHZcsCFyBmhbqh/F7XLEk9",
-	"pWgodvhQkQpC0kLUSIujiYp558nQUOhBLooOg9rw+jybiMdvXF3dWlzdWfgKzByasvoO9cH+W6EBJ7lc",
-	"oNzqO9S3/d1s4/O/4eo8uawqJhm9tfp588uF5v01XL1OLoNqHdOnABDfDJBhBmHGGDADRj7CtTVcWyLD",
-	"ahvYfkpn0Ih5bT551vjp6daP/0uuKUWykK3bzxs2mGZWjDMBCGR+nEwhmGGklYtALCKWosBQEUSBrN4x",
-	"BGP6FNk/7orgdw30WjEPdT4t6M95tHrZMJBmETkxT7vqw0/trRsvGw8WcXW1cXlxe+EagZwskaIhnrv6",
-	"IVKRhWTyc+SUjStXd+59Q/1vOmUwHo71mA0ZGWfK1PsKBb5ckTspK3Hr4q2lmyp3kDY6WXUq1FqrmU7C",
-	"j7PO2CBJ6MRta+Gi4HG1Q5TZfHFrZ/FWdKyTKsLxRjTJVDfEovHq1nX3e+m3i8myMYnycUmSkyNJAjgu",
-	"Bx3jFRZsRzuGOKiiSaTGcY/BPQVje+Ll0mnb4HDKWVcEIpCLu4ftZQGKaUqRKNNjEEXQ724QoWgWytPN",
-	"FLCdIfAtfR6ik6n8HsZz5+oPzxXAG27noTv0IYE6rhtF4jUK5TI4TCFNTq14WHeDsQkttoCUfMHytFi4",
-	"gMpGLLcvDJ+CWFuRrQIHRgAzApCLmCbzAzDItGD7c/i/LsSxbEgzkRGnuJvXNhqXv91jg3KKtbEElhKs",
-	"AgTXMjadvCa1Lw02BLOiYvmkfaC/v728k5u0A5MaIqQHAo82xO36/qvmpw/DIpvAB/eCj3bE4xbGd8K9",
-	"i/O74no+ryJwauu49gTby+TqmKrnJj4p6xZkndbntm4vgsstqapetuDaj9tLG37PFyAJouDeTAhKb+E6",
-	"tBB1hbWgx8/vkI4mC2TEtIkscms3SSyxHY9c0Jw81hINg6ITV0pLA7eND+monlh1NmMUMnxB82LiSllR",
-	"kUu6ohHJ2Vxb2Xl+EyIsqm1I4DX3pLEy75Mm5w5YB9VKXBECV5KzCrcMEKZkaVSSZQOZJtemtpyyUXPa",
-	"tFCRb3hNZIxKecaR9jbVMx8Hug/WCKCk5T2msShNnUJanhjvY/3/epxDg9OtnqcgETwNCp0m+dImnJKl",
-	"mXhpJbibJ2yn3e5bLoJOHN45jt3WZGhazPm1DQ5pOlk0J8BK0V3N5MY8rw+jnIImedH6jXlc/Z/GjTu4",
-	"NgsEtHHtOTQ1rXWdk+N5d7HBmz6Z7oZw5B93ByeJkTgxxujYYWLM5cc5pMlhZux8Wt3cWMDVOVy7tgv8",
-	"4GWMEmNOVtwp2kGeho38w+eN2bloF3/c0Isp/cDuogKYUIwKDs6w0oVHGQ8c+SVHF380Pm4i6my3da8/",
-	"8qZaAiEHtBOCuwV9vsRmQhstuVSGtm5iMu8/a955Qi7JIN3EJ4M8nM+KUghEucJ9gijQ0Vxb6iUPR1L/",
-	"vDW3FBvfQWKEo6idtqLuiit78QyOyIgmJ4/rktsZKZckvTbsjOPaGcnyxikeqDzB9ZYiOGwJJsO7LQR7",
-	"put1LZgDOgFCr0UheNgjFxxv2teWHMJDVsxWz2Qgy+skLZpzT4S4eD8CRlIA3JZL0V0cH23XtKZxU3yR",
-	"hQIuB1GIkDSCYEOfJOqQmpgOlCYFSRDWJ5PqzmGPC8lhIesah8WYEPJg+zu49gO210HXO9lu8luoRk0L",
-	"UETfuOUn8OuhwqWzAJ515dKwvqTkWFTv9P/BdeiXhDncBkjyQ0k3aUnuS7ceVzJ0wiu4PA8h1RquPYQa",
-	"W51GdSoyLV1D3BG4uortryAGW2YhIVkeFNwsyZyIvKm2QYaz9Up5WOwGrgHfkIpgqxBmXp3b+pamJQq6",
-	"Dv0x9BKuru5U15pXv9qu/YlW9ybgx0Aqz8dzCD5oho7WHETPkxq03g0kJZ+0M1WEFlu6HUGiyQZh9CCj",
-	"JHMCPvJkFrZq0ANkrYIYTNd5RIlFXOGIlvZci7v2UFZSO9aTRo7oJ7hEoeV6pnSYfZohR51fogn8NVG/",
-	"h0RHcTnhS8QGvL9j/ZwbwAJwWOeWuTk1tyQeAQHs5i4T2iNvIiHKLLHpeUzwzdkWqei055iuygn6375Z",
-	"aqzMc3vZcrqqG/FentNIVREFfUxVPimj+Fmbdx5svrjFndUqGHo5X0gA48otXF1o/lDH1flm/TsusLIm",
-	"I0NtpdPbgdtcm6XVKg6UhJWeAJ+BAS5RvKtx0eRyn2o5TsHH0zgfitoUw7RGSywGSPJAQ3LXuaROJyiC",
-	"gIbxLMO5k4siKPRwqvzR9zs9LnOkyODsWvChSilZ0xMt37aeQgR2cMbT0liwrJI5mMmoek5SC8y8SpaF",
-	"DMKY/4KffzWYyVzMZi9lBv/hnWz23Wy5v//I8Wz2V9nsP2az//Tf2ezh97LZX2Szh0Z+8S6v9tqKJ0OM",
-	"v3Bm6D+b92o7d279vF7fenizcf3u5ovrP69foUXpluqnLPT+GXanL5j8jKzr9HXedllM0DfIqr494CGX",
-	"ce4TDG1xxNVr1I93H0noNgHgaWxN2jzy6vRk8ojsdTmZp8ntqKyIgolyZUOxps8RoJR855BpMv9BIdTP",
-	"6fqEghzeDQom+91VNCXlP9A0fYxC0cZ1R7VJOcs9i0CQygVLB0H2M9fUzD6ppBwm8BQL9iy59P7ZIUEU",
-	"JpFhsvblw/2H+1ktRZNKijAoHD3cf/go3c4FWHnGjXnzNGPWKo0MycKg52lmIfA4/5H+/ihyt8ZlOA9D",
-	"e0kIqdMW8S6OVEZEwSwXi5IxDUbu/8HZvkVbyRv1R1u3F7c/vUzkuf5D4+VC48bjbfsFrgajc1y76TyN",
-	"cg/XZmkAYDpPWgrQC5TxCbGjkf3IB04sEETP6UzT0ch7DnDKRJzeVAkRcyCemFEHKFRE4Vj/UY4JXbre",
-	"uPG49ZxCKsqH2rNrN51TBAIkdekYoGtmpvV1VJErQGbJyhXCdA6ckgHyaUhFZEHn9EW2qYjMulvKC1vw",
-	"bmjLKCPR88BU7JEKIx2wNeL0kQp/jwS81foXjatfJ+caGXeMk5pZuQvhVI+4S5MyHXM3o7QMUaQeCT4V",
-	"sLdsFhn8T8rImHYnUKEtp1PItKknErhO6xCdQmdlDCainejeqDMQ9kSoqqvU24hWxymlKzNDPx11wjKB",
-	"IWGjlcZXQ9z88Fvr74HKeh31jFckWL96pyLhnjETr3BOO0nEA4WzVwoncPjIHkmXNynfA7VDZSwzQz8d",
-	"tcOVt/A5Sq+EwmmtfFcUTnt5aHO01H6IQ3V1+9HTrWdPeiAXmu5zdfw4GCiHlEk02Hcxq/X1cZpxRHI9",
-	"2JwBF0NNNXA13DiT1Uaymok0mU3C6TCBId5ON5BSMkGLJx96lr0/slpAEm34ZTOc0DUNudFmFHw3VXWh",
-	"lDckGYUzTJFTuHckgX8JjZl6bgJUatIZzqHcod+isXNw3yES5ieb6+Pjv/m4fPRo8eSvPz5+yVCOnPnl",
-	"B5MX8u+91/HUv2GZgETTDxztdJqTUxbSTKgiJUO0hAyW4zkko3FVstC/9eVUBWnWaFGaGr2kaLJ+aXRM",
-	"sUwO6kFNNEAj52D2Cyqadh20wjLZ8fXPce1q8/4GrtbppqdI0ZMJXbHznbURbij1Y/5+LodKVtw9jsS1",
-	"HVZ5xdy07aW/NO/+Eddubv24uHP/s85VpZOWi3fShmnabc9D/67cHN8JY3tm1VgROpklE5Nlt/aL/F0m",
-	"1DwnqkYk1XhnYTbX6ri68arFRl7Gdpltg32XmSH/p4yW90EO+K4rW/tbGyn7tnl8sCwmzK6+gfztMnnb",
-	"VoW8luLSbTLXrzw8uV2+JeGc9v52S1ib4+/fICNVXWbJvI7lzHJ6P+K9w/Os7+8gg7fLGTz/+Zt7JlRu",
-	"g2xPvdr9EJvO3VrfS0S6qBFzePgqKRAfr7t1dEGHZGbgo22G1q9OWu3D+26lnJUf6Kr0usp/LvIuCLBX",
-	"Vqur3rbL3qqpN00cu9CBvjdFvVbukk9YlgPC4tVz8FaDL522bXrlSi+UX4Y+FpGZoZ/J9eFZp83zzdKG",
-	"AdgOUQ5UbWpV6ztwfzc8Bc9mIT6Cszt6qWUPhHwvFLj3TYavV7TrFcFlrwiGdPcSVJge4do3u62+M0Sy",
-	"MzPkf/jTOYYqUqU7bwE5EPRugDN6v72GInLtE+1r56Eng7qyOL7X2eyGx9ZywlaDD6bi6io7M7crE0Qw",
-	"OLA7r8R27MSked/pGTZmR7hPzG8+v9tYvbb5t8/2NRZpSTZ9Rdp8SL5rN7cXFpt3/xibeimbyEjS3+15",
-	"Hokv7G+Vw8x5uU1y9m0vXd9eXKcukf9RrtieanqAV5BxSVun4R2wKRqme9nQnHJrRryuNnUJr6f7Lp5x",
-	"1eXGxoOtlduxjFOdQ/XabjjfG4UO9l271yztKbfDr0BKvmVf6/ZSQv+zhj6uRBaBD7o+D7o+D7o+97nr",
-	"s63qonScg/eSsZaSiD7QoOqagWNUFbmSkTzvPWtrwWKMl9/fYOAPErgd2cU9MIk+WeK94C7eDMJcxqQj",
-	"Bf6VwaEUAjuJBY6q8J5UMThw9MjRjFRSMpMDQmWk8vcAAAD//21hTK9ehwAA",
+	"H4sIAAAAAAAC/+y9+XMU1/Uo/q+oOvlWfV/SeFgcV6JXqU/hLSHBy2NxXj4Wj2rNXEkdzXSPu3sERKVX",
+	"6h4WbQRFgACDwWKTkCwJG2ILI9Af05pFP/lfeHXuvb3fnu6eaUkjmFRKyK27nnvuuWc/w1xWLhRlCUma",
+	"ynUPcwr6qoRU7X05JyL84X1B+kAuFEqSqJ37BBV6kXKMNIE/ZmVJQxL+VSgW82JW0ERZyvxDlSX4pmYH",
+	"UEGA34qKXESKRsdEZ4uigk4LuOOvFdTHdXO/yjgLyZCOauakJJ49IRYQN8JzChLosI16HB+QFe0TpKpC",
+	"P+JGRnhOO1dEXDcn9/4DZTVuZAS+faAgQUP2to5IQ6KGjorS4C5trSCcPV1SyQA5pGYVsQiTcd1cdepS",
+	"ZeVrU79h6oumfr7y7fPK9Jipr9bOz1XGfty6Nc3xXEGUxEKpwHUfsHcrShrqR0pcALS+bRFAKJBFwx76",
+	"hFJe47r7hLyK7CX0ynIeCRLsWBIKKApCnwoEOkOiKvaKeVE7F9XD3tAXTpcRjDpflUQF5bjuL8nMvHvB",
+	"p+LBSM6j1uEkZKEZ/lXUUEGN2tFh3J5zjlFQFOFcMgiyAWCtJGL30hBSVLy31jdfUpHCwPH6pcXK2MXN",
+	"l48qD2dNfaVyxahMfFsfvcDx8WB05EN6h46Q1n/AV4L+xwE/5HzQIGtqCITPhX70sSIXTqBCMS9oKWCB",
+	"rOSQErUtmPYz3HCE54qCgiTttJiLBwxN1PLx75eQL6FIKFu7/4K09sORDhIJyDcLeD4gkJ6NYSCrWrrI",
+	"tHPHJ6ta68ul3eKTwA/oPJ47/rskd9yekrE9ntPQWS1TEJTBnHzGt/JGy/rE6uEGUSunGuf03pdz57wT",
+	"DihIyHVOpfGpyEUxm+6lS8S/7NQFxftsF1w4sL8hMrTOwGw7/vA+PsUzZBdwKhY3XrkwtvXtsqmvmOXv",
+	"zPIts7x0wNRX6o8nK5d+Bt7dmDHLY2b5mlkum8ZqZfqyqS+Y+i3TmARAEPEjZUYTFQQxz2C2zPKcWV43",
+	"y0umcd8sj8OCjRemPl+dvF15fYEs6pf1MbO8bBpPTeOhWX6GpY97pn4Vr3qKSCJm+TGMY/yIfxpW93lT",
+	"v2wak+aoUb0zXpl4YeqL1p+mTN0wjUkbaPS7s56ZrVHdNCZqt5+b+q1f1sfj8n8fwVYP53IKUlUWp9yc",
+	"SIYkS5ppiC+kmUq6EKkzqYTqxm1rWme0kBt/VBxEaVz1QoF2i79knsuLg3ibfrnOtxncLGT9n8hDe4Mf",
+	"9O2JzBayqWOomD93UkUKudKt703oV1AkQh2GRvgY/Ysl3UMWe1wYQrDWYyifknxHZom4Y64ZT0B7/5rx",
+	"IGFLRlLuQ1FBWQsZ3zjGB9qeUARJ7UOK/SJ8dkZCijogFlvfbgFr8JpDfadvyMJPFnNujUla2kJFzqPm",
+	"Vmz1jLfeHeYK09dqxdzm26XIIrtP56nZJs0AWeKOyY54aWpRlqjGuYE2nLRqAWJ5URqMvLj2hAwOQhpk",
+	"Qs3Pm2++ulMdmyacqsVYLgLvaqxyLH13yxtrih6JucSbMY1nZvmeWX5klifN8kXTeMCFaGf3zJY217+u",
+	"P/me86kF986JlL/GMs8ax1D77J1d6D+Y+mLl1X3Orz7YQwfhyNSwiw/Fvr5PZQ19gRQ1nRuRFyUU/4k7",
+	"KkoI1hB85IJUDamxtlufm6pcvrn56jJoG35aqYxdxBuVsyXg90N2iPUUA1ohH/+N+POJT45ul9rkcDaL",
+	"ilqXqS9VNu6Y+k1Tf9z1iaNFeY2RcbULloAbPRyvXrlNTrhy8UJl5QVs+WOEcjEOVFHV3571b5xCWdUU",
+	"UepnrfDY8eNdB9/Z34UVONdN4wHWSIzDvH9CWoCfbRmtCCMdCVPSCuNOFuRVYHSGRE1MgJKHSZdzQdVc",
+	"QwSlC2TNHAdrK1dmK69vWJd0Dp7h8rRZXvcDNAVQZq2xYjPRx0uFgqCwpDJrpMRbZD7Rf0JaSg9br5Ad",
+	"BEaIpc0DpdyK/SKZ+pLN9uC1PQZNnTFpN4irUvucKnr8vHoxhn7rc5ZeC3fkXVtJjkeuV/dPSEvxydXo",
+	"UHEZ6cDm7AESb8r9CB8VVY1ISSnsCX4Xkklon1ldWAevIFUuKdkEpOcY7RH5GjpD8+5lJ7+F9wHrjZ/M",
+	"8rxZfoZ12Ium8QpjzQtQXXv+c6my+ppou6tXL2++ugP6ZnoI2ycJxYeeWyZiiMzorHY6W1JUOfIV+YC0",
+	"YglWyWHMkKwYAEuDhcQDJVHKeVawPTCzFtUc1ALASpmdiA8sh7FobJ9rGWDWwlrlGTxgI+qrloEGqsEE",
+	"xEzOowiAMVSPzex8mVjDnG2nKuJnXcMlUnnbvbbnannXlRRqjkIBQOYzEKRwvfBI8cHlWcD2wMteU1NX",
+	"q2waLx1mCmD2F1mUUG47GHOxGSpu8+iRlhRnkniQANdCD2fM5N0BJOlqEpo4cp4bItPHB6BrzZGwswdP",
+	"ikK18bFf1seqs0/xf57f+vaii3dKSeQpJrpwMOkJBUWzmsUm7wwVPTBPuQS/l8e8YtfK5tpjU3/mg4Ws",
+	"asRMnwLzOJhIETWItottHGyGAbq2Wp3SM5trl8lvbgDt1t0qymoCoy+sNNn7T8YPd01qSj8XgabfgGRT",
+	"HrPBW+rNi9n2J+rpMBBJXwIHcJUL323NNngJtkHVEB98jtIhAuGcoZvWQvyyPlaZvlwZv+ylY5aP6e5c",
+	"VA3PngBguH00uOiwydUML7CTX9ksOwBKyXDSHHxg8gTggebR0CGD7iz58lpzALDgLGSpsFOAb4sK9PSp",
+	"Vit6daLuwoTKLF/1Uiy3C9qO62KcydtZDQOrPCr3i1IHv0I1EZYDcOX7R9Xl527IOT6DrSti6FDJEMxa",
+	"QAztcr5ZhXJ5FqtjgCBlLNZgnfJXx1AOoUKqCuLkpqyWbVi2NBxiw2L4W7a1d8DWqL65MRei6Rjh6Zrw",
+	"Mg5nBz9x/LX9gLlh6v+uXJk19QfBkcBRXX9Su7ZAxeH/TFfv3uF4llcyK9jx4aXq9af1uSlTXyGWB3C+",
+	"n9qoXHhkll9Wbz8Hwbr8sjI+sXXrIZmBNCZzVl7D7/W5qdr1xcqVn4Bjoy1d0Qj4S2X8srulOaof6PJN",
+	"C3HET35O4GmPPag/LVnqYz/BUtFXkZ7k4NwmZZF/kChbUlZEQ4jpLwyT0lF4C+pBXOE56oAYPJC5qcrk",
+	"9eD5bZMBLb7ZLMRMFmUl4zn7TQnslc00+Lf+twEkJQmVyEVf6Y/OFpEiwsFDhzMDSMGAkCX0WR/X/WVs",
+	"encK95bj94W3ghvhYxpE4hgBTvkP5gyAi0ABlud44zMC4X10Znqsev5Kj/SbLk0poa59XeQDMUvCZ9zL",
+	"9Z1mAeiROFZM/QcDKDv4vnyWFfSjm8Y8ecrM8h36i/EicPROqFo82Z7O+FGe7DiRhiQ0oo3n/OPG3hCO",
+	"utJrz78N7CwLQ6JcvOwEeGnRkvlZjb0pjrenY+5OziHW3XxG3Y78S88LUn+J+VBV5yers5eA9q//VF/c",
+	"MPWlzVcbpn6xvjBaX7xbmb7MYbefo0jq1wa47kMHea4oaBpSoPP/+bKn58yvfvtOT8++U7/5Ncf7/aR4",
+	"zqFYrkEOvHfo9+8GGvuJMunJ3n1eVtggOPDe1ugP1etP4RTHf3ADBJ0VCsU8DPSr/fh/rOV+4GagGMD1",
+	"sjd8U3wJv62ZLlRN0EoJNGqkeerBBGKO42PlyXDBnEp8MSAPTkrGGDheEIHMfxDJ4vRC4xzBsfDbZGGM",
+	"+oorGtGJjtzVOMS4aNlU8CGPzdRxjd92+o7YUlqkdIYxDa/BGt4+UZ4TtMYod9y+LdEot1Kb+LF6AT+q",
+	"WCiGV3Vr9OvK2hr+pGQHxCGU69rXVV9cNvUN4P2NicpTo3ZtARrkUB5potQPbzHmrjfXlvErjKRSwZa0",
+	"YRfWUBzPWZ24U42IlaUWj7eN6mOj/lgPvm5NyI68FdCVlUvkjbUTCe1nJhJiS5q+YRqe2BceMhVju0Q1",
+	"X1s9X7n9A5xDEZs0uvZ1meVLmIf9DjMA2NVLX6r+64f6+rjDPpWkvKhqKBfVnrJVQBRG9SMfmsZM7d4j",
+	"LPsRK/GUqT+tXDFqF+btAGe8GEUcEjSER3ccVoCK6BuB5i5cIZsAjKfrw8eJxwpBFVvQ9vGPxD3ajwxI",
+	"0iiE4/HIhJGJYH7/jIQcWU+U7VGNHszmVCNZbiEnyu+XNE2WItv+WVbEf8qSJuTBET+y+ZGC0B/dyhXf",
+	"HXWxctGjnRB689Gt/ldJ1hCRdeIIxxQ9woNpeQsj2HfT6R2CYEA9F1a25u5iSQWd1TDGXzSNZWJvhM8D",
+	"BD2AgFpZD+Az4HfXvq7ayqXqN3PV22umfhk+Y+64Vz6LB2Jz8tBMgdPvxaePWz7B2pdFaGZsmOVnZAYJ",
+	"bmD16fPKi2e1n76Gb2IBFlK79rJSxtIVDapXnZtqeYnixcg5fIctdhPvEg4KNjOHyf9XcCK4NzWxYpb7",
+	"On4cXBcbYMPxHIUFB3HyqmYJA73yWY7nXFvCf5cwj1Xo5+yMAyp0AGTiObwKjufw9GGkwfHMYkhJDnOz",
+	"NQue4tRTqjWWKy5LUpKwJS4otdwZxWqsJXhrjauYTfNp2Mbg9cWqLnNU33zpSQtm6pOmMW4xa65e+kr1",
+	"+lOOb/iMNcvFhOU1ORiHwbE4G0HjbKgw72JJUZCkAflSQ5WTtSuvK3cW8Nu4UJ+bisucUpoYYEo/sO0L",
+	"vkCrtdH643m4+j9+jd+wmdrDn039Vu3VD5Xpy5svb+KP2BkGnKqXwCUI5yqs3jJMfVXu61ORBr3Oz9Uf",
+	"z1p5RcZZctuHwCehHKwwXCXr1YcGMFiTE+kuA+ZO5nm4FqbGWhlhVH5ZH9tcm6strZrGTGV6yTRGzVED",
+	"IKC/wBzFClbDrlodMYugf22O6pXlm/hoKT8CzawRbOWuaSwAIQQ+Y8kNVS84+hS5kFSZ2xIE8YR8KCA9",
+	"HpFBXMNUKXijW6RTO5HagY8FaouKxKWcQCFO954Lj/fxGySqN+5jwWWJ4CGxg9RHLzSfVDG++Iapmg1r",
+	"Z/EsRPgoJ0bddNbtbuUk/ecVK12M727Q1ZyLQ2Q/t9qyM8c0zFvFcx4dAlxkWSkA1hOpmEU9P1IUWWnd",
+	"lEWo0CI8rrhlbfpi7dr3QNX/9Qg/Aau1H5erU3o4zXFpIAKLpJYhIZ+PIY/4bUSneFYOK88u6vMPti6N",
+	"BXfh5Mu9o9euP0rD3hSel4nnXGaOIJF7dXVr4Wq4nSmRdcltTYon5GFfhxhSjKMeSNFmwsfzSYrlgxnL",
+	"9TVOhOKpOBY25jFjd6Yg4Vr4vnJllbIo2Ahh6guV7+9WR+eBxdX/bT/mVqzzSnV2uTZ+iXWrLXE7OIsl",
+	"XgXNBGgI5SM9sci4R3HbVCwdZFommNxzhW/EzplHjBWEdX+3cVprnvNJ+0EhwxIIA3BSxX9aNo3gXNGa",
+	"L9ydtd0jH3oIdqmEX8fAyRK9Q5Ctx9JqYLEDSOwf0Jg0taREnvbJY0exvVXMaQOMMXw7gwGZG5NybCMc",
+	"UauXL+GfY1zUkR2RVKREPf/UFWJnGX33ytQGS7NzsROfDJBDt0Yf4OcRQkqi1p48nQTJ2eNhR/dH5dVs",
+	"GUJ8aIIKnnM8nmJqcO2INlPHErovgrRF48+2WlRcSfkbI3YSI4omD0Z7VxAon8BNicpCTaifdxtWyJSW",
+	"+kFteLInrOX5UN97bPhcxzD3tWqWn3Fes7Kw75+H9/33/n1/OL3v1PC7h0aYtuWjNIWkbx5/pIgfP3rP",
+	"xY9u3ZXklrCzgqh5HpgD9M420EqxH7G3WxayCSALLtSrzZjBMsk9W2OyrfBKZe+Re8Y5hFh7dpIAhel7",
+	"4glYnhMNSFfV1Wv19XLlQtnnaFjfeIXdRVd8QhWNFMlTDI6jhIwt9Fjg8LrWyWlvdSzSp9K1VT8q20uj",
+	"MGh0rJ+5Nx5+vh6LS0nKDghSPzZjQnIkSI+8SO0rQi5HrNb4bOCLggoysWSTTXgMFPZQHM/hrlhdgzsw",
+	"zQsePA5bMZZwgnxaDDHbPXySXLA8F+jZcHEecGpyf3+eGHPGwDekvARfe/NydtAy81imnd90ZYV8Xi5p",
+	"+Bt4WHmgSUbieM7pzPEc7RICT5XBR7utY03CUaXmPz6pBx90bcV7j290Rs7QDNPUom1JC/HYE22xozGr",
+	"lKNrb12UpTOGbYaNaO6dOFhWEHNFWZQAczbXlrdezsBH+t6DufL608ryDQ82WT3wOnA7NgphPQ5jFY6j",
+	"UxCSxdOCo1IMDGlTsNPqOVVDBba0qSLltNBPT6SxIOmajzG6Z6xTeEtSv0vocvkavrv/D+8xYPCJKz+d",
+	"Fwygv5Il+HsXeHkZG3A0xqr7jOoLN6vProPTPVy4Sezdca86e6l6e83rLfne7353iDm7nb2NoZW0spY0",
+	"612Y1B8rnho/zCjJRPVPnMz3zA1atvPm99iqKzwRblADJ1PbbSN+ThzJYi8SlLCgWKuekKnGuJEC3pj0",
+	"6a1bdtxhKVQi9bbyULIOQZNNVA+GPXeEj78TNW4nyLYvZBN2Ytl0R045JopdDF5p4KXjIBqEZAWxzAp8",
+	"ug7kLH1EYzkmRAHaFWAViWJuE1ZsvyeARJNOT4FLEOTJ5l9WJq+Hq/LazMD+KXUyd/vpH/w94/FyZ4hh",
+	"EPh/2clNauNjQVEapwnObZO+LV59T09aHtwh5HXAf+Pda2YCLjBcEBXGx0xjZnPjm8ryTZow4tG/q0/u",
+	"YRdQpIhyDnuk1n5a2Lp9cXMNygCBG8yde6QLNFORCjOczuZlFeXsxgDm/xibP1/EEgjqkxV0WkGqJisg",
+	"hYDtHsfwwQL0perYS3C/uf28Mn7Z605K18DxnHcekEw8gzIZys+wp06UepHnGoiuJKAQy0sY2rB4nKcZ",
+	"PpVwcnvgeXFso+1NjdgiKhkB+BPcz3KjDlm768IwaOKD2vXFSKvE58z7/zdxUOxy5/gJvQy95+Khd9xr",
+	"0MblDYlBC3fnQwvh8JyzuCA3ND1l6jfrjxctxd1DbxKlZWzCd2dTGjvQVZmfhGgOY4J4HUQf5wlaNCfi",
+	"SEFCGNVN/Ru4scvT7j9hFy5XRqdgNJmYzylIis1fhqeo2k7MSPWoeWfXzFOXVaacbyUlatWXK58Xiio7",
+	"gM8/pROq702C7Av1oSsDH5eJm6YxgdXI97BH6nx9boH4O/rdFl2RXTvjX0bpTHyzR4L3NhtHB3vMahfX",
+	"+ytLtaHOmbHwhXi5hyOM7WIdwJyirMandrG0Qm4NBCuR12m8VTIUay/uMAXGjvx+662G3bqmSzvyljF0",
+	"jA3tibDbYy58Z6jwPNmjA/vIiaqdg8/vKE1tlXGcz0PGiDsAMxsf7yyOvW1H/EyinfCoM0UsnwMTh10c",
+	"4BNYDYCTxYJSXEbPGul0r6BlB8Bw4U6HYY+tEMme3co3+mkFLBqwEux8DWQjyFWSWQG68hCLueQ597ow",
+	"GXOtwG5H5mKyokxlRFhAjTFjCQDpuYokcxOJWZEE9uUo6xi3huZTx+evYtW2+5kl4TXUpdCKsfF4iGBM",
+	"wk+XL4wO/gDKUPyZupAQ802RBgA66cLwd5zPDc/hJGiDPwD9dofu4G+KDPvDn29g1fkaBCEZq/TvBTGP",
+	"VE2WELMFZh7uYl37En2ryus0mEgT1MHQTpY6mjTsx4vdMA2MzCiPMHWC+zNxvfaImJ8GZFlF9iecBWat",
+	"OnG3btwnsU+D+I8+pwm8QwHfiQDPi6emmQJhfiv1n9dMqFLjvD/G0+1TUsQSJwE7R/JpEizGmMNzNgyh",
+	"laAO4n/6YRa6U0yuYX8coYscb1WJYNRTcF00Oc9ERoojQT4ztUJ1cVmOpgvauePuw6vakbRMbHreWA/o",
+	"IelZotMDwumNKCIx0oDFifIgQbchIS/miLEHgU7PutPuJUwBni7jiy/J2uk+uSTl7NaV1df17+esEJkp",
+	"X0AM1bgURKLlsDrVFy9bjrdWq6ws9eXFrOYMfOlxbfpidW3M1DdYERTnSZCZRZYXaks/YsP/LX/wLgUb",
+	"PqFBwhR6tgwn6N0WYKRv0YRldq2Qiek+dTPD324Vs2IPcbKCZ5jITFnu+tdwloOlrgNdxE+xOrVqGpNE",
+	"JwISjxWDRwBDw5eIT42+QMR0J+aIiMgbd2rL17xlmm9QAdqYojF7RGFFxwkNVmoswnscsnxKzXf3MwBF",
+	"wmoZLgALOKPpecI44NNeIlGCpr7q+B9TbKO7bhhXBaGdSIlNS2hktetdPhhlUlLkM15atV0Tuc1cUfWg",
+	"6Lbp6lgk6YSrho4/ZIk+MS1r0PpElM8lz5z7MXRrhZ4PilIu7mR/hbYjvMO/eYFBuBdTXyBsCdQ9s7Ky",
+	"mfpGklhO2zM4nRL7LeuK6LOFYWUfVSNPYk9ZUka0DK7zV35Ze7Vi6pdxRTjIGROOTWljR3ufegJ/8J1B",
+	"gqbPnxxAONmA98SLA5XRh0H53FIuBKw32HGP2m3og7ZSGX1oxyvbfIPj6/fk5+rspcryDfpYRfqjWBB2",
+	"O0Ef3vffwr5/ghP0qeED/Hvvsr2gHQiGrduOaDvP0EGyz8D+1AjsfxWlKKi7XObYMhdLtJKsTAnUlOjz",
+	"mCNSAxUWpLC0BtYiv7D1YkIuJ8IahfznnnOPPpwkaIULPNgFIJfM8nhl+jJwBqMPCS4wwHmWqSFzsmMw",
+	"Iv7iaF1hYMeFOAU9pqUio9OfCtlMuLune1Phfp+9cj6Gjr7ycLGyfCNEq56Pke/YTiI3wnNyb178qoSi",
+	"Z63O3tl8dZU5qzagyKX+gRhjjF819bnqj2BaqI49Zg5WknJIydse/Y2G21ybJDFqjFFixnf5zhkfgAMU",
+	"92qcbTJPf4CdrsOT8T746iqqdrpIbT9xSmjEN08U8+dYcRjJEotY1gR3ShGXiaF6/Sm+9O78TfP+98D2",
+	"/Y4XduOCibUNJrwxMQxKLU++30o5jCGBR9u2WZvyQkI8ScK2tIYQS85HSAto4LJQDs5vE5bQx7phNAjc",
+	"7+5hV/bLAU0rqt2ZTF7OCvkBqnJzuA785//qzmQgtWem+//7VU/Pr3tK+/cffK+n5796ev7/np7/8X97",
+	"et75Y0/PbyHr52+ZPIltdg2A8+SnR/539ZaxNXv1l/Wx2vwMKU0d4JIokrn/Myjkn1TZPrSO8rj5DJ2F",
+	"GIkQaWhsCro65sE5NRAa7hF0XFjJ5tQnaNVO3kwiwL2dmZJ1Mm7dNVVZC1roUdnVBBiHFVIEIMyzoKkA",
+	"DvcarECOpr27qfY+NC4jMFkEhmKzw+z9zQ3DDpTBDL4DGPheKFG+3wGRh/XH/Tieg3YMnh874GZLCiTE",
+	"hM0R2B0n/mwcjgbhurmsLA+KyLp43Za/m0PFhKL4V3SOlAYQpT7ZejmFLEZt2k0oDWikeI5336qkdglF",
+	"8R3b9YV8Ovz5Ec4uxgdpit/Z/85+GjghCUWR6+YOvbP/nUOEFg/glWccW3M/8a6z4yCO5LhuV7Fn4sqO",
+	"qyrgngf37w87drtdhlEr2g1C7NBrA+/LUxD5plp5RjnT+A8+oKskYXZlDJT4OLHaVGXsx8rrucqV1Xr5",
+	"lan7reKQwIIWyAAFKbHoqFa1Uw4n1Mh4KFDo3n21yTDoFKGANJyc7cvo0HIsmK1slRcqYxchV9ml56Y+",
+	"X/vPXdOYqL9eN41RbNPlurmvSkg556AM/od3VacIIOIws18eR/W6b5qmlBAfswASiQkOHZxkS+PijkZd",
+	"NkOHozVj4g5nF4851SwqhlWai4+TYTXYMLseSN0aioeuAii0xl8Q/T7ALK4b9eBMkapZmkj2fq0mIlIz",
+	"viGOkT/S2BY3/A5Ewy8wlgU7nnt3/yGGfOC1diW69wHoGjNU0doIkJ5bnRm2fz0t5kbI+rAPBUNwXMR0",
+	"ZsLJiutOeQeGta3Z+1ujD8js3vrsq2CWA6oza+rzW9/crUxPVe/cA4MVHYOaOPEj4z1gEuLRgLbgOwOk",
+	"2nVlXJtq+pYf+ZB5iQ6GJg00Zgj3h9MpAg8Y/9Sh3buMgb0W01axwwJ2w2tGibzPEv3NXXqn9RXG0Poq",
+	"K2cyudPunMnek/0T0trnWGPQRvd6vRd7u4/OyhQem1RiP6cArTyJ/aR2FeYJSbNvxeGkeT9DXTA2XZlo",
+	"uztIa0I1S6EzNCO9l1L7jlmijdrsdrX/8QBxu4/frCXsEDZLkxFGEs1iSWPXZ3KPFVKaDbwXAmUKsN/C",
+	"IptuHu6cbpOXL3Aizd7CXkHKDOPA9wDX1JB7eV+Qdvi0eOb4dOlv6zVfs3Po1h7r1eX78a55Q4wQbf1g",
+	"qJTsr66zO4jQkYTjS8K+E9txpo/kaYvF9MVAzsww+TchzdpVbPWOb6//reVPHJSIluUaosQ/ZCvxDBsJ",
+	"jiKhw2IkPyDsnDtxB+cHTqrK+ossSu0D8QOs+CJ4MonbNcCTqQypXXtWX5p0GrXR4SyRDYDxF+rJ3QM7",
+	"L17tL1adqWZuUp6mT4377uN0q523f4+9/Z6y4O35KNj5XFtnGACnM8PwsylmYRdR3Ds+3cHbzi64MKN2",
+	"fq4y8cIOi2gGOQp2yrRokkddizrkbs+QO3JiOy3qeOwHrdMvgqKZYfJvQhq2qzjrHd9e/9vMT/txI4EV",
+	"K9zg9NYccnybVhvcfH2l/uRZ7fnT2IaukhZp5npDT7o1S5p11nvcnhZAH6dAhTFjpddO7xEBvb+VsyaI",
+	"d+8LUgfpPEgXhMjex7gGLxLETxMVAzDaxLLQNPZJssec4N2SgrJIHELdXV/2SF1djMyxPHz3J8bEHwMZ",
+	"YPHXYJZX3j+y6v4DK0GKfyDre490qkdSkZSjy2XkH8VdnRyjZKGuPKJ4iIAhFLZi49eHLgDtzrWz46rp",
+	"DB/IkoQcr9Cw8Z14gJPFfkUgtYJD3Bj9Uzg94ox/BvWqcnYQaQlmOI6y+/6Geo/jfvv+is7FnOvv733x",
+	"99KhQ4WP/vz3984o4sFPf//+0Mn+P/6x6amtPKexpj9wqNlpPjqrIUmllZvjbLSIFOoTvy+H+iDO8X92",
+	"ZfMi5HCEmkNnRCknnzndK2oqY+t+nu0AS+1K089AlMsz+rKNXTKNiertDRLrSKtU00rILrTrHm7kGuvb",
+	"+eFsFhW1qD4WxjVsNtJmTEJ98bvqzX+5EjW1QpQz6GxRVrRQ/cdH+M9tQJeaEgg+lLOlApK0nRYD7CPq",
+	"suoBdJn6fKt6AHxeQ06G5GiFleu4HIrTUV61t/LKk/e5PXX0FL9J/mdjJk3EzuRoiSkmdkOhorbHbpoP",
+	"vbWBo1NFrDrJsUjqPn2BFI2yD4K1OE3mdoDuin19ew2N7QJXKePzMP0F/8XKdu4Su/1SIqRSJ7mqrIyM",
+	"Bl4e2fZjK9n7LbY75TEyQfvdEe/4Dkz2sC6Y5949yBjPzrafGhfhru7qzrjfDG7KZyRi7mJqG08ogqT2",
+	"IcXGn8+guTogFveAf3344ptW1OxnJ9VdfgA4AEFBOI8cSabSds7frhAnY6Y2/7K+/EPzNK1I46SjmU5c",
+	"tWAPCAc4OlDo33FHyGDmU7jidxaq85Nbo9/GkxP4sOfjznfV2UumvmrFzmEvfVcdA6eOild+ZDwlvui/",
+	"3TrWpuIfybm2HPoYRI89TBAsvFty412LMZaYLGSG4WdUyKWvjIVpGDCr/sLUH7uNoNFBk7uAiGwmhu76",
+	"rfXHYRKy5sMyXSW83fkrHHypb1wz9VsRsZdvBXZEmsHb5l1LMcLzDTzZpszejd+2vUw99JWtuSnIntG6",
+	"udv7LnXsjwH7I0kq7oI94Q5tErxK8vQ7Tgl2phVanvZKlA3zzbqvHbtoxy7asYu2s12U+aBQgbh1Yynj",
+	"QUloO+1wprtmj22MGqkaaVl4kshmC2jSTqr6VF/Pjh34jbIDM+9VOjbhBvcogYn4bbpMHbPzHjE7h96a",
+	"FE3Qja7P7lqk3+gr2bFyp2QOS9Hi7bsKRVkVLbmJaQMHRU9H6WgrHQEcLakc3wCTmQc3SVnZ5kmzlT08",
+	"WhTAOcT3iCXds+idD7tzBUqlln53t8DfYsZfDP+Gpm9WPbO2zHHiOdhWTdVw7zLD8DNhCPUu4AH7DaBr",
+	"f3vzWbqveRw7czzD4ht4vi1mpm1IQvYkurSaqdZLPFwJEtkvCckc08EwG8MYAHkDHyl9ieaoSQvPrHRc",
+	"sfiVNktXtGu4FgqWNIshtH8iLRdarjGzJ7XKUGmuwsrRwoxdhnmPCDTWendclrHLgCaUZXyrosrZJeys",
+	"cd7U73UNDwN0R0agyi8ujm5NhNMAuLEB6/YuQ412Ui23ixSmhTI23sKk0W7Du3nsTdEN59xbJhZsFNrL",
+	"MQUu3EyLemSGrd8ii7awEZa4uDvlf83yS6dqYvmlo7rRV53cFhgc0b7Gu4S97AfVBae3VwR0U8dUcme9",
+	"VUcc6TjcDq9e14EuU3+YWsqsN/iAmxLzox+4NzgozoVmPlYmvTfMjpVjc2aDopTrMvUpUo/esyj4fQO/",
+	"rdjpz8OjrdTnpqDqR9CDyUp/Gi+G62NFLnSuRDBczA2Xtzl0zMtkMS1g28P6ZTSr6njEvcHtGl6cWIIJ",
+	"jNK5DAwByA+YNCQhGPMNuA4eOWN7L0JmGP9jCUURdwL+3PqVGFCQkHtz70TI8BTMu/b4yKqW4Lrteb7M",
+	"d6NgPd/BjSqP79yNymgY1TPD5N+dvGT+4+5csRbHtk6wc39350W0VG0pXF6LAYxhTcBNO8ns2j2IgcH4",
+	"7YTly8OlpecRthtY1yIjvz3MeztZOdPlyBmsdwxqhN+g9njJU31qO6QuAanDSLCdtM6N6vqKj3NOkcq9",
+	"adjcAgmlZ7oHPZU8yLLUQMyCCoI2E0e/jKdBOxPGR+8mS7dtWNeOEdIezFiws3AFSUqqgdEB7OhDKBeK",
+	"Gx8jlOtghhszACK79rh0HTt+fFuQgK2HiRElDw/Zm8dvbYtqo8PMNZEYVFa3N0uF63rpK877myYf17kj",
+	"O6X+25uu7G4UXArT42HucBFnJ3piGg/TZRCD1D8pz/imCt7bh+RtmbPHSw3dHKkLLbeXHWXgIlDZzDD8",
+	"pMEYg4193YEUHIVGHXxsYXAK7w7P0yTPE7r1wcYpBGl+t15ZziNBapV5gnuwreKKrbFYqV5brU7pGbCV",
+	"4d9ciUpa4aZgBx0Wqi1uczPcGUHAML7sIAMZr9zYfHmzsjK1+fPFXVXc2Zi9VFl9beo3AvhtzNTnFnDl",
+	"mh18/eB7aM6UY0jIde5K+9yVSHfuG/fri8ttoKS2cf21qc9DnDZemO3S3AC3SypSHARvyJf9RRYllzqR",
+	"a/Zd843jPG+xN1xfvFxfWCdwN8uPsUftj/BTn6K1dt2574P2ztAHDaDhBYwE2acELSrv5EkVCua4GrNv",
+	"cEd3lCQVjgPO7caRzfWv60++j8SLxhojBg40HXTt3noK0dYsSO4auapMT5n6TZYbwhLj9nqODvIl2mfl",
+	"06+QYckI9dELTkt9ytQN05gy9aem/g38YsyQIhheBUyjy58Zdv9XDAW705imi4/5pnsm6QhvO010PhQV",
+	"lNXokW2j4GWhJikLUDaNl1G1PiJI0HEk5doC65oRMmDxPsi3QPQYo+06zbPOeyl43luj+ubGXCJWJEiN",
+	"MjSvfmaY/hJP2GgrOuWdwtnHrjDyO3Pbo/l1Pxo4KZMa8qIkr0qHC235QXCAmSoPauWRiS2JkHPPDJN/",
+	"o1LvQSmccw1xwHvZ7FF3nvbjpbqh3GQ4daoXNvrg9KXKxp3a8rXIg4PcU6AiGkTSSANanEOokDDzFB6z",
+	"+QPDk53AYzR5P/yr3q539pf1seqde1u3pnEtlynTOL/5aqN2baFy+271+lNg9Ncmtm5Nm/rSln7Nk2bd",
+	"mKlML5nGKDD6eLI/sBSWIDFYtD82irASQW2Y+gOQXBqJNlHoIveL0aqGo9DqcFYTh4gipkPlW6fyHphu",
+	"I+vfgLZArjHjKfgHlJ9Vvn9UXX4e/4Ho1Gbz12Zj1lWDk/5ckfvE0FSSndJkndJkndJku1yarCGRJHC8",
+	"Du+qlZg2pC4Zi0jGqiPlIhONq2x0Xtm9WoopGYpFFGRqhGjRhZaaxbZOraL2q1XUDFpFVyxqiF+7Uoko",
+	"Ns52ivmk+dDFKOnjxxUF5eMZsI9ZDVsRo6xB0jXZmuVZDAuIq8lYwvU6dnkIsRXGvkoWeDLD8J/xilr4",
+	"oBWN9nToHcb5lL1MfNgZdiLGTH3+ASulqGPCKmks/tgZ0EpH67P9rgb05ytbozp4nG3Mmcb5QIntKdCu",
+	"QwbmRVO/Aj+NCXNU3/x5BqdydnlF61P1uYXaw59N/To2FNvtJ81Ro0cKINy9ADDskVarEzdhHv0e/N+4",
+	"aurznrHpkAGyelwY2k20Smqx8622PdS2PgLQgGhE0ASbFGQES8kWRTwjtHHbcG4d+35zL9QO6Pi8iHgf",
+	"p9PGeuDy1aQuaA4qxi+WHa3k2rnXqQ0CtaO5qjgxMdbB4ImVIQuc3mXm5ayQ53iupOS5bm5A04rdmQz+",
+	"OCCrWveBQwcPZYSimBk6wI2cGvl/AwDZfY3mm2UBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
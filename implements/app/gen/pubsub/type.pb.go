@@ -311,6 +311,61 @@ func (x *Text) GetValue() string {
 	return ""
 }
 
+type Content struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Bin  []byte `protobuf:"bytes,2,opt,name=bin,proto3" json:"bin,omitempty"`
+}
+
+func (x *Content) Reset() {
+	*x = Content{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_type_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Content) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Content) ProtoMessage() {}
+
+func (x *Content) ProtoReflect() protoreflect.Message {
+	mi := &file_type_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Content.ProtoReflect.Descriptor instead.
+func (*Content) Descriptor() ([]byte, []int) {
+	return file_type_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Content) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Content) GetBin() []byte {
+	if x != nil {
+		return x.Bin
+	}
+	return nil
+}
+
 var File_type_proto protoreflect.FileDescriptor
 
 var file_type_proto_rawDesc = []byte{
@@ -334,8 +389,11 @@ var file_type_proto_rawDesc = []byte{
 	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x22, 0x1c, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x42, 0x0c, 0x5a, 0x0a, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x22, 0x2f, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x62,
+	0x69, 0x6e, 0x42, 0x0c, 0x5a, 0x0a, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -350,7 +408,7 @@ func file_type_proto_rawDescGZIP() []byte {
 	return file_type_proto_rawDescData
 }
 
-var file_type_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_type_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_type_proto_goTypes = []any{
 	(*At)(nil),                    // 0: At
 	(*UUID)(nil),                  // 1: UUID
@@ -358,10 +416,11 @@ var file_type_proto_goTypes = []any{
 	(*Operation)(nil),             // 3: Operation
 	(*Action)(nil),                // 4: Action
 	(*Text)(nil),                  // 5: Text
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*Content)(nil),               // 6: Content
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_type_proto_depIdxs = []int32{
-	6, // 0: At.value:type_name -> google.protobuf.Timestamp
+	7, // 0: At.value:type_name -> google.protobuf.Timestamp
 	2, // 1: Action.resource:type_name -> Resource
 	3, // 2: Action.operation:type_name -> Operation
 	3, // [3:3] is the sub-list for method output_type
@@ -449,6 +508,18 @@ func file_type_proto_init() {
 				return nil
 			}
 		}
+		file_type_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Content); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -456,7 +527,7 @@ func file_type_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_type_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,13 +1,20 @@
 package document
 
 import (
+	lmetrics "app/lib/metrics"
+	ltracing "app/lib/tracing"
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ConnectionConfig struct {
@@ -20,7 +27,7 @@ type ConnectionConfig struct {
 
 func getConnection(config ConnectionConfig) (*mongo.Client, error) {
 	dsn := fmt.Sprintf("mongodb://%v:%v@%v:%v", config.User, config.Password, config.Host, config.Port)
-	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(dsn))
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(dsn).SetMonitor(newMonitor(config.DB)))
 	if err != nil {
 		return nil, err
 	}
@@ -38,6 +45,36 @@ func getConnection(config ConnectionConfig) (*mongo.Client, error) {
 	return client, nil
 }
 
+// コマンドの実行時間の計測とスパンの作成を行う
+func newMonitor(store string) *event.CommandMonitor {
+	var spans sync.Map
+
+	observe := func(requestID int64, command string, duration time.Duration, err error) {
+		lmetrics.ObserveQuery(lmetrics.BackendMongoDB, store, command, duration)
+
+		if span, ok := spans.LoadAndDelete(requestID); ok {
+			ltracing.End(span.(trace.Span), err)
+		}
+	}
+
+	return &event.CommandMonitor{
+		Started: func(c context.Context, e *event.CommandStartedEvent) {
+			_, span := ltracing.Start(c, "mongodb "+e.CommandName, trace.SpanKindClient,
+				semconv.DBSystemMongoDB,
+				semconv.DBName(e.DatabaseName),
+				semconv.DBOperation(e.CommandName),
+			)
+			spans.Store(e.RequestID, span)
+		},
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			observe(e.RequestID, e.CommandName, e.Duration, nil)
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			observe(e.RequestID, e.CommandName, e.Duration, errors.New(e.Failure))
+		},
+	}
+}
+
 func Unmarshal(v interface{}) (doc *bson.D, err error) {
 	var vMap map[string]interface{}
 
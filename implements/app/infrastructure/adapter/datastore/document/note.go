@@ -1,6 +1,7 @@
 package document
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 
@@ -22,6 +23,11 @@ func (u *noteStoreConnection) DB() *mongo.Database {
 	return u.conn.Database(u.db)
 }
 
+// Shutdown implements do.Shutdownable.
+func (u *noteStoreConnection) Shutdown() error {
+	return u.conn.Disconnect(context.Background())
+}
+
 func NewNoteStoreConnection(i *do.Injector) (NoteStoreConnection, error) {
 	var config ConnectionConfig
 	if err := json.Unmarshal([]byte(os.Getenv("MONGODB_NOTE")), &config); err != nil {
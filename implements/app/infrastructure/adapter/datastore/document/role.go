@@ -1,6 +1,7 @@
 package document
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 
@@ -22,6 +23,11 @@ func (r *roleStoreConnection) DB() *mongo.Database {
 	return r.conn.Database(r.db)
 }
 
+// Shutdown implements do.Shutdownable.
+func (r *roleStoreConnection) Shutdown() error {
+	return r.conn.Disconnect(context.Background())
+}
+
 func NewRoleStoreConnection(i *do.Injector) (RoleStoreConnection, error) {
 	var config ConnectionConfig
 	if err := json.Unmarshal([]byte(os.Getenv("MONGODB_ROLE")), &config); err != nil {
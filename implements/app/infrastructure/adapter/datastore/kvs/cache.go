@@ -0,0 +1,73 @@
+package kvs
+
+import (
+	ltracing "app/lib/tracing"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/do"
+)
+
+type ConnectionConfig struct {
+	Host       string `json:"host"`
+	Port       int    `json:"port"`
+	Password   string `json:"password"`
+	DB         int    `json:"db"`
+	TTLSeconds int    `json:"ttl_seconds"`
+}
+
+type CacheStoreConnection interface {
+	Client() *redis.Client
+	TTL() time.Duration
+}
+
+type cacheStoreConnection struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// Client implements CacheStoreConnection.
+func (ca *cacheStoreConnection) Client() *redis.Client {
+	return ca.client
+}
+
+// TTL implements CacheStoreConnection.
+func (ca *cacheStoreConnection) TTL() time.Duration {
+	return ca.ttl
+}
+
+// Shutdown implements do.Shutdownable.
+func (ca *cacheStoreConnection) Shutdown() error {
+	return ca.client.Close()
+}
+
+func NewCacheStoreConnection(i *do.Injector) (CacheStoreConnection, error) {
+	var config ConnectionConfig
+	if err := json.Unmarshal([]byte(os.Getenv("REDIS_CACHE")), &config); err != nil {
+		return nil, err
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%v:%v", config.Host, config.Port),
+		Password: config.Password,
+		DB:       config.DB,
+	})
+
+	client.AddHook(ltracing.NewRedisHook("cache"))
+
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to connect cache store")
+	}
+
+	fmt.Printf("connection established. %v:%v %v \n", config.Host, config.Port, config.DB)
+
+	return &cacheStoreConnection{
+		client: client,
+		ttl:    time.Duration(config.TTLSeconds) * time.Second,
+	}, nil
+}
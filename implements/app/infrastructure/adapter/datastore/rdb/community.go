@@ -28,6 +28,11 @@ func (u *communityStoreConnection) Write() *gorm.DB {
 	return u.connWrite
 }
 
+// Shutdown implements do.Shutdownable.
+func (u *communityStoreConnection) Shutdown() error {
+	return closeConnections(u.connRead, u.connWrite)
+}
+
 func NewCommunityStoreConnection(i *do.Injector) (CommunityStoreConnection, error) {
 	var configRead ConnectionConfig
 	if err := json.Unmarshal([]byte(os.Getenv("MYSQL_COMMUNITY_READ")), &configRead); err != nil {
@@ -28,6 +28,11 @@ func (u *contentStoreConnection) Write() *gorm.DB {
 	return u.connWrite
 }
 
+// Shutdown implements do.Shutdownable.
+func (u *contentStoreConnection) Shutdown() error {
+	return closeConnections(u.connRead, u.connWrite)
+}
+
 func NewContentStoreConnection(i *do.Injector) (ContentStoreConnection, error) {
 	var configRead ConnectionConfig
 	if err := json.Unmarshal([]byte(os.Getenv("MYSQL_CONTENT_READ")), &configRead); err != nil {
@@ -28,6 +28,11 @@ func (u *inviteStoreConnection) Write() *gorm.DB {
 	return u.connWrite
 }
 
+// Shutdown implements do.Shutdownable.
+func (u *inviteStoreConnection) Shutdown() error {
+	return closeConnections(u.connRead, u.connWrite)
+}
+
 func NewInviteStoreConnection(i *do.Injector) (InviteStoreConnection, error) {
 	var configRead ConnectionConfig
 	if err := json.Unmarshal([]byte(os.Getenv("MYSQL_INVITE_READ")), &configRead); err != nil {
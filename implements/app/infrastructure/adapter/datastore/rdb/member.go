@@ -27,6 +27,11 @@ func (u *memberStoreConnection) Write() *gorm.DB {
 	return u.connWrite
 }
 
+// Shutdown implements do.Shutdownable.
+func (u *memberStoreConnection) Shutdown() error {
+	return closeConnections(u.connRead, u.connWrite)
+}
+
 func NewMemberStoreConnection(i *do.Injector) (MemberStoreConnection, error) {
 	var configRead ConnectionConfig
 	if err := json.Unmarshal([]byte(os.Getenv("MYSQL_MEMBER_READ")), &configRead); err != nil {
@@ -0,0 +1,63 @@
+package rdb
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/samber/do"
+	"gorm.io/gorm"
+)
+
+type MessageStoreConnection interface {
+	Read() *gorm.DB
+	Write() *gorm.DB
+}
+
+type messageStoreConnection struct {
+	connRead  *gorm.DB
+	connWrite *gorm.DB
+}
+
+// Read implements messageStoreConnection.
+func (u *messageStoreConnection) Read() *gorm.DB {
+	return u.connRead
+}
+
+// Write implements messageStoreConnection.
+func (u *messageStoreConnection) Write() *gorm.DB {
+	return u.connWrite
+}
+
+// Shutdown implements do.Shutdownable.
+func (u *messageStoreConnection) Shutdown() error {
+	return closeConnections(u.connRead, u.connWrite)
+}
+
+func NewMessageStoreConnection(i *do.Injector) (MessageStoreConnection, error) {
+	var configRead ConnectionConfig
+	if err := json.Unmarshal([]byte(os.Getenv("MYSQL_MESSAGE_READ")), &configRead); err != nil {
+		return nil, err
+	}
+
+	read, err := getConnection(configRead)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var configWrite ConnectionConfig
+	if err := json.Unmarshal([]byte(os.Getenv("MYSQL_MESSAGE_WRITE")), &configWrite); err != nil {
+		return nil, err
+	}
+
+	write, err := getConnection(configWrite)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &messageStoreConnection{
+		connRead:  read,
+		connWrite: write,
+	}, nil
+}
@@ -27,6 +27,11 @@ func (u *noteStoreConnection) Write() *gorm.DB {
 	return u.connWrite
 }
 
+// Shutdown implements do.Shutdownable.
+func (u *noteStoreConnection) Shutdown() error {
+	return closeConnections(u.connRead, u.connWrite)
+}
+
 func NewNoteStoreConnection(i *do.Injector) (NoteStoreConnection, error) {
 	var configRead ConnectionConfig
 	if err := json.Unmarshal([]byte(os.Getenv("MYSQL_NOTE_READ")), &configRead); err != nil {
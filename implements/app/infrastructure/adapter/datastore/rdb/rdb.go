@@ -2,8 +2,17 @@ package rdb
 
 import (
 	"app/lib/environment"
+	lmetrics "app/lib/metrics"
+	ltracing "app/lib/tracing"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -18,6 +27,21 @@ type ConnectionConfig struct {
 	Parameter string `json:"parameter"`
 }
 
+func closeConnections(conns ...*gorm.DB) error {
+	for _, conn := range conns {
+		db, err := conn.DB()
+		if err != nil {
+			return err
+		}
+
+		if err := db.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func getConnection(config ConnectionConfig) (*gorm.DB, error) {
 	dsn := fmt.Sprintf("%v:%v@tcp(%v:%v)/%v?%v", config.User, config.Password, config.Host, config.Port, config.DB, config.Parameter)
 	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
@@ -34,6 +58,87 @@ func getConnection(config ConnectionConfig) (*gorm.DB, error) {
 		return logger.Silent
 	}())
 
+	if err := registerCallbacks(db, config.DB); err != nil {
+		return nil, err
+	}
+
 	fmt.Printf("connection established. %v:%v %v \n", config.Host, config.Port, config.DB)
 	return db, nil
 }
+
+// マイグレーションのファイルは複数の文を含むため、まとめて実行できる接続を開く
+func NewMultiStatementConnection(key string) (*gorm.DB, error) {
+	var config ConnectionConfig
+	if err := json.Unmarshal([]byte(os.Getenv(key)), &config); err != nil {
+		return nil, err
+	}
+
+	config.Parameter = strings.TrimPrefix(config.Parameter+"&multiStatements=true", "&")
+	return getConnection(config)
+}
+
+const (
+	metricsStartKey = "metrics:start"
+	tracingSpanKey  = "tracing:span"
+)
+
+// クエリの実行時間の計測とスパンの作成を行うコールバックを登録する
+func registerCallbacks(db *gorm.DB, store string) error {
+	before := func(operation string) func(db *gorm.DB) {
+		return func(db *gorm.DB) {
+			c, span := ltracing.Start(db.Statement.Context, "mysql "+operation, trace.SpanKindClient,
+				semconv.DBSystemMySQL,
+				semconv.DBName(store),
+				semconv.DBOperation(operation),
+			)
+			db.Statement.Context = c
+			db.InstanceSet(tracingSpanKey, span)
+			db.InstanceSet(metricsStartKey, time.Now())
+		}
+	}
+
+	after := func(operation string) func(db *gorm.DB) {
+		return func(db *gorm.DB) {
+			if start, ok := db.InstanceGet(metricsStartKey); ok {
+				lmetrics.ObserveQuerySince(lmetrics.BackendMySQL, store, operation, start.(time.Time))
+			}
+
+			if span, ok := db.InstanceGet(tracingSpanKey); ok {
+				span.(trace.Span).SetAttributes(semconv.DBStatement(db.Statement.SQL.String()))
+
+				// レコードが存在しないことはエラーとして記録しない
+				err := db.Error
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					err = nil
+				}
+				ltracing.End(span.(trace.Span), err)
+			}
+		}
+	}
+
+	callback := db.Callback()
+	registrations := []struct {
+		operation string
+		before    func(name string, fn func(*gorm.DB)) error
+		after     func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", callback.Create().Before("gorm:create").Register, callback.Create().After("gorm:create").Register},
+		{"query", callback.Query().Before("gorm:query").Register, callback.Query().After("gorm:query").Register},
+		{"update", callback.Update().Before("gorm:update").Register, callback.Update().After("gorm:update").Register},
+		{"delete", callback.Delete().Before("gorm:delete").Register, callback.Delete().After("gorm:delete").Register},
+		{"row", callback.Row().Before("gorm:row").Register, callback.Row().After("gorm:row").Register},
+		{"raw", callback.Raw().Before("gorm:raw").Register, callback.Raw().After("gorm:raw").Register},
+	}
+
+	for _, registration := range registrations {
+		if err := registration.before("instrumentation:before_"+registration.operation, before(registration.operation)); err != nil {
+			return err
+		}
+
+		if err := registration.after("instrumentation:after_"+registration.operation, after(registration.operation)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
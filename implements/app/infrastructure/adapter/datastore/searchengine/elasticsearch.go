@@ -1,15 +1,54 @@
 package searchengine
 
 import (
+	lmetrics "app/lib/metrics"
+	ltracing "app/lib/tracing"
+	"net/http"
+	"time"
+
 	"github.com/elastic/go-elasticsearch/v8"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ConnectionConfig struct {
 	Nodes []string `json:"nodes"`
 }
 
-func getClient(config ConnectionConfig) (*elasticsearch.TypedClient, error) {
+// リクエストの実行時間の計測とスパンの作成を行う
+type instrumentedTransport struct {
+	store string
+	next  http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (i instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	defer lmetrics.ObserveQuerySince(lmetrics.BackendElasticsearch, i.store, req.Method, time.Now())
+
+	c, span := ltracing.Start(req.Context(), "elasticsearch "+req.Method, trace.SpanKindClient,
+		semconv.DBSystemElasticsearch,
+		semconv.DBName(i.store),
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.URLPath(req.URL.Path),
+	)
+
+	req = req.WithContext(c)
+	ltracing.Inject(c, propagation.HeaderCarrier(req.Header))
+
+	res, err := i.next.RoundTrip(req)
+	if err == nil && res.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, res.Status)
+	}
+	ltracing.End(span, err)
+
+	return res, err
+}
+
+func getClient(config ConnectionConfig, store string) (*elasticsearch.TypedClient, error) {
 	return elasticsearch.NewTypedClient(elasticsearch.Config{
 		Addresses: config.Nodes,
+		Transport: instrumentedTransport{store: store, next: http.DefaultTransport},
 	})
 }
@@ -27,7 +27,7 @@ func NewResourceSearchIndexStoreConnection(i *do.Injector) (ResourceSearchIndexS
 		return nil, err
 	}
 
-	client, err := getClient(config)
+	client, err := getClient(config, "resource_search_index")
 
 	if err != nil {
 		return nil, err
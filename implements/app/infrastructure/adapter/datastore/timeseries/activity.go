@@ -2,24 +2,31 @@ package timeseries
 
 import (
 	llog "app/lib/log"
+	lmetrics "app/lib/metrics"
+	ltracing "app/lib/tracing"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	influxdb2 "github.com/influxdata/influxdb-client-go"
 	"github.com/influxdata/influxdb-client-go/api/write"
 	"github.com/influxdata/influxdb-client-go/domain"
 	"github.com/samber/do"
 	"github.com/samber/lo"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type TimeseriesStore interface {
 	Save(c context.Context, point Point) error
 	Count(c context.Context, option queryOption) (int, error)
+	CountBy(c context.Context, option queryOption, column string) (map[string]int, error)
 	Find(c context.Context, option queryOption, v any) error
 	Delete(c context.Context, option deleteOption) error
+	Ping(c context.Context) error
 }
 
 type activityStore struct {
@@ -29,7 +36,10 @@ type activityStore struct {
 }
 
 // Count implements TimeseriesStore.
-func (a activityStore) Count(c context.Context, option queryOption) (int, error) {
+func (a activityStore) Count(c context.Context, option queryOption) (_ int, err error) {
+	c, end := a.instrument(c, "count")
+	defer func() { end(err) }()
+
 	conditions := []string{fmt.Sprintf("r._measurement==\"%v\"", option.Measurement)}
 
 	for _, c := range option.Conditions {
@@ -100,12 +110,90 @@ func (a activityStore) Count(c context.Context, option queryOption) (int, error)
 	return countResult.Value, nil
 }
 
+// CountBy implements TimeseriesStore.
+func (a activityStore) CountBy(c context.Context, option queryOption, column string) (_ map[string]int, err error) {
+	c, end := a.instrument(c, "count_by")
+	defer func() { end(err) }()
+
+	conditions := []string{fmt.Sprintf("r._measurement==\"%v\"", option.Measurement)}
+
+	for _, c := range option.Conditions {
+		switch c.Ope {
+		case Contains:
+			if values, ok := c.Value.([]string); ok {
+				set := fmt.Sprintf("[%v]", strings.Join(lo.Map(values, func(value string, _ int) string { return fmt.Sprintf("\"%v\"", value) }), " , "))
+				conditions = append(conditions, fmt.Sprintf("contains(value: r.%v, set: %v)", c.Key, set))
+			}
+		default:
+			conditions = append(conditions, fmt.Sprintf("r.%v%v\"%v\"", c.Key, c.Ope, c.Value))
+		}
+	}
+
+	timeRange := option.TimeRange()
+
+	countQuery := fmt.Sprintf(`
+		from(bucket: "%v")
+			|> range(start: %v, stop: %v)
+			|> filter(fn: (r) => %v)
+			|> keep(columns: ["_time", "_field", "_value"])
+			|> pivot(rowKey:["_time"], columnKey:["_field"], valueColumn:"_value")
+			|> group(columns: ["%v"])
+			|> count(column: "at")
+			|> rename(columns: {"at": "count"})
+	`,
+		a.bucket.Name,
+		timeRange.Start,
+		timeRange.Stop,
+		strings.Join(conditions, " and "),
+		column,
+	)
+
+	result, err := a.client.QueryAPI(a.org.Name).Query(c, countQuery)
+
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+
+	for result.Next() {
+		values := result.Record().Values()
+
+		key, ok := values[column].(string)
+		if !ok {
+			continue
+		}
+
+		switch count := values["count"].(type) {
+		case int64:
+			counts[key] = int(count)
+		case float64:
+			counts[key] = int(count)
+		}
+	}
+
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	return counts, nil
+}
+
 // Delete implements TimeseriesStore.
-func (a activityStore) Delete(c context.Context, option deleteOption) error {
+func (a activityStore) Delete(c context.Context, option deleteOption) (err error) {
+	c, end := a.instrument(c, "delete")
+	defer func() { end(err) }()
+
 	conditions := []string{fmt.Sprintf("_measurement=\"%v\"", option.Measurement)}
 
 	for _, c := range option.Conditions {
-		conditions = append(conditions, fmt.Sprintf("r.%v%v\"%v\"", c.Key, c.Ope, c.Value))
+		// 削除の述語はFluxの式ではなく、タグ名と = / != による比較のみを受け付ける
+		ope := c.Ope
+		if ope == EQ {
+			ope = "="
+		}
+
+		conditions = append(conditions, fmt.Sprintf("%v%v\"%v\"", c.Key, ope, c.Value))
 	}
 
 	timeRange := option.TimeRange()
@@ -118,7 +206,10 @@ func (a activityStore) Delete(c context.Context, option deleteOption) error {
 }
 
 // Find implements TimeseriesStore.
-func (a activityStore) Find(c context.Context, option queryOption, v any) error {
+func (a activityStore) Find(c context.Context, option queryOption, v any) (err error) {
+	c, end := a.instrument(c, "find")
+	defer func() { end(err) }()
+
 	conditions := []string{fmt.Sprintf("r._measurement==\"%v\"", option.Measurement)}
 
 	for _, c := range option.Conditions {
@@ -135,9 +226,43 @@ func (a activityStore) Find(c context.Context, option queryOption, v any) error
 
 	timeRange := option.TimeRange()
 
+	sortColumns := []string{"\"_time\""}
+	// 同時刻の別の行をまとめないよう、行を一意にするタグをつないだ列も行のキーにする
+	rowKey := `""`
+	var after string
 	var limit string
 	if option.RowRange != nil {
-		limit = fmt.Sprintf("|> limit(n: %v, offset: %v)", option.RowRange.Limit, option.RowRange.Offset)
+		if option.RowRange.Key != "" {
+			sortColumns = append(sortColumns, fmt.Sprintf("\"%v\"", option.RowRange.Key))
+		}
+
+		if len(option.RowRange.Tags) > 0 {
+			// 空のタグは保存されないため、RowKey と同じく空文字列として扱う
+			rowKey = strings.Join(lo.Map(option.RowRange.Tags, func(tag string, _ int) string {
+				return fmt.Sprintf(`(if exists r.%v then r.%v else "")`, tag, tag)
+			}), " + "+fluxString(rowKeySeparator)+" + ")
+			sortColumns = append(sortColumns, `"_row_key"`)
+		}
+
+		// カーソル指定時はオフセットを使わず、カーソルより後ろの行に絞り込む
+		if option.RowRange.After != nil {
+			ope := GT
+			if option.Desc {
+				ope = LT
+			}
+
+			at := fmt.Sprintf("time(v: \"%v\")", option.RowRange.After.At.UTC().Format(time.RFC3339Nano))
+			value := fluxString(option.RowRange.After.Value)
+			tie := fmt.Sprintf("r.%v%v%v", option.RowRange.Key, ope, value)
+			if len(option.RowRange.Tags) > 0 {
+				tie = fmt.Sprintf("%v or (r.%v==%v and r._row_key%v%v)", tie, option.RowRange.Key, value, ope, fluxString(option.RowRange.After.RowKey))
+			}
+
+			after = fmt.Sprintf("|> filter(fn: (r) => r._time%v%v or (r._time==%v and (%v)))", ope, at, at, tie)
+			limit = fmt.Sprintf("|> limit(n: %v)", option.RowRange.Limit)
+		} else {
+			limit = fmt.Sprintf("|> limit(n: %v, offset: %v)", option.RowRange.Limit, option.RowRange.Offset)
+		}
 	} else {
 		limit = ""
 	}
@@ -146,15 +271,20 @@ func (a activityStore) Find(c context.Context, option queryOption, v any) error
 		from(bucket: "%v")
 			|> range(start: %v, stop: %v)
 			|> filter(fn: (r) => %v)
-			|> keep(columns: ["_time", "_field", "_value"])
-			|> pivot(rowKey:["_time"], columnKey:["_field"], valueColumn:"_value")
-			|> sort(columns:["_time"], desc: %v)
+			|> map(fn: (r) => ({r with _row_key: %v}))
+			|> keep(columns: ["_time", "_field", "_value", "_row_key"])
+			|> pivot(rowKey:["_time", "_row_key"], columnKey:["_field"], valueColumn:"_value")
+			%v
+			|> sort(columns:[%v], desc: %v)
 			%v
 	`,
 		a.bucket.Name,
 		timeRange.Start,
 		timeRange.Stop,
 		strings.Join(conditions, " and "),
+		rowKey,
+		after,
+		strings.Join(sortColumns, ", "),
 		option.Desc,
 		limit,
 	)
@@ -193,7 +323,10 @@ func (a activityStore) Find(c context.Context, option queryOption, v any) error
 }
 
 // Save implements TimeseriesStore.
-func (a activityStore) Save(c context.Context, point Point) error {
+func (a activityStore) Save(c context.Context, point Point) (err error) {
+	c, end := a.instrument(c, "save")
+	defer func() { end(err) }()
+
 	writeAPI := a.client.WriteAPIBlocking(a.org.Name, a.bucket.Name)
 	return writeAPI.WritePoint(c,
 		write.NewPoint(string(point.Measurement()),
@@ -204,6 +337,41 @@ func (a activityStore) Save(c context.Context, point Point) error {
 	)
 }
 
+// Ping implements TimeseriesStore.
+func (a activityStore) Ping(c context.Context) error {
+	health, err := a.client.Health(c)
+	if err != nil {
+		return err
+	}
+
+	if health.Status != domain.HealthCheckStatusPass {
+		return fmt.Errorf("unhealthy. status=%v message=%v", health.Status, lo.FromPtr(health.Message))
+	}
+
+	return nil
+}
+
+// Shutdown implements do.Shutdownable.
+func (a activityStore) Shutdown() error {
+	a.client.Close()
+	return nil
+}
+
+// instrument はクエリの実行時間の計測とスパンの作成を行う
+func (a activityStore) instrument(c context.Context, operation string) (context.Context, func(err error)) {
+	start := time.Now()
+	c, span := ltracing.Start(c, "influxdb "+operation, trace.SpanKindClient,
+		semconv.DBSystemKey.String("influxdb"),
+		semconv.DBName(a.bucket.Name),
+		semconv.DBOperation(operation),
+	)
+
+	return c, func(err error) {
+		lmetrics.ObserveQuery(lmetrics.BackendInfluxDB, "activity", operation, time.Since(start))
+		ltracing.End(span, err)
+	}
+}
+
 func NewActivityStore(i *do.Injector) (TimeseriesStore, error) {
 	client := influxdb2.NewClient(os.Getenv("INFLUXDB_ACTIVITY_URL"), os.Getenv("INFLUXDB_ACTIVITY_AUTH_TOKEN"))
 
@@ -227,3 +395,8 @@ func NewActivityStore(i *do.Injector) (TimeseriesStore, error) {
 		bucket: bucket,
 	}, nil
 }
+
+// カーソルの値はユーザーの入力を含むため、Flux の文字列リテラルとしてエスケープする
+func fluxString(v string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "${", `\${`).Replace(v) + `"`
+}
@@ -2,7 +2,10 @@ package timeseries
 
 import (
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/samber/lo"
 )
 
 type Measurement string
@@ -40,6 +43,26 @@ type TimeRange struct {
 type RowRange struct {
 	Limit  int
 	Offset int
+	// 同時刻の行の並び順を決める列（カーソルの比較にも使う）
+	Key string
+	// 時刻と合わせて行を一意にするタグで、Key列が同じ行の並び順を決める
+	Tags  []string
+	After *Cursor
+}
+
+// 前ページ末尾の行の時刻とKey列の値と、Tagsの値を RowKey でつないだ値
+type Cursor struct {
+	At     time.Time
+	Value  string
+	RowKey string
+}
+
+const rowKeySeparator = "\t"
+
+// Find の結果の並び順で使う、行を一意にするタグの値をつないだ文字列
+func RowKey(point Point, tags []string) string {
+	values := point.Tags()
+	return strings.Join(lo.Map(tags, func(tag string, _ int) string { return values[tag] }), rowKeySeparator)
 }
 
 type queryOption struct {
@@ -0,0 +1,63 @@
+package mail
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/smtp"
+	"os"
+
+	"github.com/samber/do"
+)
+
+type ConnectionConfig struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	User     string `json:"user"`
+	Password string `json:"password"`
+	From     string `json:"from"`
+}
+
+type MailConnection interface {
+	Send(to []string, message []byte) error
+	From() string
+}
+
+type smtpConnection struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// Send implements MailConnection.
+func (s *smtpConnection) Send(to []string, message []byte) error {
+	return smtp.SendMail(s.addr, s.auth, s.from, to, message)
+}
+
+// From implements MailConnection.
+func (s *smtpConnection) From() string {
+	return s.from
+}
+
+// 送信のたびに接続するため、ここではSMTPサーバーへ接続しない
+func NewMailConnection(i *do.Injector) (MailConnection, error) {
+	var config ConnectionConfig
+	if err := json.Unmarshal([]byte(os.Getenv("SMTP_CONNECTION")), &config); err != nil {
+		return nil, err
+	}
+
+	// ユーザーが未設定の場合は認証しない（開発用のSMTPサーバーなど）
+	var auth smtp.Auth
+	if config.User != "" {
+		auth = smtp.PlainAuth("", config.User, config.Password, config.Host)
+	} else {
+		auth = nil
+	}
+
+	fmt.Printf("connection configured. %v:%v \n", config.Host, config.Port)
+
+	return &smtpConnection{
+		addr: fmt.Sprintf("%v:%v", config.Host, config.Port),
+		auth: auth,
+		from: config.From,
+	}, nil
+}
@@ -11,9 +11,11 @@ import (
 
 type ActivityStoreConnection interface {
 	Publish(c context.Context, exchange ExchangeName, routingKey RoutingKey, v any) error
+	Ping(c context.Context) error
 }
 type activityStoreConnection struct {
-	channel *amqp.Channel
+	connection *amqp.Connection
+	channel    *amqp.Channel
 }
 
 var (
@@ -25,12 +27,17 @@ var (
 
 // Publish implements ActivityStoreConnection.
 func (r *activityStoreConnection) Publish(c context.Context, exchange ExchangeName, routingKey RoutingKey, v any) error {
-	message, err := createMessage(c, v)
-	if err != nil {
-		return err
-	}
+	return publish(c, r.channel, exchange, routingKey, v)
+}
+
+// Ping implements ActivityStoreConnection.
+func (r *activityStoreConnection) Ping(c context.Context) error {
+	return ping(r.channel)
+}
 
-	return r.channel.Publish(exchange.String(), routingKey.String(), false, false, *message)
+// Shutdown implements do.Shutdownable.
+func (r *activityStoreConnection) Shutdown() error {
+	return closeConnection(r.connection, r.channel)
 }
 
 func NewActivityStoreConnection(i *do.Injector) (ActivityStoreConnection, error) {
@@ -39,13 +46,14 @@ func NewActivityStoreConnection(i *do.Injector) (ActivityStoreConnection, error)
 		return nil, err
 	}
 
-	channel, err := getConnection(config)
+	connection, channel, err := getConnection(config)
 
 	if err != nil {
 		return nil, err
 	}
 
 	return &activityStoreConnection{
-		channel: channel,
+		connection: connection,
+		channel:    channel,
 	}, nil
 }
@@ -2,6 +2,8 @@ package mq
 
 import (
 	lcontext "app/lib/context"
+	lmetrics "app/lib/metrics"
+	ltracing "app/lib/tracing"
 	"context"
 	"encoding/json"
 	"errors"
@@ -9,6 +11,8 @@ import (
 
 	"github.com/labstack/echo/v4"
 	amqp "github.com/rabbitmq/amqp091-go"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ConnectionConfig struct {
@@ -31,7 +35,7 @@ func (r RoutingKey) String() string {
 	return string(r)
 }
 
-func getConnection(config ConnectionConfig) (*amqp.Channel, error) {
+func getConnection(config ConnectionConfig) (*amqp.Connection, *amqp.Channel, error) {
 	connection, err := amqp.Dial(fmt.Sprintf("%v://%v:%v@%v:%v/",
 		config.Protocol,
 		config.User,
@@ -40,10 +44,35 @@ func getConnection(config ConnectionConfig) (*amqp.Channel, error) {
 		config.Port),
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	channel, err := connection.Channel()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return connection.Channel()
+	return connection, channel, nil
+}
+
+func closeConnection(connection *amqp.Connection, channel *amqp.Channel) error {
+	if err := channel.Close(); err != nil && !errors.Is(err, amqp.ErrClosed) {
+		return err
+	}
+
+	if err := connection.Close(); err != nil && !errors.Is(err, amqp.ErrClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func ping(channel *amqp.Channel) error {
+	if channel.IsClosed() {
+		return errors.New("channel is closed")
+	}
+
+	return nil
 }
 
 func createMessage(c context.Context, body any) (*amqp.Publishing, error) {
@@ -57,11 +86,35 @@ func createMessage(c context.Context, body any) (*amqp.Publishing, error) {
 		return nil, errors.New("failied to get request id")
 	}
 
+	headers := amqp.Table{
+		lcontext.ContextKeyRequestID.String(): requestID,
+	}
+	ltracing.Inject(c, ltracing.TableCarrier(headers))
+
 	return &amqp.Publishing{
 		ContentType: echo.MIMETextPlain,
-		Headers: amqp.Table{
-			lcontext.ContextKeyRequestID.String(): requestID,
-		},
-		Body: bytes,
+		Headers:     headers,
+		Body:        bytes,
 	}, nil
 }
+
+func publish(c context.Context, channel *amqp.Channel, exchange ExchangeName, routingKey RoutingKey, v any) (err error) {
+	c, span := ltracing.Start(c, "publish "+exchange.String(), trace.SpanKindProducer,
+		semconv.MessagingSystemRabbitmq,
+		semconv.MessagingDestinationName(exchange.String()),
+		semconv.MessagingRabbitmqDestinationRoutingKey(routingKey.String()),
+	)
+	defer func() { ltracing.End(span, err) }()
+
+	message, err := createMessage(c, v)
+	if err == nil {
+		err = channel.Publish(exchange.String(), routingKey.String(), false, false, *message)
+	}
+
+	if err != nil {
+		lmetrics.IncPublishFailure(exchange.String(), routingKey.String())
+		return err
+	}
+
+	return nil
+}
@@ -11,9 +11,11 @@ import (
 
 type ResourceSearchIndexStoreConnection interface {
 	Publish(c context.Context, exchange ExchangeName, routingKey RoutingKey, v any) error
+	Ping(c context.Context) error
 }
 type resourceSearchIndexStoreConnection struct {
-	channel *amqp.Channel
+	connection *amqp.Connection
+	channel    *amqp.Channel
 }
 
 var (
@@ -25,12 +27,17 @@ var (
 
 // Publish implements ResourceSearchIndexStoreConnection.
 func (r *resourceSearchIndexStoreConnection) Publish(c context.Context, exchange ExchangeName, routingKey RoutingKey, v any) error {
-	message, err := createMessage(c, v)
-	if err != nil {
-		return err
-	}
+	return publish(c, r.channel, exchange, routingKey, v)
+}
+
+// Ping implements ResourceSearchIndexStoreConnection.
+func (r *resourceSearchIndexStoreConnection) Ping(c context.Context) error {
+	return ping(r.channel)
+}
 
-	return r.channel.Publish(exchange.String(), routingKey.String(), false, false, *message)
+// Shutdown implements do.Shutdownable.
+func (r *resourceSearchIndexStoreConnection) Shutdown() error {
+	return closeConnection(r.connection, r.channel)
 }
 
 func NewResourceSearchIndexStoreConnection(i *do.Injector) (ResourceSearchIndexStoreConnection, error) {
@@ -39,13 +46,14 @@ func NewResourceSearchIndexStoreConnection(i *do.Injector) (ResourceSearchIndexS
 		return nil, err
 	}
 
-	channel, err := getConnection(config)
+	connection, channel, err := getConnection(config)
 
 	if err != nil {
 		return nil, err
 	}
 
 	return &resourceSearchIndexStoreConnection{
-		channel: channel,
+		connection: connection,
+		channel:    channel,
 	}, nil
 }
@@ -0,0 +1,199 @@
+package migration
+
+import (
+	dmodel "app/domain/model"
+	idocument "app/infrastructure/adapter/datastore/document"
+	imodel "app/infrastructure/model"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	mongoErrorCodeNamespaceNotFound = 26
+	mongoErrorCodeIndexNotFound     = 27
+)
+
+type documentIndex struct {
+	db         *mongo.Database
+	collection string
+	name       string
+	keys       bson.D
+	unique     bool
+}
+
+// 既存のドキュメントを直す更新で、対象が無くなるまで何度適用してもよいように filter で絞り込む
+type documentUpdate struct {
+	db         *mongo.Database
+	collection string
+	name       string
+	filter     bson.M
+	update     bson.M
+}
+
+// インデックスとデータの更新は宣言的に管理し、バージョンは持たない
+type documentMigrator struct {
+	indexes []documentIndex
+	updates []documentUpdate
+}
+
+// Up implements Migrator.
+func (d *documentMigrator) Up(c context.Context) error {
+	for _, index := range d.indexes {
+		if _, err := index.db.
+			Collection(index.collection).
+			Indexes().
+			CreateOne(c, mongo.IndexModel{
+				Keys:    index.keys,
+				Options: options.Index().SetName(index.name).SetUnique(index.unique),
+			}); err != nil {
+			return errors.Wrapf(err, "failed to create index. collection=%v name=%v", index.collection, index.name)
+		}
+
+		fmt.Printf("applied. collection=%v index=%v \n", index.collection, index.name)
+	}
+
+	for _, update := range d.updates {
+		result, err := update.db.
+			Collection(update.collection).
+			UpdateMany(c, update.filter, update.update)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update documents. collection=%v name=%v", update.collection, update.name)
+		}
+
+		fmt.Printf("applied. collection=%v update=%v count=%v \n", update.collection, update.name, result.ModifiedCount)
+	}
+
+	return nil
+}
+
+// Down implements Migrator.
+// 宣言的に管理しているので steps に関わらず全てのインデックスを削除する
+// データの更新は後から行われた編集と区別できないため戻さない
+func (d *documentMigrator) Down(c context.Context, steps int) error {
+	for _, index := range d.indexes {
+		if _, err := index.db.
+			Collection(index.collection).
+			Indexes().
+			DropOne(c, index.name); err != nil && !isMongoErrorCode(err, mongoErrorCodeNamespaceNotFound, mongoErrorCodeIndexNotFound) {
+			return errors.Wrapf(err, "failed to drop index. collection=%v name=%v", index.collection, index.name)
+		}
+
+		fmt.Printf("rolled back. collection=%v index=%v \n", index.collection, index.name)
+	}
+
+	return nil
+}
+
+// Status implements Migrator.
+func (d *documentMigrator) Status(c context.Context) ([]Status, error) {
+	statuses := []Status{}
+	for _, index := range d.indexes {
+		specifications, err := index.db.
+			Collection(index.collection).
+			Indexes().
+			ListSpecifications(c)
+		if err != nil && !isMongoErrorCode(err, mongoErrorCodeNamespaceNotFound) {
+			return nil, errors.Wrapf(err, "failed to list indexes. collection=%v", index.collection)
+		}
+
+		statuses = append(statuses, Status{
+			Target:  "document/" + index.collection,
+			Name:    index.name,
+			Applied: lo.ContainsBy(specifications, func(specification *mongo.IndexSpecification) bool { return specification.Name == index.name }),
+		})
+	}
+
+	for _, update := range d.updates {
+		count, err := update.db.
+			Collection(update.collection).
+			CountDocuments(c, update.filter)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to count documents. collection=%v name=%v", update.collection, update.name)
+		}
+
+		statuses = append(statuses, Status{
+			Target:  "document/" + update.collection,
+			Name:    update.name,
+			Applied: count == 0,
+			Detail:  fmt.Sprintf("pending=%v", count),
+		})
+	}
+
+	return statuses, nil
+}
+
+// ページとテンプレートの権限を追加する前に作られたオーナーのロールに、同じ権限を付与する
+// オーナーのロールは追加前の CommunityMemberAction をすべて持つロールとする
+// キャッシュしているロールには TTL が過ぎてから反映される
+func grantCommunityOwnerAction(db *mongo.Database, resource dmodel.Resource) documentUpdate {
+	owned := bson.A{}
+	for ownedResource, operations := range dmodel.CommunityMemberAction {
+		if ownedResource == dmodel.ResourcePage || ownedResource == dmodel.ResourceTemplate {
+			continue
+		}
+
+		owned = append(owned, bson.M{"$elemMatch": bson.M{
+			"resource":  ownedResource.String(),
+			"operation": bson.M{"$all": lo.Map(operations, func(operation dmodel.Operation, _ int) string { return operation.String() })},
+		}})
+	}
+
+	return documentUpdate{
+		db:         db,
+		collection: imodel.Action{}.Collection(),
+		name:       "grant_community_owner_" + resource.String(),
+		filter: bson.M{
+			"$and": bson.A{
+				bson.M{"items": bson.M{"$all": owned}},
+				bson.M{"items.resource": bson.M{"$ne": resource.String()}},
+			},
+		},
+		update: bson.M{"$push": bson.M{"items": bson.M{
+			"resource":  resource.String(),
+			"operation": lo.Map(dmodel.CommunityMemberAction[resource], func(operation dmodel.Operation, _ int) string { return operation.String() }),
+		}}},
+	}
+}
+
+func isMongoErrorCode(err error, codes ...int) bool {
+	var commandError mongo.CommandError
+	if !errors.As(err, &commandError) {
+		return false
+	}
+
+	return lo.ContainsBy(codes, func(code int) bool { return commandError.HasErrorCode(code) })
+}
+
+func NewDocumentMigrator(i *do.Injector) (Migrator, error) {
+	noteStoreConnection := do.MustInvoke[idocument.NoteStoreConnection](i)
+	roleStoreConnection := do.MustInvoke[idocument.RoleStoreConnection](i)
+	return &documentMigrator{
+		indexes: []documentIndex{
+			{
+				db:         noteStoreConnection.DB(),
+				collection: imodel.LineProperty{}.Collection(),
+				name:       "line_id_unique",
+				keys:       bson.D{{Key: "line_id", Value: 1}},
+				unique:     true,
+			},
+			{
+				db:         roleStoreConnection.DB(),
+				collection: imodel.Action{}.Collection(),
+				name:       "role_id_unique",
+				keys:       bson.D{{Key: "role_id", Value: 1}},
+				unique:     true,
+			},
+		},
+		updates: []documentUpdate{
+			grantCommunityOwnerAction(roleStoreConnection.DB(), dmodel.ResourcePage),
+			grantCommunityOwnerAction(roleStoreConnection.DB(), dmodel.ResourceTemplate),
+		},
+	}, nil
+}
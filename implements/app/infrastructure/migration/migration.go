@@ -0,0 +1,18 @@
+package migration
+
+import (
+	"context"
+)
+
+type Status struct {
+	Target  string
+	Name    string
+	Applied bool
+	Detail  string
+}
+
+type Migrator interface {
+	Up(c context.Context) error
+	Down(c context.Context, steps int) error
+	Status(c context.Context) ([]Status, error)
+}
@@ -0,0 +1,266 @@
+package migration
+
+import (
+	irdb "app/infrastructure/adapter/datastore/rdb"
+	imodel "app/infrastructure/model"
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+//go:embed sql
+var sqlFS embed.FS
+
+const createSchemaMigrationsTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    store VARCHAR(64) NOT NULL,
+    version VARCHAR(32) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    applied_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (store, version)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`
+
+type rdbMigration struct {
+	version string
+	name    string
+	up      string
+	down    string
+}
+
+type rdbStore struct {
+	name string
+	db   *gorm.DB
+}
+
+type rdbMigrator struct {
+	stores []rdbStore
+}
+
+// Up implements Migrator.
+func (r *rdbMigrator) Up(c context.Context) error {
+	for _, store := range r.stores {
+		migrations, err := loadRDBMigrations(store.name)
+		if err != nil {
+			return err
+		}
+
+		applied, err := r.applied(c, store)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range migrations {
+			if _, ok := applied[migration.version]; ok {
+				continue
+			}
+
+			// MySQL の DDL は暗黙的にコミットされるため、途中で失敗した場合は手動で復旧する
+			if err := exec(c, store.db, migration.up); err != nil {
+				return errors.Wrapf(err, "failed to apply migration. store=%v version=%v", store.name, migration.version)
+			}
+
+			if err := store.db.WithContext(c).
+				Create(&imodel.SchemaMigration{
+					Store:     store.name,
+					Version:   migration.version,
+					Name:      migration.name,
+					AppliedAt: time.Now(),
+				}).Error; err != nil {
+				return errors.Wrapf(err, "failed to record migration. store=%v version=%v", store.name, migration.version)
+			}
+
+			fmt.Printf("applied. store=%v version=%v name=%v \n", store.name, migration.version, migration.name)
+		}
+	}
+
+	return nil
+}
+
+// Down implements Migrator.
+// ストアごとに適用済みのマイグレーションを新しいものから steps 件ロールバックする
+func (r *rdbMigrator) Down(c context.Context, steps int) error {
+	for _, store := range r.stores {
+		migrations, err := loadRDBMigrations(store.name)
+		if err != nil {
+			return err
+		}
+
+		applied, err := r.applied(c, store)
+		if err != nil {
+			return err
+		}
+
+		rollbacks := lo.Filter(lo.Reverse(migrations), func(migration rdbMigration, _ int) bool {
+			_, ok := applied[migration.version]
+			return ok
+		})
+		if len(rollbacks) > steps {
+			rollbacks = rollbacks[:steps]
+		}
+
+		for _, migration := range rollbacks {
+			if err := exec(c, store.db, migration.down); err != nil {
+				return errors.Wrapf(err, "failed to rollback migration. store=%v version=%v", store.name, migration.version)
+			}
+
+			if err := store.db.WithContext(c).
+				Where("store = ? and version = ?", store.name, migration.version).
+				Delete(&imodel.SchemaMigration{}).Error; err != nil {
+				return errors.Wrapf(err, "failed to delete migration record. store=%v version=%v", store.name, migration.version)
+			}
+
+			fmt.Printf("rolled back. store=%v version=%v name=%v \n", store.name, migration.version, migration.name)
+		}
+	}
+
+	return nil
+}
+
+// Status implements Migrator.
+func (r *rdbMigrator) Status(c context.Context) ([]Status, error) {
+	statuses := []Status{}
+	for _, store := range r.stores {
+		migrations, err := loadRDBMigrations(store.name)
+		if err != nil {
+			return nil, err
+		}
+
+		applied, err := r.applied(c, store)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, migration := range migrations {
+			status := Status{
+				Target: "rdb/" + store.name,
+				Name:   migration.version + "_" + migration.name,
+			}
+
+			if record, ok := applied[migration.version]; ok {
+				status.Applied = true
+				status.Detail = fmt.Sprintf("applied_at=%v", record.AppliedAt.Format(time.RFC3339))
+			}
+
+			statuses = append(statuses, status)
+		}
+	}
+
+	return statuses, nil
+}
+
+func (r *rdbMigrator) applied(c context.Context, store rdbStore) (map[string]imodel.SchemaMigration, error) {
+	if err := store.db.WithContext(c).Exec(createSchemaMigrationsTable).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to create schema migrations table. store=%v", store.name)
+	}
+
+	records := []imodel.SchemaMigration{}
+	if err := store.db.WithContext(c).
+		Where("store = ?", store.name).
+		Find(&records).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list schema migrations. store=%v", store.name)
+	}
+
+	return lo.KeyBy(records, func(record imodel.SchemaMigration) string { return record.Version }), nil
+}
+
+// ファイル名は {version}_{name}.(up|down).sql とする
+func loadRDBMigrations(store string) ([]rdbMigration, error) {
+	entries, err := fs.ReadDir(sqlFS, path.Join("sql", store))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read migrations. store=%v", store)
+	}
+
+	migrations := map[string]*rdbMigration{}
+	for _, entry := range entries {
+		filename := entry.Name()
+
+		var direction string
+		switch {
+		case strings.HasSuffix(filename, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(filename, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+
+		version, name, ok := strings.Cut(strings.TrimSuffix(filename, "."+direction+".sql"), "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration filename. store=%v filename=%v", store, filename)
+		}
+
+		body, err := sqlFS.ReadFile(path.Join("sql", store, filename))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read migration. store=%v filename=%v", store, filename)
+		}
+
+		migration, ok := migrations[version]
+		if !ok {
+			migration = &rdbMigration{version: version, name: name}
+			migrations[version] = migration
+		}
+
+		if direction == "up" {
+			migration.up = string(body)
+		} else {
+			migration.down = string(body)
+		}
+	}
+
+	result := lo.MapToSlice(migrations, func(_ string, migration *rdbMigration) rdbMigration { return *migration })
+	sort.Slice(result, func(i, j int) bool { return result[i].version < result[j].version })
+
+	for _, migration := range result {
+		if migration.up == "" || migration.down == "" {
+			return nil, fmt.Errorf("migration must have both up and down. store=%v version=%v", store, migration.version)
+		}
+	}
+
+	return result, nil
+}
+
+// 接続は multiStatements を有効にしているので、ファイルをそのまま実行する
+func exec(c context.Context, db *gorm.DB, body string) error {
+	return db.WithContext(c).Exec(body).Error
+}
+
+func NewRDBMigrator(i *do.Injector) (Migrator, error) {
+	stores := []rdbStore{}
+	for _, store := range []struct {
+		name string
+		key  string
+	}{
+		{name: "user", key: "MYSQL_USER_WRITE"},
+		{name: "note", key: "MYSQL_NOTE_WRITE"},
+		{name: "content", key: "MYSQL_CONTENT_WRITE"},
+		{name: "community", key: "MYSQL_COMMUNITY_WRITE"},
+		{name: "role", key: "MYSQL_ROLE_WRITE"},
+		{name: "member", key: "MYSQL_MEMBER_WRITE"},
+		{name: "invite", key: "MYSQL_INVITE_WRITE"},
+		{name: "topic", key: "MYSQL_TOPIC_WRITE"},
+		{name: "thread", key: "MYSQL_THREAD_WRITE"},
+		{name: "post", key: "MYSQL_POST_WRITE"},
+		{name: "message", key: "MYSQL_MESSAGE_WRITE"},
+	} {
+		db, err := irdb.NewMultiStatementConnection(store.key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to connect. store=%v", store.name)
+		}
+
+		stores = append(stores, rdbStore{name: store.name, db: db})
+	}
+
+	return &rdbMigrator{
+		stores: stores,
+	}, nil
+}
@@ -0,0 +1,110 @@
+package migration
+
+import (
+	isearchengine "app/infrastructure/adapter/datastore/searchengine"
+	imodel "app/infrastructure/model"
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/indices/create"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+)
+
+//go:embed searchengine/resource_search_indexes.json
+var resourceSearchIndexTemplate []byte
+
+// インデックステンプレートは宣言的に管理し、バージョンは持たない
+type searchengineMigrator struct {
+	client *elasticsearch.TypedClient
+}
+
+// Up implements Migrator.
+// テンプレートはインデックスの作成時にしか適用されないので、テンプレートを登録してからインデックスを作成する
+func (s *searchengineMigrator) Up(c context.Context) error {
+	index := imodel.ResourceSearchIndex{}.Index()
+
+	if _, err := s.client.Indices.
+		PutIndexTemplate(index).
+		Raw(bytes.NewReader(resourceSearchIndexTemplate)).
+		Do(c); err != nil {
+		return errors.Wrapf(err, "failed to put index template. name=%v", index)
+	}
+
+	fmt.Printf("applied. index_template=%v \n", index)
+
+	exists, err := s.client.Indices.Exists(index).IsSuccess(c)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check index. name=%v", index)
+	}
+
+	if exists {
+		return nil
+	}
+
+	// 再構築でエイリアスを付け替えられるように、実体は日時を付けた名前で作る
+	versioned := imodel.ResourceSearchIndex{}.VersionedIndex(time.Now())
+	if _, err := s.client.Indices.
+		Create(versioned).
+		Request(&create.Request{Aliases: map[string]types.Alias{index: {}}}).
+		Do(c); err != nil {
+		return errors.Wrapf(err, "failed to create index. name=%v", versioned)
+	}
+
+	fmt.Printf("applied. index=%v alias=%v \n", versioned, index)
+	return nil
+}
+
+// Down implements Migrator.
+// 索引済みのデータを失わないように、インデックスは削除せずテンプレートのみ削除する
+func (s *searchengineMigrator) Down(c context.Context, steps int) error {
+	index := imodel.ResourceSearchIndex{}.Index()
+
+	exists, err := s.client.Indices.ExistsIndexTemplate(index).IsSuccess(c)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check index template. name=%v", index)
+	}
+
+	if !exists {
+		return nil
+	}
+
+	if _, err := s.client.Indices.DeleteIndexTemplate(index).Do(c); err != nil {
+		return errors.Wrapf(err, "failed to delete index template. name=%v", index)
+	}
+
+	fmt.Printf("rolled back. index_template=%v \n", index)
+	return nil
+}
+
+// Status implements Migrator.
+func (s *searchengineMigrator) Status(c context.Context) ([]Status, error) {
+	index := imodel.ResourceSearchIndex{}.Index()
+
+	templateExists, err := s.client.Indices.ExistsIndexTemplate(index).IsSuccess(c)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to check index template. name=%v", index)
+	}
+
+	indexExists, err := s.client.Indices.Exists(index).IsSuccess(c)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to check index. name=%v", index)
+	}
+
+	return []Status{
+		{Target: "searchengine/index_template", Name: index, Applied: templateExists},
+		{Target: "searchengine/index", Name: index, Applied: indexExists},
+	}, nil
+}
+
+func NewSearchengineMigrator(i *do.Injector) (Migrator, error) {
+	resourceSearchIndexStoreConnection := do.MustInvoke[isearchengine.ResourceSearchIndexStoreConnection](i)
+	return &searchengineMigrator{
+		client: resourceSearchIndexStoreConnection.Client(),
+	}, nil
+}
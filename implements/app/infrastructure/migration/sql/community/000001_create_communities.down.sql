@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS community_deletions;
+DROP TABLE IF EXISTS communities;
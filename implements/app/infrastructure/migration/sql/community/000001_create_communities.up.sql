@@ -0,0 +1,22 @@
+CREATE TABLE communities (
+    id CHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    invitation TINYINT(1) NOT NULL DEFAULT 0,
+    visibility VARCHAR(32) NOT NULL DEFAULT 'unlisted',
+    status VARCHAR(32) NOT NULL DEFAULT 'active',
+    owner_id CHAR(36) NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    KEY idx_communities_visibility_status_created_at (visibility, status, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE community_deletions (
+    community_id CHAR(36) NOT NULL,
+    step VARCHAR(32) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    at DATETIME(3) NOT NULL,
+    last_error TEXT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (community_id),
+    KEY idx_community_deletions_at (at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
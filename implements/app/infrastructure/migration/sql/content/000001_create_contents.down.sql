@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS content_message_relations;
+DROP TABLE IF EXISTS content_topic_relations;
+DROP TABLE IF EXISTS content_post_relations;
+DROP TABLE IF EXISTS content_line_relations;
+DROP TABLE IF EXISTS contents;
@@ -0,0 +1,39 @@
+CREATE TABLE contents (
+    id CHAR(36) NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    bin LONGBLOB NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE content_line_relations (
+    content_id CHAR(36) NOT NULL,
+    line_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (content_id, line_id),
+    KEY idx_content_line_relations_line_id (line_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE content_post_relations (
+    content_id CHAR(36) NOT NULL,
+    post_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (content_id, post_id),
+    KEY idx_content_post_relations_post_id (post_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE content_topic_relations (
+    content_id CHAR(36) NOT NULL,
+    topic_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (content_id, topic_id),
+    KEY idx_content_topic_relations_topic_id (topic_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE content_message_relations (
+    content_id CHAR(36) NOT NULL,
+    message_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (content_id, message_id),
+    KEY idx_content_message_relations_message_id (message_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
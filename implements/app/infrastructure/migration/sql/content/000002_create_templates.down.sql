@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS template_from_member_relations;
+DROP TABLE IF EXISTS template_community_relations;
+DROP TABLE IF EXISTS templates;
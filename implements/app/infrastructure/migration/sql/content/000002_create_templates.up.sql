@@ -0,0 +1,26 @@
+CREATE TABLE templates (
+    id CHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    title VARCHAR(255) NULL,
+    fields BLOB NOT NULL,
+    body LONGBLOB NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE template_community_relations (
+    template_id CHAR(36) NOT NULL,
+    community_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (template_id, community_id),
+    KEY idx_template_community_relations_community_id (community_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE template_from_member_relations (
+    template_id CHAR(36) NOT NULL,
+    member_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (template_id, member_id),
+    KEY idx_template_from_member_relations_member_id (member_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
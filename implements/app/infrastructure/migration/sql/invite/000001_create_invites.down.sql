@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS invite_links;
+DROP TABLE IF EXISTS invited_emails;
+DROP TABLE IF EXISTS invited_users;
+DROP TABLE IF EXISTS invites;
@@ -0,0 +1,42 @@
+CREATE TABLE invites (
+    id CHAR(36) NOT NULL,
+    role_id CHAR(36) NOT NULL,
+    message TEXT NULL,
+    at DATETIME(3) NOT NULL,
+    expire_at DATETIME(3) NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    KEY idx_invites_role_id (role_id),
+    KEY idx_invites_expire_at (expire_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE invited_users (
+    invite_id CHAR(36) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (invite_id, user_id),
+    KEY idx_invited_users_user_id (user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE invited_emails (
+    invite_id CHAR(36) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (invite_id, email),
+    KEY idx_invited_emails_email (email)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE invite_links (
+    id CHAR(36) NOT NULL,
+    role_id CHAR(36) NOT NULL,
+    token VARCHAR(255) NOT NULL,
+    at DATETIME(3) NOT NULL,
+    expire_at DATETIME(3) NULL,
+    max_uses BIGINT NULL,
+    uses INT NOT NULL DEFAULT 0,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_invite_links_token (token),
+    KEY idx_invite_links_role_id (role_id),
+    KEY idx_invite_links_expire_at (expire_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
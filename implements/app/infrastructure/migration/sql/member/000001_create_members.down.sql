@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS topic_read_markers;
+DROP TABLE IF EXISTS thread_read_markers;
+DROP TABLE IF EXISTS bans;
+DROP TABLE IF EXISTS member_community_relations;
+DROP TABLE IF EXISTS members;
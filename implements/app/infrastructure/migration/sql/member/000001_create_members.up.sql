@@ -0,0 +1,45 @@
+CREATE TABLE members (
+    id CHAR(36) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    role_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    KEY idx_members_user_id (user_id),
+    KEY idx_members_role_id (role_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE member_community_relations (
+    member_id CHAR(36) NOT NULL,
+    community_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (member_id, community_id),
+    KEY idx_member_community_relations_community_id (community_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE bans (
+    community_id CHAR(36) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    reason TEXT NULL,
+    at DATETIME(3) NOT NULL,
+    expire_at DATETIME(3) NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (community_id, user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE thread_read_markers (
+    member_id CHAR(36) NOT NULL,
+    thread_id CHAR(36) NOT NULL,
+    post_id CHAR(36) NOT NULL,
+    at BIGINT NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (member_id, thread_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE topic_read_markers (
+    member_id CHAR(36) NOT NULL,
+    topic_id CHAR(36) NOT NULL,
+    thread_id CHAR(36) NOT NULL,
+    at BIGINT NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (member_id, topic_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS message_read_markers;
+DROP TABLE IF EXISTS message_from_user_relations;
+DROP TABLE IF EXISTS message_conversation_relations;
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS conversation_user_relations;
+DROP TABLE IF EXISTS conversations;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS `lines`;
+DROP TABLE IF EXISTS note_community_relations;
+DROP TABLE IF EXISTS note_user_relations;
+DROP TABLE IF EXISTS notes;
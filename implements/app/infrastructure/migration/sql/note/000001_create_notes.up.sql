@@ -0,0 +1,30 @@
+CREATE TABLE notes (
+    id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE note_user_relations (
+    note_id CHAR(36) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (note_id, user_id),
+    KEY idx_note_user_relations_user_id (user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE note_community_relations (
+    note_id CHAR(36) NOT NULL,
+    community_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (note_id, community_id),
+    KEY idx_note_community_relations_community_id (community_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE `lines` (
+    id CHAR(36) NOT NULL,
+    note_id CHAR(36) NOT NULL,
+    order_number INT NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    KEY idx_lines_note_id_order_number (note_id, order_number)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
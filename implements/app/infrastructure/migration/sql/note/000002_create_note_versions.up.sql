@@ -0,0 +1,9 @@
+CREATE TABLE note_versions (
+    id CHAR(36) NOT NULL,
+    note_id CHAR(36) NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    snapshot LONGBLOB NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    KEY idx_note_versions_note_id_created_at (note_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS note_page_relations;
+DROP TABLE IF EXISTS page_links;
+DROP TABLE IF EXISTS page_from_member_relations;
+DROP TABLE IF EXISTS page_community_relations;
+DROP TABLE IF EXISTS pages;
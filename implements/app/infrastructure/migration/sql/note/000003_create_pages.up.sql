@@ -0,0 +1,41 @@
+CREATE TABLE pages (
+    id CHAR(36) NOT NULL,
+    parent_id CHAR(36) NULL,
+    title VARCHAR(255) NOT NULL,
+    order_number INT NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    KEY idx_pages_parent_id_order_number (parent_id, order_number)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE page_community_relations (
+    page_id CHAR(36) NOT NULL,
+    community_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (page_id, community_id),
+    KEY idx_page_community_relations_community_id (community_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE page_from_member_relations (
+    page_id CHAR(36) NOT NULL,
+    member_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (page_id, member_id),
+    KEY idx_page_from_member_relations_member_id (member_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE page_links (
+    page_id CHAR(36) NOT NULL,
+    target_page_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (page_id, target_page_id),
+    KEY idx_page_links_target_page_id (target_page_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE note_page_relations (
+    note_id CHAR(36) NOT NULL,
+    page_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (note_id, page_id),
+    KEY idx_note_page_relations_page_id (page_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS post_to_role_relations;
+DROP TABLE IF EXISTS post_to_member_relations;
+DROP TABLE IF EXISTS post_from_member_relations;
+DROP TABLE IF EXISTS post_thread_relations;
+DROP TABLE IF EXISTS post_topic_relations;
+DROP TABLE IF EXISTS posts;
@@ -0,0 +1,47 @@
+CREATE TABLE posts (
+    id CHAR(36) NOT NULL,
+    at BIGINT NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    KEY idx_posts_created_at (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE post_topic_relations (
+    post_id CHAR(36) NOT NULL,
+    topic_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (post_id, topic_id),
+    KEY idx_post_topic_relations_topic_id (topic_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE post_thread_relations (
+    post_id CHAR(36) NOT NULL,
+    thread_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (post_id, thread_id),
+    KEY idx_post_thread_relations_thread_id (thread_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE post_from_member_relations (
+    post_id CHAR(36) NOT NULL,
+    member_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (post_id, member_id),
+    KEY idx_post_from_member_relations_member_id (member_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE post_to_member_relations (
+    post_id CHAR(36) NOT NULL,
+    member_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (post_id, member_id),
+    KEY idx_post_to_member_relations_member_id (member_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE post_to_role_relations (
+    post_id CHAR(36) NOT NULL,
+    role_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (post_id, role_id),
+    KEY idx_post_to_role_relations_role_id (role_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
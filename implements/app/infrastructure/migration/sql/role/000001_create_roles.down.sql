@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS role_community_relations;
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,15 @@
+CREATE TABLE roles (
+    id CHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE role_community_relations (
+    role_id CHAR(36) NOT NULL,
+    community_id CHAR(36) NOT NULL,
+    `default` TINYINT(1) NOT NULL DEFAULT 0,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (role_id, community_id),
+    KEY idx_role_community_relations_community_id (community_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
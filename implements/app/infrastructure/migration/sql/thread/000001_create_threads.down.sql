@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS thread_topic_relations;
+DROP TABLE IF EXISTS threads;
@@ -0,0 +1,13 @@
+CREATE TABLE threads (
+    id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE thread_topic_relations (
+    thread_id CHAR(36) NOT NULL,
+    topic_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (thread_id, topic_id),
+    KEY idx_thread_topic_relations_topic_id (topic_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
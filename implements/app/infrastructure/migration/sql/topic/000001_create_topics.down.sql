@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS topic_from_member_relations;
+DROP TABLE IF EXISTS topic_community_relations;
+DROP TABLE IF EXISTS topics;
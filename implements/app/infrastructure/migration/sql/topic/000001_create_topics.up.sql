@@ -0,0 +1,22 @@
+CREATE TABLE topics (
+    id CHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE topic_community_relations (
+    topic_id CHAR(36) NOT NULL,
+    community_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (topic_id, community_id),
+    KEY idx_topic_community_relations_community_id (community_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE topic_from_member_relations (
+    topic_id CHAR(36) NOT NULL,
+    member_id CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (topic_id, member_id),
+    KEY idx_topic_from_member_relations_member_id (member_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
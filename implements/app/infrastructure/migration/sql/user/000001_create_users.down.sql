@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_relations;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,21 @@
+CREATE TABLE users (
+    id CHAR(36) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    issuer VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    image_url TEXT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_users_subject (subject),
+    UNIQUE KEY uk_users_email (email)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE user_relations (
+    user_id CHAR(36) NOT NULL,
+    target_id CHAR(36) NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    PRIMARY KEY (user_id, target_id),
+    KEY idx_user_relations_target_id (target_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
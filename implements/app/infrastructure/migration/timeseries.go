@@ -0,0 +1,144 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go"
+	"github.com/influxdata/influxdb-client-go/api"
+	"github.com/influxdata/influxdb-client-go/domain"
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+	"github.com/samber/lo"
+)
+
+// バケットは宣言的に管理し、バージョンは持たない
+// activityStore はバケットが存在しないと初期化できないので、クライアントを直接作成する
+type timeseriesMigrator struct {
+	client    influxdb2.Client
+	org       string
+	bucket    string
+	retention time.Duration
+}
+
+// Up implements Migrator.
+func (t *timeseriesMigrator) Up(c context.Context) error {
+	org, err := t.client.OrganizationsAPI().FindOrganizationByName(c, t.org)
+	if err != nil {
+		return errors.Wrapf(err, "failed to find organization. name=%v", t.org)
+	}
+
+	bucket, err := t.find(c)
+	if err != nil {
+		return err
+	}
+
+	rules := t.retentionRules()
+	if bucket == nil {
+		if _, err := t.client.BucketsAPI().CreateBucketWithName(c, org, t.bucket, rules...); err != nil {
+			return errors.Wrapf(err, "failed to create bucket. name=%v", t.bucket)
+		}
+
+		fmt.Printf("applied. bucket=%v retention=%v \n", t.bucket, t.retention)
+		return nil
+	}
+
+	bucket.RetentionRules = rules
+	if _, err := t.client.BucketsAPI().UpdateBucket(c, bucket); err != nil {
+		return errors.Wrapf(err, "failed to update bucket. name=%v", t.bucket)
+	}
+
+	fmt.Printf("applied. bucket=%v retention=%v \n", t.bucket, t.retention)
+	return nil
+}
+
+// Down implements Migrator.
+// 蓄積したアクティビティを失わないように、バケットは削除せず保持期間の設定のみ戻す
+func (t *timeseriesMigrator) Down(c context.Context, steps int) error {
+	bucket, err := t.find(c)
+	if err != nil {
+		return err
+	}
+
+	if bucket == nil {
+		return nil
+	}
+
+	bucket.RetentionRules = domain.RetentionRules{}
+	if _, err := t.client.BucketsAPI().UpdateBucket(c, bucket); err != nil {
+		return errors.Wrapf(err, "failed to update bucket. name=%v", t.bucket)
+	}
+
+	fmt.Printf("rolled back. bucket=%v \n", t.bucket)
+	return nil
+}
+
+// Status implements Migrator.
+func (t *timeseriesMigrator) Status(c context.Context) ([]Status, error) {
+	bucket, err := t.find(c)
+	if err != nil {
+		return nil, err
+	}
+
+	status := Status{
+		Target: "timeseries/bucket",
+		Name:   t.bucket,
+	}
+
+	if bucket != nil {
+		retention := lo.SumBy(bucket.RetentionRules, func(rule domain.RetentionRule) int { return rule.EverySeconds })
+		status.Applied = retention == int(t.retention.Seconds())
+		status.Detail = fmt.Sprintf("retention=%v", time.Duration(retention)*time.Second)
+	}
+
+	return []Status{status}, nil
+}
+
+func (t *timeseriesMigrator) find(c context.Context) (*domain.Bucket, error) {
+	buckets, err := t.client.BucketsAPI().FindBucketsByOrgName(c, t.org, api.PagingWithLimit(100))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list buckets. org=%v", t.org)
+	}
+
+	bucket, ok := lo.Find(*buckets, func(bucket domain.Bucket) bool { return bucket.Name == t.bucket })
+	if !ok {
+		return nil, nil
+	}
+
+	return &bucket, nil
+}
+
+// 保持期間が0の場合は無期限に保持する
+func (t *timeseriesMigrator) retentionRules() domain.RetentionRules {
+	if t.retention <= 0 {
+		return domain.RetentionRules{}
+	}
+
+	return domain.RetentionRules{
+		{
+			EverySeconds: int(t.retention.Seconds()),
+			Type:         domain.RetentionRuleTypeExpire,
+		},
+	}
+}
+
+func NewTimeseriesMigrator(i *do.Injector) (Migrator, error) {
+	var retention time.Duration
+	if value := os.Getenv("INFLUXDB_ACTIVITY_RETENTION"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, err
+		}
+
+		retention = parsed
+	}
+
+	return &timeseriesMigrator{
+		client:    influxdb2.NewClient(os.Getenv("INFLUXDB_ACTIVITY_URL"), os.Getenv("INFLUXDB_ACTIVITY_AUTH_TOKEN")),
+		org:       os.Getenv("INFLUXDB_ACTIVITY_ORG"),
+		bucket:    os.Getenv("INFLUXDB_ACTIVITY_BUCKET"),
+		retention: retention,
+	}, nil
+}
@@ -24,6 +24,11 @@ func (u UserLoginActivity) Measurement() timeseries.Measurement {
 	return "user_login_activities"
 }
 
+// RowKeyTags は時刻と合わせて行を一意にするタグ
+func (u UserLoginActivity) RowKeyTags() []string {
+	return []string{"user_id", "ip_address", "operationg_system", "user_agent"}
+}
+
 // Tags implements timeseries.Point.
 func (u UserLoginActivity) Tags() map[string]string {
 	var result map[string]string
@@ -84,6 +89,11 @@ func (m MemberActivity) Measurement() timeseries.Measurement {
 	return "member_activities"
 }
 
+// RowKeyTags は時刻と合わせて行を一意にするタグ
+func (m MemberActivity) RowKeyTags() []string {
+	return []string{"member", "target", "resource", "operation"}
+}
+
 // Tags implements timeseries.Point.
 func (m MemberActivity) Tags() map[string]string {
 	var result map[string]string
@@ -145,6 +155,11 @@ func (m MemberLikeActivity) Measurement() timeseries.Measurement {
 	return "member_like_activities"
 }
 
+// RowKeyTags は時刻と合わせて行を一意にするタグ
+func (m MemberLikeActivity) RowKeyTags() []string {
+	return []string{"member", "target", "resource", "like", "comment"}
+}
+
 // Tags implements timeseries.Point.
 func (m MemberLikeActivity) Tags() map[string]string {
 	var result map[string]string
@@ -1,7 +1,23 @@
 package model
 
+import (
+	"database/sql"
+	"time"
+)
+
 type Community struct {
 	ID         string `gorm:"primaryKey"`
 	Name       string
 	Invitation bool
+	Visibility string `gorm:"default:unlisted"`
+	Status     string `gorm:"default:active"`
+	OwnerID    sql.NullString
+}
+
+type CommunityDeletion struct {
+	CommunityID string `gorm:"primaryKey"`
+	Step        string
+	Attempts    int
+	At          time.Time
+	LastError   sql.NullString
 }
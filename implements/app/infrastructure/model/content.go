@@ -20,3 +20,8 @@ type ContentTopicRelation struct {
 	ContentID string `gorm:"primaryKey"`
 	TopicID   string `gorm:"primaryKey"`
 }
+
+type ContentMessageRelation struct {
+	ContentID string `gorm:"primaryKey"`
+	MessageID string `gorm:"primaryKey"`
+}
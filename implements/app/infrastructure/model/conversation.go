@@ -0,0 +1,26 @@
+package model
+
+type Conversation struct {
+	ID string `gorm:"primaryKey"`
+	At int
+}
+
+type ConversationUserRelation struct {
+	ConversationID string `gorm:"primaryKey"`
+	UserID         string `gorm:"primaryKey"`
+}
+
+type Message struct {
+	ID string `gorm:"primaryKey"`
+	At int
+}
+
+type MessageConversationRelation struct {
+	MessageID      string `gorm:"primaryKey"`
+	ConversationID string `gorm:"primaryKey"`
+}
+
+type MessageFromUserRelation struct {
+	MessageID string `gorm:"primaryKey"`
+	UserID    string `gorm:"primaryKey"`
+}
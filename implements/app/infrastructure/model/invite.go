@@ -6,13 +6,29 @@ import (
 )
 
 type Invite struct {
-	ID      string `gorm:"primaryKey"`
-	RoleID  string
-	Message sql.NullString
-	At      time.Time
+	ID       string `gorm:"primaryKey"`
+	RoleID   string
+	Message  sql.NullString
+	At       time.Time
+	ExpireAt sql.NullTime
 }
 
 type InvitedUser struct {
 	InviteID string `gorm:"primaryKey"`
 	UserID   string `gorm:"primaryKey"`
 }
+
+type InvitedEmail struct {
+	InviteID string `gorm:"primaryKey"`
+	Email    string `gorm:"primaryKey"`
+}
+
+type InviteLink struct {
+	ID       string `gorm:"primaryKey"`
+	RoleID   string
+	Token    string `gorm:"uniqueIndex"`
+	At       time.Time
+	ExpireAt sql.NullTime
+	MaxUses  sql.NullInt64
+	Uses     int
+}
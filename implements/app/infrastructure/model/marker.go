@@ -0,0 +1,22 @@
+package model
+
+type ThreadReadMarker struct {
+	MemberID string `gorm:"primaryKey"`
+	ThreadID string `gorm:"primaryKey"`
+	PostID   string
+	At       int
+}
+
+type TopicReadMarker struct {
+	MemberID string `gorm:"primaryKey"`
+	TopicID  string `gorm:"primaryKey"`
+	ThreadID string
+	At       int
+}
+
+type MessageReadMarker struct {
+	ConversationID string `gorm:"primaryKey"`
+	UserID         string `gorm:"primaryKey"`
+	MessageID      string
+	At             int
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"database/sql"
+	"time"
+)
+
 type Member struct {
 	ID     string `gorm:"primaryKey"`
 	UserID string
@@ -10,3 +15,11 @@ type MemberCommunityRelation struct {
 	MemberID    string `gorm:"primaryKey"`
 	CommunityID string `gorm:"primaryKey"`
 }
+
+type Ban struct {
+	CommunityID string `gorm:"primaryKey"`
+	UserID      string `gorm:"primaryKey"`
+	Reason      sql.NullString
+	At          time.Time
+	ExpireAt    sql.NullTime
+}
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+type SchemaMigration struct {
+	Store     string `gorm:"primaryKey"`
+	Version   string `gorm:"primaryKey"`
+	Name      string
+	AppliedAt time.Time
+}
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type Note struct {
 	ID string `gorm:"primaryKey"`
 }
@@ -20,6 +22,25 @@ type Line struct {
 	Order  int `gorm:"column:order_number"`
 }
 
+// Snapshot は NoteVersionLine の配列を JSON で保持する
+type NoteVersion struct {
+	ID        string `gorm:"primaryKey"`
+	NoteID    string
+	Reason    string
+	Snapshot  []byte
+	CreatedAt time.Time
+}
+
+type NoteVersionLine struct {
+	Property *string                  `json:"property,omitempty"`
+	Contents []NoteVersionLineContent `json:"contents"`
+}
+
+type NoteVersionLineContent struct {
+	Type string `json:"type"`
+	Bin  []byte `json:"bin"`
+}
+
 type LineProperty struct {
 	LineID string `bson:"line_id"`
 	Type   string `bson:"type"`
@@ -28,3 +49,8 @@ type LineProperty struct {
 func (m LineProperty) Collection() string {
 	return "line_properties"
 }
+
+type NotePageRelation struct {
+	NoteID string `gorm:"primaryKey"`
+	PageID string `gorm:"primaryKey"`
+}
@@ -0,0 +1,23 @@
+package model
+
+type Page struct {
+	ID       string `gorm:"primaryKey"`
+	ParentID *string
+	Title    string
+	Order    int `gorm:"column:order_number"`
+}
+
+type PageCommunityRelation struct {
+	PageID      string `gorm:"primaryKey"`
+	CommunityID string `gorm:"primaryKey"`
+}
+
+type PageFromMemberRelation struct {
+	PageID   string `gorm:"primaryKey"`
+	MemberID string `gorm:"primaryKey"`
+}
+
+type PageLink struct {
+	PageID       string `gorm:"primaryKey"`
+	TargetPageID string `gorm:"primaryKey"`
+}
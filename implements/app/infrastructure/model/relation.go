@@ -0,0 +1,7 @@
+package model
+
+type UserRelation struct {
+	UserID   string `gorm:"primaryKey"`
+	TargetID string `gorm:"primaryKey"`
+	Type     string
+}
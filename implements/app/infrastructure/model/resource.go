@@ -1,11 +1,29 @@
 package model
 
+import "time"
+
 type ResourceSearchIndex struct {
-	ResourceID string `json:"resource_id"`
-	Type       string `json:"type"`
-	Keyword    string `json:"keyword"`
+	ResourceID  string  `json:"resource_id"`
+	Type        string  `json:"type"`
+	Keyword     string  `json:"keyword"`
+	CommunityID *string `json:"community_id,omitempty"`
+	TopicID     *string `json:"topic_id,omitempty"`
+	ThreadID    *string `json:"thread_id,omitempty"`
+	AuthorID    *string `json:"author_id,omitempty"`
+	Body        string  `json:"body,omitempty"`
 }
 
+// Index はエイリアスの名前で、検索と書き込みはエイリアス経由で行う
 func (r ResourceSearchIndex) Index() string {
 	return "resource_search_indexes"
 }
+
+// RebuildingIndex は作り直している間だけ新しいインデックスに付けるエイリアスの名前
+func (r ResourceSearchIndex) RebuildingIndex() string {
+	return r.Index() + "_rebuilding"
+}
+
+// VersionedIndex はエイリアスが指す実体のインデックスの名前
+func (r ResourceSearchIndex) VersionedIndex(at time.Time) string {
+	return r.Index() + "-" + at.UTC().Format("20060102150405")
+}
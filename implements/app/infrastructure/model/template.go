@@ -0,0 +1,27 @@
+package model
+
+// Fields は TemplateField の配列を、Body は NoteVersionLine の配列を JSON で保持する
+type Template struct {
+	ID     string `gorm:"primaryKey"`
+	Name   string
+	Kind   string
+	Title  *string
+	Fields []byte
+	Body   []byte
+}
+
+type TemplateField struct {
+	Name     string  `json:"name"`
+	Required bool    `json:"required"`
+	Default  *string `json:"default,omitempty"`
+}
+
+type TemplateCommunityRelation struct {
+	TemplateID  string `gorm:"primaryKey"`
+	CommunityID string `gorm:"primaryKey"`
+}
+
+type TemplateFromMemberRelation struct {
+	TemplateID string `gorm:"primaryKey"`
+	MemberID   string `gorm:"primaryKey"`
+}
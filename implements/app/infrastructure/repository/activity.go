@@ -31,10 +31,7 @@ func (a *activityRepository) ListRecentMemberActivity(c context.Context, memberI
 			Ope:   timeseries.EQ,
 			Value: memberID.String(),
 		},
-	}, nil, &timeseries.RowRange{
-		Limit:  page.Limit,
-		Offset: page.Offset,
-	}, true)
+	}, nil, rowRange(page, "member", imodel.MemberActivity{}.RowKeyTags()), true)
 
 	activities := []imodel.MemberActivity{}
 	if err := a.activityStoreTS.Find(c, option, &activities); err != nil {
@@ -55,21 +52,18 @@ func (a *activityRepository) ListRecentMemberActivity(c context.Context, memberI
 }
 
 // ListMembersLikeActivity implements repository.ActivityRepository.
-func (a *activityRepository) ListMembersLikeActivity(c context.Context, memberIDs []uuid.UUID, page dmodel.Range) ([]dmodel.MemberLikeActivity, error) {
+func (a *activityRepository) ListMembersLikeActivity(c context.Context, memberIDs []uuid.UUID, page dmodel.Range) ([]dmodel.MemberLikeActivity, *dmodel.Cursor, error) {
 	option := timeseries.NewQueryOption(imodel.MemberLikeActivity{}.Measurement(), []timeseries.QueryCondition{
 		{
 			Key:   "member",
 			Ope:   timeseries.Contains,
 			Value: memberIDs,
 		},
-	}, nil, &timeseries.RowRange{
-		Limit:  page.Limit,
-		Offset: page.Offset,
-	}, false)
+	}, nil, rowRange(page, "member", imodel.MemberLikeActivity{}.RowKeyTags()), false)
 
 	activities := []imodel.MemberLikeActivity{}
 	if err := a.activityStoreTS.Find(c, option, &activities); err != nil {
-		return nil, errors.Wrapf(err, "failed to list member like activity. ids=%v", memberIDs)
+		return nil, nil, errors.Wrapf(err, "failed to list member like activity. ids=%v", memberIDs)
 	}
 
 	dActivities := []dmodel.MemberLikeActivity{}
@@ -83,79 +77,109 @@ func (a *activityRepository) ListMembersLikeActivity(c context.Context, memberID
 
 		dActivity, err := dfactory.NewMemberLikeActivity(activity.At, activity.Member, activity.Target, activity.Resource, activity.Like.Bool(), comment)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		dActivities = append(dActivities, *dActivity)
 	}
 
-	return dActivities, nil
+	if len(activities) == 0 {
+		return dActivities, nil, nil
+	}
+
+	last := activities[len(activities)-1]
+	next, err := nextCursor(page, len(activities), last.At, last.Member)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. member=%v", last.Member)
+	} else if next != nil {
+		next.Key = timeseries.RowKey(last, last.RowKeyTags())
+	}
+
+	return dActivities, next, nil
 }
 
 // ListMembersActivity implements repository.ActivityRepository.
-func (a *activityRepository) ListMembersActivity(c context.Context, memberIDs []uuid.UUID, page dmodel.Range) ([]dmodel.MemberActivity, error) {
+func (a *activityRepository) ListMembersActivity(c context.Context, memberIDs []uuid.UUID, page dmodel.Range) ([]dmodel.MemberActivity, *dmodel.Cursor, error) {
 	option := timeseries.NewQueryOption(imodel.MemberActivity{}.Measurement(), []timeseries.QueryCondition{
 		{
 			Key:   "member",
 			Ope:   timeseries.Contains,
 			Value: memberIDs,
 		},
-	}, nil, &timeseries.RowRange{
-		Limit:  page.Limit,
-		Offset: page.Offset,
-	}, false)
+	}, nil, rowRange(page, "member", imodel.MemberActivity{}.RowKeyTags()), false)
 
 	activities := []imodel.MemberActivity{}
 	if err := a.activityStoreTS.Find(c, option, &activities); err != nil {
-		return nil, errors.Wrapf(err, "failed to list member activity. ids=%v", memberIDs)
+		return nil, nil, errors.Wrapf(err, "failed to list member activity. ids=%v", memberIDs)
 	}
 
 	dActivities := []dmodel.MemberActivity{}
 	for _, activity := range activities {
 		dActivity, err := dfactory.NewMemberActivity(activity.At, activity.Member, activity.Target, activity.Resource, activity.Operation)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		dActivities = append(dActivities, *dActivity)
 	}
 
-	return dActivities, nil
+	if len(activities) == 0 {
+		return dActivities, nil, nil
+	}
+
+	last := activities[len(activities)-1]
+	next, err := nextCursor(page, len(activities), last.At, last.Member)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. member=%v", last.Member)
+	} else if next != nil {
+		next.Key = timeseries.RowKey(last, last.RowKeyTags())
+	}
+
+	return dActivities, next, nil
 }
 
 // ListUserLoginActivity implements repository.ActivityRepository.
-func (a *activityRepository) ListUserLoginActivity(c context.Context, userID uuid.UUID, page dmodel.Range) ([]dmodel.UserLoginActivity, error) {
+func (a *activityRepository) ListUserLoginActivity(c context.Context, userID uuid.UUID, page dmodel.Range) ([]dmodel.UserLoginActivity, *dmodel.Cursor, error) {
 	option := timeseries.NewQueryOption(imodel.UserLoginActivity{}.Measurement(), []timeseries.QueryCondition{
 		{
 			Key:   "user_id",
 			Ope:   timeseries.EQ,
 			Value: userID.String(),
 		},
-	}, nil, &timeseries.RowRange{
-		Limit:  page.Limit,
-		Offset: page.Offset,
-	}, false)
+	}, nil, rowRange(page, "user_id", imodel.UserLoginActivity{}.RowKeyTags()), false)
 
 	activities := []imodel.UserLoginActivity{}
 	if err := a.activityStoreTS.Find(c, option, &activities); err != nil {
-		return nil, errors.Wrapf(err, "failed to list user login activity. id=%v", userID.String())
+		return nil, nil, errors.Wrapf(err, "failed to list user login activity. id=%v", userID.String())
 	}
 
 	dActivities := []dmodel.UserLoginActivity{}
 	for _, activity := range activities {
 		dActivity, err := dfactory.NewUserLoginActivity(activity.At, activity.UserID, activity.IPAddress, activity.OperationgSystem, activity.UserAgent)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		dActivities = append(dActivities, *dActivity)
 	}
 
-	return dActivities, nil
+	if len(activities) == 0 {
+		return dActivities, nil, nil
+	}
+
+	last := activities[len(activities)-1]
+	next, err := nextCursor(page, len(activities), last.At, last.UserID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. user_id=%v", last.UserID)
+	} else if next != nil {
+		next.Key = timeseries.RowKey(last, last.RowKeyTags())
+	}
+
+	return dActivities, next, nil
 }
 
 // ListMemberLikeActivity implements repository.ActivityRepository.
-func (a *activityRepository) ListMemberLikeActivity(c context.Context, target dmodel.Mention, like bool, page dmodel.Range) ([]dmodel.MemberLikeActivity, error) {
+func (a *activityRepository) ListMemberLikeActivity(c context.Context, target dmodel.Mention, like bool, page dmodel.Range) ([]dmodel.MemberLikeActivity, *dmodel.Cursor, error) {
 	option := timeseries.NewQueryOption(imodel.MemberLikeActivity{}.Measurement(), []timeseries.QueryCondition{
 		{
 			Key:   "resource",
@@ -172,14 +196,11 @@ func (a *activityRepository) ListMemberLikeActivity(c context.Context, target dm
 			Ope:   timeseries.EQ,
 			Value: timeseries.NewBoolean(like),
 		},
-	}, nil, &timeseries.RowRange{
-		Limit:  page.Limit,
-		Offset: page.Offset,
-	}, false)
+	}, nil, rowRange(page, "member", imodel.MemberLikeActivity{}.RowKeyTags()), false)
 
 	activities := []imodel.MemberLikeActivity{}
 	if err := a.activityStoreTS.Find(c, option, &activities); err != nil {
-		return nil, errors.Wrapf(err, "failed to list member like activity. resource=%v target=%v like=%v", target.Resource.String(), target.ID.String(), like)
+		return nil, nil, errors.Wrapf(err, "failed to list member like activity. resource=%v target=%v like=%v", target.Resource.String(), target.ID.String(), like)
 	}
 
 	dActivities := []dmodel.MemberLikeActivity{}
@@ -193,13 +214,25 @@ func (a *activityRepository) ListMemberLikeActivity(c context.Context, target dm
 
 		dActivity, err := dfactory.NewMemberLikeActivity(activity.At, activity.Member, activity.Target, activity.Resource, activity.Like.Bool(), comment)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		dActivities = append(dActivities, *dActivity)
 	}
 
-	return dActivities, nil
+	if len(activities) == 0 {
+		return dActivities, nil, nil
+	}
+
+	last := activities[len(activities)-1]
+	next, err := nextCursor(page, len(activities), last.At, last.Member)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. member=%v", last.Member)
+	} else if next != nil {
+		next.Key = timeseries.RowKey(last, last.RowKeyTags())
+	}
+
+	return dActivities, next, nil
 }
 
 // CountMemberLikeActivity implements repository.ActivityRepository.
@@ -230,6 +263,43 @@ func (a *activityRepository) CountMemberLikeActivity(c context.Context, mention
 	return &result, nil
 }
 
+// CountMemberLikeActivityByTargets implements repository.ActivityRepository.
+func (a *activityRepository) CountMemberLikeActivityByTargets(c context.Context, targets []dmodel.Mention, like bool) (map[uuid.UUID]int, error) {
+	if len(targets) == 0 {
+		return map[uuid.UUID]int{}, nil
+	}
+
+	option := timeseries.NewQueryOption(imodel.MemberLikeActivity{}.Measurement(), []timeseries.QueryCondition{
+		{
+			Key:   "resource",
+			Ope:   timeseries.Contains,
+			Value: lo.Uniq(lo.Map(targets, func(target dmodel.Mention, _ int) string { return target.Resource.String() })),
+		},
+		{
+			Key:   "target",
+			Ope:   timeseries.Contains,
+			Value: lo.Map(targets, func(target dmodel.Mention, _ int) string { return target.ID.String() }),
+		},
+		{
+			Key:   "like",
+			Ope:   timeseries.EQ,
+			Value: timeseries.NewBoolean(like),
+		},
+	}, nil, nil, false)
+
+	result, err := a.activityStoreTS.CountBy(c, option, "target")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to count member like activity. targets=%v like=%v", targets, like)
+	}
+
+	counts := map[uuid.UUID]int{}
+	for _, target := range targets {
+		counts[target.ID] = result[target.ID.String()]
+	}
+
+	return counts, nil
+}
+
 // SaveMemberLikeActivity implements repository.ActivityRepository.
 func (a *activityRepository) SaveMemberLikeActivity(c context.Context, activity dmodel.MemberLikeActivity) error {
 	var comment *pubsub.Text
@@ -274,6 +344,51 @@ func (a *activityRepository) SaveUserLoginActivity(c context.Context, activity d
 		})
 }
 
+// 同時刻の行はKey列で並べ、Key列も同じ行は行を一意にするタグで並べる
+// カーソル指定時はその続きから取得する
+func rowRange(page dmodel.Range, key string, tags []string) *timeseries.RowRange {
+	var after *timeseries.Cursor
+	if page.Cursor != nil {
+		after = &timeseries.Cursor{
+			At:     page.Cursor.At,
+			Value:  page.Cursor.ID.String(),
+			RowKey: page.Cursor.Key,
+		}
+	}
+
+	return &timeseries.RowRange{
+		Limit:  page.Limit,
+		Offset: page.Offset,
+		Key:    key,
+		Tags:   tags,
+		After:  after,
+	}
+}
+
+// DeleteMemberActivities implements repository.ActivityRepository.
+func (a *activityRepository) DeleteMemberActivities(c context.Context, memberIDs []uuid.UUID) error {
+	for _, memberID := range memberIDs {
+		for _, measurement := range []timeseries.Measurement{
+			imodel.MemberActivity{}.Measurement(),
+			imodel.MemberLikeActivity{}.Measurement(),
+		} {
+			option := timeseries.NewDeleteOption(measurement, []timeseries.QueryCondition{
+				{
+					Key:   "member",
+					Ope:   timeseries.EQ,
+					Value: memberID.String(),
+				},
+			}, nil)
+
+			if err := a.activityStoreTS.Delete(c, option); err != nil {
+				return errors.Wrapf(err, "failed to delete member activity. member=%v measurement=%v", memberID.String(), measurement)
+			}
+		}
+	}
+
+	return nil
+}
+
 func NewActivityRepository(i *do.Injector) (drepository.ActivityRepository, error) {
 	activityStoreMQ := do.MustInvoke[mq.ActivityStoreConnection](i)
 	activityStoreTS := do.MustInvoke[timeseries.TimeseriesStore](i)
@@ -287,28 +402,33 @@ type activityRepositoryForAsync struct {
 	activityStore timeseries.TimeseriesStore
 }
 
+// DeleteMemberActivities implements repository.ActivityRepository.
+func (a *activityRepositoryForAsync) DeleteMemberActivities(c context.Context, memberIDs []uuid.UUID) error {
+	panic("unimplemented")
+}
+
 // ListRecentMemberActivity implements repository.ActivityRepository.
 func (a *activityRepositoryForAsync) ListRecentMemberActivity(c context.Context, memberID uuid.UUID, page dmodel.Range) ([]dmodel.MemberActivity, error) {
 	panic("unimplemented")
 }
 
 // ListMembersLikeActivity implements repository.ActivityRepository.
-func (a *activityRepositoryForAsync) ListMembersLikeActivity(c context.Context, memberIDs []uuid.UUID, page dmodel.Range) ([]dmodel.MemberLikeActivity, error) {
+func (a *activityRepositoryForAsync) ListMembersLikeActivity(c context.Context, memberIDs []uuid.UUID, page dmodel.Range) ([]dmodel.MemberLikeActivity, *dmodel.Cursor, error) {
 	panic("unimplemented")
 }
 
 // ListMembersActivity implements repository.ActivityRepository.
-func (a *activityRepositoryForAsync) ListMembersActivity(c context.Context, memberIDs []uuid.UUID, page dmodel.Range) ([]dmodel.MemberActivity, error) {
+func (a *activityRepositoryForAsync) ListMembersActivity(c context.Context, memberIDs []uuid.UUID, page dmodel.Range) ([]dmodel.MemberActivity, *dmodel.Cursor, error) {
 	panic("unimplemented")
 }
 
 // ListUserLoginActivity implements repository.ActivityRepository.
-func (a *activityRepositoryForAsync) ListUserLoginActivity(c context.Context, userID uuid.UUID, page dmodel.Range) ([]dmodel.UserLoginActivity, error) {
+func (a *activityRepositoryForAsync) ListUserLoginActivity(c context.Context, userID uuid.UUID, page dmodel.Range) ([]dmodel.UserLoginActivity, *dmodel.Cursor, error) {
 	panic("unimplemented")
 }
 
 // ListMemberLikeActivity implements repository.ActivityRepository.
-func (a *activityRepositoryForAsync) ListMemberLikeActivity(c context.Context, mention dmodel.Mention, like bool, page dmodel.Range) ([]dmodel.MemberLikeActivity, error) {
+func (a *activityRepositoryForAsync) ListMemberLikeActivity(c context.Context, mention dmodel.Mention, like bool, page dmodel.Range) ([]dmodel.MemberLikeActivity, *dmodel.Cursor, error) {
 	panic("unimplemented")
 }
 
@@ -317,6 +437,11 @@ func (a *activityRepositoryForAsync) CountMemberLikeActivity(c context.Context,
 	panic("unimplemented")
 }
 
+// CountMemberLikeActivityByTargets implements repository.ActivityRepository.
+func (a *activityRepositoryForAsync) CountMemberLikeActivityByTargets(c context.Context, targets []dmodel.Mention, like bool) (map[uuid.UUID]int, error) {
+	panic("unimplemented")
+}
+
 // SaveMemberLikeActivity implements repository.ActivityRepository.
 func (a *activityRepositoryForAsync) SaveMemberLikeActivity(c context.Context, activity dmodel.MemberLikeActivity) error {
 
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	ikvs "app/infrastructure/adapter/datastore/kvs"
+	llog "app/lib/log"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	cacheKeyUser          = "cache_user_%v"
+	cacheKeyRole          = "cache_role_%v"
+	cacheKeyCommunityRole = "cache_community_role_%v"
+)
+
+// キャッシュの読み書きに失敗した場合は元のストアへフォールバックする
+func getCache[T any](c context.Context, cache ikvs.CacheStoreConnection, key string) (*T, bool) {
+	bin, err := cache.Client().Get(c, key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			llog.Warn(c, "failed to get cache. key=%v err=%v", key, err)
+		}
+
+		return nil, false
+	}
+
+	var v T
+	if err := json.Unmarshal(bin, &v); err != nil {
+		llog.Warn(c, "failed to unmarshal cache. key=%v err=%v", key, err)
+		return nil, false
+	}
+
+	return &v, true
+}
+
+func listCache[T any](c context.Context, cache ikvs.CacheStoreConnection, keys []string) map[string]T {
+	result := map[string]T{}
+	if len(keys) == 0 {
+		return result
+	}
+
+	values, err := cache.Client().MGet(c, keys...).Result()
+	if err != nil {
+		llog.Warn(c, "failed to list cache. keys=%v err=%v", keys, err)
+		return result
+	}
+
+	for i, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		var v T
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			llog.Warn(c, "failed to unmarshal cache. key=%v err=%v", keys[i], err)
+			continue
+		}
+
+		result[keys[i]] = v
+	}
+
+	return result
+}
+
+func setCache(c context.Context, cache ikvs.CacheStoreConnection, key string, v any) {
+	bin, err := json.Marshal(v)
+	if err != nil {
+		llog.Warn(c, "failed to marshal cache. key=%v err=%v", key, err)
+		return
+	}
+
+	if err := cache.Client().Set(c, key, bin, cache.TTL()).Err(); err != nil {
+		llog.Warn(c, "failed to set cache. key=%v err=%v", key, err)
+	}
+}
+
+func deleteCache(c context.Context, cache ikvs.CacheStoreConnection, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+
+	if err := cache.Client().Del(c, keys...).Err(); err != nil {
+		llog.Warn(c, "failed to delete cache. keys=%v err=%v", keys, err)
+	}
+}
+
+func cacheKey(format string, id fmt.Stringer) string {
+	return fmt.Sprintf(format, id.String())
+}
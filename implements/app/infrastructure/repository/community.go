@@ -7,26 +7,29 @@ import (
 	irdb "app/infrastructure/adapter/datastore/rdb"
 	imodel "app/infrastructure/model"
 	"context"
+	"database/sql"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 
 	"github.com/samber/do"
 	"gorm.io/gorm"
 )
 
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 type communityRepository struct {
 	communityStoreConnection irdb.CommunityStoreConnection
 }
 
 // Update implements repository.CommunityRepository.
 func (co *communityRepository) Update(c context.Context, community dmodel.Community) error {
-	return co.communityStoreConnection.Write().Transaction(func(tx *gorm.DB) error {
+	return co.communityStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
-			Save(&imodel.Community{
-				ID:   community.ID.String(),
-				Name: community.Name.String(),
-			}).Error; err != nil {
+			Save(toICommunity(community)).Error; err != nil {
 			return errors.Wrapf(err, "failed to update community. id=%v", community.ID.String())
 		}
 
@@ -39,14 +42,93 @@ func (co *communityRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Com
 	return co.get(c, id.String())
 }
 
+// List implements repository.CommunityRepository.
+func (co *communityRepository) List(c context.Context, ids []uuid.UUID) ([]dmodel.Community, error) {
+	parsedIDs := lo.Map(ids, func(id uuid.UUID, _ int) string { return id.String() })
+
+	communities := []imodel.Community{}
+	if err := co.communityStoreConnection.Read().WithContext(c).
+		Where("id in ?", parsedIDs).
+		Find(&communities).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list community. ids=%v", parsedIDs)
+	}
+
+	dCommunities := []dmodel.Community{}
+	for _, community := range communities {
+		dCommunity, err := toDCommunity(community)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse community. id=%v", community.ID)
+		}
+
+		dCommunities = append(dCommunities, *dCommunity)
+	}
+
+	return dCommunities, nil
+}
+
+// ListPublic implements repository.CommunityRepository.
+func (co *communityRepository) ListPublic(c context.Context, name *string, page dmodel.Range) ([]dmodel.Community, *dmodel.Cursor, error) {
+	query := co.communityStoreConnection.Read().WithContext(c).
+		Model(&imodel.Community{}).
+		Select("communities.id as id, communities.name as name, communities.invitation as invitation, communities.visibility as visibility, communities.status as status, communities.owner_id as owner_id, communities.created_at as created_at").
+		Where("communities.visibility = ?", dmodel.CommunityVisibilityPublic.String()).
+		Where("communities.status = ?", dmodel.CommunityStatusActive.String())
+	if name != nil {
+		// 部分一致で検索するため、ワイルドカード文字はエスケープする
+		query = query.Where("communities.name like ?", "%"+likeEscaper.Replace(*name)+"%")
+	}
+
+	communities := []struct {
+		imodel.Community
+		CreatedAt time.Time
+	}{}
+	if err := paginate(query, page, "communities.created_at", "communities.id").
+		Scan(&communities).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list public community. name=%v", lo.FromPtr(name))
+	}
+
+	dCommunities := []dmodel.Community{}
+	for _, community := range communities {
+		dCommunity, err := toDCommunity(community.Community)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse community. id=%v", community.ID)
+		}
+
+		dCommunities = append(dCommunities, *dCommunity)
+	}
+
+	if len(communities) == 0 {
+		return dCommunities, nil, nil
+	}
+
+	last := communities[len(communities)-1]
+	next, err := nextCursor(page, len(communities), last.CreatedAt, last.ID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. id=%v", last.ID)
+	}
+
+	return dCommunities, next, nil
+}
+
+// Delete implements repository.CommunityRepository.
+func (co *communityRepository) Delete(c context.Context, id uuid.UUID) error {
+	return co.communityStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Delete(&imodel.Community{
+				ID: id.String(),
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete community. id=%v", id.String())
+		}
+
+		return nil
+	})
+}
+
 // Create implements repository.CommunityRepository.
 func (co *communityRepository) Create(c context.Context, community dmodel.Community) error {
-	return co.communityStoreConnection.Write().Transaction(func(tx *gorm.DB) error {
+	return co.communityStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
-			Create(&imodel.Community{
-				ID:   community.ID.String(),
-				Name: community.Name.String(),
-			}).Error; err != nil {
+			Create(toICommunity(community)).Error; err != nil {
 			return errors.Wrapf(err, "failed to create community. id=%v", community.ID.String())
 		}
 
@@ -54,9 +136,9 @@ func (co *communityRepository) Create(c context.Context, community dmodel.Commun
 	})
 }
 
-func (co *communityRepository) get(_ context.Context, id string) (*dmodel.Community, error) {
+func (co *communityRepository) get(c context.Context, id string) (*dmodel.Community, error) {
 	community := imodel.Community{ID: id}
-	if err := co.communityStoreConnection.Read().
+	if err := co.communityStoreConnection.Read().WithContext(c).
 		First(&community).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -65,7 +147,32 @@ func (co *communityRepository) get(_ context.Context, id string) (*dmodel.Commun
 		return nil, errors.Wrapf(err, "failed to get community. id=%v", id)
 	}
 
-	return dfactory.NewCommunity(community.ID, community.Name, community.Invitation)
+	return toDCommunity(community)
+}
+
+func toICommunity(community dmodel.Community) *imodel.Community {
+	var ownerID sql.NullString
+	if community.OwnerID != nil {
+		ownerID = sql.NullString{String: community.OwnerID.String(), Valid: true}
+	}
+
+	return &imodel.Community{
+		ID:         community.ID.String(),
+		Name:       community.Name.String(),
+		Invitation: community.Invitation,
+		Visibility: community.Visibility.String(),
+		Status:     community.Status.String(),
+		OwnerID:    ownerID,
+	}
+}
+
+func toDCommunity(community imodel.Community) (*dmodel.Community, error) {
+	var ownerID *string
+	if community.OwnerID.Valid {
+		ownerID = &community.OwnerID.String
+	}
+
+	return dfactory.NewCommunity(community.ID, community.Name, community.Invitation, community.Visibility, community.Status, ownerID)
 }
 
 func NewCommunityRepository(i *do.Injector) (drepository.CommunityRepository, error) {
@@ -74,3 +181,77 @@ func NewCommunityRepository(i *do.Injector) (drepository.CommunityRepository, er
 		communityStoreConnection: communityStoreConnection,
 	}, nil
 }
+
+type communityDeletionRepository struct {
+	communityStoreConnection irdb.CommunityStoreConnection
+}
+
+// Save implements repository.CommunityDeletionRepository.
+func (co *communityDeletionRepository) Save(c context.Context, deletion dmodel.CommunityDeletion) error {
+	var lastError sql.NullString
+	if deletion.LastError != nil {
+		lastError = sql.NullString{String: *deletion.LastError, Valid: true}
+	}
+
+	return co.communityStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Save(&imodel.CommunityDeletion{
+				CommunityID: deletion.CommunityID.String(),
+				Step:        deletion.Step.String(),
+				Attempts:    deletion.Attempts,
+				At:          deletion.At,
+				LastError:   lastError,
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to save community deletion. community_id=%v", deletion.CommunityID.String())
+		}
+
+		return nil
+	})
+}
+
+// ListPending implements repository.CommunityDeletionRepository.
+func (co *communityDeletionRepository) ListPending(c context.Context, limit int) ([]dmodel.CommunityDeletion, error) {
+	deletions := []imodel.CommunityDeletion{}
+	if err := co.communityStoreConnection.Read().WithContext(c).
+		Order("at asc, community_id asc").
+		Limit(limit).
+		Find(&deletions).Error; err != nil {
+		return nil, errors.Wrap(err, "failed to list community deletion")
+	}
+
+	dDeletions := []dmodel.CommunityDeletion{}
+	for _, deletion := range deletions {
+		var lastError *string
+		if deletion.LastError.Valid {
+			lastError = &deletion.LastError.String
+		}
+
+		dDeletion, err := dfactory.NewCommunityDeletion(deletion.CommunityID, deletion.Step, deletion.Attempts, deletion.At, lastError)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse community deletion. community_id=%v", deletion.CommunityID)
+		}
+
+		dDeletions = append(dDeletions, *dDeletion)
+	}
+
+	return dDeletions, nil
+}
+
+// Delete implements repository.CommunityDeletionRepository.
+func (co *communityDeletionRepository) Delete(c context.Context, communityID uuid.UUID) error {
+	if err := co.communityStoreConnection.Write().WithContext(c).
+		Delete(&imodel.CommunityDeletion{
+			CommunityID: communityID.String(),
+		}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete community deletion. community_id=%v", communityID.String())
+	}
+
+	return nil
+}
+
+func NewCommunityDeletionRepository(i *do.Injector) (drepository.CommunityDeletionRepository, error) {
+	communityStoreConnection := do.MustInvoke[irdb.CommunityStoreConnection](i)
+	return &communityDeletionRepository{
+		communityStoreConnection: communityStoreConnection,
+	}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/samber/do"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 
 	irdb "app/infrastructure/adapter/datastore/rdb"
@@ -21,7 +22,7 @@ type contentRepository struct {
 
 // Create implements repository.ContentRepository.
 func (co *contentRepository) Create(c context.Context, contents []dmodel.Content, mention dmodel.Mention) error {
-	return co.contentStoreConnection.Write().Transaction(func(tx *gorm.DB) error {
+	return co.contentStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		for _, newContent := range contents {
 			if err := tx.
 				Create(&imodel.Content{
@@ -57,6 +58,14 @@ func (co *contentRepository) Create(c context.Context, contents []dmodel.Content
 					}).Error; err != nil {
 					return errors.Wrapf(err, "failed to create post relation. id=%v", mention.ID.String())
 				}
+			case dmodel.ResourceMessage:
+				if err := tx.
+					Create(&imodel.ContentMessageRelation{
+						ContentID: newContent.ID.String(),
+						MessageID: mention.ID.String(),
+					}).Error; err != nil {
+					return errors.Wrapf(err, "failed to create message relation. id=%v", mention.ID.String())
+				}
 			}
 		}
 
@@ -70,7 +79,7 @@ func (co *contentRepository) DeleteByResource(c context.Context, mention dmodel.
 
 	switch mention.Resource {
 	case dmodel.ResourceLine:
-		if err := co.contentStoreConnection.Write().
+		if err := co.contentStoreConnection.Write().WithContext(c).
 			Model(&imodel.Content{}).
 			Select("contents.id as id, contents.type as type, contents.bin as bin").
 			Joins("inner join content_line_relations on contents.id = content_line_relations.content_id").
@@ -80,7 +89,7 @@ func (co *contentRepository) DeleteByResource(c context.Context, mention dmodel.
 			return errors.Wrapf(err, "failed to list content. line_id=%v", mention.ID.String())
 		}
 	case dmodel.ResourceTopic:
-		if err := co.contentStoreConnection.Write().
+		if err := co.contentStoreConnection.Write().WithContext(c).
 			Model(&imodel.Content{}).
 			Select("contents.id as id, contents.type as type, contents.bin as bin").
 			Joins("inner join content_topic_relations on contents.id = content_topic_relations.content_id").
@@ -90,7 +99,7 @@ func (co *contentRepository) DeleteByResource(c context.Context, mention dmodel.
 			return errors.Wrapf(err, "failed to list content. topic_id=%v", mention.ID.String())
 		}
 	case dmodel.ResourcePost:
-		if err := co.contentStoreConnection.Write().
+		if err := co.contentStoreConnection.Write().WithContext(c).
 			Model(&imodel.Content{}).
 			Select("contents.id as id, contents.type as type, contents.bin as bin").
 			Joins("inner join content_post_relations on contents.id = content_post_relations.content_id").
@@ -99,16 +108,30 @@ func (co *contentRepository) DeleteByResource(c context.Context, mention dmodel.
 			Scan(&contents).Error; err != nil {
 			return errors.Wrapf(err, "failed to list content. post_id=%v", mention.ID.String())
 		}
+	case dmodel.ResourceMessage:
+		if err := co.contentStoreConnection.Write().WithContext(c).
+			Model(&imodel.Content{}).
+			Select("contents.id as id, contents.type as type, contents.bin as bin").
+			Joins("inner join content_message_relations on contents.id = content_message_relations.content_id").
+			Where("content_message_relations.message_id = ?", mention.ID.String()).
+			Order("contents.created_at asc").
+			Scan(&contents).Error; err != nil {
+			return errors.Wrapf(err, "failed to list content. message_id=%v", mention.ID.String())
+		}
+	}
+
+	if len(contents) == 0 {
+		return nil
 	}
 
-	return co.contentStoreConnection.Write().
+	return co.contentStoreConnection.Write().WithContext(c).
 		Delete(&contents).Error
 }
 
 // ListByLine implements repository.ContentRepository.
 func (co *contentRepository) ListByLine(c context.Context, lineID uuid.UUID) ([]dmodel.Content, error) {
 	contents := []imodel.Content{}
-	if err := co.contentStoreConnection.Read().
+	if err := co.contentStoreConnection.Read().WithContext(c).
 		Model(&imodel.Content{}).
 		Select("contents.id as id, contents.type as type, contents.bin as bin").
 		Joins("inner join content_line_relations on contents.id = content_line_relations.content_id").
@@ -134,7 +157,7 @@ func (co *contentRepository) ListByLine(c context.Context, lineID uuid.UUID) ([]
 // ListByPost implements repository.ContentRepository.
 func (co *contentRepository) ListByPost(c context.Context, postID uuid.UUID) ([]dmodel.Content, error) {
 	contents := []imodel.Content{}
-	if err := co.contentStoreConnection.Read().
+	if err := co.contentStoreConnection.Read().WithContext(c).
 		Model(&imodel.Content{}).
 		Select("contents.id as id, contents.type as type, contents.bin as bin").
 		Joins("inner join content_post_relations on contents.id = content_post_relations.content_id").
@@ -160,7 +183,7 @@ func (co *contentRepository) ListByPost(c context.Context, postID uuid.UUID) ([]
 // ListByTopic implements repository.ContentRepository.
 func (co *contentRepository) ListByTopic(c context.Context, topicID uuid.UUID) ([]dmodel.Content, error) {
 	contents := []imodel.Content{}
-	if err := co.contentStoreConnection.Read().
+	if err := co.contentStoreConnection.Read().WithContext(c).
 		Model(&imodel.Content{}).
 		Select("contents.id as id, contents.type as type, contents.bin as bin").
 		Joins("inner join content_topic_relations on contents.id = content_topic_relations.content_id").
@@ -183,9 +206,111 @@ func (co *contentRepository) ListByTopic(c context.Context, topicID uuid.UUID) (
 	return dContents, nil
 }
 
+// ListByTopics implements repository.ContentRepository.
+func (co *contentRepository) ListByTopics(c context.Context, topicIDs []uuid.UUID) (map[uuid.UUID][]dmodel.Content, error) {
+	contents := []struct {
+		imodel.Content
+		TopicID string
+	}{}
+	if err := co.contentStoreConnection.Read().WithContext(c).
+		Model(&imodel.Content{}).
+		Select("contents.id as id, contents.type as type, contents.bin as bin, content_topic_relations.topic_id as topic_id").
+		Joins("inner join content_topic_relations on contents.id = content_topic_relations.content_id").
+		Where("content_topic_relations.topic_id in ?", lo.Map(topicIDs, func(id uuid.UUID, _ int) string { return id.String() })).
+		Order("contents.created_at asc").
+		Scan(&contents).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list content. topic_ids=%v", topicIDs)
+	}
+
+	dContents := map[uuid.UUID][]dmodel.Content{}
+	for _, content := range contents {
+		topicID, err := uuid.Parse(content.TopicID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse topic id. id=%v", content.TopicID)
+		}
+
+		dContent, err := dfactory.NewContent(content.ID, content.Type, content.Bin)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse content. id=%v", content.ID)
+		}
+
+		dContents[topicID] = append(dContents[topicID], *dContent)
+	}
+
+	return dContents, nil
+}
+
+// ListByPosts implements repository.ContentRepository.
+func (co *contentRepository) ListByPosts(c context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]dmodel.Content, error) {
+	contents := []struct {
+		imodel.Content
+		PostID string
+	}{}
+	if err := co.contentStoreConnection.Read().WithContext(c).
+		Model(&imodel.Content{}).
+		Select("contents.id as id, contents.type as type, contents.bin as bin, content_post_relations.post_id as post_id").
+		Joins("inner join content_post_relations on contents.id = content_post_relations.content_id").
+		Where("content_post_relations.post_id in ?", lo.Map(postIDs, func(id uuid.UUID, _ int) string { return id.String() })).
+		Order("contents.created_at asc").
+		Scan(&contents).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list content. post_ids=%v", postIDs)
+	}
+
+	dContents := map[uuid.UUID][]dmodel.Content{}
+	for _, content := range contents {
+		postID, err := uuid.Parse(content.PostID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse post id. id=%v", content.PostID)
+		}
+
+		dContent, err := dfactory.NewContent(content.ID, content.Type, content.Bin)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse content. id=%v", content.ID)
+		}
+
+		dContents[postID] = append(dContents[postID], *dContent)
+	}
+
+	return dContents, nil
+}
+
+// ListByMessages implements repository.ContentRepository.
+func (co *contentRepository) ListByMessages(c context.Context, messageIDs []uuid.UUID) (map[uuid.UUID][]dmodel.Content, error) {
+	contents := []struct {
+		imodel.Content
+		MessageID string
+	}{}
+	if err := co.contentStoreConnection.Read().WithContext(c).
+		Model(&imodel.Content{}).
+		Select("contents.id as id, contents.type as type, contents.bin as bin, content_message_relations.message_id as message_id").
+		Joins("inner join content_message_relations on contents.id = content_message_relations.content_id").
+		Where("content_message_relations.message_id in ?", lo.Map(messageIDs, func(id uuid.UUID, _ int) string { return id.String() })).
+		Order("contents.created_at asc").
+		Scan(&contents).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list content. message_ids=%v", messageIDs)
+	}
+
+	dContents := map[uuid.UUID][]dmodel.Content{}
+	for _, content := range contents {
+		messageID, err := uuid.Parse(content.MessageID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse message id. id=%v", content.MessageID)
+		}
+
+		dContent, err := dfactory.NewContent(content.ID, content.Type, content.Bin)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse content. id=%v", content.ID)
+		}
+
+		dContents[messageID] = append(dContents[messageID], *dContent)
+	}
+
+	return dContents, nil
+}
+
 // DeleteAndCreate implements repository.ContentRepository.
 func (co *contentRepository) DeleteAndCreate(c context.Context, newContents []dmodel.Content, mention dmodel.Mention) error {
-	return co.contentStoreConnection.Write().Transaction(func(tx *gorm.DB) error {
+	return co.contentStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		currentContents := []imodel.Content{}
 		switch mention.Resource {
 		case dmodel.ResourceLine:
@@ -198,6 +323,13 @@ func (co *contentRepository) DeleteAndCreate(c context.Context, newContents []dm
 				Scan(&currentContents).Error; err != nil {
 				return errors.Wrapf(err, "failed to list content. line_id=%v", mention.ID.String())
 			}
+
+			// 消した内容を指す関連が残らないよう、関連も作り直す
+			if err := tx.
+				Where("line_id = ?", mention.ID.String()).
+				Delete(&imodel.ContentLineRelation{}).Error; err != nil {
+				return errors.Wrapf(err, "failed to delete line relation. line_id=%v", mention.ID.String())
+			}
 		case dmodel.ResourceTopic:
 			if err := tx.
 				Model(&imodel.Content{}).
@@ -218,11 +350,24 @@ func (co *contentRepository) DeleteAndCreate(c context.Context, newContents []dm
 				Scan(&currentContents).Error; err != nil {
 				return errors.Wrapf(err, "failed to list content. post_id=%v", mention.ID.String())
 			}
+		case dmodel.ResourceMessage:
+			if err := tx.
+				Model(&imodel.Content{}).
+				Select("contents.id as id, contents.type as type, contents.bin as bin").
+				Joins("inner join content_message_relations on contents.id = content_message_relations.content_id").
+				Where("content_message_relations.message_id = ?", mention.ID.String()).
+				Order("contents.created_at asc").
+				Scan(&currentContents).Error; err != nil {
+				return errors.Wrapf(err, "failed to list content. message_id=%v", mention.ID.String())
+			}
 		}
 
-		if err := tx.
-			Delete(&currentContents).Error; err != nil {
-			return errors.Wrapf(err, "failed to delete content. id=%v", mention.ID.String())
+		// 内容のないリソースでは削除する条件がないため、削除しない
+		if len(currentContents) > 0 {
+			if err := tx.
+				Delete(&currentContents).Error; err != nil {
+				return errors.Wrapf(err, "failed to delete content. id=%v", mention.ID.String())
+			}
 		}
 
 		for _, newContent := range newContents {
@@ -260,6 +405,14 @@ func (co *contentRepository) DeleteAndCreate(c context.Context, newContents []dm
 					}).Error; err != nil {
 					return errors.Wrapf(err, "failed to create post relation. id=%v", mention.ID.String())
 				}
+			case dmodel.ResourceMessage:
+				if err := tx.
+					Create(&imodel.ContentMessageRelation{
+						ContentID: newContent.ID.String(),
+						MessageID: mention.ID.String(),
+					}).Error; err != nil {
+					return errors.Wrapf(err, "failed to create message relation. id=%v", mention.ID.String())
+				}
 			}
 		}
 
@@ -267,6 +420,84 @@ func (co *contentRepository) DeleteAndCreate(c context.Context, newContents []dm
 	})
 }
 
+// ReplaceByLines implements repository.ContentRepository.
+// 複数行の内容を 1 つのトランザクションで置き換える
+func (co *contentRepository) ReplaceByLines(c context.Context, contents map[uuid.UUID][]dmodel.Content) error {
+	if len(contents) == 0 {
+		return nil
+	}
+
+	return co.contentStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		lineIDs := lo.Map(lo.Keys(contents), func(id uuid.UUID, _ int) string { return id.String() })
+		if err := co.deleteByLines(tx, lineIDs); err != nil {
+			return err
+		}
+
+		// 行内の並びは作成日時の順のため、1 件ずつ作成する
+		for lineID, lineContents := range contents {
+			for _, content := range lineContents {
+				if err := tx.
+					Create(&imodel.Content{
+						ID:   content.ID.String(),
+						Type: content.Type.String(),
+						Bin:  content.Value,
+					}).Error; err != nil {
+					return errors.Wrapf(err, "failed to create content. id=%v", content.ID)
+				}
+
+				if err := tx.
+					Create(&imodel.ContentLineRelation{
+						ContentID: content.ID.String(),
+						LineID:    lineID.String(),
+					}).Error; err != nil {
+					return errors.Wrapf(err, "failed to create line relation. id=%v", lineID.String())
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// DeleteByLines implements repository.ContentRepository.
+func (co *contentRepository) DeleteByLines(c context.Context, lineIDs []uuid.UUID) error {
+	if len(lineIDs) == 0 {
+		return nil
+	}
+
+	return co.contentStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		return co.deleteByLines(tx, lo.Map(lineIDs, func(id uuid.UUID, _ int) string { return id.String() }))
+	})
+}
+
+func (co *contentRepository) deleteByLines(tx *gorm.DB, lineIDs []string) error {
+	contentIDs := []string{}
+	if err := tx.
+		Model(&imodel.ContentLineRelation{}).
+		Where("line_id in ?", lineIDs).
+		Pluck("content_id", &contentIDs).Error; err != nil {
+		return errors.Wrapf(err, "failed to list line relations. line_ids=%v", lineIDs)
+	}
+
+	if err := tx.
+		Where("line_id in ?", lineIDs).
+		Delete(&imodel.ContentLineRelation{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete line relations. line_ids=%v", lineIDs)
+	}
+
+	if len(contentIDs) == 0 {
+		return nil
+	}
+
+	if err := tx.
+		Where("id in ?", contentIDs).
+		Delete(&imodel.Content{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete contents. line_ids=%v", lineIDs)
+	}
+
+	return nil
+}
+
 // Delete implements repository.ContentRepository.
 func (co *contentRepository) Delete(c context.Context, ids []uuid.UUID) error {
 	parsedIDs := []string{}
@@ -275,7 +506,7 @@ func (co *contentRepository) Delete(c context.Context, ids []uuid.UUID) error {
 		parsedIDs = append(parsedIDs, id.String())
 	}
 
-	return co.contentStoreConnection.Read().
+	return co.contentStoreConnection.Read().WithContext(c).
 		Where("id in ?", parsedIDs).
 		Delete(&[]imodel.Content{}).Error
 }
@@ -290,7 +521,7 @@ func (co *contentRepository) List(c context.Context, ids []uuid.UUID) ([]dmodel.
 
 	contents := []imodel.Content{}
 
-	if err := co.contentStoreConnection.Read().
+	if err := co.contentStoreConnection.Read().WithContext(c).
 		Where("id in ?", parsedIDs).
 		Find(&contents).Error; err != nil {
 		return nil, errors.Wrapf(err, "fialed to get contents. note_id=%v", parsedIDs)
@@ -0,0 +1,327 @@
+package repository
+
+import (
+	dfactory "app/domain/factory"
+	dmodel "app/domain/model"
+	drepository "app/domain/repository"
+	irdb "app/infrastructure/adapter/datastore/rdb"
+	imodel "app/infrastructure/model"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+type conversationRepository struct {
+	messageStoreConnection irdb.MessageStoreConnection
+}
+
+type pagedConversation struct {
+	imodel.Conversation
+	CreatedAt time.Time
+}
+
+// Create implements repository.ConversationRepository.
+func (co *conversationRepository) Create(c context.Context, conversation dmodel.Conversation) error {
+	return co.messageStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Create(&imodel.Conversation{
+				ID: conversation.ID.String(),
+				At: conversation.At.Int(),
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to create conversation. id=%v", conversation.ID.String())
+		}
+
+		for _, userID := range conversation.Users {
+			if err := tx.
+				Create(&imodel.ConversationUserRelation{
+					ConversationID: conversation.ID.String(),
+					UserID:         userID.String(),
+				}).Error; err != nil {
+				return errors.Wrapf(err, "failed to create user relation. user_id=%v", userID.String())
+			}
+		}
+
+		return nil
+	})
+}
+
+// Get implements repository.ConversationRepository.
+func (co *conversationRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Conversation, error) {
+	conversation := imodel.Conversation{ID: id.String()}
+	if err := co.messageStoreConnection.Read().WithContext(c).
+		First(&conversation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get conversation. id=%v", id.String())
+	}
+
+	dConversations, err := co.toConversations(c, []imodel.Conversation{conversation})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dConversations[0], nil
+}
+
+// GetByUsers implements repository.ConversationRepository.
+func (co *conversationRepository) GetByUsers(c context.Context, userIDs []uuid.UUID) (*dmodel.Conversation, error) {
+	parsedUserIDs := lo.Uniq(lo.Map(userIDs, func(id uuid.UUID, _ int) string { return id.String() }))
+
+	// 参加者が完全に一致する会話のみを対象にする
+	rows := []imodel.ConversationUserRelation{}
+	if err := co.messageStoreConnection.Read().WithContext(c).
+		Model(&imodel.ConversationUserRelation{}).
+		Select("conversation_id").
+		Group("conversation_id").
+		Having("count(*) = ? and sum(user_id in ?) = ?", len(parsedUserIDs), parsedUserIDs, len(parsedUserIDs)).
+		Limit(1).
+		Scan(&rows).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get conversation. user_ids=%v", parsedUserIDs)
+	}
+
+	if len(rows) < 1 {
+		return nil, nil
+	}
+
+	conversationID, err := uuid.Parse(rows[0].ConversationID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse conversation id. id=%v", rows[0].ConversationID)
+	}
+
+	return co.Get(c, conversationID)
+}
+
+// ListByUser implements repository.ConversationRepository.
+func (co *conversationRepository) ListByUser(c context.Context, userID uuid.UUID, page dmodel.Range) ([]dmodel.Conversation, *dmodel.Cursor, error) {
+	iConversations := []pagedConversation{}
+	if err := paginate(co.messageStoreConnection.Read().WithContext(c).
+		Model(&imodel.Conversation{}).
+		Select("conversations.id as id, conversations.at as at, conversations.created_at as created_at").
+		Joins("inner join conversation_user_relations on conversations.id = conversation_user_relations.conversation_id").
+		Where("conversation_user_relations.user_id = ?", userID.String()), page, "conversations.created_at", "conversations.id").
+		Scan(&iConversations).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list conversation. user_id=%v", userID.String())
+	}
+
+	dConversations, err := co.toConversations(c, lo.Map(iConversations, func(iConversation pagedConversation, _ int) imodel.Conversation {
+		return iConversation.Conversation
+	}))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(iConversations) == 0 {
+		return dConversations, nil, nil
+	}
+
+	last := iConversations[len(iConversations)-1]
+	next, err := nextCursor(page, len(iConversations), last.CreatedAt, last.ID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. id=%v", last.ID)
+	}
+
+	return dConversations, next, nil
+}
+
+func (co *conversationRepository) toConversations(c context.Context, iConversations []imodel.Conversation) ([]dmodel.Conversation, error) {
+	conversationIDs := lo.Map(iConversations, func(iConversation imodel.Conversation, _ int) string { return iConversation.ID })
+	if len(conversationIDs) == 0 {
+		return []dmodel.Conversation{}, nil
+	}
+
+	relations := []imodel.ConversationUserRelation{}
+	if err := co.messageStoreConnection.Read().WithContext(c).
+		Where("conversation_id in ?", conversationIDs).
+		Find(&relations).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list user relation. conversation_ids=%v", conversationIDs)
+	}
+
+	users := lo.GroupBy(relations, func(relation imodel.ConversationUserRelation) string { return relation.ConversationID })
+
+	dConversations := []dmodel.Conversation{}
+	for _, iConversation := range iConversations {
+		userIDs := lo.Map(users[iConversation.ID], func(relation imodel.ConversationUserRelation, _ int) string { return relation.UserID })
+
+		dConversation, err := dfactory.NewConversation(iConversation.ID, userIDs, iConversation.At)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse conversation. id=%v", iConversation.ID)
+		}
+
+		dConversations = append(dConversations, *dConversation)
+	}
+
+	return dConversations, nil
+}
+
+func NewConversationRepository(i *do.Injector) (drepository.ConversationRepository, error) {
+	messageStoreConnection := do.MustInvoke[irdb.MessageStoreConnection](i)
+	return &conversationRepository{
+		messageStoreConnection: messageStoreConnection,
+	}, nil
+}
+
+type messageRepository struct {
+	messageStoreConnection irdb.MessageStoreConnection
+}
+
+type fromMessage struct {
+	imodel.Message
+	UserID    string
+	CreatedAt time.Time
+}
+
+// Create implements repository.MessageRepository.
+func (m *messageRepository) Create(c context.Context, message dmodel.Message, conversationID uuid.UUID) error {
+	return m.messageStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Create(&imodel.Message{
+				ID: message.ID.String(),
+				At: message.At.Int(),
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to create message. id=%v", message.ID.String())
+		}
+
+		if err := tx.
+			Create(&imodel.MessageConversationRelation{
+				MessageID:      message.ID.String(),
+				ConversationID: conversationID.String(),
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to create conversation relation. conversation_id=%v", conversationID.String())
+		}
+
+		if err := tx.
+			Create(&imodel.MessageFromUserRelation{
+				MessageID: message.ID.String(),
+				UserID:    message.From.String(),
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to create from relation. user_id=%v", message.From.String())
+		}
+
+		// 送信者は自分のメッセージを既読にする
+		return saveMessageReadMarker(tx, dmodel.MessageReadMarker{
+			ConversationID: conversationID,
+			UserID:         message.From,
+			MessageID:      message.ID,
+			At:             message.At,
+		})
+	})
+}
+
+// GetByConversation implements repository.MessageRepository.
+func (m *messageRepository) GetByConversation(c context.Context, conversationID uuid.UUID, id uuid.UUID) (*dmodel.Message, error) {
+	iMessages := []fromMessage{}
+	if err := m.messageStoreConnection.Read().WithContext(c).
+		Model(&imodel.Message{}).
+		Select("messages.id as id, messages.at as at, message_from_user_relations.user_id as user_id").
+		Joins("inner join message_conversation_relations on messages.id = message_conversation_relations.message_id").
+		Joins("inner join message_from_user_relations on messages.id = message_from_user_relations.message_id").
+		Where("message_conversation_relations.conversation_id = ?", conversationID.String()).
+		Where("messages.id = ?", id.String()).
+		Scan(&iMessages).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get message. id=%v", id.String())
+	}
+
+	if len(iMessages) < 1 {
+		return nil, nil
+	}
+
+	dMessage, err := dfactory.NewMessage(iMessages[0].ID, iMessages[0].UserID, iMessages[0].At)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse message. id=%v", iMessages[0].ID)
+	}
+
+	return dMessage, nil
+}
+
+// ListByConversation implements repository.MessageRepository.
+func (m *messageRepository) ListByConversation(c context.Context, conversationID uuid.UUID, page dmodel.Range) ([]dmodel.Message, *dmodel.Cursor, error) {
+	iMessages := []fromMessage{}
+	if err := paginate(m.messageStoreConnection.Read().WithContext(c).
+		Model(&imodel.Message{}).
+		Select("messages.id as id, messages.at as at, message_from_user_relations.user_id as user_id, messages.created_at as created_at").
+		Joins("inner join message_conversation_relations on messages.id = message_conversation_relations.message_id").
+		Joins("inner join message_from_user_relations on messages.id = message_from_user_relations.message_id").
+		Where("message_conversation_relations.conversation_id = ?", conversationID.String()), page, "messages.created_at", "messages.id").
+		Scan(&iMessages).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list message. conversation_id=%v", conversationID.String())
+	}
+
+	dMessages := []dmodel.Message{}
+	for _, iMessage := range iMessages {
+		dMessage, err := dfactory.NewMessage(iMessage.ID, iMessage.UserID, iMessage.At)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse message. id=%v", iMessage.ID)
+		}
+
+		dMessages = append(dMessages, *dMessage)
+	}
+
+	if len(iMessages) == 0 {
+		return dMessages, nil, nil
+	}
+
+	last := iMessages[len(iMessages)-1]
+	next, err := nextCursor(page, len(iMessages), last.CreatedAt, last.ID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. id=%v", last.ID)
+	}
+
+	return dMessages, next, nil
+}
+
+// CountAfterByConversations implements repository.MessageRepository.
+func (m *messageRepository) CountAfterByConversations(c context.Context, userID uuid.UUID, after map[uuid.UUID]dmodel.UnixTime) (map[uuid.UUID]int, error) {
+	counts := map[uuid.UUID]int{}
+	if len(after) == 0 {
+		return counts, nil
+	}
+
+	conditions := []string{}
+	values := []any{}
+	for conversationID, at := range after {
+		conditions = append(conditions, "(message_conversation_relations.conversation_id = ? and messages.at > ?)")
+		values = append(values, conversationID.String(), at.Int())
+	}
+
+	rows := []struct {
+		ConversationID string
+		Count          int
+	}{}
+	if err := m.messageStoreConnection.Read().WithContext(c).
+		Model(&imodel.Message{}).
+		Select("message_conversation_relations.conversation_id as conversation_id, count(*) as count").
+		Joins("inner join message_conversation_relations on messages.id = message_conversation_relations.message_id").
+		Joins("inner join message_from_user_relations on messages.id = message_from_user_relations.message_id").
+		Where("("+strings.Join(conditions, " or ")+")", values...).
+		Where("message_from_user_relations.user_id <> ?", userID.String()).
+		Group("message_conversation_relations.conversation_id").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to count message. conversation_ids=%v", lo.Keys(after))
+	}
+
+	for _, row := range rows {
+		conversationID, err := uuid.Parse(row.ConversationID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse conversation id. id=%v", row.ConversationID)
+		}
+
+		counts[conversationID] = row.Count
+	}
+
+	return counts, nil
+}
+
+func NewMessageRepository(i *do.Injector) (drepository.MessageRepository, error) {
+	messageStoreConnection := do.MustInvoke[irdb.MessageStoreConnection](i)
+	return &messageRepository{
+		messageStoreConnection: messageStoreConnection,
+	}, nil
+}
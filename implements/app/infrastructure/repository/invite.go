@@ -8,6 +8,7 @@ import (
 	imodel "app/infrastructure/model"
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -17,6 +18,11 @@ import (
 	"gorm.io/gorm/clause"
 )
 
+type pagedInvitedUser struct {
+	imodel.InvitedUser
+	CreatedAt time.Time
+}
+
 type inviteRepository struct {
 	inviteStoreConnectionRDB irdb.InviteStoreConnection
 }
@@ -24,9 +30,9 @@ type inviteRepository struct {
 // GetByRoleAndUser implements repository.InviteRepository.
 func (i *inviteRepository) GetByRoleAndUser(c context.Context, roleID uuid.UUID, userID uuid.UUID) (*dmodel.Invite, error) {
 	invite := imodel.Invite{}
-	if err := i.inviteStoreConnectionRDB.Read().
+	if err := i.inviteStoreConnectionRDB.Read().WithContext(c).
 		Model(&imodel.Invite{}).
-		Select("invites.id as id, invites.role_id as role_id, invites.message as message, invites.at as at").
+		Select("invites.id as id, invites.role_id as role_id, invites.message as message, invites.at as at, invites.expire_at as expire_at").
 		Joins("inner join invited_users on invites.id = invited_users.invite_id").
 		Where("invites.role_id = ?", roleID.String()).
 		Where("invited_users.user_id = ?", userID.String()).
@@ -38,25 +44,38 @@ func (i *inviteRepository) GetByRoleAndUser(c context.Context, roleID uuid.UUID,
 		return nil, errors.Wrapf(err, "failed to get invite. role_id=%v user_id=%v", roleID.String(), userID.String())
 	}
 
-	invitedUsers := []imodel.InvitedUser{}
-	if err := i.inviteStoreConnectionRDB.Read().
-		Where("invite_id = ?", invite.ID).
-		Find(&invitedUsers).Error; err != nil {
-		return nil, errors.Wrapf(err, "failed to list invited users. id=%v", invite.ID)
+	if invite.ID == "" {
+		return nil, nil
 	}
 
-	invitedUserIds := lo.Map(invitedUsers, func(iu imodel.InvitedUser, _ int) string { return iu.UserID })
+	dInvite, err := i.toInvite(c, invite)
+	if err != nil {
+		return nil, err
+	}
 
-	var message *string
-	if invite.Message.Valid {
-		message = &invite.Message.String
-	} else {
-		message = nil
+	return dInvite, nil
+}
+
+// GetByRoleAndEmail implements repository.InviteRepository.
+func (i *inviteRepository) GetByRoleAndEmail(c context.Context, roleID uuid.UUID, email dmodel.EmailAddress) (*dmodel.Invite, error) {
+	invite := imodel.Invite{}
+	if err := i.inviteStoreConnectionRDB.Read().WithContext(c).
+		Model(&imodel.Invite{}).
+		Select("invites.id as id, invites.role_id as role_id, invites.message as message, invites.at as at, invites.expire_at as expire_at").
+		Joins("inner join invited_emails on invites.id = invited_emails.invite_id").
+		Where("invites.role_id = ?", roleID.String()).
+		Where("invited_emails.email = ?", email.String()).
+		Scan(&invite).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get invite. role_id=%v email=%v", roleID.String(), email.String())
+	}
+
+	if invite.ID == "" {
+		return nil, nil
 	}
 
-	dInvite, err := dfactory.NewInvite(invite.ID, invite.RoleID, message, invite.At, invitedUserIds)
+	dInvite, err := i.toInvite(c, invite)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse invite. id=%v", invite.ID)
+		return nil, err
 	}
 
 	return dInvite, nil
@@ -65,7 +84,7 @@ func (i *inviteRepository) GetByRoleAndUser(c context.Context, roleID uuid.UUID,
 // Get implements repository.InviteRepository.
 func (i *inviteRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Invite, error) {
 	invite := imodel.Invite{ID: id.String()}
-	if err := i.inviteStoreConnectionRDB.Read().
+	if err := i.inviteStoreConnectionRDB.Read().WithContext(c).
 		First(&invite).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -74,25 +93,9 @@ func (i *inviteRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Invite,
 		return nil, errors.Wrapf(err, "failed to get invite. id=%v", id.String())
 	}
 
-	invitedUsers := []imodel.InvitedUser{}
-	if err := i.inviteStoreConnectionRDB.Read().
-		Where("invite_id = ?", invite.ID).
-		Find(&invitedUsers).Error; err != nil {
-		return nil, errors.Wrapf(err, "failed to list invited users. id=%v", invite.ID)
-	}
-
-	invitedUserIds := lo.Map(invitedUsers, func(iu imodel.InvitedUser, _ int) string { return iu.UserID })
-
-	var message *string
-	if invite.Message.Valid {
-		message = &invite.Message.String
-	} else {
-		message = nil
-	}
-
-	dInvite, err := dfactory.NewInvite(invite.ID, invite.RoleID, message, invite.At, invitedUserIds)
+	dInvite, err := i.toInvite(c, invite)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse invite. id=%v", invite.ID)
+		return nil, err
 	}
 
 	return dInvite, nil
@@ -100,7 +103,7 @@ func (i *inviteRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Invite,
 
 // Create implements repository.InviteRepository.
 func (i *inviteRepository) Create(c context.Context, invite dmodel.Invite) error {
-	return i.inviteStoreConnectionRDB.Write().Transaction(func(tx *gorm.DB) error {
+	return i.inviteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		var message sql.NullString
 		if invite.Message != nil {
 			message = sql.NullString{
@@ -113,12 +116,25 @@ func (i *inviteRepository) Create(c context.Context, invite dmodel.Invite) error
 			}
 		}
 
+		var expireAt sql.NullTime
+		if invite.ExpireAt != nil {
+			expireAt = sql.NullTime{
+				Valid: true,
+				Time:  *invite.ExpireAt,
+			}
+		} else {
+			expireAt = sql.NullTime{
+				Valid: false,
+			}
+		}
+
 		if err := tx.
 			Create(&imodel.Invite{
-				ID:      invite.ID.String(),
-				RoleID:  invite.RoleID.String(),
-				Message: message,
-				At:      invite.At,
+				ID:       invite.ID.String(),
+				RoleID:   invite.RoleID.String(),
+				Message:  message,
+				At:       invite.At,
+				ExpireAt: expireAt,
 			}).Error; err != nil {
 			return errors.Wrapf(err, "failed to create invite. id=%v", invite.ID.String())
 		}
@@ -130,9 +146,25 @@ func (i *inviteRepository) Create(c context.Context, invite dmodel.Invite) error
 			}
 		})
 
-		if err := tx.
-			Create(&invitedUsers).Error; err != nil {
-			return errors.Wrapf(err, "failed to create invited users. id=%v", invite.ID.String())
+		if len(invitedUsers) > 0 {
+			if err := tx.
+				Create(&invitedUsers).Error; err != nil {
+				return errors.Wrapf(err, "failed to create invited users. id=%v", invite.ID.String())
+			}
+		}
+
+		invitedEmails := lo.Map(invite.Emails, func(email dmodel.EmailAddress, _ int) imodel.InvitedEmail {
+			return imodel.InvitedEmail{
+				InviteID: invite.ID.String(),
+				Email:    email.String(),
+			}
+		})
+
+		if len(invitedEmails) > 0 {
+			if err := tx.
+				Create(&invitedEmails).Error; err != nil {
+				return errors.Wrapf(err, "failed to create invited emails. id=%v", invite.ID.String())
+			}
 		}
 
 		return nil
@@ -141,13 +173,19 @@ func (i *inviteRepository) Create(c context.Context, invite dmodel.Invite) error
 
 // Delete implements repository.InviteRepository.
 func (i *inviteRepository) Delete(c context.Context, id uuid.UUID) error {
-	return i.inviteStoreConnectionRDB.Write().Transaction(func(tx *gorm.DB) error {
+	return i.inviteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
 			Where("invite_id = ?", id.String()).
 			Delete(&imodel.InvitedUser{}).Error; err != nil {
 			return errors.Wrapf(err, "failed to delete invited users. id=%v", id.String())
 		}
 
+		if err := tx.
+			Where("invite_id = ?", id.String()).
+			Delete(&imodel.InvitedEmail{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete invited emails. id=%v", id.String())
+		}
+
 		if err := tx.
 			Delete(&imodel.Invite{
 				ID: id.String(),
@@ -161,134 +199,528 @@ func (i *inviteRepository) Delete(c context.Context, id uuid.UUID) error {
 
 // DeleteInvitedUser implements repository.InviteRepository.
 func (i *inviteRepository) DeleteInvitedUser(c context.Context, id uuid.UUID, userID uuid.UUID) error {
-	return i.inviteStoreConnectionRDB.Write().Transaction(func(tx *gorm.DB) error {
-		invite := imodel.Invite{
-			ID: id.String(),
+	return i.inviteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		return i.deleteInvitedUser(tx, id, userID)
+	})
+}
+
+// Accept implements repository.InviteRepository.
+func (i *inviteRepository) Accept(c context.Context, id uuid.UUID, userID uuid.UUID, join func() error) error {
+	// 参加に成功した場合のみ招待を消費する
+	return i.inviteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := i.deleteInvitedUser(tx, id, userID); err != nil {
+			return err
 		}
 
+		return join()
+	})
+}
+
+func (i *inviteRepository) deleteInvitedUser(tx *gorm.DB, id uuid.UUID, userID uuid.UUID) error {
+	invite := imodel.Invite{
+		ID: id.String(),
+	}
+
+	if err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&invite).Error; err != nil {
+		return errors.Wrapf(err, "fialed to lock invite. id=%v", id.String())
+	}
+
+	invitedUser := imodel.InvitedUser{
+		InviteID: id.String(),
+		UserID:   userID.String(),
+	}
+
+	if err := tx.
+		Delete(&invitedUser).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete invited user. id=%v user_id=%v", id.String(), userID.String())
+	}
+
+	var remainingUsers int64
+	if err := tx.
+		Model(&imodel.InvitedUser{}).
+		Where("invite_id = ?", id.String()).
+		Count(&remainingUsers).Error; err != nil {
+		return errors.Wrapf(err, "failed to count invited user. id=%v user_id=%v", id.String(), userID.String())
+	}
+
+	var remainingEmails int64
+	if err := tx.
+		Model(&imodel.InvitedEmail{}).
+		Where("invite_id = ?", id.String()).
+		Count(&remainingEmails).Error; err != nil {
+		return errors.Wrapf(err, "failed to count invited email. id=%v", id.String())
+	}
+
+	// 招待された全員が応答した場合は招待自体を削除する
+	if remainingUsers+remainingEmails == 0 {
+		if err := tx.
+			Delete(&invite).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete invite. id=%v", id.String())
+		}
+	}
+
+	return nil
+}
+
+// ListByRole implements repository.InviteRepository.
+func (i *inviteRepository) ListByRole(c context.Context, roleIDs []uuid.UUID, page dmodel.Range) ([]dmodel.Invite, *dmodel.Cursor, error) {
+	invites := []struct {
+		imodel.Invite
+		CreatedAt time.Time
+	}{}
+	if err := paginate(i.inviteStoreConnectionRDB.Read().WithContext(c).
+		Model(&imodel.Invite{}).
+		Select("id, role_id, message, at, expire_at, created_at").
+		Where("role_id in ?", lo.Map(roleIDs, func(roleID uuid.UUID, _ int) string { return roleID.String() })), page, "created_at", "id").
+		Scan(&invites).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list invite. ids=%v", roleIDs)
+	}
+
+	dInvites := []dmodel.Invite{}
+	for _, invite := range invites {
+		dInvite, err := i.toInvite(c, invite.Invite)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		dInvites = append(dInvites, *dInvite)
+	}
+
+	if len(invites) == 0 {
+		return dInvites, nil, nil
+	}
+
+	last := invites[len(invites)-1]
+	next, err := nextCursor(page, len(invites), last.CreatedAt, last.ID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. id=%v", last.ID)
+	}
+
+	return dInvites, next, nil
+}
+
+// ListByUser implements repository.InviteRepository.
+func (i *inviteRepository) ListByUser(c context.Context, userID uuid.UUID, page dmodel.Range) ([]dmodel.Invite, *dmodel.Cursor, error) {
+	invitedMyselfs := []pagedInvitedUser{}
+	if err := paginate(i.inviteStoreConnectionRDB.Read().WithContext(c).
+		Model(&imodel.InvitedUser{}).
+		Select("invite_id, user_id, created_at").
+		Where("user_id = ?", userID.String()), page, "created_at", "invite_id").
+		Scan(&invitedMyselfs).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list invited users. user_id=%v", userID.String())
+	}
+
+	inviteIDs := lo.Map(invitedMyselfs, func(iu pagedInvitedUser, _ int) string { return iu.InviteID })
+
+	invites := []imodel.Invite{}
+	if err := i.inviteStoreConnectionRDB.Read().WithContext(c).
+		Where("id in ?", inviteIDs).
+		Find(&invites).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list invite. user_id=%v", userID.String())
+	}
+
+	// ページの並び順を保つ
+	invites = lo.FilterMap(inviteIDs, func(inviteID string, _ int) (imodel.Invite, bool) {
+		return lo.Find(invites, func(invite imodel.Invite) bool { return invite.ID == inviteID })
+	})
+
+	dInvites := []dmodel.Invite{}
+	for _, invite := range invites {
+		dInvite, err := i.toInvite(c, invite)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		dInvites = append(dInvites, *dInvite)
+	}
+
+	if len(invitedMyselfs) == 0 {
+		return dInvites, nil, nil
+	}
+
+	last := invitedMyselfs[len(invitedMyselfs)-1]
+	next, err := nextCursor(page, len(invitedMyselfs), last.CreatedAt, last.InviteID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. id=%v", last.InviteID)
+	}
+
+	return dInvites, next, nil
+}
+
+// DeleteExpired implements repository.InviteRepository.
+func (i *inviteRepository) DeleteExpired(c context.Context, now time.Time) (int, error) {
+	count := 0
+	if err := i.inviteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		inviteIDs := []string{}
 		if err := tx.
+			Model(&imodel.Invite{}).
 			Clauses(clause.Locking{Strength: "UPDATE"}).
-			First(&invite).Error; err != nil {
-			return errors.Wrapf(err, "fialed to lock invite. id=%v", id.String())
+			Where("expire_at <= ?", now).
+			Pluck("id", &inviteIDs).Error; err != nil {
+			return errors.Wrapf(err, "failed to list expired invite. now=%v", now)
 		}
 
-		invitedUser := imodel.InvitedUser{
-			InviteID: id.String(),
-			UserID:   userID.String(),
+		if err := i.deleteByIDs(tx, inviteIDs); err != nil {
+			return err
 		}
 
+		count = len(inviteIDs)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// DeleteByRoles implements repository.InviteRepository.
+func (i *inviteRepository) DeleteByRoles(c context.Context, roleIDs []uuid.UUID) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	parsedRoleIDs := lo.Map(roleIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+	return i.inviteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		inviteIDs := []string{}
 		if err := tx.
-			Delete(&invitedUser).Error; err != nil {
-			return errors.Wrapf(err, "failed to delete invited user. id=%v user_id=%v", id.String(), userID.String())
+			Model(&imodel.Invite{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("role_id in ?", parsedRoleIDs).
+			Pluck("id", &inviteIDs).Error; err != nil {
+			return errors.Wrapf(err, "failed to list invite. role_ids=%v", parsedRoleIDs)
 		}
 
+		return i.deleteByIDs(tx, inviteIDs)
+	})
+}
+
+func (i *inviteRepository) deleteByIDs(tx *gorm.DB, inviteIDs []string) error {
+	if len(inviteIDs) == 0 {
+		return nil
+	}
+
+	if err := tx.
+		Where("invite_id in ?", inviteIDs).
+		Delete(&imodel.InvitedUser{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete invited users. ids=%v", inviteIDs)
+	}
+
+	if err := tx.
+		Where("invite_id in ?", inviteIDs).
+		Delete(&imodel.InvitedEmail{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete invited emails. ids=%v", inviteIDs)
+	}
+
+	if err := tx.
+		Where("id in ?", inviteIDs).
+		Delete(&imodel.Invite{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete invite. ids=%v", inviteIDs)
+	}
+
+	return nil
+}
+
+// AttachEmail implements repository.InviteRepository.
+func (i *inviteRepository) AttachEmail(c context.Context, email dmodel.EmailAddress, userID uuid.UUID) error {
+	return i.inviteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		invitedEmails := []imodel.InvitedEmail{}
 		if err := tx.
-			Where("invite_id = ?", id.String()).
-			First(&imodel.InvitedUser{}).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				if err := tx.
-					Delete(&invite).Error; err != nil {
-					return errors.Wrapf(err, "failed to delete invite. id=%v", id.String())
-				}
-			} else {
-				return errors.Wrapf(err, "fialed to get invited user. id=%v user_id=%v", id.String(), userID.String())
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("email = ?", email.String()).
+			Find(&invitedEmails).Error; err != nil {
+			return errors.Wrapf(err, "failed to list invited emails. email=%v", email.String())
+		}
+
+		if len(invitedEmails) == 0 {
+			return nil
+		}
+
+		invitedUsers := lo.Map(invitedEmails, func(invitedEmail imodel.InvitedEmail, _ int) imodel.InvitedUser {
+			return imodel.InvitedUser{
+				InviteID: invitedEmail.InviteID,
+				UserID:   userID.String(),
 			}
+		})
+
+		if err := tx.
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&invitedUsers).Error; err != nil {
+			return errors.Wrapf(err, "failed to create invited users. user_id=%v", userID.String())
+		}
+
+		if err := tx.
+			Where("email = ?", email.String()).
+			Delete(&imodel.InvitedEmail{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete invited emails. email=%v", email.String())
 		}
 
 		return nil
 	})
 }
 
-// ListByRole implements repository.InviteRepository.
-func (i *inviteRepository) ListByRole(c context.Context, roleIDs []uuid.UUID, page dmodel.Range) ([]dmodel.Invite, error) {
-	invites := []imodel.Invite{}
-	if err := i.inviteStoreConnectionRDB.Read().
-		Where("role_id in ?", lo.Map(roleIDs, func(roleID uuid.UUID, _ int) string { return roleID.String() })).
-		Order("created_at asc").
-		Limit(page.Limit).Offset(page.Offset).
-		Find(&invites).Error; err != nil {
-		return nil, errors.Wrapf(err, "failed to list invite. ids=%v", roleIDs)
+func (i *inviteRepository) toInvite(c context.Context, invite imodel.Invite) (*dmodel.Invite, error) {
+	invitedUsers := []imodel.InvitedUser{}
+	if err := i.inviteStoreConnectionRDB.Read().WithContext(c).
+		Where("invite_id = ?", invite.ID).
+		Find(&invitedUsers).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list invited users. id=%v", invite.ID)
 	}
 
-	dInvites := []dmodel.Invite{}
-	for _, invite := range invites {
-		invitedUsers := []imodel.InvitedUser{}
-		if err := i.inviteStoreConnectionRDB.Read().
-			Where("invite_id = ?", invite.ID).
-			Find(&invitedUsers).Error; err != nil {
-			return nil, errors.Wrapf(err, "failed to list invited users. id=%v", invite.ID)
-		}
+	invitedUserIds := lo.Map(invitedUsers, func(iu imodel.InvitedUser, _ int) string { return iu.UserID })
 
-		invitedUserIds := lo.Map(invitedUsers, func(iu imodel.InvitedUser, _ int) string { return iu.UserID })
+	invitedEmails := []imodel.InvitedEmail{}
+	if err := i.inviteStoreConnectionRDB.Read().WithContext(c).
+		Where("invite_id = ?", invite.ID).
+		Find(&invitedEmails).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list invited emails. id=%v", invite.ID)
+	}
 
-		var message *string
-		if invite.Message.Valid {
-			message = &invite.Message.String
-		} else {
-			message = nil
+	emails := lo.Map(invitedEmails, func(ie imodel.InvitedEmail, _ int) string { return ie.Email })
+
+	var message *string
+	if invite.Message.Valid {
+		message = &invite.Message.String
+	} else {
+		message = nil
+	}
+
+	var expireAt *time.Time
+	if invite.ExpireAt.Valid {
+		expireAt = &invite.ExpireAt.Time
+	} else {
+		expireAt = nil
+	}
+
+	dInvite, err := dfactory.NewInvite(invite.ID, invite.RoleID, message, invite.At, expireAt, invitedUserIds, emails)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse invite. id=%v", invite.ID)
+	}
+
+	return dInvite, nil
+}
+
+func NewInviteRepository(i *do.Injector) (drepository.InviteRepository, error) {
+	inviteStoreConnectionRDB := do.MustInvoke[irdb.InviteStoreConnection](i)
+	return &inviteRepository{
+		inviteStoreConnectionRDB: inviteStoreConnectionRDB,
+	}, nil
+}
+
+type inviteLinkRepository struct {
+	inviteStoreConnectionRDB irdb.InviteStoreConnection
+}
+
+type pagedInviteLink struct {
+	imodel.InviteLink
+	CreatedAt time.Time
+}
+
+// Create implements repository.InviteLinkRepository.
+func (i *inviteLinkRepository) Create(c context.Context, link dmodel.InviteLink) error {
+	var expireAt sql.NullTime
+	if link.ExpireAt != nil {
+		expireAt = sql.NullTime{
+			Valid: true,
+			Time:  *link.ExpireAt,
 		}
+	} else {
+		expireAt = sql.NullTime{
+			Valid: false,
+		}
+	}
 
-		dInvite, err := dfactory.NewInvite(invite.ID, invite.RoleID, message, invite.At, invitedUserIds)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to parse invite. id=%v", invite.ID)
+	var maxUses sql.NullInt64
+	if link.MaxUses != nil {
+		maxUses = sql.NullInt64{
+			Valid: true,
+			Int64: int64(link.MaxUses.Int()),
+		}
+	} else {
+		maxUses = sql.NullInt64{
+			Valid: false,
 		}
+	}
 
-		dInvites = append(dInvites, *dInvite)
+	if err := i.inviteStoreConnectionRDB.Write().WithContext(c).
+		Create(&imodel.InviteLink{
+			ID:       link.ID.String(),
+			RoleID:   link.RoleID.String(),
+			Token:    link.Token.String(),
+			At:       link.At,
+			ExpireAt: expireAt,
+			MaxUses:  maxUses,
+			Uses:     link.Uses,
+		}).Error; err != nil {
+		return errors.Wrapf(err, "failed to create invite link. id=%v", link.ID.String())
 	}
 
-	return dInvites, nil
+	return nil
 }
 
-// ListByUser implements repository.InviteRepository.
-func (i *inviteRepository) ListByUser(c context.Context, userID uuid.UUID, page dmodel.Range) ([]dmodel.Invite, error) {
-	invitedMyselfs := []imodel.InvitedUser{}
-	if err := i.inviteStoreConnectionRDB.Read().
-		Where("user_id = ?", userID.String()).
-		Order("created_at asc").
-		Limit(page.Limit).Offset(page.Offset).
-		Find(&invitedMyselfs).Error; err != nil {
-		return nil, errors.Wrapf(err, "failed to list invited users. user_id=%v", userID.String())
+// Get implements repository.InviteLinkRepository.
+func (i *inviteLinkRepository) Get(c context.Context, id uuid.UUID) (*dmodel.InviteLink, error) {
+	link := imodel.InviteLink{ID: id.String()}
+	if err := i.inviteStoreConnectionRDB.Read().WithContext(c).
+		First(&link).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get invite link. id=%v", id.String())
 	}
 
-	invites := []imodel.Invite{}
-	if err := i.inviteStoreConnectionRDB.Read().
-		Where("id in ?", lo.Map(invitedMyselfs, func(iu imodel.InvitedUser, _ int) string { return iu.InviteID })).
-		Find(&invites).Error; err != nil {
-		return nil, errors.Wrapf(err, "failed to list invite. user_id=%v", userID.String())
+	return i.toInviteLink(link)
+}
+
+// GetByToken implements repository.InviteLinkRepository.
+func (i *inviteLinkRepository) GetByToken(c context.Context, token dmodel.InviteToken) (*dmodel.InviteLink, error) {
+	link := imodel.InviteLink{}
+	if err := i.inviteStoreConnectionRDB.Read().WithContext(c).
+		Where("token = ?", token.String()).
+		First(&link).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "failed to get invite link by token")
 	}
 
-	dInvites := []dmodel.Invite{}
-	for _, invite := range invites {
-		invitedUsers := []imodel.InvitedUser{}
-		if err := i.inviteStoreConnectionRDB.Read().
-			Where("invite_id = ?", invite.ID).
-			Find(&invitedUsers).Error; err != nil {
-			return nil, errors.Wrapf(err, "failed to list invited users. id=%v", invite.ID)
+	return i.toInviteLink(link)
+}
+
+// ListByRole implements repository.InviteLinkRepository.
+func (i *inviteLinkRepository) ListByRole(c context.Context, roleIDs []uuid.UUID, page dmodel.Range) ([]dmodel.InviteLink, *dmodel.Cursor, error) {
+	links := []pagedInviteLink{}
+	if err := paginate(i.inviteStoreConnectionRDB.Read().WithContext(c).
+		Model(&imodel.InviteLink{}).
+		Select("id, role_id, token, at, expire_at, max_uses, uses, created_at").
+		Where("role_id in ?", lo.Map(roleIDs, func(roleID uuid.UUID, _ int) string { return roleID.String() })), page, "created_at", "id").
+		Scan(&links).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list invite link. ids=%v", roleIDs)
+	}
+
+	dLinks := []dmodel.InviteLink{}
+	for _, link := range links {
+		dLink, err := i.toInviteLink(link.InviteLink)
+		if err != nil {
+			return nil, nil, err
 		}
 
-		invitedUserIds := lo.Map(invitedUsers, func(iu imodel.InvitedUser, _ int) string { return iu.UserID })
+		dLinks = append(dLinks, *dLink)
+	}
+
+	if len(links) == 0 {
+		return dLinks, nil, nil
+	}
 
-		var message *string
-		if invite.Message.Valid {
-			message = &invite.Message.String
-		} else {
-			message = nil
+	last := links[len(links)-1]
+	next, err := nextCursor(page, len(links), last.CreatedAt, last.ID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. id=%v", last.ID)
+	}
+
+	return dLinks, next, nil
+}
+
+// Use implements repository.InviteLinkRepository.
+func (i *inviteLinkRepository) Use(c context.Context, id uuid.UUID, now time.Time, join func() error) (bool, error) {
+	used := false
+	if err := i.inviteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		// 期限切れや使用回数の上限に達したリンクは更新しない。更新した行は参加が終わるまでロックされる
+		result := tx.
+			Model(&imodel.InviteLink{}).
+			Where("id = ?", id.String()).
+			Where("expire_at is null or expire_at > ?", now).
+			Where("max_uses is null or uses < max_uses").
+			Update("uses", gorm.Expr("uses + 1"))
+		if result.Error != nil {
+			return errors.Wrapf(result.Error, "failed to use invite link. id=%v", id.String())
+		} else if result.RowsAffected == 0 {
+			return nil
 		}
 
-		dInvite, err := dfactory.NewInvite(invite.ID, invite.RoleID, message, invite.At, invitedUserIds)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to parse invite. id=%v", invite.ID)
+		// 参加に成功した場合のみ使用回数を確定する
+		if err := join(); err != nil {
+			return err
 		}
 
-		dInvites = append(dInvites, *dInvite)
+		used = true
+		return nil
+	}); err != nil {
+		return false, err
 	}
 
-	return dInvites, nil
+	return used, nil
 }
 
-func NewInviteRepository(i *do.Injector) (drepository.InviteRepository, error) {
+// Delete implements repository.InviteLinkRepository.
+func (i *inviteLinkRepository) Delete(c context.Context, id uuid.UUID) error {
+	if err := i.inviteStoreConnectionRDB.Write().WithContext(c).
+		Delete(&imodel.InviteLink{ID: id.String()}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete invite link. id=%v", id.String())
+	}
+
+	return nil
+}
+
+// DeleteExpired implements repository.InviteLinkRepository.
+func (i *inviteLinkRepository) DeleteExpired(c context.Context, now time.Time) (int, error) {
+	result := i.inviteStoreConnectionRDB.Write().WithContext(c).
+		Where("expire_at <= ? or uses >= max_uses", now).
+		Delete(&imodel.InviteLink{})
+	if result.Error != nil {
+		return 0, errors.Wrapf(result.Error, "failed to delete expired invite link. now=%v", now)
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+// DeleteByRoles implements repository.InviteLinkRepository.
+func (i *inviteLinkRepository) DeleteByRoles(c context.Context, roleIDs []uuid.UUID) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	parsedRoleIDs := lo.Map(roleIDs, func(id uuid.UUID, _ int) string { return id.String() })
+	if err := i.inviteStoreConnectionRDB.Write().WithContext(c).
+		Where("role_id in ?", parsedRoleIDs).
+		Delete(&imodel.InviteLink{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete invite link. role_ids=%v", parsedRoleIDs)
+	}
+
+	return nil
+}
+
+func (i *inviteLinkRepository) toInviteLink(link imodel.InviteLink) (*dmodel.InviteLink, error) {
+	var expireAt *time.Time
+	if link.ExpireAt.Valid {
+		expireAt = &link.ExpireAt.Time
+	} else {
+		expireAt = nil
+	}
+
+	var maxUses *int
+	if link.MaxUses.Valid {
+		v := int(link.MaxUses.Int64)
+		maxUses = &v
+	} else {
+		maxUses = nil
+	}
+
+	dLink, err := dfactory.NewInviteLink(link.ID, link.RoleID, link.Token, link.At, expireAt, maxUses, link.Uses)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse invite link. id=%v", link.ID)
+	}
+
+	return dLink, nil
+}
+
+func NewInviteLinkRepository(i *do.Injector) (drepository.InviteLinkRepository, error) {
 	inviteStoreConnectionRDB := do.MustInvoke[irdb.InviteStoreConnection](i)
-	return &inviteRepository{
+	return &inviteLinkRepository{
 		inviteStoreConnectionRDB: inviteStoreConnectionRDB,
 	}, nil
 }
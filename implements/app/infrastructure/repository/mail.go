@@ -0,0 +1,90 @@
+package repository
+
+import (
+	dmodel "app/domain/model"
+	drepository "app/domain/repository"
+	imail "app/infrastructure/adapter/mail"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+)
+
+type mailRepository struct {
+	mailConnection imail.MailConnection
+}
+
+// Send implements repository.MailRepository.
+func (m *mailRepository) Send(c context.Context, mail dmodel.Mail) error {
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %v\r\n", m.mailConnection.From())
+	fmt.Fprintf(&message, "To: %v\r\n", mail.To.String())
+	fmt.Fprintf(&message, "Subject: %v\r\n", mime.BEncoding.Encode("UTF-8", mail.Subject))
+	fmt.Fprintf(&message, "Date: %v\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprint(&message, "MIME-Version: 1.0\r\n")
+
+	if mail.HTMLBody == "" {
+		fmt.Fprint(&message, "Content-Type: text/plain; charset=UTF-8\r\n")
+		fmt.Fprint(&message, "Content-Transfer-Encoding: base64\r\n")
+		fmt.Fprint(&message, "\r\n")
+		writeBase64(&message, mail.Body)
+	} else {
+		// テキストと HTML を代替として送り、表示できる方をメールクライアントに選ばせる
+		writer := multipart.NewWriter(&message)
+		fmt.Fprintf(&message, "Content-Type: multipart/alternative; boundary=%v\r\n", writer.Boundary())
+		fmt.Fprint(&message, "\r\n")
+
+		for _, part := range []struct {
+			contentType string
+			body        string
+		}{
+			{contentType: "text/plain; charset=UTF-8", body: mail.Body},
+			{contentType: "text/html; charset=UTF-8", body: mail.HTMLBody},
+		} {
+			w, err := writer.CreatePart(textproto.MIMEHeader{
+				"Content-Type":              {part.contentType},
+				"Content-Transfer-Encoding": {"base64"},
+			})
+			if err != nil {
+				return errors.Wrapf(err, "failed to create mail part. to=%v", mail.To.String())
+			}
+
+			writeBase64(w, part.body)
+		}
+
+		if err := writer.Close(); err != nil {
+			return errors.Wrapf(err, "failed to close mail parts. to=%v", mail.To.String())
+		}
+	}
+
+	if err := m.mailConnection.Send([]string{mail.To.String()}, message.Bytes()); err != nil {
+		return errors.Wrapf(err, "failed to send mail. to=%v", mail.To.String())
+	}
+
+	return nil
+}
+
+// 1行76文字以内に折り返す
+func writeBase64(w io.Writer, v string) {
+	body := base64.StdEncoding.EncodeToString([]byte(v))
+	for len(body) > 76 {
+		fmt.Fprintf(w, "%v\r\n", body[:76])
+		body = body[76:]
+	}
+	fmt.Fprintf(w, "%v\r\n", body)
+}
+
+func NewMailRepository(i *do.Injector) (drepository.MailRepository, error) {
+	mailConnection := do.MustInvoke[imail.MailConnection](i)
+	return &mailRepository{
+		mailConnection: mailConnection,
+	}, nil
+}
@@ -0,0 +1,229 @@
+package repository
+
+import (
+	dmodel "app/domain/model"
+	imail "app/infrastructure/adapter/mail"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+// 受け取ったメールを記録するだけのSMTPサーバー
+type fakeSMTPServer struct {
+	listener net.Listener
+	from     string
+	to       []string
+	data     string
+	done     chan error
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen. err=%v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	s := &fakeSMTPServer{listener: listener, done: make(chan error, 1)}
+	go func() { s.done <- s.serve() }()
+
+	return s
+}
+
+func (s *fakeSMTPServer) serve() error {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	text := textproto.NewConn(conn)
+	if err := text.PrintfLine("220 localhost ESMTP"); err != nil {
+		return err
+	}
+
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return err
+		}
+
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			err = text.PrintfLine("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			s.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+			err = text.PrintfLine("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			s.to = append(s.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			err = text.PrintfLine("250 OK")
+		case command == "DATA":
+			if err := text.PrintfLine("354 start mail input"); err != nil {
+				return err
+			}
+
+			data, err := io.ReadAll(text.DotReader())
+			if err != nil {
+				return err
+			}
+			s.data = string(data)
+
+			err = text.PrintfLine("250 OK")
+		case command == "QUIT":
+			return text.PrintfLine("221 bye")
+		default:
+			err = text.PrintfLine("502 command not implemented")
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *fakeSMTPServer) connect(t *testing.T) imail.MailConnection {
+	t.Helper()
+
+	addr := s.listener.Addr().(*net.TCPAddr)
+	t.Setenv("SMTP_CONNECTION", fmt.Sprintf(`{"host":"127.0.0.1","port":%v,"from":"noreply@example.com"}`, addr.Port))
+
+	conn, err := imail.NewMailConnection(nil)
+	if err != nil {
+		t.Fatalf("failed to configure mail connection. err=%v", err)
+	}
+
+	return conn
+}
+
+func readBase64(t *testing.T, r io.Reader) string {
+	t.Helper()
+
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read body. err=%v", err)
+	}
+
+	// 1行76文字以内に折り返されていること
+	for _, line := range strings.Split(strings.TrimSpace(string(raw)), "\n") {
+		if len(strings.TrimSpace(line)) > 76 {
+			t.Errorf("line exceeds 76 characters. line=%v", line)
+		}
+	}
+
+	body, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, strings.NewReader(string(raw))))
+	if err != nil {
+		t.Fatalf("failed to decode body. err=%v", err)
+	}
+
+	return string(body)
+}
+
+func TestMailRepositorySend(t *testing.T) {
+	to, err := dmodel.NewEmailAddress("invitee@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		mail     dmodel.Mail
+		wantType string
+	}{
+		{
+			name: "text",
+			mail: dmodel.Mail{
+				To:      *to,
+				Subject: "コミュニティへの招待",
+				Body:    "招待されました。\n" + strings.Repeat("長い本文", 40),
+			},
+			wantType: "text/plain",
+		},
+		{
+			name: "text and html",
+			mail: dmodel.Mail{
+				To:       *to,
+				Subject:  "コミュニティへの招待",
+				Body:     "招待されました。",
+				HTMLBody: "<p>招待されました。</p>",
+			},
+			wantType: "multipart/alternative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeSMTPServer(t)
+			repository := &mailRepository{mailConnection: server.connect(t)}
+
+			if err := repository.Send(context.Background(), tt.mail); err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+			if err := <-server.done; err != nil {
+				t.Fatalf("fake smtp server error = %v", err)
+			}
+
+			if server.from != "noreply@example.com" {
+				t.Errorf("from = %v, want noreply@example.com", server.from)
+			}
+			if len(server.to) != 1 || server.to[0] != tt.mail.To.String() {
+				t.Errorf("to = %v, want [%v]", server.to, tt.mail.To.String())
+			}
+
+			message, err := mail.ReadMessage(strings.NewReader(server.data))
+			if err != nil {
+				t.Fatalf("failed to read message. err=%v", err)
+			}
+
+			subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+			if err != nil {
+				t.Fatalf("failed to decode subject. err=%v", err)
+			} else if subject != tt.mail.Subject {
+				t.Errorf("subject = %v, want %v", subject, tt.mail.Subject)
+			}
+
+			mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+			if err != nil {
+				t.Fatalf("failed to parse content type. err=%v", err)
+			} else if mediaType != tt.wantType {
+				t.Fatalf("content type = %v, want %v", mediaType, tt.wantType)
+			}
+
+			// 本文はパートごとに base64 で復元して比較する
+			bodies := map[string]string{}
+			if mediaType == "multipart/alternative" {
+				reader := multipart.NewReader(message.Body, params["boundary"])
+				for {
+					part, err := reader.NextRawPart()
+					if err == io.EOF {
+						break
+					} else if err != nil {
+						t.Fatalf("failed to read part. err=%v", err)
+					}
+
+					partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+					bodies[partType] = readBase64(t, part)
+				}
+			} else {
+				bodies[mediaType] = readBase64(t, message.Body)
+			}
+
+			if bodies["text/plain"] != tt.mail.Body {
+				t.Errorf("text body = %q, want %q", bodies["text/plain"], tt.mail.Body)
+			}
+			if tt.mail.HTMLBody != "" && bodies["text/html"] != tt.mail.HTMLBody {
+				t.Errorf("html body = %q, want %q", bodies["text/html"], tt.mail.HTMLBody)
+			}
+		})
+	}
+}
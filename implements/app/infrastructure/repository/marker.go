@@ -0,0 +1,221 @@
+package repository
+
+import (
+	dfactory "app/domain/factory"
+	dmodel "app/domain/model"
+	drepository "app/domain/repository"
+	irdb "app/infrastructure/adapter/datastore/rdb"
+	imodel "app/infrastructure/model"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type readMarkerRepository struct {
+	readMarkerStoreConnection irdb.MemberStoreConnection
+	messageStoreConnection    irdb.MessageStoreConnection
+}
+
+// SaveThread implements repository.ReadMarkerRepository.
+func (r *readMarkerRepository) SaveThread(c context.Context, marker dmodel.ThreadReadMarker) error {
+	// 既に読んだ位置より前のポストでは既読位置を戻さない
+	if err := r.readMarkerStoreConnection.Write().WithContext(c).
+		Clauses(clause.OnConflict{
+			DoUpdates: clause.Set{
+				{Column: clause.Column{Name: "post_id"}, Value: gorm.Expr("if(values(at) >= at, values(post_id), post_id)")},
+				{Column: clause.Column{Name: "at"}, Value: gorm.Expr("greatest(at, values(at))")},
+			},
+		}).
+		Create(&imodel.ThreadReadMarker{
+			MemberID: marker.MemberID.String(),
+			ThreadID: marker.ThreadID.String(),
+			PostID:   marker.PostID.String(),
+			At:       marker.At.Int(),
+		}).Error; err != nil {
+		return errors.Wrapf(err, "failed to save thread read marker. member_id=%v thread_id=%v", marker.MemberID.String(), marker.ThreadID.String())
+	}
+
+	return nil
+}
+
+// SaveTopic implements repository.ReadMarkerRepository.
+func (r *readMarkerRepository) SaveTopic(c context.Context, marker dmodel.TopicReadMarker) error {
+	// 既に読んだ位置より前のスレッドでは既読位置を戻さない
+	if err := r.readMarkerStoreConnection.Write().WithContext(c).
+		Clauses(clause.OnConflict{
+			DoUpdates: clause.Set{
+				{Column: clause.Column{Name: "thread_id"}, Value: gorm.Expr("if(values(at) >= at, values(thread_id), thread_id)")},
+				{Column: clause.Column{Name: "at"}, Value: gorm.Expr("greatest(at, values(at))")},
+			},
+		}).
+		Create(&imodel.TopicReadMarker{
+			MemberID: marker.MemberID.String(),
+			TopicID:  marker.TopicID.String(),
+			ThreadID: marker.ThreadID.String(),
+			At:       marker.At.Int(),
+		}).Error; err != nil {
+		return errors.Wrapf(err, "failed to save topic read marker. member_id=%v topic_id=%v", marker.MemberID.String(), marker.TopicID.String())
+	}
+
+	return nil
+}
+
+// ListThreadByMember implements repository.ReadMarkerRepository.
+func (r *readMarkerRepository) ListThreadByMember(c context.Context, memberID uuid.UUID, threadIDs []uuid.UUID) (map[uuid.UUID]dmodel.ThreadReadMarker, error) {
+	parsedThreadIDs := lo.Map(threadIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+	iMarkers := []imodel.ThreadReadMarker{}
+	if err := r.readMarkerStoreConnection.Read().WithContext(c).
+		Where("member_id = ? and thread_id in ?", memberID.String(), parsedThreadIDs).
+		Find(&iMarkers).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list thread read marker. member_id=%v thread_ids=%v", memberID.String(), parsedThreadIDs)
+	}
+
+	dMarkers := map[uuid.UUID]dmodel.ThreadReadMarker{}
+	for _, iMarker := range iMarkers {
+		dMarker, err := dfactory.NewThreadReadMarker(iMarker.MemberID, iMarker.ThreadID, iMarker.PostID, iMarker.At)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse thread read marker. member_id=%v thread_id=%v", iMarker.MemberID, iMarker.ThreadID)
+		}
+
+		dMarkers[dMarker.ThreadID] = *dMarker
+	}
+
+	return dMarkers, nil
+}
+
+// ListTopicByMember implements repository.ReadMarkerRepository.
+func (r *readMarkerRepository) ListTopicByMember(c context.Context, memberID uuid.UUID, topicIDs []uuid.UUID) (map[uuid.UUID]dmodel.TopicReadMarker, error) {
+	parsedTopicIDs := lo.Map(topicIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+	iMarkers := []imodel.TopicReadMarker{}
+	if err := r.readMarkerStoreConnection.Read().WithContext(c).
+		Where("member_id = ? and topic_id in ?", memberID.String(), parsedTopicIDs).
+		Find(&iMarkers).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list topic read marker. member_id=%v topic_ids=%v", memberID.String(), parsedTopicIDs)
+	}
+
+	dMarkers := map[uuid.UUID]dmodel.TopicReadMarker{}
+	for _, iMarker := range iMarkers {
+		dMarker, err := dfactory.NewTopicReadMarker(iMarker.MemberID, iMarker.TopicID, iMarker.ThreadID, iMarker.At)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse topic read marker. member_id=%v topic_id=%v", iMarker.MemberID, iMarker.TopicID)
+		}
+
+		dMarkers[dMarker.TopicID] = *dMarker
+	}
+
+	return dMarkers, nil
+}
+
+// SaveMessage implements repository.ReadMarkerRepository.
+func (r *readMarkerRepository) SaveMessage(c context.Context, marker dmodel.MessageReadMarker) error {
+	return saveMessageReadMarker(r.messageStoreConnection.Write().WithContext(c), marker)
+}
+
+// メッセージの作成と同じトランザクションでも使う
+func saveMessageReadMarker(tx *gorm.DB, marker dmodel.MessageReadMarker) error {
+	// 既に読んだ位置より前のメッセージでは既読位置を戻さない
+	if err := tx.
+		Clauses(clause.OnConflict{
+			DoUpdates: clause.Set{
+				{Column: clause.Column{Name: "message_id"}, Value: gorm.Expr("if(values(at) >= at, values(message_id), message_id)")},
+				{Column: clause.Column{Name: "at"}, Value: gorm.Expr("greatest(at, values(at))")},
+			},
+		}).
+		Create(&imodel.MessageReadMarker{
+			ConversationID: marker.ConversationID.String(),
+			UserID:         marker.UserID.String(),
+			MessageID:      marker.MessageID.String(),
+			At:             marker.At.Int(),
+		}).Error; err != nil {
+		return errors.Wrapf(err, "failed to save message read marker. conversation_id=%v user_id=%v", marker.ConversationID.String(), marker.UserID.String())
+	}
+
+	return nil
+}
+
+// ListMessageByConversation implements repository.ReadMarkerRepository.
+func (r *readMarkerRepository) ListMessageByConversation(c context.Context, conversationID uuid.UUID) ([]dmodel.MessageReadMarker, error) {
+	iMarkers := []imodel.MessageReadMarker{}
+	if err := r.messageStoreConnection.Read().WithContext(c).
+		Where("conversation_id = ?", conversationID.String()).
+		Find(&iMarkers).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list message read marker. conversation_id=%v", conversationID.String())
+	}
+
+	dMarkers := []dmodel.MessageReadMarker{}
+	for _, iMarker := range iMarkers {
+		dMarker, err := dfactory.NewMessageReadMarker(iMarker.ConversationID, iMarker.UserID, iMarker.MessageID, iMarker.At)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse message read marker. conversation_id=%v user_id=%v", iMarker.ConversationID, iMarker.UserID)
+		}
+
+		dMarkers = append(dMarkers, *dMarker)
+	}
+
+	return dMarkers, nil
+}
+
+// ListMessageByUser implements repository.ReadMarkerRepository.
+func (r *readMarkerRepository) ListMessageByUser(c context.Context, userID uuid.UUID, conversationIDs []uuid.UUID) (map[uuid.UUID]dmodel.MessageReadMarker, error) {
+	parsedConversationIDs := lo.Map(conversationIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+	iMarkers := []imodel.MessageReadMarker{}
+	if err := r.messageStoreConnection.Read().WithContext(c).
+		Where("user_id = ? and conversation_id in ?", userID.String(), parsedConversationIDs).
+		Find(&iMarkers).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list message read marker. user_id=%v conversation_ids=%v", userID.String(), parsedConversationIDs)
+	}
+
+	dMarkers := map[uuid.UUID]dmodel.MessageReadMarker{}
+	for _, iMarker := range iMarkers {
+		dMarker, err := dfactory.NewMessageReadMarker(iMarker.ConversationID, iMarker.UserID, iMarker.MessageID, iMarker.At)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse message read marker. conversation_id=%v user_id=%v", iMarker.ConversationID, iMarker.UserID)
+		}
+
+		dMarkers[dMarker.ConversationID] = *dMarker
+	}
+
+	return dMarkers, nil
+}
+
+// DeleteByMembers implements repository.ReadMarkerRepository.
+func (r *readMarkerRepository) DeleteByMembers(c context.Context, memberIDs []uuid.UUID) error {
+	if len(memberIDs) == 0 {
+		return nil
+	}
+
+	parsedMemberIDs := lo.Map(memberIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+	return r.readMarkerStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Where("member_id in ?", parsedMemberIDs).
+			Delete(&imodel.ThreadReadMarker{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete thread read marker. member_ids=%v", parsedMemberIDs)
+		}
+
+		if err := tx.
+			Where("member_id in ?", parsedMemberIDs).
+			Delete(&imodel.TopicReadMarker{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete topic read marker. member_ids=%v", parsedMemberIDs)
+		}
+
+		return nil
+	})
+}
+
+func NewReadMarkerRepository(i *do.Injector) (drepository.ReadMarkerRepository, error) {
+	readMarkerStoreConnection := do.MustInvoke[irdb.MemberStoreConnection](i)
+	messageStoreConnection := do.MustInvoke[irdb.MessageStoreConnection](i)
+	return &readMarkerRepository{
+		readMarkerStoreConnection: readMarkerStoreConnection,
+		messageStoreConnection:    messageStoreConnection,
+	}, nil
+}
@@ -7,11 +7,15 @@ import (
 	irdb "app/infrastructure/adapter/datastore/rdb"
 	imodel "app/infrastructure/model"
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/samber/do"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type memberRepository struct {
@@ -21,7 +25,7 @@ type memberRepository struct {
 // GetJoinedCommunityID implements repository.MemberRepository.
 func (m *memberRepository) GetJoinedCommunityID(c context.Context, memberID uuid.UUID) (*uuid.UUID, error) {
 	relation := imodel.MemberCommunityRelation{}
-	if err := m.memberStoreConnectionRDB.Read().
+	if err := m.memberStoreConnectionRDB.Read().WithContext(c).
 		Where("member_id = ?", memberID.String()).
 		First(&relation).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -42,7 +46,7 @@ func (m *memberRepository) GetJoinedCommunityID(c context.Context, memberID uuid
 // ListByUser implements repository.MemberRepository.
 func (m *memberRepository) ListByUser(c context.Context, userID uuid.UUID) ([]dmodel.Member, error) {
 	members := []imodel.Member{}
-	if err := m.memberStoreConnectionRDB.Read().
+	if err := m.memberStoreConnectionRDB.Read().WithContext(c).
 		Where("user_id = ?", userID.String()).
 		Find(&members).Error; err != nil {
 		return nil, errors.Wrapf(err, "failed to list member. user_id=%v", userID.String())
@@ -61,10 +65,45 @@ func (m *memberRepository) ListByUser(c context.Context, userID uuid.UUID) ([]dm
 	return dMembers, nil
 }
 
+// ListJoinedCommunityIDByUsers implements repository.MemberRepository.
+func (m *memberRepository) ListJoinedCommunityIDByUsers(c context.Context, userIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	parsedUserIDs := lo.Map(userIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+	rows := []struct {
+		UserID      string
+		CommunityID string
+	}{}
+	if err := m.memberStoreConnectionRDB.Read().WithContext(c).
+		Model(&imodel.Member{}).
+		Select("members.user_id as user_id, member_community_relations.community_id as community_id").
+		Joins("inner join member_community_relations on members.id = member_community_relations.member_id").
+		Where("members.user_id in ?", parsedUserIDs).
+		Scan(&rows).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list community_relation. user_ids=%v", parsedUserIDs)
+	}
+
+	communityIDs := map[uuid.UUID][]uuid.UUID{}
+	for _, row := range rows {
+		userID, err := uuid.Parse(row.UserID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse user id. id=%v", row.UserID)
+		}
+
+		communityID, err := uuid.Parse(row.CommunityID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse community id. id=%v", row.CommunityID)
+		}
+
+		communityIDs[userID] = append(communityIDs[userID], communityID)
+	}
+
+	return communityIDs, nil
+}
+
 // GetByCommunityAndUser implements repository.MemberRepository.
 func (m *memberRepository) GetByCommunityAndUser(c context.Context, communityID uuid.UUID, userID uuid.UUID) (*dmodel.Member, error) {
 	member := imodel.Member{}
-	if err := m.memberStoreConnectionRDB.Read().
+	if err := m.memberStoreConnectionRDB.Read().WithContext(c).
 		Model(&imodel.Member{}).
 		Select("members.id as id, members.user_id as user_id, members.role_id as role_id").
 		Joins("inner join member_community_relations on members.id = member_community_relations.member_id").
@@ -90,7 +129,7 @@ func (m *memberRepository) GetByCommunityAndUser(c context.Context, communityID
 func (m *memberRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Member, error) {
 	member := imodel.Member{ID: id.String()}
 
-	if err := m.memberStoreConnectionRDB.Read().
+	if err := m.memberStoreConnectionRDB.Read().WithContext(c).
 		First(&member).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -99,39 +138,72 @@ func (m *memberRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Member,
 		return nil, errors.Wrapf(err, "failed to get member. id=%v", id.String())
 	}
 
-	return dfactory.NewMember(member.RoleID, member.UserID, member.RoleID)
+	return dfactory.NewMember(member.ID, member.UserID, member.RoleID)
 }
 
-// ListByCommunity implements repository.MemberRepository.
-func (m *memberRepository) ListByCommunity(c context.Context, communityID uuid.UUID, page dmodel.Range) ([]dmodel.Member, error) {
+// List implements repository.MemberRepository.
+func (m *memberRepository) List(c context.Context, ids []uuid.UUID) ([]dmodel.Member, error) {
 	members := []imodel.Member{}
-	if err := m.memberStoreConnectionRDB.Read().
+	if err := m.memberStoreConnectionRDB.Read().WithContext(c).
+		Where("id in ?", lo.Map(ids, func(id uuid.UUID, _ int) string { return id.String() })).
+		Find(&members).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list member. ids=%v", ids)
+	}
+
+	dMembers := []dmodel.Member{}
+	for _, member := range members {
+		dMember, err := dfactory.NewMember(member.ID, member.UserID, member.RoleID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse member. id=%v", member.ID)
+		}
+
+		dMembers = append(dMembers, *dMember)
+	}
+
+	return dMembers, nil
+}
+
+// ListByCommunity implements repository.MemberRepository.
+func (m *memberRepository) ListByCommunity(c context.Context, communityID uuid.UUID, page dmodel.Range) ([]dmodel.Member, *dmodel.Cursor, error) {
+	members := []struct {
+		imodel.Member
+		CreatedAt time.Time
+	}{}
+	if err := paginate(m.memberStoreConnectionRDB.Read().WithContext(c).
 		Model(&imodel.Member{}).
-		Select("members.id as id, members.user_id as user_id, members.role_id as role_id").
+		Select("members.id as id, members.user_id as user_id, members.role_id as role_id, members.created_at as created_at").
 		Joins("inner join member_community_relations on members.id = member_community_relations.member_id").
-		Where("member_community_relations.community_id = ?", communityID.String()).
-		Order("members.created_at asc").
-		Limit(page.Limit).Offset(page.Offset).
+		Where("member_community_relations.community_id = ?", communityID.String()), page, "members.created_at", "members.id").
 		Scan(&members).Error; err != nil {
-		return nil, errors.Wrapf(err, "failed to list member. community_id=%v", communityID.String())
+		return nil, nil, errors.Wrapf(err, "failed to list member. community_id=%v", communityID.String())
 	}
 
 	dMembers := []dmodel.Member{}
 	for _, member := range members {
 		dMember, err := dfactory.NewMember(member.ID, member.UserID, member.RoleID)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to parse member. id=%v", member.ID)
+			return nil, nil, errors.Wrapf(err, "failed to parse member. id=%v", member.ID)
 		}
 
 		dMembers = append(dMembers, *dMember)
 	}
 
-	return dMembers, nil
+	if len(members) == 0 {
+		return dMembers, nil, nil
+	}
+
+	last := members[len(members)-1]
+	next, err := nextCursor(page, len(members), last.CreatedAt, last.ID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. id=%v", last.ID)
+	}
+
+	return dMembers, next, nil
 }
 
 // Create implements repository.MemberRepository.
 func (m *memberRepository) Create(c context.Context, member dmodel.Member, mention dmodel.Mention) error {
-	return m.memberStoreConnectionRDB.Write().Transaction(func(tx *gorm.DB) error {
+	return m.memberStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
 			Create(&imodel.Member{
 				ID:     member.ID.String(),
@@ -157,9 +229,254 @@ func (m *memberRepository) Create(c context.Context, member dmodel.Member, menti
 	})
 }
 
+// UpdateRole implements repository.MemberRepository.
+func (m *memberRepository) UpdateRole(c context.Context, id uuid.UUID, roleID uuid.UUID, managerRoleIDs []uuid.UUID) (bool, error) {
+	updated := false
+	if err := m.memberStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		// 管理できるロールへの変更は管理者を減らさない
+		if !lo.Contains(managerRoleIDs, roleID) {
+			if ok, err := m.keepsManager(tx, id, managerRoleIDs); err != nil || !ok {
+				return err
+			}
+		}
+
+		if err := tx.
+			Model(&imodel.Member{ID: id.String()}).
+			Update("role_id", roleID.String()).Error; err != nil {
+			return errors.Wrapf(err, "failed to update member role. id=%v role_id=%v", id.String(), roleID.String())
+		}
+
+		updated = true
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return updated, nil
+}
+
+// Delete implements repository.MemberRepository.
+func (m *memberRepository) Delete(c context.Context, id uuid.UUID, managerRoleIDs []uuid.UUID) (bool, error) {
+	deleted := false
+	if err := m.memberStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if ok, err := m.keepsManager(tx, id, managerRoleIDs); err != nil || !ok {
+			return err
+		}
+
+		if err := tx.
+			Where("member_id = ?", id.String()).
+			Delete(&imodel.MemberCommunityRelation{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete community_relation. member_id=%v", id.String())
+		}
+
+		if err := tx.
+			Delete(&imodel.Member{ID: id.String()}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete member. id=%v", id.String())
+		}
+
+		deleted = true
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return deleted, nil
+}
+
+// 管理できるメンバーをロックし、対象が外れても1人以上残るかを返す
+func (m *memberRepository) keepsManager(tx *gorm.DB, id uuid.UUID, managerRoleIDs []uuid.UUID) (bool, error) {
+	if len(managerRoleIDs) == 0 {
+		return true, nil
+	}
+
+	managers := []imodel.Member{}
+	if err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("role_id in ?", lo.Map(managerRoleIDs, func(id uuid.UUID, _ int) string { return id.String() })).
+		Find(&managers).Error; err != nil {
+		return false, errors.Wrapf(err, "failed to lock manager. role_ids=%v", managerRoleIDs)
+	}
+
+	if !lo.ContainsBy(managers, func(manager imodel.Member) bool { return manager.ID == id.String() }) {
+		return true, nil
+	}
+
+	return len(managers) > 1, nil
+}
+
+// ListIDsByCommunity implements repository.MemberRepository.
+func (m *memberRepository) ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error) {
+	return m.listIDsByCommunity(m.memberStoreConnectionRDB.Read().WithContext(c), communityID)
+}
+
+// DeleteByCommunity implements repository.MemberRepository.
+func (m *memberRepository) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	return m.memberStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		memberIDs, err := m.listIDsByCommunity(tx, communityID)
+		if err != nil {
+			return err
+		} else if len(memberIDs) == 0 {
+			return nil
+		}
+
+		if err := tx.
+			Where("community_id = ?", communityID.String()).
+			Delete(&imodel.MemberCommunityRelation{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete community_relation. community_id=%v", communityID.String())
+		}
+
+		if err := tx.
+			Where("id in ?", lo.Map(memberIDs, func(id uuid.UUID, _ int) string { return id.String() })).
+			Delete(&imodel.Member{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete member. community_id=%v", communityID.String())
+		}
+
+		return nil
+	})
+}
+
+func (m *memberRepository) listIDsByCommunity(db *gorm.DB, communityID uuid.UUID) ([]uuid.UUID, error) {
+	relations := []imodel.MemberCommunityRelation{}
+	if err := db.
+		Where("community_id = ?", communityID.String()).
+		Find(&relations).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list community_relation. community_id=%v", communityID.String())
+	}
+
+	memberIDs := []uuid.UUID{}
+	for _, relation := range relations {
+		memberID, err := uuid.Parse(relation.MemberID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse member id. id=%v", relation.MemberID)
+		}
+
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	return memberIDs, nil
+}
+
+// CountByCommunities implements repository.MemberRepository.
+func (m *memberRepository) CountByCommunities(c context.Context, communityIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	parsedCommunityIDs := lo.Map(communityIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+	rows := []struct {
+		CommunityID string
+		Count       int
+	}{}
+	if err := m.memberStoreConnectionRDB.Read().WithContext(c).
+		Model(&imodel.MemberCommunityRelation{}).
+		Select("community_id, count(*) as count").
+		Where("community_id in ?", parsedCommunityIDs).
+		Group("community_id").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to count member. community_ids=%v", parsedCommunityIDs)
+	}
+
+	counts := map[uuid.UUID]int{}
+	for _, row := range rows {
+		communityID, err := uuid.Parse(row.CommunityID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse community id. id=%v", row.CommunityID)
+		}
+
+		counts[communityID] = row.Count
+	}
+
+	return counts, nil
+}
+
 func NewMemberRepository(i *do.Injector) (drepository.MemberRepository, error) {
 	memberStoreConnectionRDB := do.MustInvoke[irdb.MemberStoreConnection](i)
 	return &memberRepository{
 		memberStoreConnectionRDB: memberStoreConnectionRDB,
 	}, nil
 }
+
+type banRepository struct {
+	memberStoreConnectionRDB irdb.MemberStoreConnection
+}
+
+// Save implements repository.BanRepository.
+func (b *banRepository) Save(c context.Context, ban dmodel.Ban) error {
+	var reason sql.NullString
+	if ban.Reason != nil {
+		reason = sql.NullString{String: ban.Reason.String(), Valid: true}
+	}
+
+	var expireAt sql.NullTime
+	if ban.ExpireAt != nil {
+		expireAt = sql.NullTime{Time: *ban.ExpireAt, Valid: true}
+	}
+
+	if err := b.memberStoreConnectionRDB.Write().WithContext(c).
+		Save(&imodel.Ban{
+			CommunityID: ban.CommunityID.String(),
+			UserID:      ban.UserID.String(),
+			Reason:      reason,
+			At:          ban.At,
+			ExpireAt:    expireAt,
+		}).Error; err != nil {
+		return errors.Wrapf(err, "failed to save ban. community_id=%v user_id=%v", ban.CommunityID.String(), ban.UserID.String())
+	}
+
+	return nil
+}
+
+// Get implements repository.BanRepository.
+func (b *banRepository) Get(c context.Context, communityID uuid.UUID, userID uuid.UUID) (*dmodel.Ban, error) {
+	ban := imodel.Ban{CommunityID: communityID.String(), UserID: userID.String()}
+	if err := b.memberStoreConnectionRDB.Read().WithContext(c).
+		First(&ban).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get ban. community_id=%v user_id=%v", communityID.String(), userID.String())
+	}
+
+	var reason *string
+	if ban.Reason.Valid {
+		reason = &ban.Reason.String
+	}
+
+	var expireAt *time.Time
+	if ban.ExpireAt.Valid {
+		expireAt = &ban.ExpireAt.Time
+	}
+
+	dBan, err := dfactory.NewBan(ban.CommunityID, ban.UserID, reason, ban.At, expireAt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse ban. community_id=%v user_id=%v", ban.CommunityID, ban.UserID)
+	}
+
+	return dBan, nil
+}
+
+// Delete implements repository.BanRepository.
+func (b *banRepository) Delete(c context.Context, communityID uuid.UUID, userID uuid.UUID) error {
+	if err := b.memberStoreConnectionRDB.Write().WithContext(c).
+		Delete(&imodel.Ban{CommunityID: communityID.String(), UserID: userID.String()}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete ban. community_id=%v user_id=%v", communityID.String(), userID.String())
+	}
+
+	return nil
+}
+
+// DeleteByCommunity implements repository.BanRepository.
+func (b *banRepository) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	if err := b.memberStoreConnectionRDB.Write().WithContext(c).
+		Where("community_id = ?", communityID.String()).
+		Delete(&imodel.Ban{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete ban. community_id=%v", communityID.String())
+	}
+
+	return nil
+}
+
+func NewBanRepository(i *do.Injector) (drepository.BanRepository, error) {
+	memberStoreConnectionRDB := do.MustInvoke[irdb.MemberStoreConnection](i)
+	return &banRepository{
+		memberStoreConnectionRDB: memberStoreConnectionRDB,
+	}, nil
+}
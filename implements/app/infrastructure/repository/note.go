@@ -8,12 +8,14 @@ import (
 	irdb "app/infrastructure/adapter/datastore/rdb"
 	imodel "app/infrastructure/model"
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/samber/do"
 	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -29,7 +31,7 @@ func (n *noteRepository) GetbyResource(c context.Context, mention dmodel.Mention
 	notes := []imodel.Note{}
 	switch mention.Resource {
 	case dmodel.ResourceUser:
-		if err := n.noteStoreConnectionRDB.Read().
+		if err := n.noteStoreConnectionRDB.Read().WithContext(c).
 			Model(&imodel.Note{}).
 			Select("notes.id as id").
 			Joins("inner join note_user_relations on notes.id = note_user_relations.note_id").
@@ -39,7 +41,7 @@ func (n *noteRepository) GetbyResource(c context.Context, mention dmodel.Mention
 			return nil, errors.Wrapf(err, "failed to list note. user_id=%v", mention.ID.String())
 		}
 	case dmodel.ResourceCommunity:
-		if err := n.noteStoreConnectionRDB.Read().
+		if err := n.noteStoreConnectionRDB.Read().WithContext(c).
 			Model(&imodel.Note{}).
 			Select("notes.id as id").
 			Joins("inner join note_community_relations on notes.id = note_community_relations.note_id").
@@ -48,6 +50,16 @@ func (n *noteRepository) GetbyResource(c context.Context, mention dmodel.Mention
 			Scan(&notes).Error; err != nil {
 			return nil, errors.Wrapf(err, "failed to list note. user_id=%v", mention.ID.String())
 		}
+	case dmodel.ResourcePage:
+		if err := n.noteStoreConnectionRDB.Read().WithContext(c).
+			Model(&imodel.Note{}).
+			Select("notes.id as id").
+			Joins("inner join note_page_relations on notes.id = note_page_relations.note_id").
+			Where("note_page_relations.page_id = ?", mention.ID.String()).
+			Order("notes.created_at asc").
+			Scan(&notes).Error; err != nil {
+			return nil, errors.Wrapf(err, "failed to list note. page_id=%v", mention.ID.String())
+		}
 	}
 
 	if len(notes) < 1 {
@@ -65,7 +77,7 @@ func (n *noteRepository) GetbyResource(c context.Context, mention dmodel.Mention
 
 // UpdateLine implements repository.NoteRepository.
 func (n *noteRepository) UpdateLine(c context.Context, line dmodel.Line) error {
-	return n.noteStoreConnectionRDB.Write().Transaction(func(tx *gorm.DB) error {
+	return n.noteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
 			Clauses(clause.Locking{Strength: "UPDATE"}).
 			Where("note_id = ?", line.NoteID.String()).
@@ -74,14 +86,6 @@ func (n *noteRepository) UpdateLine(c context.Context, line dmodel.Line) error {
 			return errors.Wrapf(err, "fialed to lock lines. note_id=%v", line.NoteID.String())
 		}
 
-		if err := tx.
-			Where("line_id = ?", line.ID.String()).
-			Delete(&imodel.ContentLineRelation{}).Error; err != nil {
-			if !errors.Is(err, gorm.ErrRecordNotFound) {
-				return errors.Wrapf(err, "fialed to delete line content relations. line_id=%v", line.ID.String())
-			}
-		}
-
 		option, err := idocument.Unmarshal(&imodel.LineProperty{
 			LineID: line.ID.String(),
 		})
@@ -118,7 +122,7 @@ func (n *noteRepository) UpdateLine(c context.Context, line dmodel.Line) error {
 func (n *noteRepository) GetLineByOrder(c context.Context, noteID uuid.UUID, order dmodel.OrderNumber) (*dmodel.Line, error) {
 	line := imodel.Line{}
 
-	if err := n.noteStoreConnectionRDB.Read().
+	if err := n.noteStoreConnectionRDB.Read().WithContext(c).
 		Where("note_id = ? and order_number = ?", noteID.String(), order.Int()).
 		First(&line).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -145,7 +149,7 @@ func (n *noteRepository) GetLineByOrder(c context.Context, noteID uuid.UUID, ord
 
 // DeleteLine implements repository.NoteRepository.
 func (n *noteRepository) DeleteLine(c context.Context, noteID uuid.UUID, order dmodel.OrderNumber) (*dmodel.Line, error) {
-	tx := n.noteStoreConnectionRDB.Write().Begin()
+	tx := n.noteStoreConnectionRDB.Write().WithContext(c).Begin()
 	defer func() {
 		if r := recover(); r != nil {
 			tx.Rollback()
@@ -227,9 +231,52 @@ func (n *noteRepository) DeleteLine(c context.Context, noteID uuid.UUID, order d
 	return deletedLine, tx.Commit().Error
 }
 
+// Delete implements repository.NoteRepository.
+func (n *noteRepository) Delete(c context.Context, id uuid.UUID) error {
+	return n.noteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		lines := []imodel.Line{}
+		if err := tx.
+			Where("note_id = ?", id.String()).
+			Find(&lines).Error; err != nil {
+			return errors.Wrapf(err, "failed to list lines. note_id=%v", id.String())
+		}
+
+		if len(lines) > 0 {
+			if _, err := n.noteStoreConnectionDocument.DB().
+				Collection(imodel.LineProperty{}.Collection()).
+				DeleteMany(c, bson.M{"line_id": bson.M{"$in": lo.Map(lines, func(line imodel.Line, _ int) string { return line.ID })}}); err != nil {
+				return errors.Wrapf(err, "failed to delete line properties. note_id=%v", id.String())
+			}
+		}
+
+		for _, model := range []any{
+			&imodel.Line{},
+			&imodel.NoteVersion{},
+			&imodel.NoteUserRelation{},
+			&imodel.NoteCommunityRelation{},
+			&imodel.NotePageRelation{},
+		} {
+			if err := tx.
+				Where("note_id = ?", id.String()).
+				Delete(model).Error; err != nil {
+				return errors.Wrapf(err, "failed to delete note relation. note_id=%v", id.String())
+			}
+		}
+
+		if err := tx.
+			Delete(&imodel.Note{
+				ID: id.String(),
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete note. id=%v", id.String())
+		}
+
+		return nil
+	})
+}
+
 // MoveLine implements repository.NoteRepository.
 func (n *noteRepository) MoveLine(c context.Context, noteID uuid.UUID, src dmodel.OrderNumber, dst dmodel.OrderNumber) error {
-	return n.noteStoreConnectionRDB.Write().Transaction(func(tx *gorm.DB) error {
+	return n.noteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
 			Clauses(clause.Locking{Strength: "UPDATE"}).
 			Where("note_id = ?", noteID.String()).
@@ -303,7 +350,7 @@ func (n *noteRepository) MoveLine(c context.Context, noteID uuid.UUID, src dmode
 
 // InsertLine implements repository.NoteRepository.
 func (n *noteRepository) InsertLine(c context.Context, dLine dmodel.Line) error {
-	return n.noteStoreConnectionRDB.Write().Transaction(func(tx *gorm.DB) error {
+	return n.noteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
 			Clauses(clause.Locking{Strength: "UPDATE"}).
 			Where("note_id = ?", dLine.NoteID.String()).
@@ -339,11 +386,338 @@ func (n *noteRepository) InsertLine(c context.Context, dLine dmodel.Line) error
 	})
 }
 
+// InsertLines implements repository.NoteRepository.
+// 指定した行から連続して挿入し、挿入後の行番号を返す
+// 内容は行をロックしたまま write で書き込み、失敗した場合は行を挿入しない
+func (n *noteRepository) InsertLines(c context.Context, noteID uuid.UUID, order dmodel.OrderNumber, dLines []dmodel.Line, write func() error) ([]dmodel.OrderNumber, error) {
+	orders := []dmodel.OrderNumber{}
+
+	// 行の属性は RDB のトランザクションで戻せないため、まだ参照されない新しい行の属性として先に作る
+	if err := n.insertLineProperties(c, dLines); err != nil {
+		return nil, err
+	}
+
+	if err := n.noteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("note_id = ?", noteID.String()).
+			Order("order_number asc").
+			Find(&[]imodel.Line{}).Error; err != nil {
+			return errors.Wrapf(err, "fialed to lock lines. note_id=%v", noteID.String())
+		}
+
+		var lastLine imodel.Line
+		if err := tx.
+			Where("note_id = ?", noteID.String()).
+			Order("order_number desc").
+			First(&lastLine).Error; err != nil {
+			return errors.Wrapf(err, "fialed to get lines. note_id=%v", noteID.String())
+		}
+
+		orderToInsert := lo.Min([]int{lastLine.Order, order.Int()})
+
+		if err := tx.
+			Model(&imodel.Line{}).
+			Where("note_id = ? and order_number >= ?", noteID.String(), orderToInsert).
+			Order("order_number desc").
+			Update("order_number", gorm.Expr("order_number + ?", len(dLines))).Error; err != nil {
+			return errors.Wrapf(err, "fialed to update lines order. note_id=%v", noteID.String())
+		}
+
+		lines := lo.Map(dLines, func(dLine dmodel.Line, i int) imodel.Line {
+			return imodel.Line{
+				ID:     dLine.ID.String(),
+				NoteID: noteID.String(),
+				Order:  orderToInsert + i,
+			}
+		})
+
+		if err := tx.
+			Create(&lines).Error; err != nil {
+			return errors.Wrapf(err, "failed to create lines. note_id=%v", noteID.String())
+		}
+
+		if err := write(); err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			orders = append(orders, dmodel.OrderNumber(line.Order))
+		}
+
+		return nil
+	}); err != nil {
+		if deleteErr := n.deleteLineProperties(c, lo.Map(dLines, func(dLine dmodel.Line, _ int) string { return dLine.ID.String() })); deleteErr != nil {
+			return nil, errors.Wrapf(err, "failed to insert lines and delete line properties. note_id=%v delete_err=%v", noteID.String(), deleteErr)
+		}
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// UpdateLines implements repository.NoteRepository.
+// 行をロックしたまま属性と内容を書き換え、行が削除されていた場合は更新しない
+// 内容の書き込みに失敗した場合は属性を元に戻す
+func (n *noteRepository) UpdateLines(c context.Context, noteID uuid.UUID, dLines []dmodel.Line, write func() error) (bool, error) {
+	updated := false
+
+	if err := n.noteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		lineIDs := lo.Map(dLines, func(dLine dmodel.Line, _ int) string { return dLine.ID.String() })
+
+		lines := []imodel.Line{}
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("note_id = ?", noteID.String()).
+			Order("order_number asc").
+			Find(&lines).Error; err != nil {
+			return errors.Wrapf(err, "fialed to lock lines. note_id=%v", noteID.String())
+		}
+
+		existing := lo.Map(lines, func(line imodel.Line, _ int) string { return line.ID })
+		if !lo.Every(existing, lineIDs) {
+			return nil
+		}
+
+		currentProperties, err := n.listLineProperties(c, lineIDs)
+		if err != nil {
+			return err
+		}
+
+		if err := n.deleteLineProperties(c, lineIDs); err != nil {
+			return err
+		}
+
+		if err := n.insertLineProperties(c, dLines); err != nil {
+			return n.restoreLineProperties(c, lineIDs, currentProperties, err)
+		}
+
+		if err := write(); err != nil {
+			return n.restoreLineProperties(c, lineIDs, currentProperties, err)
+		}
+
+		updated = true
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return updated, nil
+}
+
+// DeleteLines implements repository.NoteRepository.
+// 範囲の両端を含めて削除し、削除した行を返す
+// check はロックした行数で削除できるかを判定し、エラーの場合は削除しない
+func (n *noteRepository) DeleteLines(c context.Context, noteID uuid.UUID, from dmodel.OrderNumber, to dmodel.OrderNumber, check func(count int) error) ([]dmodel.Line, error) {
+	deleteLines := []imodel.Line{}
+
+	if err := n.noteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		lines := []imodel.Line{}
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("note_id = ?", noteID.String()).
+			Order("order_number asc").
+			Find(&lines).Error; err != nil {
+			return errors.Wrapf(err, "fialed to lock lines. note_id=%v", noteID.String())
+		}
+
+		if err := check(len(lines)); err != nil {
+			return err
+		}
+
+		if err := tx.
+			Where("note_id = ? and order_number between ? and ?", noteID.String(), from.Int(), to.Int()).
+			Order("order_number asc").
+			Find(&deleteLines).Error; err != nil {
+			return errors.Wrapf(err, "fialed to get lines. note_id=%v from=%v to=%v", noteID.String(), from.Int(), to.Int())
+		}
+
+		if len(deleteLines) == 0 {
+			return nil
+		}
+
+		if err := tx.
+			Where("note_id = ? and order_number between ? and ?", noteID.String(), from.Int(), to.Int()).
+			Delete(&imodel.Line{}).Error; err != nil {
+			return errors.Wrapf(err, "fialed to delete lines. note_id=%v from=%v to=%v", noteID.String(), from.Int(), to.Int())
+		}
+
+		if err := tx.
+			Model(&imodel.Line{}).
+			Where("note_id = ? and order_number > ?", noteID.String(), to.Int()).
+			Order("order_number asc").
+			Update("order_number", gorm.Expr("order_number - ?", len(deleteLines))).Error; err != nil {
+			return errors.Wrapf(err, "fialed to update lines order. note_id=%v", noteID.String())
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// 削除した行の属性は参照されなくなったため、最後に消す
+	if err := n.deleteLineProperties(c, lo.Map(deleteLines, func(line imodel.Line, _ int) string { return line.ID })); err != nil {
+		return nil, err
+	}
+
+	dLines := []dmodel.Line{}
+	for _, line := range deleteLines {
+		dLine, err := dfactory.NewLine(line.ID, line.NoteID, line.Order, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fialed to parse line. id=%v", line.ID)
+		}
+
+		dLines = append(dLines, *dLine)
+	}
+
+	return dLines, nil
+}
+
+// ReplaceLines implements repository.NoteRepository.
+// ノートのすべての行を 1 つのトランザクションで置き換え、置き換える前の行を返す
+// 行の属性は RDB のトランザクションで戻せないため、まだ参照されない新しい行の属性として先に作る
+func (n *noteRepository) ReplaceLines(c context.Context, noteID uuid.UUID, dLines []dmodel.Line) ([]dmodel.Line, error) {
+	currentLines := []imodel.Line{}
+
+	if err := n.insertLineProperties(c, dLines); err != nil {
+		return nil, err
+	}
+
+	if err := n.noteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("note_id = ?", noteID.String()).
+			Order("order_number asc").
+			Find(&currentLines).Error; err != nil {
+			return errors.Wrapf(err, "fialed to lock lines. note_id=%v", noteID.String())
+		}
+
+		if err := tx.
+			Where("note_id = ?", noteID.String()).
+			Delete(&imodel.Line{}).Error; err != nil {
+			return errors.Wrapf(err, "fialed to delete lines. note_id=%v", noteID.String())
+		}
+
+		lines := lo.Map(dLines, func(dLine dmodel.Line, _ int) imodel.Line {
+			return imodel.Line{
+				ID:     dLine.ID.String(),
+				NoteID: noteID.String(),
+				Order:  dLine.Order.Int(),
+			}
+		})
+
+		if err := tx.
+			Create(&lines).Error; err != nil {
+			return errors.Wrapf(err, "failed to create lines. note_id=%v", noteID.String())
+		}
+
+		return nil
+	}); err != nil {
+		if deleteErr := n.deleteLineProperties(c, lo.Map(dLines, func(dLine dmodel.Line, _ int) string { return dLine.ID.String() })); deleteErr != nil {
+			return nil, errors.Wrapf(err, "failed to replace lines and delete line properties. note_id=%v delete_err=%v", noteID.String(), deleteErr)
+		}
+		return nil, err
+	}
+
+	// 置き換える前の行の属性は参照されなくなったため、最後に消す
+	if err := n.deleteLineProperties(c, lo.Map(currentLines, func(line imodel.Line, _ int) string { return line.ID })); err != nil {
+		return nil, err
+	}
+
+	dCurrentLines := []dmodel.Line{}
+	for _, line := range currentLines {
+		dLine, err := dfactory.NewLine(line.ID, line.NoteID, line.Order, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "fialed to parse line. id=%v", line.ID)
+		}
+
+		dCurrentLines = append(dCurrentLines, *dLine)
+	}
+
+	return dCurrentLines, nil
+}
+
+func (n *noteRepository) insertLineProperties(c context.Context, dLines []dmodel.Line) error {
+	properties := []any{}
+	for _, dLine := range dLines {
+		if dLine.Property == nil {
+			continue
+		}
+
+		properties = append(properties, &imodel.LineProperty{
+			LineID: dLine.ID.String(),
+			Type:   dLine.Property.Type.String(),
+		})
+	}
+
+	if len(properties) == 0 {
+		return nil
+	}
+
+	if _, err := n.noteStoreConnectionDocument.DB().
+		Collection(imodel.LineProperty{}.Collection()).
+		InsertMany(c, properties); err != nil {
+		return errors.Wrapf(err, "failed to create line properties. count=%v", len(properties))
+	}
+
+	return nil
+}
+
+func (n *noteRepository) listLineProperties(c context.Context, lineIDs []string) ([]imodel.LineProperty, error) {
+	properties := []imodel.LineProperty{}
+
+	cursor, err := n.noteStoreConnectionDocument.DB().
+		Collection(imodel.LineProperty{}.Collection()).
+		Find(c, bson.M{"line_id": bson.M{"$in": lineIDs}})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list line properties. line_ids=%v", lineIDs)
+	}
+
+	if err := cursor.All(c, &properties); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode line properties. line_ids=%v", lineIDs)
+	}
+
+	return properties, nil
+}
+
+// 書き換える前の属性に戻し、元のエラーを返す
+func (n *noteRepository) restoreLineProperties(c context.Context, lineIDs []string, properties []imodel.LineProperty, err error) error {
+	if deleteErr := n.deleteLineProperties(c, lineIDs); deleteErr != nil {
+		return errors.Wrapf(err, "failed to restore line properties. line_ids=%v restore_err=%v", lineIDs, deleteErr)
+	}
+
+	if len(properties) == 0 {
+		return err
+	}
+
+	if _, insertErr := n.noteStoreConnectionDocument.DB().
+		Collection(imodel.LineProperty{}.Collection()).
+		InsertMany(c, lo.Map(properties, func(property imodel.LineProperty, _ int) any { return &property })); insertErr != nil {
+		return errors.Wrapf(err, "failed to restore line properties. line_ids=%v restore_err=%v", lineIDs, insertErr)
+	}
+
+	return err
+}
+
+func (n *noteRepository) deleteLineProperties(c context.Context, lineIDs []string) error {
+	if len(lineIDs) == 0 {
+		return nil
+	}
+
+	if _, err := n.noteStoreConnectionDocument.DB().
+		Collection(imodel.LineProperty{}.Collection()).
+		DeleteMany(c, bson.M{"line_id": bson.M{"$in": lineIDs}}); err != nil {
+		return errors.Wrapf(err, "failed to delete line properties. line_ids=%v", lineIDs)
+	}
+
+	return nil
+}
+
 // ListLines implements repository.NoteRepository.
 func (n *noteRepository) ListLines(c context.Context, noteID uuid.UUID) ([]dmodel.Line, error) {
 	lines := []imodel.Line{}
 
-	if err := n.noteStoreConnectionRDB.Read().
+	if err := n.noteStoreConnectionRDB.Read().WithContext(c).
 		Where("note_id = ?", noteID.String()).
 		Order("order_number asc").
 		Find(&lines).Error; err != nil {
@@ -375,7 +749,7 @@ func (n *noteRepository) ListLines(c context.Context, noteID uuid.UUID) ([]dmode
 func (n *noteRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Note, error) {
 	note := imodel.Note{ID: id.String()}
 
-	if err := n.noteStoreConnectionRDB.Read().
+	if err := n.noteStoreConnectionRDB.Read().WithContext(c).
 		First(&note).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -395,8 +769,8 @@ func (n *noteRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Note, err
 
 // Create implements repository.NoteRepository.
 func (n *noteRepository) Create(c context.Context, note dmodel.Note, mention dmodel.Mention) error {
-	return n.noteStoreConnectionRDB.Write().Transaction(func(tx *gorm.DB) error {
-		if err := n.noteStoreConnectionRDB.Write().
+	return n.noteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := n.noteStoreConnectionRDB.Write().WithContext(c).
 			Create(&imodel.Note{
 				ID: note.ID.String(),
 			}).Error; err != nil {
@@ -420,12 +794,189 @@ func (n *noteRepository) Create(c context.Context, note dmodel.Note, mention dmo
 				}).Error; err != nil {
 				return errors.Wrapf(err, "failed to create community relation. id=%v", mention.ID.String())
 			}
+		case dmodel.ResourcePage:
+			if err := tx.
+				Create(&imodel.NotePageRelation{
+					NoteID: note.ID.String(),
+					PageID: mention.ID.String(),
+				}).Error; err != nil {
+				return errors.Wrapf(err, "failed to create page relation. id=%v", mention.ID.String())
+			}
 		}
 
 		return nil
 	})
 }
 
+// CreateVersion implements repository.NoteRepository.
+func (n *noteRepository) CreateVersion(c context.Context, version dmodel.NoteVersion) error {
+	lines := lo.Map(version.Lines, func(line dmodel.NoteVersionLine, _ int) imodel.NoteVersionLine {
+		var property *string
+		if line.Property != nil {
+			property = lo.ToPtr(line.Property.Type.String())
+		}
+
+		return imodel.NoteVersionLine{
+			Property: property,
+			Contents: lo.Map(line.Contents, func(content dmodel.Content, _ int) imodel.NoteVersionLineContent {
+				return imodel.NoteVersionLineContent{
+					Type: content.Type.String(),
+					Bin:  content.Value,
+				}
+			}),
+		}
+	})
+
+	snapshot, err := json.Marshal(lines)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal note version. id=%v", version.ID.String())
+	}
+
+	return n.noteStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Create(&imodel.NoteVersion{
+				ID:        version.ID.String(),
+				NoteID:    version.NoteID.String(),
+				Reason:    version.Reason.String(),
+				Snapshot:  snapshot,
+				CreatedAt: version.CreatedAt,
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to create note version. id=%v", version.ID.String())
+		}
+
+		// 上限を超えた古い版を消す
+		ids := []string{}
+		if err := tx.
+			Model(&imodel.NoteVersion{}).
+			Where("note_id = ?", version.NoteID.String()).
+			Order("created_at desc, id desc").
+			Pluck("id", &ids).Error; err != nil {
+			return errors.Wrapf(err, "failed to list note versions. note_id=%v", version.NoteID.String())
+		}
+
+		if len(ids) <= dmodel.MaxNoteVersions {
+			return nil
+		}
+
+		if err := tx.
+			Where("id in ?", ids[dmodel.MaxNoteVersions:]).
+			Delete(&imodel.NoteVersion{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete old note versions. note_id=%v", version.NoteID.String())
+		}
+
+		return nil
+	})
+}
+
+// GetVersion implements repository.NoteRepository.
+func (n *noteRepository) GetVersion(c context.Context, noteID uuid.UUID, id uuid.UUID) (*dmodel.NoteVersion, error) {
+	version := imodel.NoteVersion{}
+	if err := n.noteStoreConnectionRDB.Read().WithContext(c).
+		Where("id = ? and note_id = ?", id.String(), noteID.String()).
+		First(&version).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get note version. id=%v", id.String())
+	}
+
+	return n.toNoteVersion(version)
+}
+
+// GetLatestVersion implements repository.NoteRepository.
+func (n *noteRepository) GetLatestVersion(c context.Context, noteID uuid.UUID) (*dmodel.NoteVersion, error) {
+	version := imodel.NoteVersion{}
+	if err := n.noteStoreConnectionRDB.Read().WithContext(c).
+		Where("note_id = ?", noteID.String()).
+		Order("created_at desc").
+		First(&version).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get latest note version. note_id=%v", noteID.String())
+	}
+
+	return n.toNoteVersion(version)
+}
+
+// ListVersions implements repository.NoteRepository.
+// 一覧では行の写しを読み込まない
+func (n *noteRepository) ListVersions(c context.Context, noteID uuid.UUID, page dmodel.Range) ([]dmodel.NoteVersion, *dmodel.Cursor, error) {
+	versions := []imodel.NoteVersion{}
+	if err := paginateDesc(n.noteStoreConnectionRDB.Read().WithContext(c).
+		Select("id", "note_id", "reason", "created_at").
+		Where("note_id = ?", noteID.String()), page, "created_at", "id").
+		Find(&versions).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list note versions. note_id=%v", noteID.String())
+	}
+
+	dVersions := []dmodel.NoteVersion{}
+	for _, version := range versions {
+		dVersion, err := dfactory.NewNoteVersion(version.ID, version.NoteID, version.Reason, nil, version.CreatedAt)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse note version. id=%v", version.ID)
+		}
+
+		dVersions = append(dVersions, *dVersion)
+	}
+
+	if len(versions) == 0 {
+		return dVersions, nil, nil
+	}
+
+	last := versions[len(versions)-1]
+	next, err := nextCursor(page, len(versions), last.CreatedAt, last.ID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. id=%v", last.ID)
+	}
+
+	return dVersions, next, nil
+}
+
+func (n *noteRepository) toNoteVersion(version imodel.NoteVersion) (*dmodel.NoteVersion, error) {
+	lines := []imodel.NoteVersionLine{}
+	if err := json.Unmarshal(version.Snapshot, &lines); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal note version. id=%v", version.ID)
+	}
+
+	dLines := []dmodel.NoteVersionLine{}
+	for _, line := range lines {
+		var property *dmodel.LineProperty
+		if line.Property != nil {
+			propertyType, err := dmodel.NewLinePropertyType(*line.Property)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse line property. id=%v", version.ID)
+			}
+
+			property = &dmodel.LineProperty{Type: *propertyType}
+		}
+
+		contents := []dmodel.Content{}
+		for _, content := range line.Contents {
+			dContent, err := dfactory.NewContent(uuid.Nil.String(), content.Type, content.Bin)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse content. id=%v", version.ID)
+			}
+
+			contents = append(contents, *dContent)
+		}
+
+		dLines = append(dLines, dmodel.NoteVersionLine{
+			Property: property,
+			Contents: contents,
+		})
+	}
+
+	dVersion, err := dfactory.NewNoteVersion(version.ID, version.NoteID, version.Reason, dLines, version.CreatedAt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse note version. id=%v", version.ID)
+	}
+
+	return dVersion, nil
+}
+
 func (n *noteRepository) getLineProperty(c context.Context, lineID string) (propertyType *string, err error) {
 	lineProperty := imodel.LineProperty{
 		LineID: lineID,
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	dmodel "app/domain/model"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// カーソル指定時は (作成日時, ID) のキーセットで、未指定時はオフセットで取得範囲を絞り込む
+func paginate(db *gorm.DB, page dmodel.Range, atColumn string, idColumn string) *gorm.DB {
+	db = db.
+		Order(fmt.Sprintf("%v asc, %v asc", atColumn, idColumn)).
+		Limit(page.Limit)
+
+	if page.Cursor == nil {
+		return db.Offset(page.Offset)
+	}
+
+	return db.Where(fmt.Sprintf("(%v, %v) > (?, ?)", atColumn, idColumn), page.Cursor.At, page.Cursor.ID.String())
+}
+
+// 新しい順に並べる一覧で使う。カーソル指定時はカーソルより古い要素を取得する
+func paginateDesc(db *gorm.DB, page dmodel.Range, atColumn string, idColumn string) *gorm.DB {
+	db = db.
+		Order(fmt.Sprintf("%v desc, %v desc", atColumn, idColumn)).
+		Limit(page.Limit)
+
+	if page.Cursor == nil {
+		return db.Offset(page.Offset)
+	}
+
+	return db.Where(fmt.Sprintf("(%v, %v) < (?, ?)", atColumn, idColumn), page.Cursor.At, page.Cursor.ID.String())
+}
+
+// 取得件数が上限に満たない場合は続きがないものとしてnilを返す
+func nextCursor(page dmodel.Range, count int, at time.Time, id string) (*dmodel.Cursor, error) {
+	if page.Limit == 0 || count < page.Limit {
+		return nil, nil
+	}
+
+	return dmodel.NewCursor(at, id)
+}
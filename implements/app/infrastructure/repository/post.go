@@ -6,10 +6,13 @@ import (
 	drepository "app/domain/repository"
 	imodel "app/infrastructure/model"
 	"context"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/samber/do"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 
 	irdb "app/infrastructure/adapter/datastore/rdb"
@@ -19,10 +22,25 @@ type postRepository struct {
 	postStoreConnection irdb.PostStoreConnection
 }
 
+type topicPost struct {
+	imodel.Post
+	TopicID string
+}
+
+type threadPost struct {
+	imodel.Post
+	ThreadID string
+}
+
+type pagedPost struct {
+	imodel.Post
+	CreatedAt time.Time
+}
+
 // Last implements repository.PostRepository.
 func (p *postRepository) Last(c context.Context, topicID uuid.UUID) (*dmodel.Post, error) {
 	iPosts := []imodel.Post{}
-	if err := p.postStoreConnection.Read().
+	if err := p.postStoreConnection.Read().WithContext(c).
 		Model(&imodel.Post{}).
 		Select("posts.id as id, posts.at as at").
 		Joins("inner join post_topic_relations on posts.id = post_topic_relations.post_id").
@@ -37,7 +55,7 @@ func (p *postRepository) Last(c context.Context, topicID uuid.UUID) (*dmodel.Pos
 		return nil, nil
 	}
 
-	dPost, err := p.toPost(iPosts[0])
+	dPost, err := p.toPost(c, iPosts[0])
 	if err != nil {
 		return nil, err
 	}
@@ -45,9 +63,54 @@ func (p *postRepository) Last(c context.Context, topicID uuid.UUID) (*dmodel.Pos
 	return dPost, nil
 }
 
+// LastByTopics implements repository.PostRepository.
+func (p *postRepository) LastByTopics(c context.Context, topicIDs []uuid.UUID) (map[uuid.UUID]dmodel.Post, error) {
+	parsedTopicIDs := lo.Map(topicIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+	iPosts := []topicPost{}
+	if err := p.postStoreConnection.Read().WithContext(c).
+		Model(&imodel.Post{}).
+		Select("posts.id as id, posts.at as at, post_topic_relations.topic_id as topic_id").
+		Joins("inner join post_topic_relations on posts.id = post_topic_relations.post_id").
+		Where("(post_topic_relations.topic_id, posts.created_at) in (?)", p.postStoreConnection.Read().WithContext(c).
+			Model(&imodel.Post{}).
+			Select("post_topic_relations.topic_id, max(posts.created_at)").
+			Joins("inner join post_topic_relations on posts.id = post_topic_relations.post_id").
+			Where("post_topic_relations.topic_id in ?", parsedTopicIDs).
+			Group("post_topic_relations.topic_id")).
+		Order("posts.created_at desc").
+		Scan(&iPosts).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list post. topic_ids=%v", parsedTopicIDs)
+	}
+
+	// created_atが同一の投稿が複数ある場合は先頭のみ採用する
+	iPosts = lo.UniqBy(iPosts, func(iPost topicPost) string {
+		return iPost.TopicID
+	})
+
+	dPosts, err := p.toPosts(c, lo.Map(iPosts, func(iPost topicPost, _ int) imodel.Post {
+		return iPost.Post
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	lastPosts := map[uuid.UUID]dmodel.Post{}
+	for index, iPost := range iPosts {
+		topicID, err := uuid.Parse(iPost.TopicID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse topic id. id=%v", iPost.TopicID)
+		}
+
+		lastPosts[topicID] = dPosts[index]
+	}
+
+	return lastPosts, nil
+}
+
 // Create implements repository.PostRepository.
 func (p *postRepository) Create(c context.Context, post dmodel.Post, topicID uuid.UUID, threadID uuid.UUID) error {
-	return p.postStoreConnection.Write().Transaction(func(tx *gorm.DB) error {
+	return p.postStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
 			Create(&imodel.Post{
 				ID: post.ID.String(),
@@ -108,7 +171,7 @@ func (p *postRepository) Create(c context.Context, post dmodel.Post, topicID uui
 // Get implements repository.PostRepository.
 func (p *postRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Post, error) {
 	iPost := imodel.Post{ID: id.String()}
-	if err := p.postStoreConnection.Read().
+	if err := p.postStoreConnection.Read().WithContext(c).
 		First(&iPost).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -117,83 +180,333 @@ func (p *postRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Post, err
 		return nil, errors.Wrapf(err, "failed to get post. id=%v", id.String())
 	}
 
-	return p.toPost(iPost)
+	return p.toPost(c, iPost)
 }
 
-// ListByThread implements repository.PostRepository.
-func (p *postRepository) ListByThread(c context.Context, threadID uuid.UUID, page dmodel.Range) ([]dmodel.Post, error) {
+// GetInThread implements repository.PostRepository.
+// トピックとスレッドに属していないポストは存在しないものとする
+func (p *postRepository) GetInThread(c context.Context, topicID uuid.UUID, threadID uuid.UUID, id uuid.UUID) (*dmodel.Post, error) {
 	iPosts := []imodel.Post{}
-	if err := p.postStoreConnection.Read().
+	if err := p.postStoreConnection.Read().WithContext(c).
 		Model(&imodel.Post{}).
 		Select("posts.id as id, posts.at as at").
+		Joins("inner join post_topic_relations on posts.id = post_topic_relations.post_id").
 		Joins("inner join post_thread_relations on posts.id = post_thread_relations.post_id").
+		Where("posts.id = ?", id.String()).
+		Where("post_topic_relations.topic_id = ?", topicID.String()).
 		Where("post_thread_relations.thread_id = ?", threadID.String()).
-		Order("posts.created_at asc").
-		Limit(page.Limit).Offset(page.Offset).
 		Scan(&iPosts).Error; err != nil {
-		return nil, errors.Wrapf(err, "failed to list post. thread_id=%v", threadID.String())
+		return nil, errors.Wrapf(err, "failed to get post. id=%v", id.String())
 	}
 
-	dPosts := []dmodel.Post{}
-	for _, iPost := range iPosts {
-		dPost, err := p.toPost(iPost)
+	if len(iPosts) < 1 {
+		return nil, nil
+	}
+
+	return p.toPost(c, iPosts[0])
+}
+
+// ListByThread implements repository.PostRepository.
+func (p *postRepository) ListByThread(c context.Context, threadID uuid.UUID, page dmodel.Range) ([]dmodel.Post, *dmodel.Cursor, error) {
+	iPosts := []pagedPost{}
+	if err := paginate(p.postStoreConnection.Read().WithContext(c).
+		Model(&imodel.Post{}).
+		Select("posts.id as id, posts.at as at, posts.created_at as created_at").
+		Joins("inner join post_thread_relations on posts.id = post_thread_relations.post_id").
+		Where("post_thread_relations.thread_id = ?", threadID.String()), page, "posts.created_at", "posts.id").
+		Scan(&iPosts).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list post. thread_id=%v", threadID.String())
+	}
+
+	dPosts, err := p.toPosts(c, lo.Map(iPosts, func(iPost pagedPost, _ int) imodel.Post {
+		return iPost.Post
+	}))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(iPosts) == 0 {
+		return dPosts, nil, nil
+	}
+
+	last := iPosts[len(iPosts)-1]
+	next, err := nextCursor(page, len(iPosts), last.CreatedAt, last.ID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. id=%v", last.ID)
+	}
+
+	return dPosts, next, nil
+}
+
+// ListByThreads implements repository.PostRepository.
+func (p *postRepository) ListByThreads(c context.Context, threadIDs []uuid.UUID, limit int) (map[uuid.UUID][]dmodel.Post, error) {
+	parsedThreadIDs := lo.Map(threadIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+	iPosts := []threadPost{}
+	if err := p.postStoreConnection.Read().WithContext(c).
+		Table("(?) as ranked_posts", p.postStoreConnection.Read().WithContext(c).
+			Model(&imodel.Post{}).
+			Select("posts.id as id, posts.at as at, post_thread_relations.thread_id as thread_id, row_number() over (partition by post_thread_relations.thread_id order by posts.created_at asc) as sequence_number").
+			Joins("inner join post_thread_relations on posts.id = post_thread_relations.post_id").
+			Where("post_thread_relations.thread_id in ?", parsedThreadIDs)).
+		Select("ranked_posts.id as id, ranked_posts.at as at, ranked_posts.thread_id as thread_id").
+		Where("ranked_posts.sequence_number <= ?", limit).
+		Order("ranked_posts.thread_id asc, ranked_posts.sequence_number asc").
+		Scan(&iPosts).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list post. thread_ids=%v", parsedThreadIDs)
+	}
+
+	dPosts, err := p.toPosts(c, lo.Map(iPosts, func(iPost threadPost, _ int) imodel.Post {
+		return iPost.Post
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	threadPosts := map[uuid.UUID][]dmodel.Post{}
+	for index, iPost := range iPosts {
+		threadID, err := uuid.Parse(iPost.ThreadID)
 		if err != nil {
-			return nil, err
+			return nil, errors.Wrapf(err, "failed to parse thread id. id=%v", iPost.ThreadID)
 		}
 
-		dPosts = append(dPosts, *dPost)
+		threadPosts[threadID] = append(threadPosts[threadID], dPosts[index])
 	}
 
-	return dPosts, nil
+	return threadPosts, nil
 }
 
-func (p *postRepository) toPost(post imodel.Post) (*dmodel.Post, error) {
-	var posted *string
-	memberRelation := imodel.PostFromMemberRelation{PostID: post.ID}
-	if err := p.postStoreConnection.Read().
-		First(&memberRelation).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			posted = nil
-		} else {
-			return nil, errors.Wrapf(err, "failed to get from member relation. post_id=%v", post.ID)
+// CountAfterByThreads implements repository.PostRepository.
+func (p *postRepository) CountAfterByThreads(c context.Context, after map[uuid.UUID]dmodel.UnixTime) (map[uuid.UUID]int, error) {
+	counts := map[uuid.UUID]int{}
+	if len(after) == 0 {
+		return counts, nil
+	}
+
+	conditions := []string{}
+	values := []any{}
+	for threadID, at := range after {
+		conditions = append(conditions, "(post_thread_relations.thread_id = ? and posts.at > ?)")
+		values = append(values, threadID.String(), at.Int())
+	}
+
+	rows := []struct {
+		ThreadID string
+		Count    int
+	}{}
+	if err := p.postStoreConnection.Read().WithContext(c).
+		Model(&imodel.Post{}).
+		Select("post_thread_relations.thread_id as thread_id, count(*) as count").
+		Joins("inner join post_thread_relations on posts.id = post_thread_relations.post_id").
+		Where(strings.Join(conditions, " or "), values...).
+		Group("post_thread_relations.thread_id").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to count post. thread_ids=%v", lo.Keys(after))
+	}
+
+	for _, row := range rows {
+		threadID, err := uuid.Parse(row.ThreadID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse thread id. id=%v", row.ThreadID)
+		}
+
+		counts[threadID] = row.Count
+	}
+
+	return counts, nil
+}
+
+// CountThreadAfterByTopics implements repository.PostRepository.
+func (p *postRepository) CountThreadAfterByTopics(c context.Context, after map[uuid.UUID]dmodel.UnixTime) (map[uuid.UUID]int, error) {
+	counts := map[uuid.UUID]int{}
+	if len(after) == 0 {
+		return counts, nil
+	}
+
+	conditions := []string{}
+	values := []any{}
+	for topicID, at := range after {
+		conditions = append(conditions, "(last_posts.topic_id = ? and last_posts.at > ?)")
+		values = append(values, topicID.String(), at.Int())
+	}
+
+	rows := []struct {
+		TopicID string
+		Count   int
+	}{}
+	// 古いスレッドへの返信も未読にするため、スレッドの更新日時は最後のポストの日時とする
+	if err := p.postStoreConnection.Read().WithContext(c).
+		Table("(?) as last_posts", p.postStoreConnection.Read().WithContext(c).
+			Model(&imodel.Post{}).
+			Select("post_topic_relations.topic_id as topic_id, post_thread_relations.thread_id as thread_id, max(posts.at) as at").
+			Joins("inner join post_topic_relations on posts.id = post_topic_relations.post_id").
+			Joins("inner join post_thread_relations on posts.id = post_thread_relations.post_id").
+			Where("post_topic_relations.topic_id in ?", lo.Map(lo.Keys(after), func(id uuid.UUID, _ int) string { return id.String() })).
+			Group("post_topic_relations.topic_id, post_thread_relations.thread_id")).
+		Select("last_posts.topic_id as topic_id, count(*) as count").
+		Where(strings.Join(conditions, " or "), values...).
+		Group("last_posts.topic_id").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to count thread. topic_ids=%v", lo.Keys(after))
+	}
+
+	for _, row := range rows {
+		topicID, err := uuid.Parse(row.TopicID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse topic id. id=%v", row.TopicID)
 		}
-	} else {
-		posted = &memberRelation.MemberID
+
+		counts[topicID] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (p *postRepository) toPost(c context.Context, post imodel.Post) (*dmodel.Post, error) {
+	dPosts, err := p.toPosts(c, []imodel.Post{post})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dPosts[0], nil
+}
+
+func (p *postRepository) toPosts(c context.Context, posts []imodel.Post) ([]dmodel.Post, error) {
+	if len(posts) == 0 {
+		return []dmodel.Post{}, nil
+	}
+
+	postIDs := lo.Map(posts, func(post imodel.Post, _ int) string { return post.ID })
+
+	iPostFromMembers := []imodel.PostFromMemberRelation{}
+	if err := p.postStoreConnection.Read().WithContext(c).
+		Where("post_id in ?", postIDs).
+		Find(&iPostFromMembers).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list from member relation. post_ids=%v", postIDs)
 	}
 
 	iPostToMembers := []imodel.PostToMemberRelation{}
-	if err := p.postStoreConnection.Read().
-		Where("post_id = ?", post.ID).
+	if err := p.postStoreConnection.Read().WithContext(c).
+		Where("post_id in ?", postIDs).
 		Find(&iPostToMembers).Error; err != nil {
-		return nil, errors.Wrapf(err, "failed to list to member relation. post_id=%v", post.ID)
+		return nil, errors.Wrapf(err, "failed to list to member relation. post_ids=%v", postIDs)
 	}
 
 	iPostToRoles := []imodel.PostToRoleRelation{}
-	if err := p.postStoreConnection.Read().
-		Where("post_id = ?", post.ID).
+	if err := p.postStoreConnection.Read().WithContext(c).
+		Where("post_id in ?", postIDs).
 		Find(&iPostToRoles).Error; err != nil {
-		return nil, errors.Wrapf(err, "failed to list to role relation. post_id=%v", post.ID)
+		return nil, errors.Wrapf(err, "failed to list to role relation. post_ids=%v", postIDs)
+	}
+
+	dPosts := []dmodel.Post{}
+	for _, post := range posts {
+		var posted *string
+		if memberRelation, ok := lo.Find(iPostFromMembers, func(relation imodel.PostFromMemberRelation) bool { return relation.PostID == post.ID }); ok {
+			posted = &memberRelation.MemberID
+		}
+
+		dPostTo := []dmodel.Mention{}
+		for _, iPostTomember := range iPostToMembers {
+			if iPostTomember.PostID != post.ID {
+				continue
+			}
+
+			mention, err := dmodel.NewMention(iPostTomember.MemberID, dmodel.ResourceMember.String())
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse mention. member_id=%v", iPostTomember.MemberID)
+			}
+
+			dPostTo = append(dPostTo, *mention)
+		}
+		for _, iPostToRole := range iPostToRoles {
+			if iPostToRole.PostID != post.ID {
+				continue
+			}
+
+			mention, err := dmodel.NewMention(iPostToRole.RoleID, dmodel.ResourceRole.String())
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse mention. role_id=%v", iPostToRole.RoleID)
+			}
+
+			dPostTo = append(dPostTo, *mention)
+		}
+
+		dPost, err := dfactory.NewPost(post.ID, posted, dPostTo, post.At)
+		if err != nil {
+			return nil, err
+		}
+
+		dPosts = append(dPosts, *dPost)
 	}
 
-	dPostTo := []dmodel.Mention{}
-	for _, iPostTomember := range iPostToMembers {
-		mention, err := dmodel.NewMention(iPostTomember.MemberID, dmodel.ResourceMember.String())
+	return dPosts, nil
+}
+
+// ListIDsByTopics implements repository.PostRepository.
+func (p *postRepository) ListIDsByTopics(c context.Context, topicIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return p.listIDsByTopics(p.postStoreConnection.Read().WithContext(c), topicIDs)
+}
+
+// DeleteByTopics implements repository.PostRepository.
+func (p *postRepository) DeleteByTopics(c context.Context, topicIDs []uuid.UUID) error {
+	return p.postStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		postIDs, err := p.listIDsByTopics(tx, topicIDs)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to parse mention. member_id=%v", iPostTomember.MemberID)
+			return err
+		} else if len(postIDs) == 0 {
+			return nil
 		}
 
-		dPostTo = append(dPostTo, *mention)
+		parsedPostIDs := lo.Map(postIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+		for _, relation := range []any{
+			&imodel.PostToRoleRelation{},
+			&imodel.PostToMemberRelation{},
+			&imodel.PostFromMemberRelation{},
+			&imodel.PostThreadRelation{},
+			&imodel.PostTopicRelation{},
+		} {
+			if err := tx.
+				Where("post_id in ?", parsedPostIDs).
+				Delete(relation).Error; err != nil {
+				return errors.Wrapf(err, "failed to delete post relation. topic_ids=%v", topicIDs)
+			}
+		}
+
+		if err := tx.
+			Where("id in ?", parsedPostIDs).
+			Delete(&imodel.Post{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete post. topic_ids=%v", topicIDs)
+		}
+
+		return nil
+	})
+}
+
+func (p *postRepository) listIDsByTopics(db *gorm.DB, topicIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(topicIDs) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	relations := []imodel.PostTopicRelation{}
+	if err := db.
+		Where("topic_id in ?", lo.Map(topicIDs, func(id uuid.UUID, _ int) string { return id.String() })).
+		Find(&relations).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list topic relation. topic_ids=%v", topicIDs)
 	}
-	for _, iPostToRole := range iPostToRoles {
-		mention, err := dmodel.NewMention(iPostToRole.RoleID, dmodel.ResourceRole.String())
+
+	postIDs := []uuid.UUID{}
+	for _, relation := range relations {
+		postID, err := uuid.Parse(relation.PostID)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to parse mention. role_id=%v", iPostToRole.RoleID)
+			return nil, errors.Wrapf(err, "failed to parse post id. id=%v", relation.PostID)
 		}
 
-		dPostTo = append(dPostTo, *mention)
+		postIDs = append(postIDs, postID)
 	}
 
-	return dfactory.NewPost(post.ID, posted, dPostTo, post.At)
+	return postIDs, nil
 }
 
 func NewPostRepository(i *do.Injector) (drepository.PostRepository, error) {
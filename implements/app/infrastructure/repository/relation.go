@@ -0,0 +1,122 @@
+package repository
+
+import (
+	dfactory "app/domain/factory"
+	dmodel "app/domain/model"
+	drepository "app/domain/repository"
+	irdb "app/infrastructure/adapter/datastore/rdb"
+	imodel "app/infrastructure/model"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+type userRelationRepository struct {
+	userStoreConnection irdb.UserStoreConnection
+}
+
+// Save implements repository.UserRelationRepository.
+func (u *userRelationRepository) Save(c context.Context, relation dmodel.UserRelation) error {
+	if err := u.userStoreConnection.Write().WithContext(c).
+		Save(&imodel.UserRelation{
+			UserID:   relation.UserID.String(),
+			TargetID: relation.TargetID.String(),
+			Type:     relation.Type.String(),
+		}).Error; err != nil {
+		return errors.Wrapf(err, "failed to save user relation. user_id=%v target_id=%v", relation.UserID.String(), relation.TargetID.String())
+	}
+
+	return nil
+}
+
+// Delete implements repository.UserRelationRepository.
+func (u *userRelationRepository) Delete(c context.Context, userID uuid.UUID, targetID uuid.UUID) error {
+	if err := u.userStoreConnection.Write().WithContext(c).
+		Delete(&imodel.UserRelation{
+			UserID:   userID.String(),
+			TargetID: targetID.String(),
+		}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete user relation. user_id=%v target_id=%v", userID.String(), targetID.String())
+	}
+
+	return nil
+}
+
+// Get implements repository.UserRelationRepository.
+func (u *userRelationRepository) Get(c context.Context, userID uuid.UUID, targetID uuid.UUID) (*dmodel.UserRelation, error) {
+	iRelation := imodel.UserRelation{UserID: userID.String(), TargetID: targetID.String()}
+	if err := u.userStoreConnection.Read().WithContext(c).
+		First(&iRelation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get user relation. user_id=%v target_id=%v", userID.String(), targetID.String())
+	}
+
+	return dfactory.NewUserRelation(iRelation.UserID, iRelation.TargetID, iRelation.Type)
+}
+
+// ListByUser implements repository.UserRelationRepository.
+func (u *userRelationRepository) ListByUser(c context.Context, userID uuid.UUID) ([]dmodel.UserRelation, error) {
+	iRelations := []imodel.UserRelation{}
+	if err := u.userStoreConnection.Read().WithContext(c).
+		Where("user_id = ?", userID.String()).
+		Order("created_at asc").
+		Find(&iRelations).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list user relation. user_id=%v", userID.String())
+	}
+
+	return u.toUserRelations(iRelations)
+}
+
+// ListInvolving implements repository.UserRelationRepository.
+func (u *userRelationRepository) ListInvolving(c context.Context, userID uuid.UUID) ([]dmodel.UserRelation, error) {
+	iRelations := []imodel.UserRelation{}
+	if err := u.userStoreConnection.Read().WithContext(c).
+		Where("user_id = ? or target_id = ?", userID.String(), userID.String()).
+		Find(&iRelations).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list user relation. user_id=%v", userID.String())
+	}
+
+	return u.toUserRelations(iRelations)
+}
+
+// ListBetween implements repository.UserRelationRepository.
+func (u *userRelationRepository) ListBetween(c context.Context, userIDs []uuid.UUID) ([]dmodel.UserRelation, error) {
+	parsedUserIDs := lo.Map(userIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+	iRelations := []imodel.UserRelation{}
+	if err := u.userStoreConnection.Read().WithContext(c).
+		Where("user_id in ? and target_id in ?", parsedUserIDs, parsedUserIDs).
+		Find(&iRelations).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list user relation. user_ids=%v", parsedUserIDs)
+	}
+
+	return u.toUserRelations(iRelations)
+}
+
+func (u *userRelationRepository) toUserRelations(iRelations []imodel.UserRelation) ([]dmodel.UserRelation, error) {
+	dRelations := []dmodel.UserRelation{}
+	for _, iRelation := range iRelations {
+		dRelation, err := dfactory.NewUserRelation(iRelation.UserID, iRelation.TargetID, iRelation.Type)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse user relation. user_id=%v target_id=%v", iRelation.UserID, iRelation.TargetID)
+		}
+
+		dRelations = append(dRelations, *dRelation)
+	}
+
+	return dRelations, nil
+}
+
+func NewUserRelationRepository(i *do.Injector) (drepository.UserRelationRepository, error) {
+	userStoreConnection := do.MustInvoke[irdb.UserStoreConnection](i)
+	return &userRelationRepository{
+		userStoreConnection: userStoreConnection,
+	}, nil
+}
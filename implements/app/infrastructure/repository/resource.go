@@ -2,11 +2,16 @@ package repository
 
 import (
 	"app/gen/pubsub"
+	irdb "app/infrastructure/adapter/datastore/rdb"
 	isearchengine "app/infrastructure/adapter/datastore/searchengine"
 	"app/infrastructure/adapter/mq"
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
 
 	dfactory "app/domain/factory"
 	dmodel "app/domain/model"
@@ -15,11 +20,18 @@ import (
 
 	isearch "github.com/elastic/go-elasticsearch/v8/typedapi/core/search"
 	itypes "github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/highlighterencoder"
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"github.com/samber/do"
 	"github.com/samber/lo"
 )
 
+const (
+	highlightFragmentSize = 100
+	highlightFragments    = 3
+)
+
 type resourceSearchIndexRepository struct {
 	resourceSearchIndexStoreConnectionRDB isearchengine.ResourceSearchIndexStoreConnection
 	resourceSearchIndexStoreConnectionMQ  mq.ResourceSearchIndexStoreConnection
@@ -34,23 +46,44 @@ func (r *resourceSearchIndexRepository) Delete(c context.Context, id uuid.UUID)
 
 // Update implements repository.ResourceSearchIndexRepository.
 func (r *resourceSearchIndexRepository) Update(c context.Context, index dmodel.ResourceSearchIndex) error {
-	return r.resourceSearchIndexStoreConnectionMQ.Publish(c, mq.ExchangeResource, mq.RoutingKeyResourceUpdate, pubsub.ResourceSearchIndex{
-		ResourceId:   &pubsub.UUID{Value: index.ResourceID.String()},
-		ResourceType: &pubsub.Resource{Value: index.Type.String()},
-		Keyword:      index.Keyword.String(),
-	})
+	return r.resourceSearchIndexStoreConnectionMQ.Publish(c, mq.ExchangeResource, mq.RoutingKeyResourceUpdate, toResourceSearchIndexMessage(index))
 }
 
 // Create implements repository.ResourceSearchIndexRepository.
 func (r *resourceSearchIndexRepository) Create(c context.Context, index dmodel.ResourceSearchIndex) error {
-	return r.resourceSearchIndexStoreConnectionMQ.Publish(c, mq.ExchangeResource, mq.RoutingKeyResourceCreate, pubsub.ResourceSearchIndex{
+	return r.resourceSearchIndexStoreConnectionMQ.Publish(c, mq.ExchangeResource, mq.RoutingKeyResourceCreate, toResourceSearchIndexMessage(index))
+}
+
+// ListIDs implements repository.ResourceSearchIndexRepository.
+func (r *resourceSearchIndexRepository) ListIDs(c context.Context, resourceType dmodel.Resource, after *uuid.UUID, limit int) ([]uuid.UUID, error) {
+	return listResourceSearchIndexIDs(c, r.resourceSearchIndexStoreConnectionRDB, resourceType, after, limit)
+}
+
+func toResourceSearchIndexMessage(index dmodel.ResourceSearchIndex) *pubsub.ResourceSearchIndex {
+	toUUID := func(id *uuid.UUID) *pubsub.UUID {
+		if id == nil {
+			return nil
+		}
+
+		return &pubsub.UUID{Value: id.String()}
+	}
+
+	return &pubsub.ResourceSearchIndex{
 		ResourceId:   &pubsub.UUID{Value: index.ResourceID.String()},
 		ResourceType: &pubsub.Resource{Value: index.Type.String()},
 		Keyword:      index.Keyword.String(),
-	})
+		CommunityId:  toUUID(index.CommunityID),
+		TopicId:      toUUID(index.TopicID),
+		ThreadId:     toUUID(index.ThreadID),
+		AuthorId:     toUUID(index.AuthorID),
+		Contents: lo.Map(index.Contents, func(content dmodel.Content, _ int) *pubsub.Content {
+			return &pubsub.Content{Type: string(content.Type), Bin: content.Value}
+		}),
+	}
 }
 
 // List implements repository.ResourceSearchIndexRepository.
+// 名前は本文より重く扱い、一致した箇所を強調した抜粋を返す
 func (r *resourceSearchIndexRepository) List(c context.Context, resourceTypes []dmodel.Resource, freeword string, page dmodel.Range) ([]dmodel.ResourceSearchIndex, error) {
 	response, err := r.resourceSearchIndexStoreConnectionRDB.Client().
 		Search().
@@ -69,15 +102,25 @@ func (r *resourceSearchIndexRepository) List(c context.Context, resourceTypes []
 							},
 						},
 						{
-							Match: map[string]itypes.MatchQuery{
-								"keyword": {
-									Query: freeword,
-								},
+							MultiMatch: &itypes.MultiMatchQuery{
+								Query:  freeword,
+								Fields: []string{"keyword^2", "body"},
 							},
 						},
 					},
 				},
 			},
+			Highlight: &itypes.Highlight{
+				Encoder:           lo.ToPtr(highlighterencoder.Html),
+				PreTags:           []string{"<em>"},
+				PostTags:          []string{"</em>"},
+				FragmentSize:      lo.ToPtr(highlightFragmentSize),
+				NumberOfFragments: lo.ToPtr(highlightFragments),
+				Fields: map[string]itypes.HighlightField{
+					"keyword": {NumberOfFragments: lo.ToPtr(0)},
+					"body":    {},
+				},
+			},
 		}).
 		Do(c)
 
@@ -98,17 +141,37 @@ func (r *resourceSearchIndexRepository) List(c context.Context, resourceTypes []
 			return nil, err
 		}
 
-		dIndex, err := dfactory.NewResourceSearchIndex(index.ResourceID, index.Type, index.Keyword)
+		dIndex, err := toResourceSearchIndex(index)
 		if err != nil {
 			return nil, err
 		}
 
+		dIndex.Highlights = append(hit.Highlight["keyword"], hit.Highlight["body"]...)
 		dIndexes = append(dIndexes, *dIndex)
 	}
 
 	return dIndexes, nil
 }
 
+func toResourceSearchIndex(index imodel.ResourceSearchIndex) (*dmodel.ResourceSearchIndex, error) {
+	if index.CommunityID == nil {
+		return dfactory.NewResourceSearchIndex(index.ResourceID, index.Type, index.Keyword)
+	}
+
+	dIndex, err := dfactory.NewContentSearchIndex(index.ResourceID, index.Type, index.Keyword, *index.CommunityID, index.TopicID, index.ThreadID, index.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := dmodel.NewSearchBody(index.Body)
+	if err != nil {
+		return nil, err
+	}
+	dIndex.Body = *body
+
+	return dIndex, nil
+}
+
 func NewResourceSearchIndexRepository(i *do.Injector) (drepository.ResourceSearchIndexRepository, error) {
 	resourceSearchIndexStoreConnection := do.MustInvoke[isearchengine.ResourceSearchIndexStoreConnection](i)
 	resourceSearchIndexStoreConnectionMQ := do.MustInvoke[mq.ResourceSearchIndexStoreConnection](i)
@@ -129,12 +192,19 @@ func (r *resourceSearchIndexRepositoryForAsync) Create(c context.Context, index
 
 // Delete implements repository.ResourceSearchIndexRepository.
 func (r *resourceSearchIndexRepositoryForAsync) Delete(c context.Context, id uuid.UUID) error {
-	if _, err := r.resourceSearchIndexStoreConnection.Client().
-		Delete(imodel.ResourceSearchIndex{}.Index(), id.String()).
-		Do(c); err != nil {
+	indexes, err := r.writeIndexes(c)
+	if err != nil {
 		return err
 	}
 
+	for _, index := range indexes {
+		if _, err := r.resourceSearchIndexStoreConnection.Client().
+			Delete(index, id.String()).
+			Do(c); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -148,32 +218,618 @@ func (r *resourceSearchIndexRepositoryForAsync) Update(c context.Context, index
 	return r.save(c, index)
 }
 
+// ListIDs implements repository.ResourceSearchIndexRepository.
+func (r *resourceSearchIndexRepositoryForAsync) ListIDs(c context.Context, resourceType dmodel.Resource, after *uuid.UUID, limit int) ([]uuid.UUID, error) {
+	return listResourceSearchIndexIDs(c, r.resourceSearchIndexStoreConnection, resourceType, after, limit)
+}
+
 func (r *resourceSearchIndexRepositoryForAsync) save(c context.Context, index dmodel.ResourceSearchIndex) error {
-	iIndex := imodel.ResourceSearchIndex{
-		ResourceID: index.ResourceID.String(),
-		Type:       index.Type.String(),
-		Keyword:    index.Keyword.String(),
-	}
+	iIndex := toIResourceSearchIndex(index)
 
 	bin, err := json.Marshal(iIndex)
 	if err != nil {
 		return err
 	}
 
-	if _, err := r.resourceSearchIndexStoreConnection.Client().
-		Index(iIndex.Index()).
-		Id(iIndex.ResourceID).
-		Raw(bytes.NewReader(bin)).
-		Do(c); err != nil {
+	indexes, err := r.writeIndexes(c)
+	if err != nil {
 		return err
 	}
 
+	for _, index := range indexes {
+		if _, err := r.resourceSearchIndexStoreConnection.Client().
+			Index(index).
+			Id(iIndex.ResourceID).
+			Raw(bytes.NewReader(bin)).
+			Do(c); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// 作り直している間は新しいインデックスにも書き込み、付け替えで更新が失われないようにする
+func (r *resourceSearchIndexRepositoryForAsync) writeIndexes(c context.Context) ([]string, error) {
+	indexes := []string{imodel.ResourceSearchIndex{}.Index()}
+
+	rebuilding := imodel.ResourceSearchIndex{}.RebuildingIndex()
+	if exists, err := r.resourceSearchIndexStoreConnection.Client().Indices.ExistsAlias(rebuilding).IsSuccess(c); err != nil {
+		return nil, errors.Wrapf(err, "failed to check alias. name=%v", rebuilding)
+	} else if exists {
+		indexes = append(indexes, rebuilding)
+	}
+
+	return indexes, nil
+}
+
 func NewResourceSearchIndexRepositoryForAsync(i *do.Injector) (drepository.ResourceSearchIndexRepository, error) {
 	resourceSearchIndexStoreConnection := do.MustInvoke[isearchengine.ResourceSearchIndexStoreConnection](i)
 	return &resourceSearchIndexRepositoryForAsync{
 		resourceSearchIndexStoreConnection: resourceSearchIndexStoreConnection,
 	}, nil
 }
+
+func toIResourceSearchIndex(index dmodel.ResourceSearchIndex) imodel.ResourceSearchIndex {
+	toString := func(id *uuid.UUID) *string {
+		if id == nil {
+			return nil
+		}
+
+		return lo.ToPtr(id.String())
+	}
+
+	return imodel.ResourceSearchIndex{
+		ResourceID:  index.ResourceID.String(),
+		Type:        index.Type.String(),
+		Keyword:     index.Keyword.String(),
+		CommunityID: toString(index.CommunityID),
+		TopicID:     toString(index.TopicID),
+		ThreadID:    toString(index.ThreadID),
+		AuthorID:    toString(index.AuthorID),
+		Body:        index.Body.String(),
+	}
+}
+
+// ドキュメントの ID はリソースの ID なので、resource_id の順に search_after で読み進める
+func listResourceSearchIndexIDs(c context.Context, connection isearchengine.ResourceSearchIndexStoreConnection, resourceType dmodel.Resource, after *uuid.UUID, limit int) ([]uuid.UUID, error) {
+	request := &isearch.Request{
+		Size:    &limit,
+		Source_: false,
+		Sort:    []itypes.SortCombinations{"resource_id"},
+		Query: &itypes.Query{
+			Term: map[string]itypes.TermQuery{
+				"type": {Value: resourceType.String()},
+			},
+		},
+	}
+	if after != nil {
+		request.SearchAfter = []itypes.FieldValue{after.String()}
+	}
+
+	response, err := connection.Client().
+		Search().
+		Index(imodel.ResourceSearchIndex{}.Index()).
+		Request(request).
+		Do(c)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list resource search index ids. type=%v", resourceType.String())
+	}
+
+	ids := []uuid.UUID{}
+	for _, hit := range response.Hits.Hits {
+		if hit.Id_ == nil {
+			continue
+		}
+
+		id, err := uuid.Parse(*hit.Id_)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse resource id. id=%v", *hit.Id_)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+type resourceSearchIndexRebuildRepository struct {
+	resourceSearchIndexStoreConnection isearchengine.ResourceSearchIndexStoreConnection
+}
+
+// Begin implements repository.ResourceSearchIndexRebuildRepository.
+// テンプレートはインデックス名のパターンで適用されるので、日時を付けた名前で作るだけでよい
+// 作り直している間の更新も書き込まれるよう、作り直し中のエイリアスを付けておく
+func (r *resourceSearchIndexRebuildRepository) Begin(c context.Context) (string, error) {
+	name := imodel.ResourceSearchIndex{}.VersionedIndex(time.Now())
+
+	// 作り直し中のエイリアスが複数のインデックスを指すと書き込めなくなるため、同時には作り直さない
+	rebuilding := imodel.ResourceSearchIndex{}.RebuildingIndex()
+	if exists, err := r.resourceSearchIndexStoreConnection.Client().Indices.ExistsAlias(rebuilding).IsSuccess(c); err != nil {
+		return "", errors.Wrapf(err, "failed to check alias. name=%v", rebuilding)
+	} else if exists {
+		return "", fmt.Errorf("resource search index is already rebuilding. alias=%v", rebuilding)
+	}
+
+	if _, err := r.resourceSearchIndexStoreConnection.Client().Indices.
+		Create(name).
+		Aliases(map[string]itypes.Alias{rebuilding: {}}).
+		Do(c); err != nil {
+		return "", errors.Wrapf(err, "failed to create index. name=%v", name)
+	}
+
+	return name, nil
+}
+
+// Save implements repository.ResourceSearchIndexRebuildRepository.
+func (r *resourceSearchIndexRebuildRepository) Save(c context.Context, name string, indexes []dmodel.ResourceSearchIndex) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	// 作り直している間に書き込まれた索引の方が新しいため、既にあるものは上書きしない
+	bulk := r.resourceSearchIndexStoreConnection.Client().Bulk().Index(name)
+	for _, index := range indexes {
+		iIndex := toIResourceSearchIndex(index)
+		if err := bulk.CreateOp(itypes.CreateOperation{Id_: &iIndex.ResourceID}, iIndex); err != nil {
+			return errors.Wrapf(err, "failed to add bulk operation. id=%v", iIndex.ResourceID)
+		}
+	}
+
+	response, err := bulk.Do(c)
+	if err != nil {
+		return errors.Wrapf(err, "failed to save resource search indexes. name=%v", name)
+	}
+
+	if response.Errors {
+		for _, item := range response.Items {
+			for _, result := range item {
+				if result.Error != nil && result.Status != http.StatusConflict {
+					return fmt.Errorf("failed to save resource search index. name=%v id=%v reason=%v", name, result.Id_, lo.FromPtr(result.Error.Reason))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Commit implements repository.ResourceSearchIndexRebuildRepository.
+// エイリアスの付け替えと古いインデックスの削除を一度に行う
+// エイリアス導入前のインデックスはエイリアスと同じ名前なので、インデックスごと削除して置き換える
+func (r *resourceSearchIndexRebuildRepository) Commit(c context.Context, name string) error {
+	alias := imodel.ResourceSearchIndex{}.Index()
+	rebuilding := imodel.ResourceSearchIndex{}.RebuildingIndex()
+	client := r.resourceSearchIndexStoreConnection.Client()
+
+	actions := []itypes.IndicesAction{
+		{Add: &itypes.AddAction{Index: &name, Alias: &alias}},
+		{Remove: &itypes.RemoveAction{Index: &name, Alias: &rebuilding}},
+	}
+
+	aliasExists, err := client.Indices.ExistsAlias(alias).IsSuccess(c)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check alias. name=%v", alias)
+	}
+
+	if aliasExists {
+		current, err := client.Indices.GetAlias().Name(alias).Do(c)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get alias. name=%v", alias)
+		}
+
+		for index := range current {
+			if index != name {
+				actions = append(actions, itypes.IndicesAction{RemoveIndex: &itypes.RemoveIndexAction{Index: lo.ToPtr(index)}})
+			}
+		}
+	} else {
+		indexExists, err := client.Indices.Exists(alias).IsSuccess(c)
+		if err != nil {
+			return errors.Wrapf(err, "failed to check index. name=%v", alias)
+		}
+
+		if indexExists {
+			actions = append(actions, itypes.IndicesAction{RemoveIndex: &itypes.RemoveIndexAction{Index: &alias}})
+		}
+	}
+
+	if _, err := client.Indices.UpdateAliases().Actions(actions...).Do(c); err != nil {
+		return errors.Wrapf(err, "failed to swap alias. name=%v index=%v", alias, name)
+	}
+
+	return nil
+}
+
+// Abort implements repository.ResourceSearchIndexRebuildRepository.
+func (r *resourceSearchIndexRebuildRepository) Abort(c context.Context, name string) error {
+	if _, err := r.resourceSearchIndexStoreConnection.Client().Indices.
+		Delete(name).
+		Do(c); err != nil {
+		return errors.Wrapf(err, "failed to delete index. name=%v", name)
+	}
+
+	return nil
+}
+
+func NewResourceSearchIndexRebuildRepository(i *do.Injector) (drepository.ResourceSearchIndexRebuildRepository, error) {
+	resourceSearchIndexStoreConnection := do.MustInvoke[isearchengine.ResourceSearchIndexStoreConnection](i)
+	return &resourceSearchIndexRebuildRepository{
+		resourceSearchIndexStoreConnection: resourceSearchIndexStoreConnection,
+	}, nil
+}
+
+type resourceSearchIndexSourceRepository struct {
+	userStoreConnection      irdb.UserStoreConnection
+	communityStoreConnection irdb.CommunityStoreConnection
+	roleStoreConnection      irdb.RoleStoreConnection
+	topicStoreConnection     irdb.TopicStoreConnection
+	postStoreConnection      irdb.PostStoreConnection
+	noteStoreConnection      irdb.NoteStoreConnection
+	contentStoreConnection   irdb.ContentStoreConnection
+}
+
+type sourceName struct {
+	ID   string
+	Name string
+}
+
+type sourceTopic struct {
+	ID          string
+	Name        string
+	CommunityID string
+	MemberID    sql.NullString
+}
+
+type sourcePost struct {
+	ID       string
+	TopicID  string
+	ThreadID string
+	MemberID sql.NullString
+}
+
+type sourcePage struct {
+	ID          string
+	Title       string
+	CommunityID string
+	MemberID    sql.NullString
+}
+
+type sourceLine struct {
+	ID     string
+	PageID string
+}
+
+type sourceContent struct {
+	imodel.Content
+	ResourceID string
+}
+
+// List implements repository.ResourceSearchIndexSourceRepository.
+// 索引を作る時と同じく、名前だけのリソースは名前を、トピックとポストとページは内容も返す
+func (r *resourceSearchIndexSourceRepository) List(c context.Context, resourceType dmodel.Resource, after *uuid.UUID, limit int) ([]dmodel.ResourceSearchIndex, *uuid.UUID, error) {
+	afterID := ""
+	if after != nil {
+		afterID = after.String()
+	}
+
+	switch resourceType {
+	case dmodel.ResourceUser:
+		names := []sourceName{}
+		if err := r.userStoreConnection.Read().WithContext(c).
+			Model(&imodel.User{}).
+			Select("id, name").
+			Where("id > ?", afterID).
+			Order("id asc").
+			Limit(limit).
+			Scan(&names).Error; err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to list user. after=%v", afterID)
+		}
+
+		return r.toNamedIndexes(resourceType, names, limit)
+	case dmodel.ResourceCommunity:
+		names := []sourceName{}
+		if err := r.communityStoreConnection.Read().WithContext(c).
+			Model(&imodel.Community{}).
+			Select("id, name").
+			Where("id > ?", afterID).
+			Where("status <> ?", dmodel.CommunityStatusDeleting.String()).
+			Order("id asc").
+			Limit(limit).
+			Scan(&names).Error; err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to list community. after=%v", afterID)
+		}
+
+		return r.toNamedIndexes(resourceType, names, limit)
+	case dmodel.ResourceRole:
+		// 既定のロールはコミュニティと同時に作られ、索引されない
+		names := []sourceName{}
+		if err := r.roleStoreConnection.Read().WithContext(c).
+			Model(&imodel.Role{}).
+			Select("roles.id as id, roles.name as name").
+			Joins("inner join role_community_relations on roles.id = role_community_relations.role_id").
+			Where("roles.id > ?", afterID).
+			Where("role_community_relations.default = ?", false).
+			Order("roles.id asc").
+			Limit(limit).
+			Scan(&names).Error; err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to list role. after=%v", afterID)
+		}
+
+		return r.toNamedIndexes(resourceType, names, limit)
+	case dmodel.ResourceTopic:
+		return r.listTopic(c, afterID, limit)
+	case dmodel.ResourcePost:
+		return r.listPost(c, afterID, limit)
+	case dmodel.ResourcePage:
+		return r.listPage(c, afterID, limit)
+	}
+
+	return nil, nil, fmt.Errorf("unsupported resource. v=%v", resourceType.String())
+}
+
+func (r *resourceSearchIndexSourceRepository) toNamedIndexes(resourceType dmodel.Resource, names []sourceName, limit int) ([]dmodel.ResourceSearchIndex, *uuid.UUID, error) {
+	dIndexes := []dmodel.ResourceSearchIndex{}
+	for _, name := range names {
+		dIndex, err := dfactory.NewResourceSearchIndex(name.ID, resourceType.String(), name.Name)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse resource search index. id=%v", name.ID)
+		}
+
+		dIndexes = append(dIndexes, *dIndex)
+	}
+
+	return dIndexes, nextSourceID(lo.Map(names, func(name sourceName, _ int) string { return name.ID }), limit), nil
+}
+
+// 除外したリソースがあっても読み進められるように、最後に読んだ行の ID を次の起点にする
+func nextSourceID(ids []string, limit int) *uuid.UUID {
+	if len(ids) < limit {
+		return nil
+	}
+
+	next, err := uuid.Parse(ids[len(ids)-1])
+	if err != nil {
+		return nil
+	}
+
+	return &next
+}
+
+func (r *resourceSearchIndexSourceRepository) listTopic(c context.Context, afterID string, limit int) ([]dmodel.ResourceSearchIndex, *uuid.UUID, error) {
+	topics := []sourceTopic{}
+	if err := r.topicStoreConnection.Read().WithContext(c).
+		Model(&imodel.Topic{}).
+		Select("topics.id as id, topics.name as name, topic_community_relations.community_id as community_id, topic_from_member_relations.member_id as member_id").
+		Joins("inner join topic_community_relations on topics.id = topic_community_relations.topic_id").
+		Joins("left join topic_from_member_relations on topics.id = topic_from_member_relations.topic_id").
+		Where("topics.id > ?", afterID).
+		Order("topics.id asc").
+		Limit(limit).
+		Scan(&topics).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list topic. after=%v", afterID)
+	}
+
+	deleting, err := r.listDeletingCommunityIDs(c, lo.Map(topics, func(topic sourceTopic, _ int) string { return topic.CommunityID }))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contents, err := r.listContents(c, "content_topic_relations", "topic_id", lo.Map(topics, func(topic sourceTopic, _ int) string { return topic.ID }))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dIndexes := []dmodel.ResourceSearchIndex{}
+	for _, topic := range topics {
+		if lo.Contains(deleting, topic.CommunityID) {
+			continue
+		}
+
+		dIndex, err := dfactory.NewContentSearchIndex(topic.ID, dmodel.ResourceTopic.String(), topic.Name, topic.CommunityID, &topic.ID, nil, toNullableString(topic.MemberID))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse resource search index. id=%v", topic.ID)
+		}
+		dIndex.Contents = contents[topic.ID]
+
+		dIndexes = append(dIndexes, *dIndex)
+	}
+
+	return dIndexes, nextSourceID(lo.Map(topics, func(row sourceTopic, _ int) string { return row.ID }), limit), nil
+}
+
+func (r *resourceSearchIndexSourceRepository) listPost(c context.Context, afterID string, limit int) ([]dmodel.ResourceSearchIndex, *uuid.UUID, error) {
+	posts := []sourcePost{}
+	if err := r.postStoreConnection.Read().WithContext(c).
+		Model(&imodel.Post{}).
+		Select("posts.id as id, post_topic_relations.topic_id as topic_id, post_thread_relations.thread_id as thread_id, post_from_member_relations.member_id as member_id").
+		Joins("inner join post_topic_relations on posts.id = post_topic_relations.post_id").
+		Joins("inner join post_thread_relations on posts.id = post_thread_relations.post_id").
+		Joins("left join post_from_member_relations on posts.id = post_from_member_relations.post_id").
+		Where("posts.id > ?", afterID).
+		Order("posts.id asc").
+		Limit(limit).
+		Scan(&posts).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list post. after=%v", afterID)
+	}
+
+	if len(posts) == 0 {
+		return []dmodel.ResourceSearchIndex{}, nil, nil
+	}
+
+	relations := []imodel.TopicCommunityRelation{}
+	if err := r.topicStoreConnection.Read().WithContext(c).
+		Where("topic_id in ?", lo.Uniq(lo.Map(posts, func(post sourcePost, _ int) string { return post.TopicID }))).
+		Find(&relations).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list topic community relation. after=%v", afterID)
+	}
+
+	communityIDs := lo.SliceToMap(relations, func(relation imodel.TopicCommunityRelation) (string, string) {
+		return relation.TopicID, relation.CommunityID
+	})
+
+	deleting, err := r.listDeletingCommunityIDs(c, lo.Values(communityIDs))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contents, err := r.listContents(c, "content_post_relations", "post_id", lo.Map(posts, func(post sourcePost, _ int) string { return post.ID }))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dIndexes := []dmodel.ResourceSearchIndex{}
+	for _, post := range posts {
+		communityID, ok := communityIDs[post.TopicID]
+		if !ok || lo.Contains(deleting, communityID) {
+			continue
+		}
+
+		dIndex, err := dfactory.NewContentSearchIndex(post.ID, dmodel.ResourcePost.String(), "", communityID, &post.TopicID, &post.ThreadID, toNullableString(post.MemberID))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse resource search index. id=%v", post.ID)
+		}
+		dIndex.Contents = contents[post.ID]
+
+		dIndexes = append(dIndexes, *dIndex)
+	}
+
+	return dIndexes, nextSourceID(lo.Map(posts, func(row sourcePost, _ int) string { return row.ID }), limit), nil
+}
+
+// ページの内容はノートの行にあるため、行の順に並べてつなげる
+func (r *resourceSearchIndexSourceRepository) listPage(c context.Context, afterID string, limit int) ([]dmodel.ResourceSearchIndex, *uuid.UUID, error) {
+	pages := []sourcePage{}
+	if err := r.noteStoreConnection.Read().WithContext(c).
+		Model(&imodel.Page{}).
+		Select("pages.id as id, pages.title as title, page_community_relations.community_id as community_id, page_from_member_relations.member_id as member_id").
+		Joins("inner join page_community_relations on pages.id = page_community_relations.page_id").
+		Joins("left join page_from_member_relations on pages.id = page_from_member_relations.page_id").
+		Where("pages.id > ?", afterID).
+		Order("pages.id asc").
+		Limit(limit).
+		Scan(&pages).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list page. after=%v", afterID)
+	}
+
+	if len(pages) == 0 {
+		return []dmodel.ResourceSearchIndex{}, nil, nil
+	}
+
+	deleting, err := r.listDeletingCommunityIDs(c, lo.Map(pages, func(page sourcePage, _ int) string { return page.CommunityID }))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lines := []sourceLine{}
+	if err := r.noteStoreConnection.Read().WithContext(c).
+		Model(&imodel.Line{}).
+		Select("lines.id as id, note_page_relations.page_id as page_id").
+		Joins("inner join note_page_relations on lines.note_id = note_page_relations.note_id").
+		Where("note_page_relations.page_id in ?", lo.Map(pages, func(page sourcePage, _ int) string { return page.ID })).
+		Order("lines.order_number asc").
+		Scan(&lines).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list line. after=%v", afterID)
+	}
+
+	lineContents, err := r.listContents(c, "content_line_relations", "line_id", lo.Map(lines, func(line sourceLine, _ int) string { return line.ID }))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contents := map[string][]dmodel.Content{}
+	for _, line := range lines {
+		contents[line.PageID] = append(contents[line.PageID], lineContents[line.ID]...)
+	}
+
+	dIndexes := []dmodel.ResourceSearchIndex{}
+	for _, page := range pages {
+		if lo.Contains(deleting, page.CommunityID) {
+			continue
+		}
+
+		dIndex, err := dfactory.NewContentSearchIndex(page.ID, dmodel.ResourcePage.String(), page.Title, page.CommunityID, nil, nil, toNullableString(page.MemberID))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse resource search index. id=%v", page.ID)
+		}
+		dIndex.Contents = contents[page.ID]
+
+		dIndexes = append(dIndexes, *dIndex)
+	}
+
+	return dIndexes, nextSourceID(lo.Map(pages, func(row sourcePage, _ int) string { return row.ID }), limit), nil
+}
+
+// 削除中のコミュニティのリソースは削除処理が索引も消すため、再構築の対象にしない
+func (r *resourceSearchIndexSourceRepository) listDeletingCommunityIDs(c context.Context, communityIDs []string) ([]string, error) {
+	if len(communityIDs) == 0 {
+		return []string{}, nil
+	}
+
+	communities := []imodel.Community{}
+	if err := r.communityStoreConnection.Read().WithContext(c).
+		Select("id").
+		Where("id in ?", lo.Uniq(communityIDs)).
+		Where("status = ?", dmodel.CommunityStatusDeleting.String()).
+		Find(&communities).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list deleting community. ids=%v", communityIDs)
+	}
+
+	return lo.Map(communities, func(community imodel.Community, _ int) string { return community.ID }), nil
+}
+
+func (r *resourceSearchIndexSourceRepository) listContents(c context.Context, relation string, column string, resourceIDs []string) (map[string][]dmodel.Content, error) {
+	if len(resourceIDs) == 0 {
+		return map[string][]dmodel.Content{}, nil
+	}
+
+	contents := []sourceContent{}
+	if err := r.contentStoreConnection.Read().WithContext(c).
+		Model(&imodel.Content{}).
+		Select(fmt.Sprintf("contents.id as id, contents.type as type, contents.bin as bin, %v.%v as resource_id", relation, column)).
+		Joins(fmt.Sprintf("inner join %v on contents.id = %v.content_id", relation, relation)).
+		Where(fmt.Sprintf("%v.%v in ?", relation, column), resourceIDs).
+		Order("contents.created_at asc").
+		Scan(&contents).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list content. relation=%v", relation)
+	}
+
+	dContents := map[string][]dmodel.Content{}
+	for _, content := range contents {
+		dContent, err := dfactory.NewContent(content.ID, content.Type, content.Bin)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse content. id=%v", content.ID)
+		}
+
+		dContents[content.ResourceID] = append(dContents[content.ResourceID], *dContent)
+	}
+
+	return dContents, nil
+}
+
+func toNullableString(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.String
+}
+
+func NewResourceSearchIndexSourceRepository(i *do.Injector) (drepository.ResourceSearchIndexSourceRepository, error) {
+	userStoreConnection := do.MustInvoke[irdb.UserStoreConnection](i)
+	communityStoreConnection := do.MustInvoke[irdb.CommunityStoreConnection](i)
+	roleStoreConnection := do.MustInvoke[irdb.RoleStoreConnection](i)
+	topicStoreConnection := do.MustInvoke[irdb.TopicStoreConnection](i)
+	postStoreConnection := do.MustInvoke[irdb.PostStoreConnection](i)
+	noteStoreConnection := do.MustInvoke[irdb.NoteStoreConnection](i)
+	contentStoreConnection := do.MustInvoke[irdb.ContentStoreConnection](i)
+	return &resourceSearchIndexSourceRepository{
+		userStoreConnection:      userStoreConnection,
+		communityStoreConnection: communityStoreConnection,
+		roleStoreConnection:      roleStoreConnection,
+		topicStoreConnection:     topicStoreConnection,
+		postStoreConnection:      postStoreConnection,
+		noteStoreConnection:      noteStoreConnection,
+		contentStoreConnection:   contentStoreConnection,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	dmodel "app/domain/model"
 	drepository "app/domain/repository"
 	idocument "app/infrastructure/adapter/datastore/document"
+	ikvs "app/infrastructure/adapter/datastore/kvs"
 	irdb "app/infrastructure/adapter/datastore/rdb"
 	imodel "app/infrastructure/model"
 	"context"
@@ -13,18 +14,26 @@ import (
 	"github.com/pkg/errors"
 	"github.com/samber/do"
 	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/bson"
 	"gorm.io/gorm"
 )
 
 type roleRepository struct {
 	roleStoreConnectionRDB      irdb.RoleStoreConnection
 	roleStoreConnectionDocument idocument.RoleStoreConnection
+	roleStoreConnectionCache    ikvs.CacheStoreConnection
+}
+
+type roleCache struct {
+	ID     string              `json:"id"`
+	Name   string              `json:"name"`
+	Action map[string][]string `json:"action"`
 }
 
 // GetDefaultByCommunity implements repository.RoleRepository.
 func (r *roleRepository) GetDefaultByCommunity(c context.Context, communityID uuid.UUID) (*dmodel.Role, error) {
 	communityRelation := imodel.RoleCommunityRelation{}
-	if err := r.roleStoreConnectionRDB.Write().
+	if err := r.roleStoreConnectionRDB.Write().WithContext(c).
 		Where("community_id = ?", communityID.String()).
 		Where("default = ?", true).
 		First(&communityRelation).Error; err != nil {
@@ -36,7 +45,7 @@ func (r *roleRepository) GetDefaultByCommunity(c context.Context, communityID uu
 	}
 
 	role := imodel.Role{ID: communityRelation.RoleID}
-	if err := r.roleStoreConnectionRDB.Read().
+	if err := r.roleStoreConnectionRDB.Read().WithContext(c).
 		First(&role).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -72,7 +81,7 @@ func (r *roleRepository) GetDefaultByCommunity(c context.Context, communityID uu
 // GetRelatedCommunity implements repository.RoleRepository.
 func (r *roleRepository) GetRelatedCommunity(c context.Context, id uuid.UUID) (*uuid.UUID, error) {
 	communityRelation := imodel.RoleCommunityRelation{}
-	if err := r.roleStoreConnectionRDB.Write().
+	if err := r.roleStoreConnectionRDB.Write().WithContext(c).
 		Where("role_id = ?", id.String()).
 		First(&communityRelation).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -92,8 +101,22 @@ func (r *roleRepository) GetRelatedCommunity(c context.Context, id uuid.UUID) (*
 
 // ListByCommunity implements repository.RoleRepository.
 func (r *roleRepository) ListByCommunity(c context.Context, communityID uuid.UUID) ([]dmodel.Role, error) {
+	if cached, ok := getCache[[]roleCache](c, r.roleStoreConnectionCache, cacheKey(cacheKeyCommunityRole, communityID)); ok {
+		dRoles := []dmodel.Role{}
+		for _, role := range *cached {
+			dRole, err := dfactory.NewRole(role.ID, role.Name, role.Action)
+			if err != nil {
+				return nil, err
+			}
+
+			dRoles = append(dRoles, *dRole)
+		}
+
+		return dRoles, nil
+	}
+
 	roles := []imodel.Role{}
-	if err := r.roleStoreConnectionRDB.Read().
+	if err := r.roleStoreConnectionRDB.Read().WithContext(c).
 		Model(&imodel.Role{}).
 		Select("roles.id as id, roles.name as name").
 		Joins("inner join role_community_relations on roles.id = role_community_relations.role_id").
@@ -104,6 +127,7 @@ func (r *roleRepository) ListByCommunity(c context.Context, communityID uuid.UUI
 	}
 
 	dRoles := []dmodel.Role{}
+	cachedRoles := []roleCache{}
 	for _, role := range roles {
 		action := imodel.Action{
 			RoleID: role.ID,
@@ -132,14 +156,22 @@ func (r *roleRepository) ListByCommunity(c context.Context, communityID uuid.UUI
 		}
 
 		dRoles = append(dRoles, *dRole)
+		cachedRoles = append(cachedRoles, roleCache{ID: role.ID, Name: role.Name, Action: actions})
 	}
 
+	setCache(c, r.roleStoreConnectionCache, cacheKey(cacheKeyCommunityRole, communityID), cachedRoles)
+
 	return dRoles, nil
 }
 
 // Delete implements repository.RoleRepository.
 func (r *roleRepository) Delete(c context.Context, id uuid.UUID) error {
-	return r.roleStoreConnectionRDB.Write().Transaction(func(tx *gorm.DB) error {
+	communityID, err := r.GetRelatedCommunity(c, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.roleStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
 			Delete(&imodel.Role{
 				ID: id.String(),
@@ -158,12 +190,67 @@ func (r *roleRepository) Delete(c context.Context, id uuid.UUID) error {
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	r.deleteCache(c, id, communityID)
+
+	return nil
+}
+
+// DeleteByCommunity implements repository.RoleRepository.
+func (r *roleRepository) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	relations := []imodel.RoleCommunityRelation{}
+	if err := r.roleStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Where("community_id = ?", communityID.String()).
+			Find(&relations).Error; err != nil {
+			return errors.Wrapf(err, "failed to list community relation. community_id=%v", communityID.String())
+		} else if len(relations) == 0 {
+			return nil
+		}
+
+		roleIDs := lo.Map(relations, func(relation imodel.RoleCommunityRelation, _ int) string { return relation.RoleID })
+
+		if _, err := r.roleStoreConnectionDocument.DB().
+			Collection(imodel.Action{}.Collection()).
+			DeleteMany(c, bson.M{"role_id": bson.M{"$in": roleIDs}}); err != nil {
+			return errors.Wrapf(err, "failed to delete actions. community_id=%v", communityID.String())
+		}
+
+		if err := tx.
+			Where("community_id = ?", communityID.String()).
+			Delete(&imodel.RoleCommunityRelation{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete community relation. community_id=%v", communityID.String())
+		}
+
+		if err := tx.
+			Where("id in ?", roleIDs).
+			Delete(&imodel.Role{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete role. community_id=%v", communityID.String())
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, relation := range relations {
+		roleID, err := uuid.Parse(relation.RoleID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse role id. id=%v", relation.RoleID)
+		}
+
+		r.deleteCache(c, roleID, &communityID)
+	}
+
+	return nil
 }
 
 // Update implements repository.RoleRepository.
 func (r *roleRepository) Update(c context.Context, role dmodel.Role) error {
-	return r.roleStoreConnectionRDB.Write().Transaction(func(tx *gorm.DB) error {
+	if err := r.roleStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
 			Updates(&imodel.Role{
 				ID:   role.ID.String(),
@@ -198,13 +285,24 @@ func (r *roleRepository) Update(c context.Context, role dmodel.Role) error {
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	communityID, err := r.GetRelatedCommunity(c, role.ID)
+	if err != nil {
+		return err
+	}
+
+	r.deleteCache(c, role.ID, communityID)
+
+	return nil
 }
 
 // List implements repository.RoleRepository.
 func (r *roleRepository) List(c context.Context, ids []uuid.UUID) ([]dmodel.Role, error) {
 	roles := []imodel.Role{}
-	if err := r.roleStoreConnectionRDB.Read().
+	if err := r.roleStoreConnectionRDB.Read().WithContext(c).
 		Where("id in ?", lo.Map(ids, func(id uuid.UUID, _ int) string { return id.String() })).
 		Find(&roles).Error; err != nil {
 		return nil, errors.Wrapf(err, "failed to get role. ids=%v", ids)
@@ -246,8 +344,12 @@ func (r *roleRepository) List(c context.Context, ids []uuid.UUID) ([]dmodel.Role
 
 // Get implements repository.RoleRepository.
 func (r *roleRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Role, error) {
+	if cached, ok := getCache[roleCache](c, r.roleStoreConnectionCache, cacheKey(cacheKeyRole, id)); ok {
+		return dfactory.NewRole(cached.ID, cached.Name, cached.Action)
+	}
+
 	role := imodel.Role{ID: id.String()}
-	if err := r.roleStoreConnectionRDB.Read().
+	if err := r.roleStoreConnectionRDB.Read().WithContext(c).
 		First(&role).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -277,12 +379,14 @@ func (r *roleRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Role, err
 		actions[actionItem.Resource] = actionItem.Operation
 	}
 
+	setCache(c, r.roleStoreConnectionCache, cacheKey(cacheKeyRole, id), roleCache{ID: role.ID, Name: role.Name, Action: actions})
+
 	return dfactory.NewRole(role.ID, role.Name, actions)
 }
 
 // Create implements repository.RoleRepository.
 func (r *roleRepository) Create(c context.Context, role dmodel.Role, mention dmodel.Mention) error {
-	return r.roleStoreConnectionRDB.Write().Transaction(func(tx *gorm.DB) error {
+	if err := r.roleStoreConnectionRDB.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
 			Create(&imodel.Role{
 				ID:   role.ID.String(),
@@ -322,14 +426,34 @@ func (r *roleRepository) Create(c context.Context, role dmodel.Role, mention dmo
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	switch mention.Resource {
+	case dmodel.ResourceCommunity:
+		r.deleteCache(c, role.ID, &mention.ID)
+	}
+
+	return nil
+}
+
+func (r *roleRepository) deleteCache(c context.Context, id uuid.UUID, communityID *uuid.UUID) {
+	keys := []string{cacheKey(cacheKeyRole, id)}
+	if communityID != nil {
+		keys = append(keys, cacheKey(cacheKeyCommunityRole, *communityID))
+	}
+
+	deleteCache(c, r.roleStoreConnectionCache, keys...)
 }
 
 func NewRoleRepository(i *do.Injector) (drepository.RoleRepository, error) {
 	roleStoreConnectionRDB := do.MustInvoke[irdb.RoleStoreConnection](i)
 	roleStoreConnectionDocument := do.MustInvoke[idocument.RoleStoreConnection](i)
+	roleStoreConnectionCache := do.MustInvoke[ikvs.CacheStoreConnection](i)
 	return &roleRepository{
 		roleStoreConnectionRDB:      roleStoreConnectionRDB,
 		roleStoreConnectionDocument: roleStoreConnectionDocument,
+		roleStoreConnectionCache:    roleStoreConnectionCache,
 	}, nil
 }
@@ -0,0 +1,288 @@
+package repository
+
+import (
+	dfactory "app/domain/factory"
+	dmodel "app/domain/model"
+	drepository "app/domain/repository"
+	imodel "app/infrastructure/model"
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	irdb "app/infrastructure/adapter/datastore/rdb"
+)
+
+type templateRepository struct {
+	contentStoreConnection irdb.ContentStoreConnection
+}
+
+type scannedTemplate struct {
+	ID       string
+	Name     string
+	Kind     string
+	Title    sql.NullString
+	Fields   []byte
+	Body     []byte
+	MemberID sql.NullString
+}
+
+// Create implements repository.TemplateRepository.
+func (t *templateRepository) Create(c context.Context, template dmodel.Template, communityID uuid.UUID) error {
+	model, err := t.toModel(template)
+	if err != nil {
+		return err
+	}
+
+	return t.contentStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Create(model).Error; err != nil {
+			return errors.Wrapf(err, "failed to create template. id=%v", template.ID.String())
+		}
+
+		if err := tx.
+			Create(&imodel.TemplateCommunityRelation{
+				TemplateID:  template.ID.String(),
+				CommunityID: communityID.String(),
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to create community relation. template_id=%v", template.ID.String())
+		}
+
+		if template.Created != nil {
+			if err := tx.
+				Create(&imodel.TemplateFromMemberRelation{
+					TemplateID: template.ID.String(),
+					MemberID:   template.Created.String(),
+				}).Error; err != nil {
+				return errors.Wrapf(err, "failed to create from member relation. template_id=%v", template.ID.String())
+			}
+		}
+
+		return nil
+	})
+}
+
+// Get implements repository.TemplateRepository.
+func (t *templateRepository) Get(c context.Context, communityID uuid.UUID, id uuid.UUID) (*dmodel.Template, error) {
+	templates := []scannedTemplate{}
+	if err := t.selectTemplates(t.contentStoreConnection.Read().WithContext(c)).
+		Where("template_community_relations.community_id = ?", communityID.String()).
+		Where("templates.id = ?", id.String()).
+		Scan(&templates).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get template. id=%v", id.String())
+	}
+
+	if len(templates) < 1 {
+		return nil, nil
+	}
+
+	return t.toTemplate(templates[0])
+}
+
+// ListByCommunity implements repository.TemplateRepository.
+func (t *templateRepository) ListByCommunity(c context.Context, communityID uuid.UUID) ([]dmodel.Template, error) {
+	templates := []scannedTemplate{}
+	if err := t.selectTemplates(t.contentStoreConnection.Read().WithContext(c)).
+		Where("template_community_relations.community_id = ?", communityID.String()).
+		Order("templates.name asc, templates.id asc").
+		Scan(&templates).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list template. community_id=%v", communityID.String())
+	}
+
+	dTemplates := []dmodel.Template{}
+	for _, template := range templates {
+		dTemplate, err := t.toTemplate(template)
+		if err != nil {
+			return nil, err
+		}
+
+		dTemplates = append(dTemplates, *dTemplate)
+	}
+
+	return dTemplates, nil
+}
+
+// Update implements repository.TemplateRepository.
+func (t *templateRepository) Update(c context.Context, template dmodel.Template) error {
+	model, err := t.toModel(template)
+	if err != nil {
+		return err
+	}
+
+	if err := t.contentStoreConnection.Write().WithContext(c).
+		Model(&imodel.Template{ID: model.ID}).
+		Select("name", "kind", "title", "fields", "body").
+		Updates(model).Error; err != nil {
+		return errors.Wrapf(err, "failed to update template. id=%v", template.ID.String())
+	}
+
+	return nil
+}
+
+// Delete implements repository.TemplateRepository.
+func (t *templateRepository) Delete(c context.Context, id uuid.UUID) error {
+	return t.contentStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		return t.delete(tx, []string{id.String()})
+	})
+}
+
+// DeleteByCommunity implements repository.TemplateRepository.
+func (t *templateRepository) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	return t.contentStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		relations := []imodel.TemplateCommunityRelation{}
+		if err := tx.
+			Where("community_id = ?", communityID.String()).
+			Find(&relations).Error; err != nil {
+			return errors.Wrapf(err, "failed to list community relation. community_id=%v", communityID.String())
+		} else if len(relations) == 0 {
+			return nil
+		}
+
+		return t.delete(tx, lo.Map(relations, func(relation imodel.TemplateCommunityRelation, _ int) string { return relation.TemplateID }))
+	})
+}
+
+func (t *templateRepository) selectTemplates(db *gorm.DB) *gorm.DB {
+	return db.
+		Model(&imodel.Template{}).
+		Select("templates.id as id, templates.name as name, templates.kind as kind, templates.title as title, templates.fields as fields, templates.body as body, template_from_member_relations.member_id as member_id").
+		Joins("inner join template_community_relations on templates.id = template_community_relations.template_id").
+		Joins("left join template_from_member_relations on templates.id = template_from_member_relations.template_id")
+}
+
+func (t *templateRepository) delete(tx *gorm.DB, ids []string) error {
+	for _, model := range []any{
+		&imodel.TemplateFromMemberRelation{},
+		&imodel.TemplateCommunityRelation{},
+	} {
+		if err := tx.
+			Where("template_id in ?", ids).
+			Delete(model).Error; err != nil {
+			return errors.Wrap(err, "failed to delete template relation")
+		}
+	}
+
+	if err := tx.
+		Where("id in ?", ids).
+		Delete(&imodel.Template{}).Error; err != nil {
+		return errors.Wrap(err, "failed to delete template")
+	}
+
+	return nil
+}
+
+func (t *templateRepository) toModel(template dmodel.Template) (*imodel.Template, error) {
+	fields, err := json.Marshal(lo.Map(template.Fields, func(field dmodel.TemplateField, _ int) imodel.TemplateField {
+		return imodel.TemplateField{
+			Name:     field.Name.String(),
+			Required: field.Required,
+			Default:  field.Default,
+		}
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal template field. id=%v", template.ID.String())
+	}
+
+	body, err := json.Marshal(lo.Map(template.Lines, func(line dmodel.TemplateLine, _ int) imodel.NoteVersionLine {
+		var property *string
+		if line.Property != nil {
+			property = lo.ToPtr(line.Property.Type.String())
+		}
+
+		return imodel.NoteVersionLine{
+			Property: property,
+			Contents: lo.Map(line.Contents, func(content dmodel.Content, _ int) imodel.NoteVersionLineContent {
+				return imodel.NoteVersionLineContent{
+					Type: content.Type.String(),
+					Bin:  content.Value,
+				}
+			}),
+		}
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal template body. id=%v", template.ID.String())
+	}
+
+	var title *string
+	if template.Title != nil {
+		title = lo.ToPtr(template.Title.String())
+	}
+
+	return &imodel.Template{
+		ID:     template.ID.String(),
+		Name:   template.Name.String(),
+		Kind:   template.Kind.String(),
+		Title:  title,
+		Fields: fields,
+		Body:   body,
+	}, nil
+}
+
+func (t *templateRepository) toTemplate(template scannedTemplate) (*dmodel.Template, error) {
+	fields := []imodel.TemplateField{}
+	if err := json.Unmarshal(template.Fields, &fields); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal template field. id=%v", template.ID)
+	}
+
+	dFields := []dmodel.TemplateField{}
+	for _, field := range fields {
+		dField, err := dfactory.NewTemplateField(field.Name, field.Required, field.Default)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse template field. id=%v", template.ID)
+		}
+
+		dFields = append(dFields, *dField)
+	}
+
+	lines := []imodel.NoteVersionLine{}
+	if err := json.Unmarshal(template.Body, &lines); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal template body. id=%v", template.ID)
+	}
+
+	dLines := []dmodel.TemplateLine{}
+	for _, line := range lines {
+		var property *dmodel.LineProperty
+		if line.Property != nil {
+			propertyType, err := dmodel.NewLinePropertyType(*line.Property)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse line property. id=%v", template.ID)
+			}
+
+			property = &dmodel.LineProperty{Type: *propertyType}
+		}
+
+		contents := []dmodel.Content{}
+		for _, content := range line.Contents {
+			dContent, err := dfactory.NewContent(uuid.Nil.String(), content.Type, content.Bin)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse content. id=%v", template.ID)
+			}
+
+			contents = append(contents, *dContent)
+		}
+
+		dLines = append(dLines, dmodel.TemplateLine{
+			Property: property,
+			Contents: contents,
+		})
+	}
+
+	dTemplate, err := dfactory.NewTemplate(template.ID, template.Name, template.Kind, toNullableString(template.Title), dFields, dLines, toNullableString(template.MemberID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse template. id=%v", template.ID)
+	}
+
+	return dTemplate, nil
+}
+
+func NewTemplateRepository(i *do.Injector) (drepository.TemplateRepository, error) {
+	contentStoreConnection := do.MustInvoke[irdb.ContentStoreConnection](i)
+	return &templateRepository{
+		contentStoreConnection: contentStoreConnection,
+	}, nil
+}
@@ -6,10 +6,12 @@ import (
 	drepository "app/domain/repository"
 	imodel "app/infrastructure/model"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/samber/do"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 
 	irdb "app/infrastructure/adapter/datastore/rdb"
@@ -21,7 +23,7 @@ type threadRepository struct {
 
 // Create implements repository.ThreadRepository.
 func (t *threadRepository) Create(c context.Context, thread dmodel.Thread, topicID uuid.UUID) error {
-	return t.threadStoreConnection.Write().Transaction(func(tx *gorm.DB) error {
+	return t.threadStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
 			Create(&imodel.Thread{
 				ID: thread.ID.String(),
@@ -44,7 +46,7 @@ func (t *threadRepository) Create(c context.Context, thread dmodel.Thread, topic
 // Get implements repository.ThreadRepository.
 func (t *threadRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Thread, error) {
 	iThread := imodel.Thread{ID: id.String()}
-	if err := t.threadStoreConnection.Read().
+	if err := t.threadStoreConnection.Read().WithContext(c).
 		First(&iThread).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -57,17 +59,55 @@ func (t *threadRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Thread,
 }
 
 // ListByTopic implements repository.ThreadRepository.
-func (t *threadRepository) ListByTopic(c context.Context, topicID uuid.UUID, page dmodel.Range) ([]dmodel.Thread, error) {
+func (t *threadRepository) ListByTopic(c context.Context, topicID uuid.UUID, page dmodel.Range) ([]dmodel.Thread, *dmodel.Cursor, error) {
+	iThreads := []struct {
+		imodel.Thread
+		CreatedAt time.Time
+	}{}
+	if err := paginate(t.threadStoreConnection.Read().WithContext(c).
+		Model(&imodel.Thread{}).
+		Select("threads.id as id, threads.created_at as created_at").
+		Joins("inner join thread_topic_relations on threads.id = thread_topic_relations.thread_id").
+		Where("thread_topic_relations.topic_id = ?", topicID.String()), page, "threads.created_at", "threads.id").
+		Scan(&iThreads).Error; err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list thread. topic_id=%v", topicID.String())
+	}
+
+	dThreads := []dmodel.Thread{}
+	for _, iThread := range iThreads {
+		dThread, err := dfactory.NewThread(iThread.ID)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to parse thread. id=%v", iThread.ID)
+		}
+
+		dThreads = append(dThreads, *dThread)
+	}
+
+	if len(iThreads) == 0 {
+		return dThreads, nil, nil
+	}
+
+	last := iThreads[len(iThreads)-1]
+	next, err := nextCursor(page, len(iThreads), last.CreatedAt, last.ID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. id=%v", last.ID)
+	}
+
+	return dThreads, next, nil
+}
+
+// ListLatestByTopic implements repository.ThreadRepository.
+func (t *threadRepository) ListLatestByTopic(c context.Context, topicID uuid.UUID, limit int) ([]dmodel.Thread, error) {
 	iThreads := []imodel.Thread{}
-	if err := t.threadStoreConnection.Read().
+	if err := t.threadStoreConnection.Read().WithContext(c).
 		Model(&imodel.Thread{}).
 		Select("threads.id as id").
 		Joins("inner join thread_topic_relations on threads.id = thread_topic_relations.thread_id").
 		Where("thread_topic_relations.topic_id = ?", topicID.String()).
-		Order("threads.created_at asc").
-		Limit(page.Limit).Offset(page.Offset).
+		Order("threads.created_at desc, threads.id desc").
+		Limit(limit).
 		Scan(&iThreads).Error; err != nil {
-		return nil, errors.Wrapf(err, "failed to list thread. topic_id=%v", topicID.String())
+		return nil, errors.Wrapf(err, "failed to list latest thread. topic_id=%v", topicID.String())
 	}
 
 	dThreads := []dmodel.Thread{}
@@ -83,6 +123,40 @@ func (t *threadRepository) ListByTopic(c context.Context, topicID uuid.UUID, pag
 	return dThreads, nil
 }
 
+// DeleteByTopics implements repository.ThreadRepository.
+func (t *threadRepository) DeleteByTopics(c context.Context, topicIDs []uuid.UUID) error {
+	if len(topicIDs) == 0 {
+		return nil
+	}
+
+	parsedTopicIDs := lo.Map(topicIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+	return t.threadStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		relations := []imodel.ThreadTopicRelation{}
+		if err := tx.
+			Where("topic_id in ?", parsedTopicIDs).
+			Find(&relations).Error; err != nil {
+			return errors.Wrapf(err, "failed to list topic_relation. topic_ids=%v", parsedTopicIDs)
+		} else if len(relations) == 0 {
+			return nil
+		}
+
+		if err := tx.
+			Where("topic_id in ?", parsedTopicIDs).
+			Delete(&imodel.ThreadTopicRelation{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete topic_relation. topic_ids=%v", parsedTopicIDs)
+		}
+
+		if err := tx.
+			Where("id in ?", lo.Map(relations, func(relation imodel.ThreadTopicRelation, _ int) string { return relation.ThreadID })).
+			Delete(&imodel.Thread{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete thread. topic_ids=%v", parsedTopicIDs)
+		}
+
+		return nil
+	})
+}
+
 func NewThreadRepository(i *do.Injector) (drepository.ThreadRepository, error) {
 	threadStoreConnection := do.MustInvoke[irdb.ThreadStoreConnection](i)
 	return &threadRepository{
@@ -6,10 +6,12 @@ import (
 	drepository "app/domain/repository"
 	imodel "app/infrastructure/model"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/samber/do"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 
 	irdb "app/infrastructure/adapter/datastore/rdb"
@@ -21,7 +23,7 @@ type topicRepository struct {
 
 // Create implements repository.TopicRepository.
 func (t *topicRepository) Create(c context.Context, topic dmodel.Topic, communityID uuid.UUID) error {
-	return t.topicStoreConnection.Write().Transaction(func(tx *gorm.DB) error {
+	return t.topicStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		if err := tx.
 			Create(&imodel.Topic{
 				ID:   topic.ID.String(),
@@ -53,7 +55,7 @@ func (t *topicRepository) Create(c context.Context, topic dmodel.Topic, communit
 // Get implements repository.TopicRepository.
 func (t *topicRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Topic, error) {
 	iTopic := imodel.Topic{ID: id.String()}
-	if err := t.topicStoreConnection.Read().
+	if err := t.topicStoreConnection.Read().WithContext(c).
 		First(&iTopic).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -64,7 +66,7 @@ func (t *topicRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Topic, e
 
 	var created *string
 	memberRelation := imodel.TopicFromMemberRelation{TopicID: iTopic.ID}
-	if err := t.topicStoreConnection.Read().
+	if err := t.topicStoreConnection.Read().WithContext(c).
 		First(&memberRelation).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			created = nil
@@ -78,30 +80,52 @@ func (t *topicRepository) Get(c context.Context, id uuid.UUID) (*dmodel.Topic, e
 	return dfactory.NewTopic(iTopic.ID, iTopic.Name, created)
 }
 
+// GetCommunityID implements repository.TopicRepository.
+func (t *topicRepository) GetCommunityID(c context.Context, id uuid.UUID) (*uuid.UUID, error) {
+	relation := imodel.TopicCommunityRelation{}
+	if err := t.topicStoreConnection.Read().WithContext(c).
+		Where("topic_id = ?", id.String()).
+		First(&relation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get community relation. topic_id=%v", id.String())
+	}
+
+	communityID, err := uuid.Parse(relation.CommunityID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse community id. id=%v", relation.CommunityID)
+	}
+
+	return &communityID, nil
+}
+
 // ListByCommunity implements repository.TopicRepository.
-func (t *topicRepository) ListByCommunity(c context.Context, communityID uuid.UUID, page dmodel.Range) ([]dmodel.Topic, error) {
-	iTopics := []imodel.Topic{}
-	if err := t.topicStoreConnection.Read().
+func (t *topicRepository) ListByCommunity(c context.Context, communityID uuid.UUID, page dmodel.Range) ([]dmodel.Topic, *dmodel.Cursor, error) {
+	iTopics := []struct {
+		imodel.Topic
+		CreatedAt time.Time
+	}{}
+	if err := paginate(t.topicStoreConnection.Read().WithContext(c).
 		Model(&imodel.Topic{}).
-		Select("topics.id as id, topics.name as name").
+		Select("topics.id as id, topics.name as name, topics.created_at as created_at").
 		Joins("inner join topic_community_relations on topics.id = topic_community_relations.topic_id").
-		Where("topic_community_relations.community_id = ?", communityID.String()).
-		Order("topics.created_at asc").
-		Limit(page.Limit).Offset(page.Offset).
+		Where("topic_community_relations.community_id = ?", communityID.String()), page, "topics.created_at", "topics.id").
 		Scan(&iTopics).Error; err != nil {
-		return nil, errors.Wrapf(err, "failed to list topic. community_id=%v", communityID.String())
+		return nil, nil, errors.Wrapf(err, "failed to list topic. community_id=%v", communityID.String())
 	}
 
 	dTopics := []dmodel.Topic{}
 	for _, iTopic := range iTopics {
 		var created *string
 		memberRelation := imodel.TopicFromMemberRelation{TopicID: iTopic.ID}
-		if err := t.topicStoreConnection.Read().
+		if err := t.topicStoreConnection.Read().WithContext(c).
 			First(&memberRelation).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				created = nil
 			} else {
-				return nil, errors.Wrapf(err, "failed to get from member relation. topic_id=%v", iTopic.ID)
+				return nil, nil, errors.Wrapf(err, "failed to get from member relation. topic_id=%v", iTopic.ID)
 			}
 		} else {
 			created = &memberRelation.MemberID
@@ -109,13 +133,83 @@ func (t *topicRepository) ListByCommunity(c context.Context, communityID uuid.UU
 
 		dTopic, err := dfactory.NewTopic(iTopic.ID, iTopic.Name, created)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to parse topic. id=%v", iTopic.ID)
+			return nil, nil, errors.Wrapf(err, "failed to parse topic. id=%v", iTopic.ID)
 		}
 
 		dTopics = append(dTopics, *dTopic)
 	}
 
-	return dTopics, nil
+	if len(iTopics) == 0 {
+		return dTopics, nil, nil
+	}
+
+	last := iTopics[len(iTopics)-1]
+	next, err := nextCursor(page, len(iTopics), last.CreatedAt, last.ID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create cursor. id=%v", last.ID)
+	}
+
+	return dTopics, next, nil
+}
+
+// ListIDsByCommunity implements repository.TopicRepository.
+func (t *topicRepository) ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error) {
+	return t.listIDsByCommunity(t.topicStoreConnection.Read().WithContext(c), communityID)
+}
+
+// DeleteByCommunity implements repository.TopicRepository.
+func (t *topicRepository) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	return t.topicStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		topicIDs, err := t.listIDsByCommunity(tx, communityID)
+		if err != nil {
+			return err
+		} else if len(topicIDs) == 0 {
+			return nil
+		}
+
+		parsedTopicIDs := lo.Map(topicIDs, func(id uuid.UUID, _ int) string { return id.String() })
+
+		if err := tx.
+			Where("topic_id in ?", parsedTopicIDs).
+			Delete(&imodel.TopicFromMemberRelation{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete from member relation. community_id=%v", communityID.String())
+		}
+
+		if err := tx.
+			Where("community_id = ?", communityID.String()).
+			Delete(&imodel.TopicCommunityRelation{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete community relation. community_id=%v", communityID.String())
+		}
+
+		if err := tx.
+			Where("id in ?", parsedTopicIDs).
+			Delete(&imodel.Topic{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete topic. community_id=%v", communityID.String())
+		}
+
+		return nil
+	})
+}
+
+func (t *topicRepository) listIDsByCommunity(db *gorm.DB, communityID uuid.UUID) ([]uuid.UUID, error) {
+	relations := []imodel.TopicCommunityRelation{}
+	if err := db.
+		Where("community_id = ?", communityID.String()).
+		Find(&relations).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list community relation. community_id=%v", communityID.String())
+	}
+
+	topicIDs := []uuid.UUID{}
+	for _, relation := range relations {
+		topicID, err := uuid.Parse(relation.TopicID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse topic id. id=%v", relation.TopicID)
+		}
+
+		topicIDs = append(topicIDs, topicID)
+	}
+
+	return topicIDs, nil
 }
 
 func NewTopicRepository(i *do.Injector) (drepository.TopicRepository, error) {
@@ -4,10 +4,12 @@ import (
 	dfactory "app/domain/factory"
 	dmodel "app/domain/model"
 	drepository "app/domain/repository"
+	ikvs "app/infrastructure/adapter/datastore/kvs"
 	irdb "app/infrastructure/adapter/datastore/rdb"
 	imodel "app/infrastructure/model"
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -19,16 +21,34 @@ import (
 )
 
 type userRepository struct {
-	userStoreConnection irdb.UserStoreConnection
+	userStoreConnection      irdb.UserStoreConnection
+	userStoreConnectionCache ikvs.CacheStoreConnection
 }
 
 // List implements repository.UserRepository.
 func (u *userRepository) List(c context.Context, ids []uuid.UUID) ([]dmodel.User, error) {
-	users := []imodel.User{}
-	if err := u.userStoreConnection.Read().
-		Where("id in ?", lo.Map(ids, func(id uuid.UUID, _ int) string { return id.String() })).
-		Find(&users).Error; err != nil {
-		return nil, errors.Wrapf(err, "fialed to list user. ids=%v", ids)
+	keys := lo.Map(ids, func(id uuid.UUID, _ int) string { return cacheKey(cacheKeyUser, id) })
+	cached := listCache[imodel.User](c, u.userStoreConnectionCache, keys)
+
+	missingIDs := lo.Filter(ids, func(id uuid.UUID, _ int) bool {
+		_, ok := cached[cacheKey(cacheKeyUser, id)]
+		return !ok
+	})
+
+	users := lo.Values(cached)
+	if len(missingIDs) > 0 {
+		missingUsers := []imodel.User{}
+		if err := u.userStoreConnection.Read().WithContext(c).
+			Where("id in ?", lo.Map(missingIDs, func(id uuid.UUID, _ int) string { return id.String() })).
+			Find(&missingUsers).Error; err != nil {
+			return nil, errors.Wrapf(err, "fialed to list user. ids=%v", missingIDs)
+		}
+
+		for _, user := range missingUsers {
+			setCache(c, u.userStoreConnectionCache, fmt.Sprintf(cacheKeyUser, user.ID), user)
+		}
+
+		users = append(users, missingUsers...)
 	}
 
 	dUsers := []dmodel.User{}
@@ -55,14 +75,20 @@ func (u *userRepository) List(c context.Context, ids []uuid.UUID) ([]dmodel.User
 // Get implements repository.UserRepository.
 func (u *userRepository) Get(c context.Context, id uuid.UUID) (*dmodel.User, error) {
 	user := imodel.User{}
-	if err := u.userStoreConnection.Read().
-		Where("id = ?", id.String()).
-		First(&user).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil
+	if cached, ok := getCache[imodel.User](c, u.userStoreConnectionCache, cacheKey(cacheKeyUser, id)); ok {
+		user = *cached
+	} else {
+		if err := u.userStoreConnection.Read().WithContext(c).
+			Where("id = ?", id.String()).
+			First(&user).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil
+			}
+
+			return nil, errors.Wrapf(err, "fialed to get user. id=%v", id.String())
 		}
 
-		return nil, errors.Wrapf(err, "fialed to get user. id=%v", id.String())
+		setCache(c, u.userStoreConnectionCache, cacheKey(cacheKeyUser, id), user)
 	}
 
 	var imageURL *string
@@ -84,7 +110,7 @@ func (u *userRepository) Get(c context.Context, id uuid.UUID) (*dmodel.User, err
 // GetBySubject implements repository.UserRepository.
 func (u *userRepository) GetBySubject(c context.Context, subject dmodel.Subject) (*dmodel.User, error) {
 	user := imodel.User{}
-	if err := u.userStoreConnection.Read().
+	if err := u.userStoreConnection.Read().WithContext(c).
 		Where("subject = ?", subject).
 		First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -110,9 +136,38 @@ func (u *userRepository) GetBySubject(c context.Context, subject dmodel.Subject)
 	return dUser, nil
 }
 
+// GetByEmail implements repository.UserRepository.
+func (u *userRepository) GetByEmail(c context.Context, email dmodel.EmailAddress) (*dmodel.User, error) {
+	user := imodel.User{}
+	if err := u.userStoreConnection.Read().WithContext(c).
+		Where("email = ?", email.String()).
+		First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrapf(err, "failed to get user. email=%v", email.String())
+	}
+
+	var imageURL *string
+	if user.ImageUrl.Valid {
+		imageURL = &user.ImageUrl.String
+	} else {
+		imageURL = nil
+	}
+
+	dUser, err := dfactory.NewUser(user.ID, user.Subject, user.Email, user.Issuer, user.Name, imageURL)
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse user. email=%v", email.String())
+	}
+
+	return dUser, nil
+}
+
 // Save implements repository.UserRepository.
 func (u *userRepository) Save(c context.Context, user dmodel.User) error {
-	return u.userStoreConnection.Write().Transaction(func(tx *gorm.DB) error {
+	if err := u.userStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
 		imageURL := func(user dmodel.User) sql.NullString {
 			if user.ImageURL == nil {
 				return sql.NullString{}
@@ -139,12 +194,20 @@ func (u *userRepository) Save(c context.Context, user dmodel.User) error {
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	deleteCache(c, u.userStoreConnectionCache, cacheKey(cacheKeyUser, user.ID))
+
+	return nil
 }
 
 func NewUserRepository(i *do.Injector) (drepository.UserRepository, error) {
 	userStoreConnection := do.MustInvoke[irdb.UserStoreConnection](i)
+	userStoreConnectionCache := do.MustInvoke[ikvs.CacheStoreConnection](i)
 	return &userRepository{
-		userStoreConnection: userStoreConnection,
+		userStoreConnection:      userStoreConnection,
+		userStoreConnectionCache: userStoreConnectionCache,
 	}, nil
 }
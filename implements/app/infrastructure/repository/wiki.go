@@ -0,0 +1,400 @@
+package repository
+
+import (
+	dfactory "app/domain/factory"
+	dmodel "app/domain/model"
+	drepository "app/domain/repository"
+	imodel "app/infrastructure/model"
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	irdb "app/infrastructure/adapter/datastore/rdb"
+)
+
+type pageRepository struct {
+	noteStoreConnection irdb.NoteStoreConnection
+}
+
+type scannedPage struct {
+	ID       string
+	ParentID sql.NullString
+	Title    string
+	Order    int `gorm:"column:order_number"`
+	MemberID sql.NullString
+}
+
+// Create implements repository.PageRepository.
+func (p *pageRepository) Create(c context.Context, page dmodel.Page, communityID uuid.UUID) error {
+	return p.noteStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := p.lockSiblings(tx, communityID, page.ParentID); err != nil {
+			return err
+		}
+
+		if err := p.siblings(tx, communityID, page.ParentID).
+			Where("order_number >= ?", page.Order.Int()).
+			Update("order_number", gorm.Expr("order_number + 1")).Error; err != nil {
+			return errors.Wrapf(err, "failed to update page order. community_id=%v", communityID.String())
+		}
+
+		if err := tx.
+			Create(&imodel.Page{
+				ID:       page.ID.String(),
+				ParentID: toPageParentID(page.ParentID),
+				Title:    page.Title.String(),
+				Order:    page.Order.Int(),
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to create page. id=%v", page.ID.String())
+		}
+
+		if err := tx.
+			Create(&imodel.PageCommunityRelation{
+				PageID:      page.ID.String(),
+				CommunityID: communityID.String(),
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to create community relation. page_id=%v", page.ID.String())
+		}
+
+		if page.Created != nil {
+			if err := tx.
+				Create(&imodel.PageFromMemberRelation{
+					PageID:   page.ID.String(),
+					MemberID: page.Created.String(),
+				}).Error; err != nil {
+				return errors.Wrapf(err, "failed to create from member relation. page_id=%v", page.ID.String())
+			}
+		}
+
+		return nil
+	})
+}
+
+// Get implements repository.PageRepository.
+func (p *pageRepository) Get(c context.Context, communityID uuid.UUID, id uuid.UUID) (*dmodel.Page, error) {
+	pages := []scannedPage{}
+	if err := p.selectPages(p.noteStoreConnection.Read().WithContext(c)).
+		Where("page_community_relations.community_id = ?", communityID.String()).
+		Where("pages.id = ?", id.String()).
+		Scan(&pages).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get page. id=%v", id.String())
+	}
+
+	if len(pages) < 1 {
+		return nil, nil
+	}
+
+	return p.toPage(pages[0])
+}
+
+// ListByCommunity implements repository.PageRepository.
+func (p *pageRepository) ListByCommunity(c context.Context, communityID uuid.UUID) ([]dmodel.Page, error) {
+	pages := []scannedPage{}
+	if err := p.selectPages(p.noteStoreConnection.Read().WithContext(c)).
+		Where("page_community_relations.community_id = ?", communityID.String()).
+		Order("pages.parent_id asc, pages.order_number asc").
+		Scan(&pages).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list page. community_id=%v", communityID.String())
+	}
+
+	return p.toPages(pages)
+}
+
+// ListIDsByCommunity implements repository.PageRepository.
+func (p *pageRepository) ListIDsByCommunity(c context.Context, communityID uuid.UUID) ([]uuid.UUID, error) {
+	return p.listIDsByCommunity(p.noteStoreConnection.Read().WithContext(c), communityID)
+}
+
+// Rename implements repository.PageRepository.
+func (p *pageRepository) Rename(c context.Context, id uuid.UUID, title dmodel.Name) error {
+	if err := p.noteStoreConnection.Write().WithContext(c).
+		Model(&imodel.Page{ID: id.String()}).
+		Update("title", title.String()).Error; err != nil {
+		return errors.Wrapf(err, "failed to rename page. id=%v", id.String())
+	}
+
+	return nil
+}
+
+// Move implements repository.PageRepository.
+// 元の兄弟の間を詰めてから、移動先の兄弟を後ろにずらして差し込む
+func (p *pageRepository) Move(c context.Context, communityID uuid.UUID, id uuid.UUID, parentID *uuid.UUID, order dmodel.OrderNumber) error {
+	return p.noteStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		page := imodel.Page{ID: id.String()}
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&page).Error; err != nil {
+			return errors.Wrapf(err, "failed to get page. id=%v", id.String())
+		}
+
+		currentParentID, err := toPageParentUUID(page.ParentID)
+		if err != nil {
+			return err
+		}
+
+		if err := p.lockSiblings(tx, communityID, currentParentID); err != nil {
+			return err
+		}
+
+		if err := p.lockSiblings(tx, communityID, parentID); err != nil {
+			return err
+		}
+
+		if err := p.siblings(tx, communityID, currentParentID).
+			Where("order_number > ?", page.Order).
+			Update("order_number", gorm.Expr("order_number - 1")).Error; err != nil {
+			return errors.Wrapf(err, "failed to update page order. id=%v", id.String())
+		}
+
+		if err := p.siblings(tx, communityID, parentID).
+			Where("id <> ?", id.String()).
+			Where("order_number >= ?", order.Int()).
+			Update("order_number", gorm.Expr("order_number + 1")).Error; err != nil {
+			return errors.Wrapf(err, "failed to update page order. id=%v", id.String())
+		}
+
+		if err := tx.
+			Model(&imodel.Page{ID: id.String()}).
+			Updates(map[string]any{
+				"parent_id":    toPageParentID(parentID),
+				"order_number": order.Int(),
+			}).Error; err != nil {
+			return errors.Wrapf(err, "failed to move page. id=%v", id.String())
+		}
+
+		return nil
+	})
+}
+
+// Delete implements repository.PageRepository.
+// 消すページの外にある兄弟は、間を詰める
+func (p *pageRepository) Delete(c context.Context, communityID uuid.UUID, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	parsedIDs := lo.Map(ids, func(id uuid.UUID, _ int) string { return id.String() })
+
+	return p.noteStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		pages := []imodel.Page{}
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id in ?", parsedIDs).
+			Order("order_number desc").
+			Find(&pages).Error; err != nil {
+			return errors.Wrapf(err, "failed to list page. community_id=%v", communityID.String())
+		}
+
+		for _, page := range pages {
+			if page.ParentID != nil && slices.Contains(parsedIDs, *page.ParentID) {
+				continue
+			}
+
+			parentID, err := toPageParentUUID(page.ParentID)
+			if err != nil {
+				return err
+			}
+
+			if err := p.siblings(tx, communityID, parentID).
+				Where("order_number > ?", page.Order).
+				Update("order_number", gorm.Expr("order_number - 1")).Error; err != nil {
+				return errors.Wrapf(err, "failed to update page order. id=%v", page.ID)
+			}
+		}
+
+		return p.delete(tx, parsedIDs)
+	})
+}
+
+// DeleteByCommunity implements repository.PageRepository.
+func (p *pageRepository) DeleteByCommunity(c context.Context, communityID uuid.UUID) error {
+	return p.noteStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		pageIDs, err := p.listIDsByCommunity(tx, communityID)
+		if err != nil {
+			return err
+		} else if len(pageIDs) == 0 {
+			return nil
+		}
+
+		return p.delete(tx, lo.Map(pageIDs, func(id uuid.UUID, _ int) string { return id.String() }))
+	})
+}
+
+// SaveLinks implements repository.PageRepository.
+func (p *pageRepository) SaveLinks(c context.Context, id uuid.UUID, targetIDs []uuid.UUID) error {
+	return p.noteStoreConnection.Write().WithContext(c).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Where("page_id = ?", id.String()).
+			Delete(&imodel.PageLink{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete page link. page_id=%v", id.String())
+		}
+
+		if len(targetIDs) == 0 {
+			return nil
+		}
+
+		links := lo.Map(lo.Uniq(targetIDs), func(targetID uuid.UUID, _ int) imodel.PageLink {
+			return imodel.PageLink{PageID: id.String(), TargetPageID: targetID.String()}
+		})
+		if err := tx.
+			Create(&links).Error; err != nil {
+			return errors.Wrapf(err, "failed to create page link. page_id=%v", id.String())
+		}
+
+		return nil
+	})
+}
+
+// ListBacklinks implements repository.PageRepository.
+func (p *pageRepository) ListBacklinks(c context.Context, id uuid.UUID) ([]dmodel.Page, error) {
+	pages := []scannedPage{}
+	if err := p.selectPages(p.noteStoreConnection.Read().WithContext(c)).
+		Joins("inner join page_links on pages.id = page_links.page_id").
+		Where("page_links.target_page_id = ?", id.String()).
+		Order("pages.title asc, pages.id asc").
+		Scan(&pages).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list backlink. page_id=%v", id.String())
+	}
+
+	return p.toPages(pages)
+}
+
+func (p *pageRepository) selectPages(db *gorm.DB) *gorm.DB {
+	return db.
+		Model(&imodel.Page{}).
+		Select("pages.id as id, pages.parent_id as parent_id, pages.title as title, pages.order_number as order_number, page_from_member_relations.member_id as member_id").
+		Joins("inner join page_community_relations on pages.id = page_community_relations.page_id").
+		Joins("left join page_from_member_relations on pages.id = page_from_member_relations.page_id")
+}
+
+// ルートのページは親を持たないため、コミュニティで絞り込む
+func (p *pageRepository) siblings(db *gorm.DB, communityID uuid.UUID, parentID *uuid.UUID) *gorm.DB {
+	db = db.
+		Model(&imodel.Page{}).
+		Where("id in (?)", db.
+			Model(&imodel.PageCommunityRelation{}).
+			Select("page_id").
+			Where("community_id = ?", communityID.String()))
+
+	if parentID == nil {
+		return db.Where("parent_id is null")
+	}
+
+	return db.Where("parent_id = ?", parentID.String())
+}
+
+func (p *pageRepository) lockSiblings(tx *gorm.DB, communityID uuid.UUID, parentID *uuid.UUID) error {
+	if err := p.siblings(tx, communityID, parentID).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Order("order_number asc").
+		Find(&[]imodel.Page{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to lock pages. community_id=%v", communityID.String())
+	}
+
+	return nil
+}
+
+func (p *pageRepository) delete(tx *gorm.DB, ids []string) error {
+	if err := tx.
+		Where("page_id in ? or target_page_id in ?", ids, ids).
+		Delete(&imodel.PageLink{}).Error; err != nil {
+		return errors.Wrap(err, "failed to delete page link")
+	}
+
+	for _, model := range []any{
+		&imodel.PageFromMemberRelation{},
+		&imodel.PageCommunityRelation{},
+	} {
+		if err := tx.
+			Where("page_id in ?", ids).
+			Delete(model).Error; err != nil {
+			return errors.Wrap(err, "failed to delete page relation")
+		}
+	}
+
+	if err := tx.
+		Where("id in ?", ids).
+		Delete(&imodel.Page{}).Error; err != nil {
+		return errors.Wrap(err, "failed to delete page")
+	}
+
+	return nil
+}
+
+func (p *pageRepository) listIDsByCommunity(db *gorm.DB, communityID uuid.UUID) ([]uuid.UUID, error) {
+	relations := []imodel.PageCommunityRelation{}
+	if err := db.
+		Where("community_id = ?", communityID.String()).
+		Find(&relations).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list community relation. community_id=%v", communityID.String())
+	}
+
+	pageIDs := []uuid.UUID{}
+	for _, relation := range relations {
+		pageID, err := uuid.Parse(relation.PageID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse page id. id=%v", relation.PageID)
+		}
+
+		pageIDs = append(pageIDs, pageID)
+	}
+
+	return pageIDs, nil
+}
+
+func (p *pageRepository) toPages(pages []scannedPage) ([]dmodel.Page, error) {
+	dPages := []dmodel.Page{}
+	for _, page := range pages {
+		dPage, err := p.toPage(page)
+		if err != nil {
+			return nil, err
+		}
+
+		dPages = append(dPages, *dPage)
+	}
+
+	return dPages, nil
+}
+
+func (p *pageRepository) toPage(page scannedPage) (*dmodel.Page, error) {
+	dPage, err := dfactory.NewPage(page.ID, toNullableString(page.ParentID), page.Title, page.Order, toNullableString(page.MemberID))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse page. id=%v", page.ID)
+	}
+
+	return dPage, nil
+}
+
+func toPageParentID(parentID *uuid.UUID) *string {
+	if parentID == nil {
+		return nil
+	}
+
+	return lo.ToPtr(parentID.String())
+}
+
+func toPageParentUUID(parentID *string) (*uuid.UUID, error) {
+	if parentID == nil {
+		return nil, nil
+	}
+
+	parsedParentID, err := uuid.Parse(*parentID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse parent id. id=%v", *parentID)
+	}
+
+	return &parsedParentID, nil
+}
+
+func NewPageRepository(i *do.Injector) (drepository.PageRepository, error) {
+	noteStoreConnection := do.MustInvoke[irdb.NoteStoreConnection](i)
+	return &pageRepository{
+		noteStoreConnection: noteStoreConnection,
+	}, nil
+}
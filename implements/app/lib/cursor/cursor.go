@@ -0,0 +1,82 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var signingKey []byte
+
+// 空の鍵では署名を偽造できるため、鍵がなければ起動しない
+func Init() error {
+	key := os.Getenv("CURSOR_SIGNING_KEY")
+	if key == "" {
+		return errors.New("CURSOR_SIGNING_KEY is empty")
+	}
+
+	signingKey = []byte(key)
+
+	return nil
+}
+
+// 一覧の続きを示す不透明な文字列（時刻とIDと、IDが一意でない一覧ではその後の並び順を決めるキーを署名付きでエンコードする）
+// scope は署名にのみ含め、発行した一覧以外ではカーソルを受け付けない
+func Encode(scope string, at time.Time, id string, key string) string {
+	payload := fmt.Sprintf("%v.%v.%v", at.UnixNano(), id, key)
+	return fmt.Sprintf("%v.%v", base64.RawURLEncoding.EncodeToString([]byte(payload)), sign(scope, payload))
+}
+
+func Decode(scope string, v string) (time.Time, string, string, error) {
+	if len(signingKey) == 0 {
+		return time.Time{}, "", "", errors.New("cursor signing key is not initialized")
+	}
+
+	encoded, signature, ok := strings.Cut(v, ".")
+	if !ok {
+		return time.Time{}, "", "", errors.Errorf("invalid cursor. v=%v", v)
+	}
+
+	bin, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return time.Time{}, "", "", errors.Wrapf(err, "failed to decode cursor. v=%v", v)
+	}
+
+	payload := string(bin)
+	if !hmac.Equal([]byte(signature), []byte(sign(scope, payload))) {
+		return time.Time{}, "", "", errors.Errorf("invalid cursor signature. v=%v", v)
+	}
+
+	nano, rest, ok := strings.Cut(payload, ".")
+	if !ok {
+		return time.Time{}, "", "", errors.Errorf("invalid cursor. v=%v", v)
+	}
+
+	// キーには . が含まれうるため、ID の後ろはすべてキーとする
+	id, key, ok := strings.Cut(rest, ".")
+	if !ok {
+		return time.Time{}, "", "", errors.Errorf("invalid cursor. v=%v", v)
+	}
+
+	unixNano, err := strconv.ParseInt(nano, 10, 64)
+	if err != nil {
+		return time.Time{}, "", "", errors.Wrapf(err, "failed to parse cursor. v=%v", v)
+	}
+
+	return time.Unix(0, unixNano), id, key, nil
+}
+
+func sign(scope string, payload string) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte(scope))
+	mac.Write([]byte{0})
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -32,7 +32,8 @@ const (
 )
 
 var (
-	sessionStore *redisstore.RedisStore
+	sessionStore  *redisstore.RedisStore
+	sessionClient *redis.Client
 )
 
 func Init() error {
@@ -63,12 +64,21 @@ func Init() error {
 	store.KeyPrefix(os.Getenv("SESSION_KEY_PREFIX"))
 
 	sessionStore = store
+	sessionClient = client
 
 	fmt.Printf("connection established. %v:%v \n", os.Getenv("SESSION_STORE_HOST"), os.Getenv("SESSION_STORE_PORT"))
 
 	return nil
 }
 
+func Ping(c context.Context) error {
+	return sessionClient.Ping(c).Err()
+}
+
+func Close() error {
+	return sessionClient.Close()
+}
+
 func SetLoginSession(c echo.Context, id string, email string, name string, expire time.Time) error {
 	session, err := sessionStore.Get(c.Request(), SessionKey)
 
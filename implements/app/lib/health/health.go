@@ -0,0 +1,88 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type Status string
+
+func (s Status) String() string {
+	return string(s)
+}
+
+const (
+	StatusOK Status = "ok"
+	StatusNG Status = "ng"
+)
+
+type Check struct {
+	Name string
+	Ping func(c context.Context) error
+}
+
+type Result struct {
+	Name    string
+	Status  Status
+	Latency time.Duration
+	Err     error
+}
+
+// Run はすべてのチェックを並行に実行し、チェックごとに timeout で打ち切る
+func Run(c context.Context, checks []Check, timeout time.Duration) []Result {
+	results := make([]Result, len(checks))
+
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = run(c, check, timeout)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+func run(c context.Context, check Check, timeout time.Duration) Result {
+	ctx, cancel := context.WithTimeout(c, timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		done <- check.Ping(ctx)
+	}()
+
+	// Ping がコンテキストを無視する場合でも timeout で打ち切る
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := Result{
+		Name:    check.Name,
+		Status:  StatusOK,
+		Latency: time.Since(start),
+		Err:     err,
+	}
+	if err != nil {
+		result.Status = StatusNG
+	}
+
+	return result
+}
+
+func Healthy(results []Result) bool {
+	for _, result := range results {
+		if result.Status != StatusOK {
+			return false
+		}
+	}
+
+	return true
+}
@@ -1,6 +1,8 @@
 package lock
 
 import (
+	lmetrics "app/lib/metrics"
+	ltracing "app/lib/tracing"
 	"context"
 	"fmt"
 	"os"
@@ -29,6 +31,8 @@ func Init() error {
 		DB:       sessionStoreDB,
 	})
 
+	c.AddHook(ltracing.NewRedisHook("lock"))
+
 	if err := c.Set(context.Background(), os.Getenv("LOCK_STORE_HOST"), "", time.Second).Err(); err != nil {
 		return errors.Wrap(err, "failed to connect session store")
 	}
@@ -38,6 +42,14 @@ func Init() error {
 	return nil
 }
 
+func Ping(c context.Context) error {
+	return client.Ping(c).Err()
+}
+
+func Close() error {
+	return client.Close()
+}
+
 func Set(c context.Context, key uuid.UUID, ttl time.Duration) error {
 	if err := client.Del(c, key.String()).Err(); err != nil {
 		if !errors.Is(err, redis.Nil) {
@@ -56,6 +68,7 @@ func Exist(c context.Context, key uuid.UUID) (bool, error) {
 		return false, err
 	}
 
+	lmetrics.IncLockContention()
 	return true, nil
 }
 
@@ -3,6 +3,7 @@ package log
 import (
 	lcontext "app/lib/context"
 	"app/lib/environment"
+	ltracing "app/lib/tracing"
 	"context"
 	"fmt"
 	"log/slog"
@@ -53,8 +54,14 @@ func log(c context.Context, level slog.Level, message string) {
 	_, file, line, _ := runtime.Caller(2)
 	fileName := strings.Split(file, "/")[len(strings.Split(file, "/"))-1]
 
-	logger.LogAttrs(context.Background(), level, message,
+	attrs := []slog.Attr{
 		slog.String(lcontext.ContextKeyRequestID.String(), requestID),
 		slog.String("caller", fmt.Sprintf("%v:%v", fileName, line)),
-	)
+	}
+
+	if traceID, spanID, ok := ltracing.IDs(c); ok {
+		attrs = append(attrs, slog.String("trace_id", traceID), slog.String("span_id", spanID))
+	}
+
+	logger.LogAttrs(context.Background(), level, message, attrs...)
 }
@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "app"
+
+type Backend string
+
+func (b Backend) String() string {
+	return string(b)
+}
+
+const (
+	BackendMySQL         Backend = "mysql"
+	BackendMongoDB       Backend = "mongodb"
+	BackendInfluxDB      Backend = "influxdb"
+	BackendElasticsearch Backend = "elasticsearch"
+)
+
+type Result string
+
+func (r Result) String() string {
+	return string(r)
+}
+
+const (
+	ResultSuccess Result = "success"
+	ResultFailure Result = "failure"
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests per OpenAPI operation.",
+	}, []string{"operation_id", "method", "status"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency per OpenAPI operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation_id", "method"})
+
+	subscriberMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "subscriber_messages_total",
+		Help:      "Number of consumed messages per exchange and queue.",
+	}, []string{"exchange", "queue", "result"})
+
+	subscriberHandleDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "subscriber_handle_duration_seconds",
+		Help:      "Time spent handling a message per exchange and queue.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"exchange", "queue"})
+
+	publishFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "mq_publish_failures_total",
+		Help:      "Number of messages that could not be published.",
+	}, []string{"exchange", "routing_key"})
+
+	datastoreQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "datastore_query_duration_seconds",
+		Help:      "Query latency per datastore.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"backend", "store", "operation"})
+
+	noteSessions = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "note_websocket_sessions",
+		Help:      "Number of active note editing websocket sessions.",
+	})
+
+	lockContentions = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "lock_contentions_total",
+		Help:      "Number of lock checks that found the key already held.",
+	})
+)
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+func ObserveHTTPRequest(operationID string, method string, status string, duration time.Duration) {
+	httpRequests.WithLabelValues(operationID, method, status).Inc()
+	httpRequestDuration.WithLabelValues(operationID, method).Observe(duration.Seconds())
+}
+
+func ObserveSubscriberMessage(exchange string, queue string, result Result, duration time.Duration) {
+	subscriberMessages.WithLabelValues(exchange, queue, result.String()).Inc()
+	subscriberHandleDuration.WithLabelValues(exchange, queue).Observe(duration.Seconds())
+}
+
+func IncPublishFailure(exchange string, routingKey string) {
+	publishFailures.WithLabelValues(exchange, routingKey).Inc()
+}
+
+func ObserveQuery(backend Backend, store string, operation string, duration time.Duration) {
+	datastoreQueryDuration.WithLabelValues(backend.String(), store, operation).Observe(duration.Seconds())
+}
+
+// ObserveQuerySince は defer で呼び出すことを想定している
+func ObserveQuerySince(backend Backend, store string, operation string, start time.Time) {
+	ObserveQuery(backend, store, operation, time.Since(start))
+}
+
+func IncNoteSession() {
+	noteSessions.Inc()
+}
+
+func DecNoteSession() {
+	noteSessions.Dec()
+}
+
+func IncLockContention() {
+	lockContentions.Inc()
+}
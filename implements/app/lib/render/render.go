@@ -0,0 +1,454 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/pkg/errors"
+)
+
+const (
+	TypeText        = "text"
+	TypeHeading     = "heading"
+	TypeList        = "list"
+	TypeCheckbox    = "checkbox"
+	TypeRadiobutton = "radiobutton"
+	TypeLine        = "line"
+	TypeImg         = "img"
+	TypeMentions    = "mentions"
+	TypeCode        = "code"
+	TypeTable       = "table"
+	TypeQuote       = "quote"
+
+	PropertyToggle     = "toggle"
+	PropertyBlockquote = "blockquote"
+	PropertyCallout    = "callout"
+
+	listTypeInteger = "integer"
+	maxHeadingLevel = 4
+	maxLineSize     = 4
+)
+
+var (
+	// model.NewURL と同じく http と https のみ許可する
+	urlPattern   = regexp.MustCompile(`^https?://[\w/:%#\$&\?\(\)~\.=\+\-]+$`)
+	colorPattern = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+	sizePattern  = regexp.MustCompile(`^[0-9]{1,4}(?:px|%)?$`)
+	// OpenAPI の Code.language と同じ文字だけ許可する
+	languagePattern = regexp.MustCompile(`^[\w#+.\-]{1,32}$`)
+
+	policy = newPolicy()
+)
+
+// Content は保存されている内容の種類と JSON
+type Content struct {
+	Type string
+	Bin  []byte
+}
+
+// Line はノートの行（属性がない場合 Property は空文字）
+type Line struct {
+	Property string
+	Contents []Content
+}
+
+type text struct {
+	Value  string       `json:"value"`
+	Option textProperty `json:"option"`
+}
+
+type textProperty struct {
+	Bold      bool    `json:"bold"`
+	Oblique   bool    `json:"oblique"`
+	Underline bool    `json:"underline"`
+	Through   bool    `json:"through"`
+	Color     *string `json:"color"`
+	Url       *string `json:"url"`
+}
+
+type heading struct {
+	Value text `json:"value"`
+	Level int  `json:"level"`
+}
+
+type list struct {
+	Type   string `json:"type"`
+	Values []struct {
+		Value  text `json:"value"`
+		Indent int  `json:"indent"`
+	} `json:"values"`
+}
+
+// チェックボックスとラジオボタンは同じ形をしている
+type choices struct {
+	Values []struct {
+		Value   text `json:"value"`
+		Checked bool `json:"checked"`
+	} `json:"values"`
+}
+
+type horizontalLine struct {
+	Size int `json:"size"`
+}
+
+type image struct {
+	Url    string  `json:"url"`
+	Width  *string `json:"width"`
+	Height *string `json:"height"`
+}
+
+type code struct {
+	Language *string `json:"language"`
+	Source   string  `json:"source"`
+}
+
+type table struct {
+	Header []text   `json:"header"`
+	Rows   [][]text `json:"rows"`
+}
+
+type quote struct {
+	PostID string `json:"post_id"`
+	Value  string `json:"value"`
+}
+
+type mention struct {
+	Id       string `json:"id"`
+	Resource string `json:"resource"`
+}
+
+// 描画する要素と属性だけを許可する
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+
+	p.AllowElements("p", "br", "strong", "em", "u", "s", "h1", "h2", "h3", "h4", "ul", "ol", "li", "hr", "blockquote", "details", "summary", "aside", "pre", "table", "thead", "tbody", "tr", "th", "td")
+
+	p.RequireParseableURLs(true)
+	p.AllowURLSchemes("http", "https")
+	p.AllowRelativeURLs(false)
+	p.RequireNoFollowOnLinks(true)
+	p.RequireNoReferrerOnLinks(true)
+	p.AllowAttrs("href").Matching(urlPattern).OnElements("a")
+	p.AllowAttrs("src").Matching(urlPattern).OnElements("img")
+	p.AllowAttrs("alt").OnElements("img")
+	p.AllowAttrs("width", "height").Matching(sizePattern).OnElements("img")
+
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^(?:checkbox|radio)$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").Matching(regexp.MustCompile(`^$`)).OnElements("input")
+
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^(?:checkbox|radiobutton)$`)).OnElements("ul")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^line-size-[0-4]$`)).OnElements("hr")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^callout$`)).OnElements("aside")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^mention$`)).OnElements("span")
+	p.AllowAttrs("data-resource").Matching(regexp.MustCompile(`^[a-z]+$`)).OnElements("span")
+	p.AllowAttrs("data-id").Matching(regexp.MustCompile(`^[0-9a-f\-]{36}$`)).OnElements("span")
+	p.AllowStyles("color").Matching(colorPattern).OnElements("span")
+
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w#+.\-]{1,32}$`)).OnElements("code")
+	p.AllowElements("code")
+	p.AllowAttrs("data-post-id").Matching(regexp.MustCompile(`^[0-9a-f\-]{36}$`)).OnElements("blockquote")
+
+	return p
+}
+
+// Contents は内容を HTML に変換する
+// テキストとメンションは段落にまとめ、それ以外の内容はブロックとして並べる
+func Contents(contents []Content) (string, error) {
+	var b strings.Builder
+	if err := writeContents(&b, contents); err != nil {
+		return "", err
+	}
+
+	return policy.Sanitize(b.String()), nil
+}
+
+// Lines はノートの行を HTML に変換する
+// トグルは先頭の内容を見出しとし、残りの内容を折りたたむ
+func Lines(lines []Line) (string, error) {
+	var b strings.Builder
+	for _, line := range lines {
+		switch line.Property {
+		case PropertyToggle:
+			b.WriteString("<details>")
+			if len(line.Contents) > 0 {
+				b.WriteString("<summary>")
+				if err := writeContents(&b, line.Contents[:1]); err != nil {
+					return "", err
+				}
+				b.WriteString("</summary>")
+
+				if err := writeContents(&b, line.Contents[1:]); err != nil {
+					return "", err
+				}
+			}
+			b.WriteString("</details>")
+		case PropertyBlockquote:
+			b.WriteString("<blockquote>")
+			if err := writeContents(&b, line.Contents); err != nil {
+				return "", err
+			}
+			b.WriteString("</blockquote>")
+		case PropertyCallout:
+			b.WriteString(`<aside class="callout">`)
+			if err := writeContents(&b, line.Contents); err != nil {
+				return "", err
+			}
+			b.WriteString("</aside>")
+		case "":
+			if err := writeContents(&b, line.Contents); err != nil {
+				return "", err
+			}
+		default:
+			return "", fmt.Errorf("unsupported line property. v=%v", line.Property)
+		}
+
+		b.WriteString("\n")
+	}
+
+	return policy.Sanitize(b.String()), nil
+}
+
+func writeContents(b *strings.Builder, contents []Content) error {
+	paragraph := false
+
+	for _, content := range contents {
+		inline := content.Type == TypeText || content.Type == TypeMentions
+
+		if inline && !paragraph {
+			b.WriteString("<p>")
+			paragraph = true
+		} else if !inline && paragraph {
+			b.WriteString("</p>")
+			paragraph = false
+		}
+
+		if err := writeContent(b, content); err != nil {
+			return err
+		}
+	}
+
+	if paragraph {
+		b.WriteString("</p>")
+	}
+
+	return nil
+}
+
+func writeContent(b *strings.Builder, content Content) error {
+	switch content.Type {
+	case TypeText:
+		var t text
+		if err := unmarshal(content, &t); err != nil {
+			return err
+		}
+
+		// テキスト中の空行は段落の区切りとする
+		for i, paragraph := range strings.Split(t.Value, "\n\n") {
+			if i > 0 {
+				b.WriteString("</p><p>")
+			}
+
+			writeText(b, text{Value: paragraph, Option: t.Option})
+		}
+	case TypeHeading:
+		var h heading
+		if err := unmarshal(content, &h); err != nil {
+			return err
+		}
+
+		level := min(max(h.Level, 1), maxHeadingLevel)
+		fmt.Fprintf(b, "<h%v>", level)
+		writeText(b, h.Value)
+		fmt.Fprintf(b, "</h%v>", level)
+	case TypeList:
+		var l list
+		if err := unmarshal(content, &l); err != nil {
+			return err
+		}
+
+		tag := "ul"
+		if l.Type == listTypeInteger {
+			tag = "ol"
+		}
+
+		// インデントを入れ子のリストにする（飛ばした階層は詰める）
+		open := 0
+		for _, value := range l.Values {
+			level := min(max(value.Indent, 1), open+1)
+			if level > open {
+				b.WriteString("<" + tag + ">")
+				open = level
+			} else {
+				b.WriteString("</li>")
+				for ; open > level; open-- {
+					b.WriteString("</" + tag + "></li>")
+				}
+			}
+
+			b.WriteString("<li>")
+			writeText(b, value.Value)
+		}
+
+		if open > 0 {
+			b.WriteString("</li>")
+			for ; open > 1; open-- {
+				b.WriteString("</" + tag + "></li>")
+			}
+			b.WriteString("</" + tag + ">")
+		}
+	case TypeCheckbox, TypeRadiobutton:
+		var c choices
+		if err := unmarshal(content, &c); err != nil {
+			return err
+		}
+
+		inputType := "checkbox"
+		if content.Type == TypeRadiobutton {
+			inputType = "radio"
+		}
+
+		fmt.Fprintf(b, `<ul class="%v">`, content.Type)
+		for _, value := range c.Values {
+			checked := ""
+			if value.Checked {
+				checked = " checked"
+			}
+
+			fmt.Fprintf(b, `<li><input type="%v" disabled%v> `, inputType, checked)
+			writeText(b, value.Value)
+			b.WriteString("</li>")
+		}
+		b.WriteString("</ul>")
+	case TypeLine:
+		var h horizontalLine
+		if err := unmarshal(content, &h); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(b, `<hr class="line-size-%v">`, min(max(h.Size, 0), maxLineSize))
+	case TypeImg:
+		var i image
+		if err := unmarshal(content, &i); err != nil {
+			return err
+		}
+
+		if !urlPattern.MatchString(i.Url) {
+			return nil
+		}
+
+		fmt.Fprintf(b, `<img src="%v" alt=""`, html.EscapeString(i.Url))
+		if i.Width != nil && sizePattern.MatchString(*i.Width) {
+			fmt.Fprintf(b, ` width="%v"`, *i.Width)
+		}
+		if i.Height != nil && sizePattern.MatchString(*i.Height) {
+			fmt.Fprintf(b, ` height="%v"`, *i.Height)
+		}
+		b.WriteString(">")
+	case TypeMentions:
+		var mentions []mention
+		if err := unmarshal(content, &mentions); err != nil {
+			return err
+		}
+
+		for i, m := range mentions {
+			if i > 0 {
+				b.WriteString(" ")
+			}
+
+			fmt.Fprintf(b, `<span class="mention" data-resource="%v" data-id="%v">@%v</span>`, html.EscapeString(m.Resource), html.EscapeString(m.Id), html.EscapeString(m.Resource))
+		}
+	case TypeCode:
+		var c code
+		if err := unmarshal(content, &c); err != nil {
+			return err
+		}
+
+		b.WriteString("<pre><code")
+		if c.Language != nil && languagePattern.MatchString(*c.Language) {
+			fmt.Fprintf(b, ` class="language-%v"`, *c.Language)
+		}
+		b.WriteString(">" + html.EscapeString(c.Source) + "</code></pre>")
+	case TypeTable:
+		var t table
+		if err := unmarshal(content, &t); err != nil {
+			return err
+		}
+
+		b.WriteString("<table><thead><tr>")
+		for _, cell := range t.Header {
+			b.WriteString("<th>")
+			writeText(b, cell)
+			b.WriteString("</th>")
+		}
+		b.WriteString("</tr></thead><tbody>")
+		for _, row := range t.Rows {
+			b.WriteString("<tr>")
+			for _, cell := range row {
+				b.WriteString("<td>")
+				writeText(b, cell)
+				b.WriteString("</td>")
+			}
+			b.WriteString("</tr>")
+		}
+		b.WriteString("</tbody></table>")
+	case TypeQuote:
+		var q quote
+		if err := unmarshal(content, &q); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(b, `<blockquote data-post-id="%v"><p>`, html.EscapeString(q.PostID))
+		writeText(b, text{Value: q.Value})
+		b.WriteString("</p></blockquote>")
+	default:
+		return fmt.Errorf("unsupported type. v=%v", content.Type)
+	}
+
+	return nil
+}
+
+// 改行は <br> にし、属性は内側から色、下線、斜体、太字、打ち消し線、リンクの順に囲む
+func writeText(b *strings.Builder, t text) {
+	value := strings.ReplaceAll(html.EscapeString(t.Value), "\n", "<br>")
+	if value == "" {
+		return
+	}
+
+	if t.Option.Color != nil && colorPattern.MatchString(*t.Option.Color) {
+		value = fmt.Sprintf(`<span style="color: %v">%v</span>`, *t.Option.Color, value)
+	}
+	if t.Option.Underline {
+		value = "<u>" + value + "</u>"
+	}
+	if t.Option.Oblique {
+		value = "<em>" + value + "</em>"
+	}
+	if t.Option.Bold {
+		value = "<strong>" + value + "</strong>"
+	}
+	if t.Option.Through {
+		value = "<s>" + value + "</s>"
+	}
+	if t.Option.Url != nil && urlPattern.MatchString(*t.Option.Url) {
+		value = fmt.Sprintf(`<a href="%v">%v</a>`, html.EscapeString(*t.Option.Url), value)
+	}
+
+	b.WriteString(value)
+}
+
+func unmarshal(content Content, v any) error {
+	if err := json.Unmarshal(content.Bin, v); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal content. type=%v", content.Type)
+	}
+
+	return nil
+}
+
+// Document はメールなどで単独の文書として使えるように HTML を包む
+func Document(title string, body string) string {
+	return "<!DOCTYPE html>\n<html><head><meta charset=\"UTF-8\"><title>" + html.EscapeString(title) + "</title></head><body>\n" + body + "</body></html>\n"
+}
@@ -0,0 +1,69 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type redisHook struct {
+	store string
+}
+
+// NewRedisHook はコマンドごとにスパンを作成するフックを返す
+func NewRedisHook(store string) redis.Hook {
+	return redisHook{store: store}
+}
+
+// DialHook implements redis.Hook.
+func (r redisHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(c context.Context, network string, addr string) (net.Conn, error) {
+		return next(c, network, addr)
+	}
+}
+
+// ProcessHook implements redis.Hook.
+func (r redisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(c context.Context, cmd redis.Cmder) error {
+		c, span := Start(c, "redis "+cmd.Name(), trace.SpanKindClient,
+			semconv.DBSystemRedis,
+			semconv.DBOperation(cmd.Name()),
+			attribute.String("db.store", r.store),
+		)
+
+		err := next(c, cmd)
+		End(span, ignoreNil(err))
+
+		return err
+	}
+}
+
+// ProcessPipelineHook implements redis.Hook.
+func (r redisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(c context.Context, cmds []redis.Cmder) error {
+		c, span := Start(c, "redis pipeline", trace.SpanKindClient,
+			semconv.DBSystemRedis,
+			attribute.Int("db.redis.commands", len(cmds)),
+			attribute.String("db.store", r.store),
+		)
+
+		err := next(c, cmds)
+		End(span, ignoreNil(err))
+
+		return err
+	}
+}
+
+// キーが存在しないことはエラーとして記録しない
+func ignoreNil(err error) error {
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+
+	return err
+}
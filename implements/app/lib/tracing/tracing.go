@@ -0,0 +1,149 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName         = "app"
+	defaultServiceName = "app"
+)
+
+type Exporter string
+
+func (e Exporter) String() string {
+	return string(e)
+}
+
+const (
+	ExporterNone Exporter = "none"
+	ExporterOTLP Exporter = "otlp"
+)
+
+var (
+	propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	provider   *sdktrace.TracerProvider
+)
+
+// Init は TRACING_EXPORTER に応じてエクスポーターを設定する
+// OTLP の接続先やサンプリングは OTEL_EXPORTER_OTLP_ENDPOINT や OTEL_TRACES_SAMPLER など標準の環境変数で指定する
+func Init(c context.Context) error {
+	otel.SetTextMapPropagator(propagator)
+
+	switch Exporter(os.Getenv("TRACING_EXPORTER")) {
+	case ExporterOTLP:
+		exporter, err := otlptracehttp.New(c)
+		if err != nil {
+			return err
+		}
+
+		return InitWithExporter(c, sdktrace.WithBatcher(exporter))
+	case ExporterNone, "":
+		return nil
+	default:
+		return fmt.Errorf("unknown tracing exporter. exporter=%v", os.Getenv("TRACING_EXPORTER"))
+	}
+}
+
+// InitWithExporter は任意のエクスポーターでトレースを開始する
+// テストでは tracetest.NewInMemoryExporter を sdktrace.WithSyncer で渡す
+func InitWithExporter(c context.Context, option sdktrace.TracerProviderOption) error {
+	otel.SetTextMapPropagator(propagator)
+
+	serviceName := os.Getenv("OTEL_SERVICE_NAME")
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return err
+	}
+
+	provider = sdktrace.NewTracerProvider(option, sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+
+	return nil
+}
+
+// Shutdown は未送信のスパンを送信してから終了する
+func Shutdown(c context.Context) error {
+	if provider == nil {
+		return nil
+	}
+
+	return provider.Shutdown(c)
+}
+
+// プロバイダーを差し替えても反映されるよう、トレーサーは都度取得する
+func Start(c context.Context, name string, kind trace.SpanKind, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(c, name, trace.WithSpanKind(kind), trace.WithAttributes(attributes...))
+}
+
+// End はエラーがあればスパンに記録してから終了する
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+func Inject(c context.Context, carrier propagation.TextMapCarrier) {
+	propagator.Inject(c, carrier)
+}
+
+func Extract(c context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return propagator.Extract(c, carrier)
+}
+
+// IDs はログに出力するトレース ID とスパン ID を返す
+func IDs(c context.Context) (traceID string, spanID string, ok bool) {
+	spanContext := trace.SpanContextFromContext(c)
+	if !spanContext.IsValid() {
+		return "", "", false
+	}
+
+	return spanContext.TraceID().String(), spanContext.SpanID().String(), true
+}
+
+// TableCarrier は AMQP のヘッダーにトレースコンテキストを載せる
+type TableCarrier map[string]any
+
+// Get implements propagation.TextMapCarrier.
+func (t TableCarrier) Get(key string) string {
+	value, ok := t[key].(string)
+	if !ok {
+		return ""
+	}
+
+	return value
+}
+
+// Set implements propagation.TextMapCarrier.
+func (t TableCarrier) Set(key string, value string) {
+	t[key] = value
+}
+
+// Keys implements propagation.TextMapCarrier.
+func (t TableCarrier) Keys() []string {
+	keys := make([]string, 0, len(t))
+	for key := range t {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
@@ -0,0 +1,176 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 送信せずにメモリに記録するエクスポーターでトレースを開始する
+func newExporter(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	if err := InitWithExporter(context.Background(), sdktrace.WithSyncer(exporter)); err != nil {
+		t.Fatalf("failed to init tracing. err=%v", err)
+	}
+	t.Cleanup(func() {
+		if err := Shutdown(context.Background()); err != nil {
+			t.Errorf("failed to shutdown tracing. err=%v", err)
+		}
+	})
+
+	return exporter
+}
+
+func findAttribute(span tracetest.SpanStub, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+
+	return attribute.Value{}, false
+}
+
+func TestStartEnd(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus codes.Code
+		wantEvents int
+	}{
+		{name: "success", err: nil, wantStatus: codes.Unset, wantEvents: 0},
+		{name: "error", err: errors.New("failed"), wantStatus: codes.Error, wantEvents: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := newExporter(t)
+
+			_, span := Start(context.Background(), "mysql query", trace.SpanKindClient, semconv.DBSystemMySQL)
+			End(span, tt.err)
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("spans = %v, want 1", len(spans))
+			}
+
+			got := spans[0]
+			if got.Name != "mysql query" {
+				t.Errorf("name = %v, want mysql query", got.Name)
+			}
+			if got.SpanKind != trace.SpanKindClient {
+				t.Errorf("kind = %v, want %v", got.SpanKind, trace.SpanKindClient)
+			}
+			if v, ok := findAttribute(got, semconv.DBSystemKey); !ok || v.AsString() != "mysql" {
+				t.Errorf("db.system = %v, want mysql", v.AsString())
+			}
+			if got.Status.Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v", got.Status.Code, tt.wantStatus)
+			}
+			if len(got.Events) != tt.wantEvents {
+				t.Errorf("events = %v, want %v", len(got.Events), tt.wantEvents)
+			}
+			if v, ok := findAttribute(got, semconv.ServiceNameKey); ok {
+				t.Errorf("service.name must be a resource attribute. v=%v", v.AsString())
+			}
+			if v, ok := got.Resource.Set().Value(semconv.ServiceNameKey); !ok || v.AsString() != defaultServiceName {
+				t.Errorf("service.name = %v, want %v", v.AsString(), defaultServiceName)
+			}
+		})
+	}
+}
+
+func TestInjectExtract(t *testing.T) {
+	exporter := newExporter(t)
+
+	// パブリッシュ側のスパンをヘッダーに載せ、コンシューマー側で親として引き継ぐ
+	c, producer := Start(context.Background(), "publish", trace.SpanKindProducer)
+	headers := TableCarrier{}
+	Inject(c, headers)
+	End(producer, nil)
+
+	if headers.Get("traceparent") == "" {
+		t.Fatalf("traceparent is not injected. headers=%v", headers)
+	}
+
+	c = Extract(context.Background(), headers)
+	c, consumer := Start(c, "consume", trace.SpanKindConsumer)
+
+	traceID, spanID, ok := IDs(c)
+	if !ok {
+		t.Fatal("IDs() returned no ids")
+	}
+	End(consumer, nil)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("spans = %v, want 2", len(spans))
+	}
+
+	publish, consume := spans[0], spans[1]
+	if consume.SpanContext.TraceID() != publish.SpanContext.TraceID() {
+		t.Errorf("trace id = %v, want %v", consume.SpanContext.TraceID(), publish.SpanContext.TraceID())
+	}
+	if consume.Parent.SpanID() != publish.SpanContext.SpanID() {
+		t.Errorf("parent span id = %v, want %v", consume.Parent.SpanID(), publish.SpanContext.SpanID())
+	}
+	if traceID != consume.SpanContext.TraceID().String() || spanID != consume.SpanContext.SpanID().String() {
+		t.Errorf("IDs() = %v %v, want %v %v", traceID, spanID, consume.SpanContext.TraceID(), consume.SpanContext.SpanID())
+	}
+}
+
+func TestIDsWithoutSpan(t *testing.T) {
+	if _, _, ok := IDs(context.Background()); ok {
+		t.Error("IDs() returned ids without span")
+	}
+}
+
+func TestRedisHook(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus codes.Code
+	}{
+		{name: "hit", err: nil, wantStatus: codes.Unset},
+		{name: "miss", err: redis.Nil, wantStatus: codes.Unset},
+		{name: "error", err: errors.New("connection refused"), wantStatus: codes.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := newExporter(t)
+
+			process := NewRedisHook("cache").ProcessHook(func(c context.Context, cmd redis.Cmder) error {
+				return tt.err
+			})
+			if err := process(context.Background(), redis.NewStringCmd(context.Background(), "get", "key")); err != tt.err {
+				t.Fatalf("process() error = %v, want %v", err, tt.err)
+			}
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("spans = %v, want 1", len(spans))
+			}
+
+			if spans[0].Name != "redis get" {
+				t.Errorf("name = %v, want redis get", spans[0].Name)
+			}
+			if v, ok := findAttribute(spans[0], "db.store"); !ok || v.AsString() != "cache" {
+				t.Errorf("db.store = %v, want cache", v.AsString())
+			}
+			if spans[0].Status.Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v", spans[0].Status.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -2,23 +2,60 @@ package main
 
 import (
 	"app/lib/auth/google"
+	lcursor "app/lib/cursor"
 	"app/lib/lock"
 	llog "app/lib/log"
+	ltracing "app/lib/tracing"
 	presentation "app/presentation/api"
+	"app/presentation/migration"
+	"app/presentation/reindex"
+	"context"
+	"fmt"
+	"os"
 )
 
 func main() {
 	llog.Init()
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migration.Run(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to migrate. err=%v \n", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "reindex" {
+		if err := reindex.Run(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to reindex. err=%v \n", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	if err := google.Init(); err != nil {
 		panic(err)
 	}
 
+	if err := lcursor.Init(); err != nil {
+		panic(err)
+	}
+
 	if err := lock.Init(); err != nil {
 		panic(err)
 	}
 
+	if err := ltracing.Init(context.Background()); err != nil {
+		panic(err)
+	}
+
 	if err := presentation.Init(); err != nil {
 		panic(err)
 	}
+
+	if err := lock.Close(); err != nil {
+		panic(err)
+	}
 }
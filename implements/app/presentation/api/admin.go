@@ -0,0 +1,127 @@
+package presentation
+
+import (
+	llog "app/lib/log"
+	"app/presentation/reindex"
+	uerror "app/usecase/error"
+	umodel "app/usecase/model"
+	uservice "app/usecase/service"
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
+	"github.com/samber/lo"
+)
+
+const (
+	PathAdminSearchIndexRebuild = "/admin/search-index/rebuild"
+	PathAdminSearchIndexVerify  = "/admin/search-index/verify"
+)
+
+type searchIndexReportResponse struct {
+	Type    string      `json:"type"`
+	Sources int         `json:"sources"`
+	Indexed int         `json:"indexed"`
+	Missing []uuid.UUID `json:"missing"`
+	Extra   []uuid.UUID `json:"extra"`
+}
+
+// 管理用の操作は ADMIN_TOKEN を Bearer トークンとして送った場合のみ受け付ける
+type adminHandler struct {
+	injector   *do.Injector
+	token      string
+	rebuilding atomic.Bool
+}
+
+// RebuildSearchIndex は索引の再構築を始め、終わるのを待たずに返す
+func (h *adminHandler) RebuildSearchIndex(ctx echo.Context) error {
+	usecase, err := do.Invoke[uservice.ResourceSearchIndexMaintenanceUsecase](h.injector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error()).SetInternal(err)
+	}
+
+	if !h.rebuilding.CompareAndSwap(false, true) {
+		return echo.NewHTTPError(http.StatusConflict, "already rebuilding")
+	}
+
+	go func(c context.Context) {
+		defer h.rebuilding.Store(false)
+
+		reports, err := usecase.Rebuild(c)
+		if err != nil {
+			llog.Error(c, "failed to rebuild resource search index. err=%v", err)
+			return
+		}
+
+		for _, report := range reports {
+			llog.Info(c, "rebuilt resource search index. type=%v sources=%v indexed=%v missing=%v extra=%v", report.Type, report.Sources, report.Indexed, len(report.Missing), len(report.Extra))
+		}
+	}(context.WithoutCancel(ctx.Request().Context()))
+
+	return ctx.NoContent(http.StatusAccepted)
+}
+
+// VerifySearchIndex は RDB と索引の差分を返す
+func (h *adminHandler) VerifySearchIndex(ctx echo.Context) error {
+	usecase, err := do.Invoke[uservice.ResourceSearchIndexMaintenanceUsecase](h.injector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error()).SetInternal(err)
+	}
+
+	types := reindex.Types
+	if value := ctx.QueryParam("type"); value != "" {
+		types = value
+	}
+
+	repair, _ := strconv.ParseBool(ctx.QueryParam("repair"))
+
+	reports, err := usecase.Verify(ctx.Request().Context(), reindex.ParseTypes(types), repair)
+	if err != nil {
+		if _, ok := err.(uerror.InvalidParameter); ok {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+		}
+
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error()).SetInternal(err)
+	}
+
+	return ctx.JSON(http.StatusOK, lo.Map(reports, func(report umodel.ResourceSearchIndexReport, _ int) searchIndexReportResponse {
+		return searchIndexReportResponse(report)
+	}))
+}
+
+func (h *adminHandler) authorize(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		token, ok := strings.CutPrefix(ctx.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+			return echo.NewHTTPError(http.StatusUnauthorized)
+		}
+
+		return next(ctx)
+	}
+}
+
+// Shutdown は確立した接続を閉じる
+func (h *adminHandler) Shutdown() error {
+	return h.injector.Shutdown()
+}
+
+// ADMIN_TOKEN が設定されていない場合は管理用のエンドポイントを公開しない
+// 接続は最初に呼び出された時に確立する
+func newAdminHandler() *adminHandler {
+	token := os.Getenv("ADMIN_TOKEN")
+	if token == "" {
+		return nil
+	}
+
+	return &adminHandler{
+		injector: reindex.NewInjector(),
+		token:    token,
+	}
+}
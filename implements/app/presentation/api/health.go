@@ -0,0 +1,94 @@
+package presentation
+
+import (
+	lsession "app/lib/echo/session"
+	"app/lib/health"
+	llock "app/lib/lock"
+	"app/presentation/subscriber"
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+)
+
+const (
+	PathHealthz = "/healthz"
+	PathReadyz  = "/readyz"
+)
+
+type checkResponse struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+type readyzResponse struct {
+	Status string          `json:"status"`
+	Checks []checkResponse `json:"checks"`
+}
+
+type healthHandler struct {
+	checks  []health.Check
+	timeout time.Duration
+}
+
+// Healthz はプロセスが起動していることのみを返す
+func (h healthHandler) Healthz(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, map[string]string{"status": health.StatusOK.String()})
+}
+
+// Readyz は依存するすべてのストアへの疎通とサブスクライバの状態を返す
+func (h healthHandler) Readyz(ctx echo.Context) error {
+	results := health.Run(ctx.Request().Context(), h.checks, h.timeout)
+
+	status := http.StatusOK
+	response := readyzResponse{
+		Status: health.StatusOK.String(),
+		Checks: lo.Map(results, func(result health.Result, _ int) checkResponse {
+			response := checkResponse{
+				Name:      result.Name,
+				Status:    result.Status.String(),
+				LatencyMs: result.Latency.Milliseconds(),
+			}
+			if result.Err != nil {
+				response.Error = result.Err.Error()
+			}
+
+			return response
+		}),
+	}
+	if !health.Healthy(results) {
+		status = http.StatusServiceUnavailable
+		response.Status = health.StatusNG.String()
+	}
+
+	return ctx.JSON(status, response)
+}
+
+func newHealthHandler(checks []health.Check) healthHandler {
+	timeoutSeconds, err := strconv.Atoi(os.Getenv("TIMEOUT_SECONDS_READINESS"))
+	if err != nil {
+		timeoutSeconds = 2
+	}
+
+	return healthHandler{
+		checks: append(checks,
+			health.Check{Name: "redis/session", Ping: lsession.Ping},
+			health.Check{Name: "redis/lock", Ping: llock.Ping},
+			health.Check{Name: "subscriber", Ping: func(c context.Context) error {
+				if !subscriber.Ready() {
+					return errors.New("consumers are not attached")
+				}
+
+				return nil
+			}},
+		),
+		timeout: time.Duration(timeoutSeconds) * time.Second,
+	}
+}
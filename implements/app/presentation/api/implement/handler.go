@@ -10,14 +10,21 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
 )
 
 type Handler struct {
+	injector        *do.Injector
 	activityUsecase uservice.ActivityUsecase
 	authUsecase     uservice.AuthUsecase
 	userUsecase     uservice.UserUsecase
 }
 
+// Shutdown はハンドラが保持する接続を閉じる
+func (h *Handler) Shutdown() error {
+	return h.injector.Shutdown()
+}
+
 // Auth implements api.ServerInterface.
 func (h *Handler) Auth(ctx echo.Context) error {
 	url, err := h.authUsecase.GetAuthURL(ctx.Request().Context())
@@ -3,9 +3,11 @@ package v1
 import (
 	dservice "app/domain/service"
 	"app/infrastructure/adapter/datastore/document"
+	"app/infrastructure/adapter/datastore/kvs"
 	"app/infrastructure/adapter/datastore/rdb"
 	"app/infrastructure/adapter/datastore/searchengine"
 	"app/infrastructure/adapter/datastore/timeseries"
+	"app/infrastructure/adapter/mail"
 	"app/infrastructure/adapter/mq"
 	"app/infrastructure/repository"
 	uservice "app/usecase/service"
@@ -29,36 +31,59 @@ func NewHandler() Handler {
 	do.Provide(i, rdb.NewTopicStoreConnection)
 	do.Provide(i, rdb.NewThreadStoreConnection)
 	do.Provide(i, rdb.NewPostStoreConnection)
+	do.Provide(i, rdb.NewMessageStoreConnection)
+	do.Provide(i, mail.NewMailConnection)
 	do.Provide(i, mq.NewActivityStoreConnection)
 	do.Provide(i, mq.NewResourceSearchIndexStoreConnection)
 	do.Provide(i, searchengine.NewResourceSearchIndexStoreConnection)
 	do.Provide(i, timeseries.NewActivityStore)
+	do.Provide(i, kvs.NewCacheStoreConnection)
 
 	do.Provide(i, repository.NewNoteRepository)
 	do.Provide(i, repository.NewContentRepository)
 	do.Provide(i, repository.NewUserRepository)
 	do.Provide(i, repository.NewRoleRepository)
 	do.Provide(i, repository.NewMemberRepository)
+	do.Provide(i, repository.NewBanRepository)
 	do.Provide(i, repository.NewCommunityRepository)
+	do.Provide(i, repository.NewCommunityDeletionRepository)
 	do.Provide(i, repository.NewActivityRepository)
 	do.Provide(i, repository.NewResourceSearchIndexRepository)
 	do.Provide(i, repository.NewInviteRepository)
+	do.Provide(i, repository.NewInviteLinkRepository)
+	do.Provide(i, repository.NewMailRepository)
 	do.Provide(i, repository.NewTopicRepository)
+	do.Provide(i, repository.NewPageRepository)
+	do.Provide(i, repository.NewTemplateRepository)
 	do.Provide(i, repository.NewThreadRepository)
 	do.Provide(i, repository.NewPostRepository)
+	do.Provide(i, repository.NewReadMarkerRepository)
+	do.Provide(i, repository.NewConversationRepository)
+	do.Provide(i, repository.NewMessageRepository)
+	do.Provide(i, repository.NewUserRelationRepository)
 
 	do.Provide(i, dservice.NewNoteService)
 	do.Provide(i, dservice.NewContentService)
 	do.Provide(i, dservice.NewUserService)
 	do.Provide(i, dservice.NewRoleService)
 	do.Provide(i, dservice.NewMemberService)
+	do.Provide(i, dservice.NewBanService)
 	do.Provide(i, dservice.NewCommunityService)
+	do.Provide(i, dservice.NewCommunityDeletionService)
 	do.Provide(i, dservice.NewActivityService)
 	do.Provide(i, dservice.NewResourceSearchIndexService)
 	do.Provide(i, dservice.NewInviteService)
+	do.Provide(i, dservice.NewInviteLinkService)
+	do.Provide(i, dservice.NewMailService)
 	do.Provide(i, dservice.NewTopicService)
+	do.Provide(i, dservice.NewPageService)
+	do.Provide(i, dservice.NewTemplateService)
 	do.Provide(i, dservice.NewThreadService)
 	do.Provide(i, dservice.NewPostService)
+	do.Provide(i, dservice.NewReadMarkerService)
+	do.Provide(i, dservice.NewConversationService)
+	do.Provide(i, dservice.NewMessageService)
+	do.Provide(i, dservice.NewUserRelationService)
 
 	do.Provide(i, uservice.NewNoteUsecase)
 	do.Provide(i, uservice.NewUserUsecase)
@@ -68,6 +93,7 @@ func NewHandler() Handler {
 	do.Provide(i, uservice.NewMemberUsecase)
 	do.Provide(i, uservice.NewTopicUsecase)
 	do.Provide(i, uservice.NewPostUsecase)
+	do.Provide(i, uservice.NewConversationUsecase)
 
 	noteUsecase := do.MustInvoke[uservice.NoteUsecase](i)
 	userUsecase := do.MustInvoke[uservice.UserUsecase](i)
@@ -77,16 +103,23 @@ func NewHandler() Handler {
 	memberUsecase := do.MustInvoke[uservice.MemberUsecase](i)
 	topicUsecase := do.MustInvoke[uservice.TopicUsecase](i)
 	postUsecase := do.MustInvoke[uservice.PostUsecase](i)
+	conversationUsecase := do.MustInvoke[uservice.ConversationUsecase](i)
+
+	checks := newChecks(i)
 
 	return Handler{
-		upgrader:         websocket.Upgrader{},
-		noteUsecase:      noteUsecase,
-		userUsecase:      userUsecase,
-		communityUsecase: communityUsecase,
-		roleUsecase:      roleUsecase,
-		activityUsecase:  activityUsecase,
-		memberUsecase:    memberUsecase,
-		topicUsecase:     topicUsecase,
-		postUsecase:      postUsecase,
+		injector:            i,
+		sockets:             newSockets(),
+		checks:              checks,
+		upgrader:            websocket.Upgrader{},
+		noteUsecase:         noteUsecase,
+		userUsecase:         userUsecase,
+		communityUsecase:    communityUsecase,
+		roleUsecase:         roleUsecase,
+		activityUsecase:     activityUsecase,
+		memberUsecase:       memberUsecase,
+		topicUsecase:        topicUsecase,
+		postUsecase:         postUsecase,
+		conversationUsecase: conversationUsecase,
 	}
 }
@@ -3,39 +3,62 @@ package v1
 import (
 	v1 "app/gen/api/v1"
 	lsession "app/lib/echo/session"
+	"app/lib/health"
 	llock "app/lib/lock"
 	llog "app/lib/log"
 	uerror "app/usecase/error"
 	umodel "app/usecase/model"
 	uservice "app/usecase/service"
+	"context"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"html"
+	"io"
 	"net/http"
 	"os"
 	"reflect"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/labstack/echo/v4"
+	"github.com/samber/do"
 	"github.com/samber/lo"
 )
 
 type Handler struct {
-	upgrader         websocket.Upgrader
-	noteUsecase      uservice.NoteUsecase
-	userUsecase      uservice.UserUsecase
-	communityUsecase uservice.CommunityUsecase
-	roleUsecase      uservice.RoleUsecase
-	activityUsecase  uservice.ActivityUsecase
-	memberUsecase    uservice.MemberUsecase
-	topicUsecase     uservice.TopicUsecase
-	postUsecase      uservice.PostUsecase
+	injector            *do.Injector
+	sockets             *sockets
+	checks              []health.Check
+	upgrader            websocket.Upgrader
+	noteUsecase         uservice.NoteUsecase
+	userUsecase         uservice.UserUsecase
+	communityUsecase    uservice.CommunityUsecase
+	roleUsecase         uservice.RoleUsecase
+	activityUsecase     uservice.ActivityUsecase
+	memberUsecase       uservice.MemberUsecase
+	topicUsecase        uservice.TopicUsecase
+	postUsecase         uservice.PostUsecase
+	conversationUsecase uservice.ConversationUsecase
+}
+
+// Shutdown は WebSocket を閉じてから、ハンドラが保持する接続を閉じる
+func (h *Handler) Shutdown(c context.Context) error {
+	// 期限切れで WebSocket が残っていても接続は閉じる
+	return errors.Join(h.sockets.Close(c), h.injector.Shutdown())
 }
 
 // GetCommunityMember implements v1.ServerInterface.
 func (h *Handler) GetCommunityMember(ctx echo.Context, communityId uuid.UUID, memberId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
 	member, err := h.memberUsecase.Get(ctx.Request().Context(), memberId)
 	if err != nil {
 		return h.handle(err)
@@ -64,7 +87,7 @@ func (h *Handler) GetCommunityMember(ctx echo.Context, communityId uuid.UUID, me
 		return h.handle(err)
 	}
 
-	pActivities, err := h.listActivity(ctx, uActivities)
+	pActivities, err := h.listActivity(ctx, loggedInUser.ID, uActivities)
 	if err != nil {
 		return h.handle(err)
 	}
@@ -90,18 +113,19 @@ func (h *Handler) ListUserActivity(ctx echo.Context, userId uuid.UUID, params v1
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	uActivities, err := h.activityUsecase.ListUsersMemberActivity(ctx.Request().Context(), loggedInUser.ID, params.Limit, params.Offset)
+	uActivities, next, err := h.activityUsecase.ListUsersMemberActivity(ctx.Request().Context(), loggedInUser.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
 	if err != nil {
 		return h.handle(err)
 	}
 
-	pActivities, err := h.listActivity(ctx, uActivities)
+	pActivities, err := h.listActivity(ctx, loggedInUser.ID, uActivities)
 	if err != nil {
 		return h.handle(err)
 	}
 
 	return ctx.JSON(http.StatusOK, v1.ListUserActivityResponse{
 		Activities: pActivities,
+		NextCursor: next,
 	})
 }
 
@@ -112,7 +136,7 @@ func (h *Handler) ListUserLoginActivity(ctx echo.Context, params v1.ListUserLogi
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	uActivities, err := h.activityUsecase.ListUserLoginActivity(ctx.Request().Context(), loggedInUser.ID, params.Limit, params.Offset)
+	uActivities, next, err := h.activityUsecase.ListUserLoginActivity(ctx.Request().Context(), loggedInUser.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
 	if err != nil {
 		return h.handle(err)
 	}
@@ -141,12 +165,18 @@ func (h *Handler) ListUserLoginActivity(ctx echo.Context, params v1.ListUserLogi
 
 	return ctx.JSON(http.StatusOK, v1.ListUserLoginActivityResponse{
 		Activities: pActivities,
+		NextCursor: next,
 	})
 }
 
 // ListPostLike implements v1.ServerInterface.
 func (h *Handler) ListPostLike(ctx echo.Context, communityId uuid.UUID, topicId uuid.UUID, threadId uuid.UUID, postId uuid.UUID, params v1.ListPostLikeParams) error {
-	likes, err := h.communityUsecase.ListPostLike(ctx.Request().Context(), communityId, topicId, threadId, postId, params.Like, params.Limit, params.Offset)
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	likes, next, err := h.communityUsecase.ListPostLike(ctx.Request().Context(), communityId, loggedInUser.ID, topicId, threadId, postId, params.Like, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
 	if err != nil {
 		return h.handle(err)
 	}
@@ -173,7 +203,8 @@ func (h *Handler) ListPostLike(ctx echo.Context, communityId uuid.UUID, topicId
 	}
 
 	return ctx.JSON(http.StatusOK, v1.ListPostLikeResponse{
-		Likes: pLikes,
+		Likes:      pLikes,
+		NextCursor: next,
 	})
 }
 
@@ -196,10 +227,31 @@ func (h *Handler) LikePost(ctx echo.Context, communityId uuid.UUID, topicId uuid
 	return ctx.NoContent(http.StatusOK)
 }
 
+// ReadPost implements v1.ServerInterface.
+func (h *Handler) ReadPost(ctx echo.Context, communityId uuid.UUID, topicId uuid.UUID, threadId uuid.UUID, postId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.ReadPost(ctx.Request().Context(), communityId, topicId, threadId, postId, loggedInUser.ID); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
 // CreateCommunityPost implements v1.ServerInterface.
 func (h *Handler) CreateCommunityPost(ctx echo.Context, communityId uuid.UUID, topicId uuid.UUID, threadId uuid.UUID) error {
 	var body v1.CreatePostRequest
-	if err := ctx.Bind(&body); err != nil {
+	if isMarkdown(ctx) {
+		contents, err := h.bindMarkdown(ctx, maxPostContents)
+		if err != nil {
+			return err
+		}
+
+		body.Contents = contents
+	} else if err := ctx.Bind(&body); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
 	}
 
@@ -230,12 +282,7 @@ func (h *Handler) CreateCommunityPost(ctx echo.Context, communityId uuid.UUID, t
 		return umodel.Mention{ID: mention.Id, ResourceType: string(mention.Resource)}
 	})
 
-	searchWord, err := ToText(body.Contents)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
-	}
-
-	if err := h.communityUsecase.Reply(ctx.Request().Context(), communityId, loggedInUser.ID, topicId, threadId, contents, uMention, *searchWord); err != nil {
+	if err := h.communityUsecase.Reply(ctx.Request().Context(), communityId, loggedInUser.ID, topicId, threadId, contents, uMention); err != nil {
 		return h.handle(err)
 	}
 
@@ -245,7 +292,14 @@ func (h *Handler) CreateCommunityPost(ctx echo.Context, communityId uuid.UUID, t
 // CreateCommunityThread implements v1.ServerInterface.
 func (h *Handler) CreateCommunityThread(ctx echo.Context, communityId uuid.UUID, topicId uuid.UUID) error {
 	var body v1.CreateThreadRequest
-	if err := ctx.Bind(&body); err != nil {
+	if isMarkdown(ctx) {
+		contents, err := h.bindMarkdown(ctx, maxPostContents)
+		if err != nil {
+			return err
+		}
+
+		body.Contents = contents
+	} else if err := ctx.Bind(&body); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
 	}
 
@@ -276,12 +330,7 @@ func (h *Handler) CreateCommunityThread(ctx echo.Context, communityId uuid.UUID,
 		return umodel.Mention{ID: mention.Id, ResourceType: string(mention.Resource)}
 	})
 
-	searchWord, err := ToText(body.Contents)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
-	}
-
-	if err := h.communityUsecase.Post(ctx.Request().Context(), communityId, loggedInUser.ID, topicId, contents, uMention, *searchWord); err != nil {
+	if err := h.communityUsecase.Post(ctx.Request().Context(), communityId, loggedInUser.ID, topicId, contents, uMention); err != nil {
 		return h.handle(err)
 	}
 
@@ -291,7 +340,24 @@ func (h *Handler) CreateCommunityThread(ctx echo.Context, communityId uuid.UUID,
 // CreateCommunityTopic implements v1.ServerInterface.
 func (h *Handler) CreateCommunityTopic(ctx echo.Context, communityId uuid.UUID) error {
 	var body v1.CreateTopicRequest
-	if err := ctx.Bind(&body); err != nil {
+	if isMarkdown(ctx) {
+		source, err := io.ReadAll(ctx.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+		}
+
+		name, contents, err := MarkdownToTopic(source)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+		}
+
+		if err := validateContents(contents, maxTopicContents); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+		}
+
+		body.Name = name
+		body.Contents = contents
+	} else if err := ctx.Bind(&body); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
 	}
 
@@ -325,11 +391,31 @@ func (h *Handler) CreateCommunityTopic(ctx echo.Context, communityId uuid.UUID)
 
 // ListCommunityPost implements v1.ServerInterface.
 func (h *Handler) ListCommunityPost(ctx echo.Context, communityId uuid.UUID, topicId uuid.UUID, threadId uuid.UUID, params v1.ListCommunityPostParams) error {
-	posts, err := h.communityUsecase.ListPost(ctx.Request().Context(), communityId, topicId, threadId, params.Limit, params.Offset)
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	posts, next, err := h.communityUsecase.ListPost(ctx.Request().Context(), communityId, loggedInUser.ID, topicId, threadId, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
 	if err != nil {
 		return h.handle(err)
 	}
 
+	if acceptsHTML(ctx, echo.MIMEApplicationJSON) {
+		body := ""
+		for _, post := range posts {
+			article, err := renderPost(post)
+			if err != nil {
+				return err
+			}
+
+			body += article
+		}
+
+		setNextLink(ctx, next)
+		return ctx.HTML(http.StatusOK, body)
+	}
+
 	pPosts := []v1.Post{}
 	for _, post := range posts {
 		pContents := []v1.Content{}
@@ -356,17 +442,24 @@ func (h *Handler) ListCommunityPost(ctx echo.Context, communityId uuid.UUID, top
 				Likes:    post.Reaction.Likes,
 				Dislikes: post.Reaction.Dislikes,
 			},
+			Collapsed: post.Collapsed,
 		})
 	}
 
 	return ctx.JSON(http.StatusOK, v1.ListPostResponse{
-		Posts: pPosts,
+		Posts:      pPosts,
+		NextCursor: next,
 	})
 }
 
 // ListCommunityThread implements v1.ServerInterface.
 func (h *Handler) ListCommunityThread(ctx echo.Context, communityId uuid.UUID, topicId uuid.UUID, params v1.ListCommunityThreadParams) error {
-	threads, err := h.communityUsecase.ListThread(ctx.Request().Context(), communityId, topicId, params.Limit, params.Offset)
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	threads, next, err := h.communityUsecase.ListThread(ctx.Request().Context(), communityId, loggedInUser.ID, topicId, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
 	if err != nil {
 		return h.handle(err)
 	}
@@ -404,23 +497,46 @@ func (h *Handler) ListCommunityThread(ctx echo.Context, communityId uuid.UUID, t
 					Likes:    firstPost.Reaction.Likes,
 					Dislikes: firstPost.Reaction.Dislikes,
 				},
+				Collapsed: firstPost.Collapsed,
 			},
-			Reply: len(thread.Posts) > 1,
+			Reply:  len(thread.Posts) > 1,
+			Unread: thread.Unread,
 		})
 	}
 
 	return ctx.JSON(http.StatusOK, v1.ListThreadResponse{
-		Threads: pThreads,
+		Threads:    pThreads,
+		NextCursor: next,
 	})
 }
 
 // ListCommunityTopic implements v1.ServerInterface.
 func (h *Handler) ListCommunityTopic(ctx echo.Context, communityId uuid.UUID, params v1.ListCommunityTopicParams) error {
-	topics, err := h.communityUsecase.ListTopic(ctx.Request().Context(), communityId, params.Limit, params.Offset)
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	topics, next, err := h.communityUsecase.ListTopic(ctx.Request().Context(), communityId, loggedInUser.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
 	if err != nil {
 		return h.handle(err)
 	}
 
+	if acceptsHTML(ctx, echo.MIMEApplicationJSON) {
+		body := ""
+		for _, topic := range topics {
+			article, err := renderTopic(topic)
+			if err != nil {
+				return err
+			}
+
+			body += article
+		}
+
+		setNextLink(ctx, next)
+		return ctx.HTML(http.StatusOK, body)
+	}
+
 	pTopics := []v1.Topic{}
 	for _, topic := range topics {
 		pContents := []v1.Content{}
@@ -464,6 +580,7 @@ func (h *Handler) ListCommunityTopic(ctx echo.Context, communityId uuid.UUID, pa
 					Likes:    topic.LastPost.Reaction.Likes,
 					Dislikes: topic.LastPost.Reaction.Dislikes,
 				},
+				Collapsed: topic.LastPost.Collapsed,
 			}
 		}
 
@@ -473,17 +590,169 @@ func (h *Handler) ListCommunityTopic(ctx echo.Context, communityId uuid.UUID, pa
 			Contents: pContents,
 			Created:  pMember,
 			LastPost: pLastPost,
+			Unread:   topic.Unread,
 		})
 	}
 
 	return ctx.JSON(http.StatusOK, v1.ListTopicResponse{
-		Topics: pTopics,
+		Topics:     pTopics,
+		NextCursor: next,
 	})
 }
 
+// ExportCommunityTopic implements v1.ServerInterface.
+func (h *Handler) ExportCommunityTopic(ctx echo.Context, communityId uuid.UUID, topicId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	topic, err := h.topicUsecase.GetInCommunity(ctx.Request().Context(), communityId, topicId)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	threadIDs, err := h.listAllThreadIDs(ctx, communityId, loggedInUser.ID, topicId)
+	if err != nil {
+		return err
+	}
+
+	// トピックの内容のあとにスレッドを作成順に続ける
+	threads := [][]umodel.Post{}
+	for _, threadID := range threadIDs {
+		posts, err := h.listAllPosts(ctx, communityId, loggedInUser.ID, topicId, threadID)
+		if err != nil {
+			return err
+		}
+
+		threads = append(threads, posts)
+	}
+
+	if acceptsHTML(ctx, mimeTextMarkdown) {
+		body, err := renderContents(topic.Contents)
+		if err != nil {
+			return err
+		}
+
+		body = "<h1>" + html.EscapeString(topic.Name) + "</h1>\n" + body + "\n"
+		for _, posts := range threads {
+			thread, err := renderThread(posts)
+			if err != nil {
+				return err
+			}
+
+			body += thread
+		}
+
+		return ctx.HTML(http.StatusOK, body)
+	}
+
+	contents, err := h.contentsToMarkdown(topic.Contents)
+	if err != nil {
+		return err
+	}
+
+	blocks := []string{"# " + singleLine(textToMarkdown(v1.Text{Value: topic.Name})), contents}
+	for _, posts := range threads {
+		thread, err := h.threadToMarkdown(posts)
+		if err != nil {
+			return err
+		}
+
+		blocks = append(blocks, threadSeparator, thread)
+	}
+
+	return ctx.Blob(http.StatusOK, mimeTextMarkdownCharsetUTF8, []byte(joinMarkdown(blocks)))
+}
+
+// ExportCommunityThread implements v1.ServerInterface.
+func (h *Handler) ExportCommunityThread(ctx echo.Context, communityId uuid.UUID, topicId uuid.UUID, threadId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	posts, err := h.listAllPosts(ctx, communityId, loggedInUser.ID, topicId, threadId)
+	if err != nil {
+		return err
+	}
+
+	if acceptsHTML(ctx, mimeTextMarkdown) {
+		body, err := renderThread(posts)
+		if err != nil {
+			return err
+		}
+
+		return ctx.HTML(http.StatusOK, body)
+	}
+
+	md, err := h.threadToMarkdown(posts)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Blob(http.StatusOK, mimeTextMarkdownCharsetUTF8, []byte(joinMarkdown([]string{md})))
+}
+
+// FeedCommunityTopic implements v1.ServerInterface.
+func (h *Handler) FeedCommunityTopic(ctx echo.Context, communityId uuid.UUID, topicId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	topic, err := h.topicUsecase.GetInCommunity(ctx.Request().Context(), communityId, topicId)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	// 新しいスレッドから上限まで取得する
+	threads, err := h.communityUsecase.ListLatestThread(ctx.Request().Context(), communityId, loggedInUser.ID, topicId, feedItems)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	items := []rssItem{}
+	for _, thread := range threads {
+		firstPost, exists := lo.First(thread.Posts)
+		if !exists {
+			continue
+		}
+
+		item, err := newRSSItem(thread.ID, firstPost)
+		if err != nil {
+			return err
+		}
+
+		items = append(items, *item)
+	}
+
+	feed := rss{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       topic.Name,
+			Link:        ctx.Scheme() + "://" + ctx.Request().Host + ctx.Request().URL.Path,
+			Description: topic.Name,
+			Items:       items,
+		},
+	}
+
+	bin, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ctx.Blob(http.StatusOK, mimeApplicationRSSXMLCharsetUTF8, append([]byte(xml.Header), bin...))
+}
+
 // ListCommunityMember implements v1.ServerInterface.
 func (h *Handler) ListCommunityMember(ctx echo.Context, communityId uuid.UUID, params v1.ListCommunityMemberParams) error {
-	members, err := h.communityUsecase.ListMember(ctx.Request().Context(), communityId, params.Limit, params.Offset)
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	members, next, err := h.communityUsecase.ListMember(ctx.Request().Context(), communityId, loggedInUser.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
 	if err != nil {
 		return h.handle(err)
 	}
@@ -520,7 +789,8 @@ func (h *Handler) ListCommunityMember(ctx echo.Context, communityId uuid.UUID, p
 	}
 
 	return ctx.JSON(http.StatusOK, &v1.ListCommunityMemberResponse{
-		Members: pMembers,
+		Members:    pMembers,
+		NextCursor: next,
 	})
 }
 
@@ -538,9 +808,23 @@ func (h *Handler) DeleteCommunityInvite(ctx echo.Context, communityId uuid.UUID,
 	return ctx.NoContent(http.StatusOK)
 }
 
-// InviteCommunityRole implements v1.ServerInterface.
-func (h *Handler) InviteCommunityRole(ctx echo.Context, communityId uuid.UUID, roleId uuid.UUID) error {
-	var body v1.InviteCommunityRoleRequest
+// LeaveCommunity implements v1.ServerInterface.
+func (h *Handler) LeaveCommunity(ctx echo.Context, communityId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.Leave(ctx.Request().Context(), communityId, loggedInUser.ID); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// UpdateCommunityMember implements v1.ServerInterface.
+func (h *Handler) UpdateCommunityMember(ctx echo.Context, communityId uuid.UUID, memberId uuid.UUID) error {
+	var body v1.UpdateCommunityMemberJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
 	}
@@ -550,76 +834,211 @@ func (h *Handler) InviteCommunityRole(ctx echo.Context, communityId uuid.UUID, r
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	userIDs := lo.Map(body.Mention, func(mention v1.Mention, _ int) uuid.UUID { return mention.Id })
-	if err := h.communityUsecase.Invite(ctx.Request().Context(), communityId, loggedInUser.ID, roleId, userIDs, &body.Message); err != nil {
+	if err := h.communityUsecase.UpdateMemberRole(ctx.Request().Context(), communityId, loggedInUser.ID, memberId, body.RoleId); err != nil {
 		return h.handle(err)
 	}
 
-	return ctx.NoContent(http.StatusCreated)
+	return ctx.NoContent(http.StatusOK)
 }
 
-// ListCommunityInvite implements v1.ServerInterface.
-func (h *Handler) ListCommunityInvite(ctx echo.Context, communityId uuid.UUID, params v1.ListCommunityInviteParams) error {
-	invites, err := h.communityUsecase.ListInvite(ctx.Request().Context(), communityId, params.Limit, params.Offset)
+// DeleteCommunityMember implements v1.ServerInterface.
+func (h *Handler) DeleteCommunityMember(ctx echo.Context, communityId uuid.UUID, memberId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
 	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.DeleteMember(ctx.Request().Context(), communityId, loggedInUser.ID, memberId); err != nil {
 		return h.handle(err)
 	}
 
-	pInvites := []v1.CommunityInvite{}
-	for _, invite := range invites {
-		actions := []v1.Action{}
-		for resource, operation := range invite.Role.Action {
-			actions = append(actions, v1.Action{
-				Resource:   v1.Resource(resource),
-				Operations: lo.Map(operation, func(op string, _ int) v1.Operation { return v1.Operation(op) }),
-			})
-		}
+	return ctx.NoContent(http.StatusOK)
+}
 
-		var message *v1.ShortMessage
-		if invite.Message != nil {
-			message = invite.Message
-		} else {
-			message = nil
-		}
+// BanCommunityMember implements v1.ServerInterface.
+func (h *Handler) BanCommunityMember(ctx echo.Context, communityId uuid.UUID, memberId uuid.UUID) error {
+	var body v1.BanCommunityMemberJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
 
-		pInvites = append(pInvites, v1.CommunityInvite{
-			Id: invite.ID,
-			Role: v1.Role{
-				Id:      invite.Role.ID,
-				Name:    invite.Role.Name,
-				Actions: actions,
-			},
-			Users: lo.Map(invite.Users, func(user umodel.User, _ int) v1.User {
-				return v1.User{
-					Id:    user.ID,
-					Name:  user.Name,
-					Image: user.ImageUrl,
-				}
-			}),
-			At:      int(invite.At.Unix()),
-			Message: message,
-		})
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	return ctx.JSON(http.StatusOK, &v1.ListCommunityInviteResponse{
-		Invites: pInvites,
-	})
+	if err := h.communityUsecase.BanMember(ctx.Request().Context(), communityId, loggedInUser.ID, memberId, body.Reason, body.ExpireAt); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
 }
 
-// ListUserInvite implements v1.ServerInterface.
-func (h *Handler) ListUserInvite(ctx echo.Context, params v1.ListUserInviteParams) error {
+// DeleteCommunityBan implements v1.ServerInterface.
+func (h *Handler) DeleteCommunityBan(ctx echo.Context, communityId uuid.UUID, userId uuid.UUID) error {
 	loggedInUser, err := lsession.GetLoginSession(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	invites, err := h.userUsecase.ListInvite(ctx.Request().Context(), loggedInUser.ID, params.Limit, params.Offset)
-	if err != nil {
+	if err := h.communityUsecase.Unban(ctx.Request().Context(), communityId, loggedInUser.ID, userId); err != nil {
 		return h.handle(err)
 	}
 
-	pInvites := []v1.UserInvite{}
-	for _, invite := range invites {
+	return ctx.NoContent(http.StatusOK)
+}
+
+// JoinCommunity implements v1.ServerInterface.
+func (h *Handler) JoinCommunity(ctx echo.Context, communityId uuid.UUID) error {
+	// コミュニティへの参加は招待経由のみ受け付ける
+	return echo.NewHTTPError(http.StatusForbidden, "community can only be joined by invitation")
+}
+
+// InviteCommunityRole implements v1.ServerInterface.
+func (h *Handler) InviteCommunityRole(ctx echo.Context, communityId uuid.UUID, roleId uuid.UUID) error {
+	var body v1.InviteCommunityRoleRequest
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	userIDs := lo.Map(body.Mention, func(mention v1.Mention, _ int) uuid.UUID { return mention.Id })
+	emails := lo.Map(lo.FromPtr(body.Emails), func(email v1.EmailAddress, _ int) string { return string(email) })
+	if err := h.communityUsecase.Invite(ctx.Request().Context(), communityId, loggedInUser.ID, roleId, userIDs, emails, &body.Message, body.ExpireAt); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusCreated)
+}
+
+// CreateCommunityInviteLink implements v1.ServerInterface.
+func (h *Handler) CreateCommunityInviteLink(ctx echo.Context, communityId uuid.UUID, roleId uuid.UUID) error {
+	var body v1.CreateCommunityInviteLinkJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	link, err := h.communityUsecase.CreateInviteLink(ctx.Request().Context(), communityId, loggedInUser.ID, roleId, body.ExpireAt, body.MaxUses)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusCreated, &v1.CreateCommunityInviteLinkResponse{
+		Link: h.buildInviteLink(*link),
+	})
+}
+
+// ListCommunityInviteLink implements v1.ServerInterface.
+func (h *Handler) ListCommunityInviteLink(ctx echo.Context, communityId uuid.UUID, params v1.ListCommunityInviteLinkParams) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	links, next, err := h.communityUsecase.ListInviteLink(ctx.Request().Context(), communityId, loggedInUser.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.ListCommunityInviteLinkResponse{
+		Links:      lo.Map(links, func(link umodel.InviteLink, _ int) v1.InviteLink { return h.buildInviteLink(link) }),
+		NextCursor: next,
+	})
+}
+
+// DeleteCommunityInviteLink implements v1.ServerInterface.
+func (h *Handler) DeleteCommunityInviteLink(ctx echo.Context, communityId uuid.UUID, linkId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.DeleteInviteLink(ctx.Request().Context(), communityId, loggedInUser.ID, linkId); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// ListCommunityInvite implements v1.ServerInterface.
+func (h *Handler) ListCommunityInvite(ctx echo.Context, communityId uuid.UUID, params v1.ListCommunityInviteParams) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	invites, next, err := h.communityUsecase.ListInvite(ctx.Request().Context(), communityId, loggedInUser.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	pInvites := []v1.CommunityInvite{}
+	for _, invite := range invites {
+		actions := []v1.Action{}
+		for resource, operation := range invite.Role.Action {
+			actions = append(actions, v1.Action{
+				Resource:   v1.Resource(resource),
+				Operations: lo.Map(operation, func(op string, _ int) v1.Operation { return v1.Operation(op) }),
+			})
+		}
+
+		var message *v1.ShortMessage
+		if invite.Message != nil {
+			message = invite.Message
+		} else {
+			message = nil
+		}
+
+		pInvites = append(pInvites, v1.CommunityInvite{
+			Id: invite.ID,
+			Role: v1.Role{
+				Id:      invite.Role.ID,
+				Name:    invite.Role.Name,
+				Actions: actions,
+			},
+			Users: lo.Map(invite.Users, func(user umodel.User, _ int) v1.User {
+				return v1.User{
+					Id:    user.ID,
+					Name:  user.Name,
+					Image: user.ImageUrl,
+				}
+			}),
+			Emails:   lo.Map(invite.Emails, func(email string, _ int) v1.EmailAddress { return v1.EmailAddress(email) }),
+			At:       int(invite.At.Unix()),
+			ExpireAt: h.buildUnixTime(invite.ExpireAt),
+			Message:  message,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.ListCommunityInviteResponse{
+		Invites:    pInvites,
+		NextCursor: next,
+	})
+}
+
+// ListUserInvite implements v1.ServerInterface.
+func (h *Handler) ListUserInvite(ctx echo.Context, params v1.ListUserInviteParams) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	invites, next, err := h.userUsecase.ListInvite(ctx.Request().Context(), loggedInUser.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	pInvites := []v1.UserInvite{}
+	for _, invite := range invites {
 		actions := []v1.Action{}
 		for resource, operation := range invite.Role.Action {
 			actions = append(actions, v1.Action{
@@ -646,13 +1065,15 @@ func (h *Handler) ListUserInvite(ctx echo.Context, params v1.ListUserInviteParam
 				Name:    invite.Community.Name,
 				Actions: actions,
 			},
-			At:      int(invite.At.Unix()),
-			Message: message,
+			At:       int(invite.At.Unix()),
+			ExpireAt: h.buildUnixTime(invite.ExpireAt),
+			Message:  message,
 		})
 	}
 
 	return ctx.JSON(http.StatusOK, &v1.ListUserInviteResponse{
-		Invites: pInvites,
+		Invites:    pInvites,
+		NextCursor: next,
 	})
 }
 
@@ -675,80 +1096,50 @@ func (h *Handler) ReplyInvite(ctx echo.Context, inviteId uuid.UUID) error {
 	return ctx.NoContent(http.StatusOK)
 }
 
-// CreateCommunityRole implements v1.ServerInterface.
-func (h *Handler) CreateCommunityRole(ctx echo.Context, communityId uuid.UUID) error {
-	var body v1.CreateCommunityRoleRequest
-	if err := ctx.Bind(&body); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
-	}
-
+// RedeemInviteLink implements v1.ServerInterface.
+func (h *Handler) RedeemInviteLink(ctx echo.Context, token v1.InviteToken) error {
 	loggedInUser, err := lsession.GetLoginSession(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	actions := map[string][]string{}
-	for _, action := range body.Actions {
-		if _, ok := actions[string(action.Resource)]; ok {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("duplicate resource. v=%v", action.Resource))
-		}
-
-		operations := lo.Map(action.Operations, func(operation v1.Operation, _ int) string { return string(operation) })
-		actions[string(action.Resource)] = operations
-	}
-
-	if err := h.communityUsecase.CreateRole(ctx.Request().Context(), communityId, loggedInUser.ID, body.Name, actions); err != nil {
+	community, err := h.userUsecase.RedeemInviteLink(ctx.Request().Context(), loggedInUser.ID, token)
+	if err != nil {
 		return h.handle(err)
 	}
 
-	return ctx.NoContent(http.StatusCreated)
+	return ctx.JSON(http.StatusOK, &v1.RedeemInviteLinkResponse{
+		Community: *h.buildCommunity(*community),
+	})
 }
 
-// DeleteCommunityRole implements v1.ServerInterface.
-func (h *Handler) DeleteCommunityRole(ctx echo.Context, communityId uuid.UUID, roleId uuid.UUID) error {
+// ListUserRelation implements v1.ServerInterface.
+func (h *Handler) ListUserRelation(ctx echo.Context) error {
 	loggedInUser, err := lsession.GetLoginSession(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	if err := h.communityUsecase.DeleteRole(ctx.Request().Context(), communityId, loggedInUser.ID, roleId); err != nil {
-		return h.handle(err)
-	}
-
-	return ctx.NoContent(http.StatusOK)
-}
-
-// ListCommunityRole implements v1.ServerInterface.
-func (h *Handler) ListCommunityRole(ctx echo.Context, communityId uuid.UUID) error {
-	roles, err := h.communityUsecase.ListRole(ctx.Request().Context(), communityId)
+	relations, err := h.userUsecase.ListRelation(ctx.Request().Context(), loggedInUser.ID)
 	if err != nil {
 		return h.handle(err)
 	}
 
-	pRoles := lo.Map(roles, func(role umodel.Role, _ int) v1.Role {
-		action := []v1.Action{}
-		for resource, operations := range role.Action {
-			action = append(action, v1.Action{
-				Resource:   v1.Resource(resource),
-				Operations: lo.Map(operations, func(operaiton string, _ int) v1.Operation { return v1.Operation(operaiton) }),
-			})
-		}
-
-		return v1.Role{
-			Id:      role.ID,
-			Name:    role.Name,
-			Actions: action,
+	pRelations := lo.Map(relations, func(relation umodel.UserRelation, _ int) v1.UserRelation {
+		return v1.UserRelation{
+			User: h.buildUser(relation.User),
+			Type: v1.UserRelationType(relation.Type),
 		}
 	})
 
-	return ctx.JSON(http.StatusOK, v1.ListCommunityRoleResponse{
-		Roles: pRoles,
+	return ctx.JSON(http.StatusOK, v1.ListUserRelationResponse{
+		Relations: pRelations,
 	})
 }
 
-// UpdateCommunityRole implements v1.ServerInterface.
-func (h *Handler) UpdateCommunityRole(ctx echo.Context, communityId uuid.UUID, roleId uuid.UUID) error {
-	var body v1.UpdateCommunityRoleRequest
+// SaveUserRelation implements v1.ServerInterface.
+func (h *Handler) SaveUserRelation(ctx echo.Context, userId uuid.UUID) error {
+	var body v1.SaveUserRelationRequest
 	if err := ctx.Bind(&body); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
 	}
@@ -758,78 +1149,58 @@ func (h *Handler) UpdateCommunityRole(ctx echo.Context, communityId uuid.UUID, r
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	actions := map[string][]string{}
-	for _, action := range body.Actions {
-		if _, ok := actions[string(action.Resource)]; ok {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("duplicate resource. v=%v", action.Resource))
-		}
-
-		operations := lo.Map(action.Operations, func(operation v1.Operation, _ int) string { return string(operation) })
-		actions[string(action.Resource)] = operations
-	}
-
-	if err := h.communityUsecase.UpdateRole(ctx.Request().Context(), communityId, loggedInUser.ID, roleId, body.Name, actions); err != nil {
+	if err := h.userUsecase.SaveRelation(ctx.Request().Context(), loggedInUser.ID, userId, string(body.Type)); err != nil {
 		return h.handle(err)
 	}
 
 	return ctx.NoContent(http.StatusOK)
 }
 
-// ListAction implements v1.ServerInterface.
-func (h *Handler) ListAction(ctx echo.Context) error {
-	resources, operations, err := h.roleUsecase.ListAction(ctx.Request().Context())
-	if err != nil {
-		return h.handle(err)
-	}
-
-	return ctx.JSON(http.StatusOK, &v1.ListActionResponse{
-		Resources:  lo.Map(resources, func(resource string, _ int) v1.Resource { return v1.Resource(resource) }),
-		Operations: lo.Map(operations, func(operation string, _ int) v1.Operation { return v1.Operation(operation) }),
-	})
-}
-
-// EditCommunityDescription implements v1.ServerInterface.
-func (h *Handler) EditCommunityDescription(ctx echo.Context, id uuid.UUID, params v1.EditCommunityDescriptionParams) error {
+// DeleteUserRelation implements v1.ServerInterface.
+func (h *Handler) DeleteUserRelation(ctx echo.Context, userId uuid.UUID) error {
 	loggedInUser, err := lsession.GetLoginSession(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	community, err := h.communityUsecase.CanUpdate(ctx.Request().Context(), id, loggedInUser.ID)
-	if err != nil {
-		return h.handle(err)
-	}
-
-	description, err := h.noteUsecase.GetCommunityDescription(ctx.Request().Context(), community.ID)
-	if err != nil {
+	if err := h.userUsecase.DeleteRelation(ctx.Request().Context(), loggedInUser.ID, userId); err != nil {
 		return h.handle(err)
 	}
 
-	return h.editNote(ctx, description.ID)
+	return ctx.NoContent(http.StatusOK)
 }
 
-// UpdateCommunity implements v1.ServerInterface.
-func (h *Handler) UpdateCommunity(ctx echo.Context, id uuid.UUID) error {
-	var body v1.UpdateCommunityRequest
-	if err := ctx.Bind(&body); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
-	}
-
+// ListUserConversation implements v1.ServerInterface.
+func (h *Handler) ListUserConversation(ctx echo.Context, params v1.ListUserConversationParams) error {
 	loggedInUser, err := lsession.GetLoginSession(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	if err := h.communityUsecase.Update(ctx.Request().Context(), id, loggedInUser.ID, body.Name); err != nil {
+	conversations, next, err := h.conversationUsecase.List(ctx.Request().Context(), loggedInUser.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
+	if err != nil {
 		return h.handle(err)
 	}
 
-	return ctx.NoContent(http.StatusOK)
+	pConversations := []v1.Conversation{}
+	for _, conversation := range conversations {
+		pConversations = append(pConversations, v1.Conversation{
+			Id:     conversation.ID,
+			At:     conversation.At,
+			Users:  lo.Map(conversation.Users, func(user umodel.User, _ int) v1.User { return h.buildUser(user) }),
+			Unread: conversation.Unread,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, v1.ListConversationResponse{
+		Conversations: pConversations,
+		NextCursor:    next,
+	})
 }
 
-// CreateCommunity implements v1.ServerInterface.
-func (h *Handler) CreateCommunity(ctx echo.Context) error {
-	var body v1.CreateCommunityRequest
+// CreateUserConversation implements v1.ServerInterface.
+func (h *Handler) CreateUserConversation(ctx echo.Context) error {
+	var body v1.CreateConversationRequest
 	if err := ctx.Bind(&body); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
 	}
@@ -839,42 +1210,1185 @@ func (h *Handler) CreateCommunity(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	communityID, err := h.communityUsecase.Create(ctx.Request().Context(), loggedInUser.ID, body.Name, body.Invitation)
+	conversationID, err := h.conversationUsecase.Create(ctx.Request().Context(), loggedInUser.ID, body.Users)
 	if err != nil {
 		return h.handle(err)
 	}
 
-	return ctx.JSON(http.StatusCreated, &v1.CreateCommunityResponse{
-		Id: *communityID,
+	return ctx.JSON(http.StatusCreated, v1.CreateConversationResponse{
+		Id: *conversationID,
 	})
 }
 
-// EditUserProfile implements v1.ServerInterface.
-func (h *Handler) EditUserProfile(ctx echo.Context, _ v1.EditUserProfileParams) (err error) {
+// ListConversationMessage implements v1.ServerInterface.
+func (h *Handler) ListConversationMessage(ctx echo.Context, conversationId uuid.UUID, params v1.ListConversationMessageParams) error {
 	loggedInUser, err := lsession.GetLoginSession(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
 	}
 
-	user, err := h.userUsecase.Get(ctx.Request().Context(), loggedInUser.ID)
+	messages, next, err := h.conversationUsecase.ListMessage(ctx.Request().Context(), conversationId, loggedInUser.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
 	if err != nil {
 		return h.handle(err)
 	}
 
-	profile, err := h.noteUsecase.GetUserProfile(ctx.Request().Context(), user.ID)
-	if err != nil {
-		return h.handle(err)
+	pMessages := []v1.DirectMessage{}
+	for _, message := range messages {
+		pContents := []v1.Content{}
+		for _, content := range message.Contents {
+			pContent, err := NewContent(content.Type, content.Bin)
+			if err != nil {
+				return err
+			}
+
+			pContents = append(pContents, *pContent)
+		}
+
+		var pFrom *v1.User
+		if message.From != nil {
+			from := h.buildUser(*message.From)
+			pFrom = &from
+		}
+
+		pMessages = append(pMessages, v1.DirectMessage{
+			Id:       message.ID,
+			At:       message.At,
+			Contents: pContents,
+			From:     pFrom,
+			ReadBy:   lo.Map(message.ReadBy, func(user umodel.User, _ int) v1.User { return h.buildUser(user) }),
+		})
 	}
 
-	return h.editNote(ctx, profile.ID)
+	return ctx.JSON(http.StatusOK, v1.ListDirectMessageResponse{
+		Messages:   pMessages,
+		NextCursor: next,
+	})
 }
 
-func (h *Handler) editNote(ctx echo.Context, id uuid.UUID) error {
-	if _, err := h.noteUsecase.Get(ctx.Request().Context(), id); err != nil {
-		return h.handle(err)
+// SendConversationMessage implements v1.ServerInterface.
+func (h *Handler) SendConversationMessage(ctx echo.Context, conversationId uuid.UUID) error {
+	var body v1.SendDirectMessageRequest
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
 	}
 
-	ws, err := h.upgrader.Upgrade(ctx.Response(), ctx.Request(), nil)
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	contents := []umodel.Content{}
+	for _, content := range body.Contents {
+		_, bin, err := ToMetaAndBin(content)
+		if err != nil {
+			return err
+		}
+
+		contents = append(contents, umodel.Content{
+			Type: string(content.Type),
+			Bin:  bin,
+		})
+	}
+
+	messageID, err := h.conversationUsecase.SendMessage(ctx.Request().Context(), conversationId, loggedInUser.ID, contents)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusCreated, v1.SendDirectMessageResponse{
+		Id: *messageID,
+	})
+}
+
+// ReadConversationMessage implements v1.ServerInterface.
+func (h *Handler) ReadConversationMessage(ctx echo.Context, conversationId uuid.UUID, messageId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.conversationUsecase.ReadMessage(ctx.Request().Context(), conversationId, messageId, loggedInUser.ID); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// CreateCommunityRole implements v1.ServerInterface.
+func (h *Handler) CreateCommunityRole(ctx echo.Context, communityId uuid.UUID) error {
+	var body v1.CreateCommunityRoleRequest
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	actions := map[string][]string{}
+	for _, action := range body.Actions {
+		if _, ok := actions[string(action.Resource)]; ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("duplicate resource. v=%v", action.Resource))
+		}
+
+		operations := lo.Map(action.Operations, func(operation v1.Operation, _ int) string { return string(operation) })
+		actions[string(action.Resource)] = operations
+	}
+
+	if err := h.communityUsecase.CreateRole(ctx.Request().Context(), communityId, loggedInUser.ID, body.Name, actions); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusCreated)
+}
+
+// DeleteCommunityRole implements v1.ServerInterface.
+func (h *Handler) DeleteCommunityRole(ctx echo.Context, communityId uuid.UUID, roleId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.DeleteRole(ctx.Request().Context(), communityId, loggedInUser.ID, roleId); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// ListCommunityRole implements v1.ServerInterface.
+func (h *Handler) ListCommunityRole(ctx echo.Context, communityId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	roles, err := h.communityUsecase.ListRole(ctx.Request().Context(), communityId, loggedInUser.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	pRoles := lo.Map(roles, func(role umodel.Role, _ int) v1.Role {
+		action := []v1.Action{}
+		for resource, operations := range role.Action {
+			action = append(action, v1.Action{
+				Resource:   v1.Resource(resource),
+				Operations: lo.Map(operations, func(operaiton string, _ int) v1.Operation { return v1.Operation(operaiton) }),
+			})
+		}
+
+		return v1.Role{
+			Id:      role.ID,
+			Name:    role.Name,
+			Actions: action,
+		}
+	})
+
+	return ctx.JSON(http.StatusOK, v1.ListCommunityRoleResponse{
+		Roles: pRoles,
+	})
+}
+
+// UpdateCommunityRole implements v1.ServerInterface.
+func (h *Handler) UpdateCommunityRole(ctx echo.Context, communityId uuid.UUID, roleId uuid.UUID) error {
+	var body v1.UpdateCommunityRoleRequest
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	actions := map[string][]string{}
+	for _, action := range body.Actions {
+		if _, ok := actions[string(action.Resource)]; ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("duplicate resource. v=%v", action.Resource))
+		}
+
+		operations := lo.Map(action.Operations, func(operation v1.Operation, _ int) string { return string(operation) })
+		actions[string(action.Resource)] = operations
+	}
+
+	if err := h.communityUsecase.UpdateRole(ctx.Request().Context(), communityId, loggedInUser.ID, roleId, body.Name, actions); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// ListAction implements v1.ServerInterface.
+func (h *Handler) ListAction(ctx echo.Context) error {
+	resources, operations, err := h.roleUsecase.ListAction(ctx.Request().Context())
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.ListActionResponse{
+		Resources:  lo.Map(resources, func(resource string, _ int) v1.Resource { return v1.Resource(resource) }),
+		Operations: lo.Map(operations, func(operation string, _ int) v1.Operation { return v1.Operation(operation) }),
+	})
+}
+
+// EditCommunityDescription implements v1.ServerInterface.
+func (h *Handler) EditCommunityDescription(ctx echo.Context, id uuid.UUID, params v1.EditCommunityDescriptionParams) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	community, err := h.communityUsecase.CanUpdate(ctx.Request().Context(), id, loggedInUser.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	description, err := h.noteUsecase.GetCommunityDescription(ctx.Request().Context(), community.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return h.editNote(ctx, description.ID)
+}
+
+// ExportCommunityDescription implements v1.ServerInterface.
+func (h *Handler) ExportCommunityDescription(ctx echo.Context, communityId uuid.UUID) error {
+	community, err := h.communityUsecase.Get(ctx.Request().Context(), communityId)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	description, err := h.noteUsecase.GetCommunityDescription(ctx.Request().Context(), community.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return h.exportNote(ctx, description.ID)
+}
+
+// ListCommunityDescriptionVersion implements v1.ServerInterface.
+func (h *Handler) ListCommunityDescriptionVersion(ctx echo.Context, communityId uuid.UUID, params v1.ListCommunityDescriptionVersionParams) error {
+	description, err := h.getEditableCommunityDescription(ctx, communityId)
+	if err != nil {
+		return err
+	}
+
+	return h.listNoteVersion(ctx, description.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
+}
+
+// DiffCommunityDescriptionVersion implements v1.ServerInterface.
+func (h *Handler) DiffCommunityDescriptionVersion(ctx echo.Context, communityId uuid.UUID, params v1.DiffCommunityDescriptionVersionParams) error {
+	description, err := h.getEditableCommunityDescription(ctx, communityId)
+	if err != nil {
+		return err
+	}
+
+	return h.diffNoteVersion(ctx, description.ID, params.From, params.To)
+}
+
+// RestoreCommunityDescriptionVersion implements v1.ServerInterface.
+func (h *Handler) RestoreCommunityDescriptionVersion(ctx echo.Context, communityId uuid.UUID, versionId uuid.UUID) error {
+	description, err := h.getEditableCommunityDescription(ctx, communityId)
+	if err != nil {
+		return err
+	}
+
+	return h.restoreNoteVersion(ctx, description.ID, versionId)
+}
+
+// 版の操作は説明を編集できるメンバーに限る
+func (h *Handler) getEditableCommunityDescription(ctx echo.Context, communityId uuid.UUID) (*umodel.Note, error) {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	community, err := h.communityUsecase.CanUpdate(ctx.Request().Context(), communityId, loggedInUser.ID)
+	if err != nil {
+		return nil, h.handle(err)
+	}
+
+	description, err := h.noteUsecase.GetCommunityDescription(ctx.Request().Context(), community.ID)
+	if err != nil {
+		return nil, h.handle(err)
+	}
+
+	return description, nil
+}
+
+// CreateCommunityPage implements v1.ServerInterface.
+func (h *Handler) CreateCommunityPage(ctx echo.Context, communityId uuid.UUID) error {
+	var body v1.CreatePageRequest
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	pageID, err := h.communityUsecase.CreatePage(ctx.Request().Context(), communityId, loggedInUser.ID, body.ParentId, body.Title, body.Order, nil)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusCreated, &v1.CreatePageResponse{
+		Id: *pageID,
+	})
+}
+
+// ListCommunityPage implements v1.ServerInterface.
+func (h *Handler) ListCommunityPage(ctx echo.Context, communityId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	pages, err := h.communityUsecase.ListPage(ctx.Request().Context(), communityId, loggedInUser.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.ListPageResponse{
+		Pages: h.buildPageTree(pages, nil),
+	})
+}
+
+// GetCommunityPage implements v1.ServerInterface.
+func (h *Handler) GetCommunityPage(ctx echo.Context, communityId uuid.UUID, pageId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	page, err := h.communityUsecase.GetPage(ctx.Request().Context(), communityId, loggedInUser.ID, pageId)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.GetPageResponse{
+		Page:      h.buildPage(*page),
+		Backlinks: lo.Map(page.Backlinks, func(backlink umodel.Page, _ int) v1.Page { return h.buildPage(backlink) }),
+	})
+}
+
+// UpdateCommunityPage implements v1.ServerInterface.
+func (h *Handler) UpdateCommunityPage(ctx echo.Context, communityId uuid.UUID, pageId uuid.UUID) error {
+	var body v1.UpdatePageRequest
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.RenamePage(ctx.Request().Context(), communityId, loggedInUser.ID, pageId, body.Title); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// MoveCommunityPage implements v1.ServerInterface.
+func (h *Handler) MoveCommunityPage(ctx echo.Context, communityId uuid.UUID, pageId uuid.UUID) error {
+	var body v1.MovePageRequest
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.MovePage(ctx.Request().Context(), communityId, loggedInUser.ID, pageId, body.ParentId, body.Order); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// DeleteCommunityPage implements v1.ServerInterface.
+func (h *Handler) DeleteCommunityPage(ctx echo.Context, communityId uuid.UUID, pageId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.DeletePage(ctx.Request().Context(), communityId, loggedInUser.ID, pageId); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// EditCommunityPage implements v1.ServerInterface.
+// 編集を終えたら本文からリンクと索引を作り直す
+func (h *Handler) EditCommunityPage(ctx echo.Context, communityId uuid.UUID, pageId uuid.UUID, params v1.EditCommunityPageParams) error {
+	body, err := h.getEditableCommunityPage(ctx, communityId, pageId)
+	if err != nil {
+		return err
+	}
+
+	defer h.syncPage(ctx, communityId, pageId, body.ID)
+
+	return h.editNote(ctx, body.ID)
+}
+
+// ExportCommunityPage implements v1.ServerInterface.
+func (h *Handler) ExportCommunityPage(ctx echo.Context, communityId uuid.UUID, pageId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	page, err := h.communityUsecase.GetPage(ctx.Request().Context(), communityId, loggedInUser.ID, pageId)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	body, err := h.noteUsecase.GetPageBody(ctx.Request().Context(), page.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return h.exportNote(ctx, body.ID)
+}
+
+// ListCommunityPageVersion implements v1.ServerInterface.
+func (h *Handler) ListCommunityPageVersion(ctx echo.Context, communityId uuid.UUID, pageId uuid.UUID, params v1.ListCommunityPageVersionParams) error {
+	body, err := h.getEditableCommunityPage(ctx, communityId, pageId)
+	if err != nil {
+		return err
+	}
+
+	return h.listNoteVersion(ctx, body.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
+}
+
+// DiffCommunityPageVersion implements v1.ServerInterface.
+func (h *Handler) DiffCommunityPageVersion(ctx echo.Context, communityId uuid.UUID, pageId uuid.UUID, params v1.DiffCommunityPageVersionParams) error {
+	body, err := h.getEditableCommunityPage(ctx, communityId, pageId)
+	if err != nil {
+		return err
+	}
+
+	return h.diffNoteVersion(ctx, body.ID, params.From, params.To)
+}
+
+// RestoreCommunityPageVersion implements v1.ServerInterface.
+func (h *Handler) RestoreCommunityPageVersion(ctx echo.Context, communityId uuid.UUID, pageId uuid.UUID, versionId uuid.UUID) error {
+	body, err := h.getEditableCommunityPage(ctx, communityId, pageId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.restoreNoteVersion(ctx, body.ID, versionId); err != nil {
+		return err
+	}
+
+	h.syncPage(ctx, communityId, pageId, body.ID)
+
+	return nil
+}
+
+// 本文の編集と版の操作はページを更新できるメンバーに限る
+func (h *Handler) getEditableCommunityPage(ctx echo.Context, communityId uuid.UUID, pageId uuid.UUID) (*umodel.Note, error) {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	page, err := h.communityUsecase.CanUpdatePage(ctx.Request().Context(), communityId, loggedInUser.ID, pageId)
+	if err != nil {
+		return nil, h.handle(err)
+	}
+
+	body, err := h.noteUsecase.GetPageBody(ctx.Request().Context(), page.ID)
+	if err != nil {
+		return nil, h.handle(err)
+	}
+
+	return body, nil
+}
+
+// 本文中のページのメンションをリンクとして保存する
+// 編集の結果は保存済みのため、失敗してもログに残すだけにする
+func (h *Handler) syncPage(ctx echo.Context, communityID uuid.UUID, pageID uuid.UUID, noteID uuid.UUID) {
+	c := context.WithoutCancel(ctx.Request().Context())
+
+	mentions, err := func() ([]umodel.Mention, error) {
+		lines, err := h.noteUsecase.ListLines(c, noteID)
+		if err != nil {
+			return nil, err
+		}
+
+		contents := []v1.Content{}
+		for _, line := range lines {
+			for _, content := range line.Contents {
+				parsedContent, err := NewContent(content.Type, content.Bin)
+				if err != nil {
+					return nil, err
+				}
+
+				contents = append(contents, *parsedContent)
+			}
+		}
+
+		mentions, err := ListMention(contents)
+		if err != nil {
+			return nil, err
+		}
+
+		return lo.Map(mentions, func(mention v1.Mention, _ int) umodel.Mention {
+			return umodel.Mention{ID: mention.Id, ResourceType: string(mention.Resource)}
+		}), nil
+	}()
+	if err != nil {
+		llog.Error(c, "failed to list page mentions. page_id=%v err=%v", pageID, err)
+		return
+	}
+
+	if err := h.communityUsecase.SyncPage(c, communityID, pageID, mentions); err != nil {
+		llog.Error(c, "failed to sync page. page_id=%v err=%v", pageID, err)
+	}
+}
+
+// CreateCommunityTemplate implements v1.ServerInterface.
+func (h *Handler) CreateCommunityTemplate(ctx echo.Context, communityId uuid.UUID) error {
+	var body v1.CreateCommunityTemplateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	template, err := toUTemplate(body)
+	if err != nil {
+		return err
+	}
+
+	templateID, err := h.communityUsecase.CreateTemplate(ctx.Request().Context(), communityId, loggedInUser.ID, *template)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusCreated, &v1.CreateTemplateResponse{
+		Id: *templateID,
+	})
+}
+
+// ListCommunityTemplate implements v1.ServerInterface.
+func (h *Handler) ListCommunityTemplate(ctx echo.Context, communityId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	templates, err := h.communityUsecase.ListTemplate(ctx.Request().Context(), communityId, loggedInUser.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	pTemplates := []v1.Template{}
+	for _, template := range templates {
+		pTemplate, err := h.buildTemplate(template)
+		if err != nil {
+			return err
+		}
+
+		pTemplates = append(pTemplates, *pTemplate)
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.ListTemplateResponse{
+		Templates: pTemplates,
+	})
+}
+
+// GetCommunityTemplate implements v1.ServerInterface.
+func (h *Handler) GetCommunityTemplate(ctx echo.Context, communityId uuid.UUID, templateId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	template, err := h.communityUsecase.GetTemplate(ctx.Request().Context(), communityId, loggedInUser.ID, templateId)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	pTemplate, err := h.buildTemplate(*template)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.GetTemplateResponse{
+		Template: *pTemplate,
+	})
+}
+
+// UpdateCommunityTemplate implements v1.ServerInterface.
+func (h *Handler) UpdateCommunityTemplate(ctx echo.Context, communityId uuid.UUID, templateId uuid.UUID) error {
+	var body v1.UpdateCommunityTemplateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	template, err := toUTemplate(body)
+	if err != nil {
+		return err
+	}
+
+	if err := h.communityUsecase.UpdateTemplate(ctx.Request().Context(), communityId, loggedInUser.ID, templateId, *template); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// DeleteCommunityTemplate implements v1.ServerInterface.
+func (h *Handler) DeleteCommunityTemplate(ctx echo.Context, communityId uuid.UUID, templateId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.DeleteTemplate(ctx.Request().Context(), communityId, loggedInUser.ID, templateId); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// CreateCommunityTopicFromTemplate implements v1.ServerInterface.
+func (h *Handler) CreateCommunityTopicFromTemplate(ctx echo.Context, communityId uuid.UUID, templateId uuid.UUID) error {
+	var body v1.CreateCommunityTopicFromTemplateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	template, _, err := h.fillTemplate(ctx, communityId, loggedInUser.ID, templateId, v1.TemplateKindTopic, body.Values)
+	if err != nil {
+		return err
+	}
+
+	name, ok := lo.Coalesce(body.Name, template.Title)
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "name is required")
+	}
+
+	topicID, err := h.communityUsecase.CreateTopic(ctx.Request().Context(), communityId, loggedInUser.ID, *name, template.Lines[0].Contents)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusCreated, v1.CreateTopicResponse{
+		Id: *topicID,
+	})
+}
+
+// CreateCommunityThreadFromTemplate implements v1.ServerInterface.
+func (h *Handler) CreateCommunityThreadFromTemplate(ctx echo.Context, communityId uuid.UUID, templateId uuid.UUID, topicId uuid.UUID) error {
+	var body v1.CreateCommunityThreadFromTemplateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	template, mentions, err := h.fillTemplate(ctx, communityId, loggedInUser.ID, templateId, v1.TemplateKindPost, body.Values)
+	if err != nil {
+		return err
+	}
+
+	if err := h.communityUsecase.Post(ctx.Request().Context(), communityId, loggedInUser.ID, topicId, template.Lines[0].Contents, mentions); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusCreated)
+}
+
+// CreateCommunityPostFromTemplate implements v1.ServerInterface.
+func (h *Handler) CreateCommunityPostFromTemplate(ctx echo.Context, communityId uuid.UUID, templateId uuid.UUID, topicId uuid.UUID, threadId uuid.UUID) error {
+	var body v1.CreateCommunityPostFromTemplateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	template, mentions, err := h.fillTemplate(ctx, communityId, loggedInUser.ID, templateId, v1.TemplateKindPost, body.Values)
+	if err != nil {
+		return err
+	}
+
+	if err := h.communityUsecase.Reply(ctx.Request().Context(), communityId, loggedInUser.ID, topicId, threadId, template.Lines[0].Contents, mentions); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusCreated)
+}
+
+// CreateCommunityPageFromTemplate implements v1.ServerInterface.
+// ひな形の行を本文にしてページを作ったあと、リンクと索引を作り直す
+func (h *Handler) CreateCommunityPageFromTemplate(ctx echo.Context, communityId uuid.UUID, templateId uuid.UUID) error {
+	var body v1.CreateCommunityPageFromTemplateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	template, _, err := h.fillTemplate(ctx, communityId, loggedInUser.ID, templateId, v1.TemplateKindNote, body.Values)
+	if err != nil {
+		return err
+	}
+
+	title, ok := lo.Coalesce(body.Title, template.Title)
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "title is required")
+	}
+
+	pageID, err := h.communityUsecase.CreatePage(ctx.Request().Context(), communityId, loggedInUser.ID, body.ParentId, *title, body.Order, template.Lines)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	page, err := h.noteUsecase.GetPageBody(ctx.Request().Context(), *pageID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	h.syncPage(ctx, communityId, *pageID, page.ID)
+
+	return ctx.JSON(http.StatusCreated, &v1.CreatePageResponse{
+		Id: *pageID,
+	})
+}
+
+// 置き換えた内容を作成するときと同じく検証し、メンションを取り出す
+func (h *Handler) fillTemplate(ctx echo.Context, communityId uuid.UUID, userID uuid.UUID, templateId uuid.UUID, kind v1.TemplateKind, values v1.TemplateValues) (*umodel.Template, []umodel.Mention, error) {
+	template, err := h.communityUsecase.FillTemplate(ctx.Request().Context(), communityId, userID, templateId, string(kind), values)
+	if err != nil {
+		return nil, nil, h.handle(err)
+	}
+
+	contents := []v1.Content{}
+	for _, line := range template.Lines {
+		for _, content := range line.Contents {
+			parsedContent, err := NewContent(content.Type, content.Bin)
+			if err != nil {
+				return nil, nil, echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+			}
+
+			contents = append(contents, *parsedContent)
+		}
+	}
+
+	mentions, err := ListMention(contents)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	return template, lo.Map(mentions, func(mention v1.Mention, _ int) umodel.Mention {
+		return umodel.Mention{ID: mention.Id, ResourceType: string(mention.Resource)}
+	}), nil
+}
+
+// ListJoinedCommunity implements v1.ServerInterface.
+func (h *Handler) ListJoinedCommunity(ctx echo.Context) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	communities, err := h.communityUsecase.ListJoined(ctx.Request().Context(), loggedInUser.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.ListJoinedCommunityResponse{
+		Communities: lo.Map(communities, func(community umodel.CommunitySummary, _ int) v1.CommunitySummary { return *h.buildCommunitySummary(community) }),
+	})
+}
+
+// ListPublicCommunity implements v1.ServerInterface.
+func (h *Handler) ListPublicCommunity(ctx echo.Context, params v1.ListPublicCommunityParams) error {
+	if _, err := lsession.GetLoginSession(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	communities, next, err := h.communityUsecase.ListPublic(ctx.Request().Context(), params.Name, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.ListPublicCommunityResponse{
+		Communities: lo.Map(communities, func(community umodel.CommunitySummary, _ int) v1.CommunitySummary { return *h.buildCommunitySummary(community) }),
+		NextCursor:  next,
+	})
+}
+
+// GetCommunity implements v1.ServerInterface.
+func (h *Handler) GetCommunity(ctx echo.Context, communityId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	community, err := h.communityUsecase.GetSummary(ctx.Request().Context(), communityId, loggedInUser.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.GetCommunityResponse{
+		Community: *h.buildCommunitySummary(*community),
+	})
+}
+
+// UpdateCommunity implements v1.ServerInterface.
+func (h *Handler) UpdateCommunity(ctx echo.Context, id uuid.UUID) error {
+	var body v1.UpdateCommunityRequest
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.Update(ctx.Request().Context(), id, loggedInUser.ID, body.Name, (*string)(body.Visibility)); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// DeleteCommunity implements v1.ServerInterface.
+func (h *Handler) DeleteCommunity(ctx echo.Context, communityId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.Delete(ctx.Request().Context(), communityId, loggedInUser.ID); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusAccepted)
+}
+
+// ArchiveCommunity implements v1.ServerInterface.
+func (h *Handler) ArchiveCommunity(ctx echo.Context, communityId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.Archive(ctx.Request().Context(), communityId, loggedInUser.ID, true); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// UnarchiveCommunity implements v1.ServerInterface.
+func (h *Handler) UnarchiveCommunity(ctx echo.Context, communityId uuid.UUID) error {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.Archive(ctx.Request().Context(), communityId, loggedInUser.ID, false); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// TransferCommunityOwnership implements v1.ServerInterface.
+func (h *Handler) TransferCommunityOwnership(ctx echo.Context, communityId uuid.UUID) error {
+	var body v1.TransferCommunityOwnershipJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	if err := h.communityUsecase.TransferOwnership(ctx.Request().Context(), communityId, loggedInUser.ID, body.MemberId); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// CreateCommunity implements v1.ServerInterface.
+func (h *Handler) CreateCommunity(ctx echo.Context) error {
+	var body v1.CreateCommunityRequest
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	visibility := lo.FromPtrOr(body.Visibility, v1.Unlisted)
+	communityID, err := h.communityUsecase.Create(ctx.Request().Context(), loggedInUser.ID, body.Name, body.Invitation, string(visibility))
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusCreated, &v1.CreateCommunityResponse{
+		Id: *communityID,
+	})
+}
+
+// EditUserProfile implements v1.ServerInterface.
+func (h *Handler) EditUserProfile(ctx echo.Context, _ v1.EditUserProfileParams) (err error) {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	user, err := h.userUsecase.Get(ctx.Request().Context(), loggedInUser.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	profile, err := h.noteUsecase.GetUserProfile(ctx.Request().Context(), user.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return h.editNote(ctx, profile.ID)
+}
+
+// ExportUserProfile implements v1.ServerInterface.
+func (h *Handler) ExportUserProfile(ctx echo.Context, userId uuid.UUID) error {
+	user, err := h.userUsecase.Get(ctx.Request().Context(), userId)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	profile, err := h.noteUsecase.GetUserProfile(ctx.Request().Context(), user.ID)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return h.exportNote(ctx, profile.ID)
+}
+
+// ListUserProfileVersion implements v1.ServerInterface.
+func (h *Handler) ListUserProfileVersion(ctx echo.Context, params v1.ListUserProfileVersionParams) error {
+	profile, err := h.getLoggedInUserProfile(ctx)
+	if err != nil {
+		return err
+	}
+
+	return h.listNoteVersion(ctx, profile.ID, params.Limit, lo.FromPtr(params.Offset), params.Cursor)
+}
+
+// DiffUserProfileVersion implements v1.ServerInterface.
+func (h *Handler) DiffUserProfileVersion(ctx echo.Context, params v1.DiffUserProfileVersionParams) error {
+	profile, err := h.getLoggedInUserProfile(ctx)
+	if err != nil {
+		return err
+	}
+
+	return h.diffNoteVersion(ctx, profile.ID, params.From, params.To)
+}
+
+// RestoreUserProfileVersion implements v1.ServerInterface.
+func (h *Handler) RestoreUserProfileVersion(ctx echo.Context, versionId uuid.UUID) error {
+	profile, err := h.getLoggedInUserProfile(ctx)
+	if err != nil {
+		return err
+	}
+
+	return h.restoreNoteVersion(ctx, profile.ID, versionId)
+}
+
+func (h *Handler) getLoggedInUserProfile(ctx echo.Context) (*umodel.Note, error) {
+	loggedInUser, err := lsession.GetLoginSession(ctx)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+	}
+
+	profile, err := h.noteUsecase.GetUserProfile(ctx.Request().Context(), loggedInUser.ID)
+	if err != nil {
+		return nil, h.handle(err)
+	}
+
+	return profile, nil
+}
+
+func (h *Handler) listNoteVersion(ctx echo.Context, id uuid.UUID, limit int, offset int, cursor *string) error {
+	versions, next, err := h.noteUsecase.ListVersions(ctx.Request().Context(), id, limit, offset, cursor)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.ListNoteVersionResponse{
+		Versions: lo.Map(versions, func(version umodel.NoteVersion, _ int) v1.NoteVersion {
+			return v1.NoteVersion{
+				Id:        version.ID,
+				Reason:    v1.NoteVersionReason(version.Reason),
+				CreatedAt: v1.UnixTime(version.CreatedAt.Unix()),
+			}
+		}),
+		NextCursor: next,
+	})
+}
+
+func (h *Handler) diffNoteVersion(ctx echo.Context, id uuid.UUID, from uuid.UUID, to *uuid.UUID) error {
+	diffs, err := h.noteUsecase.DiffVersions(ctx.Request().Context(), id, from, to)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	lines := []v1.LineDiff{}
+	for _, diff := range diffs {
+		var property *v1.LineProperty
+		if diff.Line.Property != nil {
+			property = &v1.LineProperty{
+				Type: v1.LinePropertyType(diff.Line.Property.Type),
+			}
+		}
+
+		contents := []v1.Content{}
+		for _, content := range diff.Line.Contents {
+			parsedContent, err := NewContent(content.Type, content.Bin)
+			if err != nil {
+				return err
+			}
+
+			contents = append(contents, *parsedContent)
+		}
+
+		lines = append(lines, v1.LineDiff{
+			Operation: v1.LineDiffOperation(diff.Operation),
+			From:      diff.From,
+			To:        diff.To,
+			Line: v1.Line{
+				Order:    diff.Line.Order,
+				Property: property,
+				Contents: contents,
+			},
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, &v1.DiffNoteVersionResponse{
+		Lines: lines,
+	})
+}
+
+// 編集中のノートは戻さない
+func (h *Handler) restoreNoteVersion(ctx echo.Context, id uuid.UUID, versionID uuid.UUID) error {
+	if exist, err := llock.Exist(ctx.Request().Context(), id); err != nil {
+		return err
+	} else if exist {
+		err = fmt.Errorf("locked")
+		return echo.NewHTTPError(http.StatusLocked, err.Error()).SetInternal(err)
+	}
+
+	if err := llock.Set(ctx.Request().Context(), id, time.Minute); err != nil {
+		return err
+	}
+	defer llock.Delete(ctx.Request().Context(), id)
+
+	if err := h.noteUsecase.RestoreVersion(ctx.Request().Context(), id, versionID); err != nil {
+		return h.handle(err)
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+func (h *Handler) exportNote(ctx echo.Context, id uuid.UUID) error {
+	uLines, err := h.noteUsecase.ListLines(ctx.Request().Context(), id)
+	if err != nil {
+		return h.handle(err)
+	}
+
+	if acceptsHTML(ctx, mimeTextMarkdown) {
+		body, err := renderLines(uLines)
+		if err != nil {
+			return err
+		}
+
+		return ctx.HTML(http.StatusOK, body)
+	}
+
+	lines := []v1.Line{}
+	for _, line := range uLines {
+		var property *v1.LineProperty
+		if line.Property != nil {
+			property = &v1.LineProperty{
+				Type: v1.LinePropertyType(line.Property.Type),
+			}
+		}
+
+		contents := []v1.Content{}
+		for _, content := range line.Contents {
+			parsedContent, err := NewContent(content.Type, content.Bin)
+			if err != nil {
+				return err
+			}
+
+			contents = append(contents, *parsedContent)
+		}
+
+		lines = append(lines, v1.Line{
+			Order:    line.Order,
+			Property: property,
+			Contents: contents,
+		})
+	}
+
+	md, err := LinesToMarkdown(lines)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Blob(http.StatusOK, mimeTextMarkdownCharsetUTF8, []byte(md))
+}
+
+func (h *Handler) editNote(ctx echo.Context, id uuid.UUID) error {
+	if _, err := h.noteUsecase.Get(ctx.Request().Context(), id); err != nil {
+		return h.handle(err)
+	}
+
+	ws, err := h.upgrader.Upgrade(ctx.Response(), ctx.Request(), nil)
 	if err != nil {
 		return err
 	} else if ws == nil {
@@ -883,6 +2397,9 @@ func (h *Handler) editNote(ctx echo.Context, id uuid.UUID) error {
 
 	defer ws.Close()
 
+	h.sockets.add(ws)
+	defer h.sockets.remove(ws)
+
 	lines, err := h.noteUsecase.ListLines(ctx.Request().Context(), id)
 	if err != nil {
 		return h.handle(err)
@@ -918,49 +2435,191 @@ func (h *Handler) editNote(ctx echo.Context, id uuid.UUID) error {
 		})
 	}
 
-	messagesToSend, err := NewMessagesToSend(currentLinesMessage)
-	if err != nil {
-		return err
-	}
+	messagesToSend, err := NewMessagesToSend(currentLinesMessage)
+	if err != nil {
+		return err
+	}
+
+	messagesToSendBin, err := json.Marshal(&messagesToSend)
+	if err != nil {
+		return err
+	}
+
+	if err := ws.WriteMessage(websocket.TextMessage, messagesToSendBin); err != nil {
+		return err
+	}
+
+	if exist, err := llock.Exist(ctx.Request().Context(), id); err != nil {
+		return err
+	} else if exist {
+		err = fmt.Errorf("locked")
+		return echo.NewHTTPError(http.StatusLocked, err.Error()).SetInternal(err)
+	}
+
+	defer func() {
+		err = llock.Delete(ctx.Request().Context(), id)
+	}()
+
+	// 編集を終えた時点と編集中は一定間隔で版を保存する
+	defer func() {
+		if err := h.noteUsecase.Snapshot(context.WithoutCancel(ctx.Request().Context()), id, string(v1.SessionClosed)); err != nil {
+			llog.Error(ctx.Request().Context(), "failed to save note version. note_id=%v err=%v", id, err)
+		}
+	}()
+
+	snapshotInterval := func() time.Duration {
+		intervalSeconds, err := strconv.Atoi(os.Getenv("NOTE_SNAPSHOT_INTERVAL_SECONDS"))
+
+		if err == nil && intervalSeconds > 0 {
+			return time.Duration(intervalSeconds) * time.Second
+		}
+
+		return 300 * time.Second
+	}()
+	snapshotAt := time.Now()
+
+	// 応答のない接続は pong の待ち時間を過ぎると切断し、ロックも同じ期限で失効させる
+	pingInterval := func() time.Duration {
+		intervalSeconds, err := strconv.Atoi(os.Getenv("WEBSOCKET_PING_INTERVAL_SECONDS"))
+
+		if err == nil && intervalSeconds > 0 {
+			return time.Duration(intervalSeconds) * time.Second
+		}
+
+		return 30 * time.Second
+	}()
+	pongWait := pingInterval * 2
+
+	keepAlive := func() error {
+		if err := ws.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
+			return err
+		}
+
+		return llock.Set(ctx.Request().Context(), id, pongWait)
+	}
+
+	if err := keepAlive(); err != nil {
+		return err
+	}
+
+	ws.SetPongHandler(func(string) error {
+		return keepAlive()
+	})
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(pingInterval)); err != nil {
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	consumers := map[v1.RecieveType]MessageReciever{
+		v1.RecieveTypeInsert: func(v v1.MessagesToRecieve_Entity) ([]int, error) {
+			message, err := v.AsInsertedLineMessage()
+
+			if err != nil {
+				return nil, uerror.NewInvalidParameter("failed to parse message", err)
+			}
+
+			return nil, h.noteUsecase.InsertLine(ctx.Request().Context(), id, message.To)
+		},
+		v1.RecieveTypeMove: func(v v1.MessagesToRecieve_Entity) ([]int, error) {
+			message, err := v.AsMovedLineMessage()
+
+			if err != nil {
+				return nil, uerror.NewInvalidParameter("failed to parse message", err)
+			}
+
+			return nil, h.noteUsecase.MoveLine(ctx.Request().Context(), id, message.From, message.To)
+		},
+		v1.RecieveTypeUpdate: func(v v1.MessagesToRecieve_Entity) ([]int, error) {
+			message, err := v.AsEditedLineMessage()
+
+			if err != nil {
+				return nil, uerror.NewInvalidParameter("failed to parse message", err)
+			}
+
+			line, err := toULine(id, message.Order, message.Property, message.Contents)
+			if err != nil {
+				return nil, uerror.NewInvalidParameter("failed to parse line", err)
+			}
+
+			return nil, h.noteUsecase.UpdateLine(ctx.Request().Context(), *line)
+		},
+		v1.RecieveTypeDelete: func(v v1.MessagesToRecieve_Entity) ([]int, error) {
+			message, err := v.AsDeletedLineMessage()
+
+			if err != nil {
+				return nil, uerror.NewInvalidParameter("failed to parse message", err)
+			}
+
+			return nil, h.noteUsecase.DeleteLine(ctx.Request().Context(), id, message.To)
+		},
+		v1.RecieveTypeInsertBatch: func(v v1.MessagesToRecieve_Entity) ([]int, error) {
+			message, err := v.AsInsertedLinesMessage()
 
-	messagesToSendBin, err := json.Marshal(&messagesToSend)
-	if err != nil {
-		return err
-	}
+			if err != nil {
+				return nil, uerror.NewInvalidParameter("failed to parse message", err)
+			}
 
-	if err := ws.WriteMessage(websocket.TextMessage, messagesToSendBin); err != nil {
-		return err
-	}
+			lines := []umodel.Line{}
+			for _, line := range message.Lines {
+				uLine, err := toULine(id, message.To, line.Property, line.Contents)
+				if err != nil {
+					return nil, uerror.NewInvalidParameter("failed to parse line", err)
+				}
 
-	if exist, err := llock.Exist(ctx.Request().Context(), id); err != nil {
-		return err
-	} else if exist {
-		err = fmt.Errorf("locked")
-		return echo.NewHTTPError(http.StatusLocked, err.Error()).SetInternal(err)
-	}
+				lines = append(lines, *uLine)
+			}
 
-	defer func() {
-		err = llock.Delete(ctx.Request().Context(), id)
-	}()
+			return h.noteUsecase.InsertLines(ctx.Request().Context(), id, message.To, lines)
+		},
+		v1.RecieveTypeReplaceBatch: func(v v1.MessagesToRecieve_Entity) ([]int, error) {
+			message, err := v.AsReplacedLinesMessage()
 
-	deadline := func() time.Duration {
-		timeoutSeconds, err := strconv.Atoi(os.Getenv("TIMEOUT_SECONDS_WEBSOCKET"))
+			if err != nil {
+				return nil, uerror.NewInvalidParameter("failed to parse message", err)
+			}
 
-		if err != nil {
-			return time.Duration(timeoutSeconds) * time.Second
-		}
+			lines := []umodel.Line{}
+			for _, line := range message.Lines {
+				uLine, err := toULine(id, line.Order, line.Property, line.Contents)
+				if err != nil {
+					return nil, uerror.NewInvalidParameter("failed to parse line", err)
+				}
 
-		return 1800 * time.Second
-	}()
+				lines = append(lines, *uLine)
+			}
 
-	for {
-		ws.SetReadDeadline(time.Now().Add(deadline))
+			return h.noteUsecase.UpdateLines(ctx.Request().Context(), id, lines)
+		},
+		v1.RecieveTypeDeleteRange: func(v v1.MessagesToRecieve_Entity) ([]int, error) {
+			message, err := v.AsDeletedLinesMessage()
 
-		if err := llock.Set(ctx.Request().Context(), id, deadline); err != nil {
-			return err
-		}
+			if err != nil {
+				return nil, uerror.NewInvalidParameter("failed to parse message", err)
+			}
+
+			return h.noteUsecase.DeleteLines(ctx.Request().Context(), id, message.From, message.To)
+		},
+	}
 
-		_, recievedMessage, err := ws.ReadMessage()
+	// 最後に処理したメッセージの応答は、同じ連番で再送された場合に送り直す
+	var lastAck *v1.AckMessage
+	for {
+		_, recievedBin, err := ws.ReadMessage()
 		if err != nil {
 			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
 				llog.Info(ctx.Request().Context(), "recieve close messege. err=%v", err)
@@ -971,96 +2630,143 @@ func (h *Handler) editNote(ctx echo.Context, id uuid.UUID) error {
 			break
 		}
 
-		if err := HandleRecievedMessage(recievedMessage, map[v1.RecieveType]MessageReciever{
-			v1.RecieveTypeInsert: func(v v1.MessagesToRecieve_Entity) error {
-				message, err := v.AsInsertedLineMessage()
+		if err := keepAlive(); err != nil {
+			return err
+		}
 
-				if err != nil {
-					return err
-				}
+		// 処理済みの連番は適用せず、再送されたメッセージを二重に反映しない
+		errorMessage := v1.ErrorMessage{}
+		ack, err := func() (*v1.AckMessage, error) {
+			recievedMessage, err := ParseRecievedMessage(recievedBin)
+			if err != nil {
+				return nil, err
+			}
 
-				if err := h.noteUsecase.InsertLine(ctx.Request().Context(), id, message.To); err != nil {
-					return h.handle(err)
-				}
+			errorMessage.Seq = &recievedMessage.Seq
+			errorMessage.Type = &recievedMessage.Type
 
-				return nil
-			},
-			v1.RecieveTypeMove: func(v v1.MessagesToRecieve_Entity) error {
-				message, err := v.AsMovedLineMessage()
+			if lastAck != nil && recievedMessage.Seq == lastAck.Seq {
+				return lastAck, nil
+			} else if lastAck != nil && recievedMessage.Seq < lastAck.Seq {
+				return nil, uerror.NewAlreadyExists(fmt.Sprintf("already applied. seq=%v last=%v", recievedMessage.Seq, lastAck.Seq), nil)
+			}
 
-				if err != nil {
-					return err
-				}
+			orders, err := HandleRecievedMessage(*recievedMessage, consumers)
+			if err != nil {
+				return nil, err
+			}
 
-				if err := h.noteUsecase.MoveLine(ctx.Request().Context(), id, message.From, message.To); err != nil {
-					return h.handle(err)
-				}
+			lastAck = &v1.AckMessage{
+				Seq:    recievedMessage.Seq,
+				Type:   recievedMessage.Type,
+				Orders: lo.Ternary(orders == nil, []int{}, orders),
+			}
 
-				return nil
-			},
-			v1.RecieveTypeUpdate: func(v v1.MessagesToRecieve_Entity) error {
-				message, err := v.AsEditedLineMessage()
+			return lastAck, nil
+		}()
 
-				if err != nil {
-					return err
-				}
+		var messagesToSend *v1.MessagesToSend
+		if err != nil {
+			var ok bool
+			if messagesToSend, ok = NewErrorMessagesToSend(err, errorMessage); !ok {
+				llog.Error(ctx.Request().Context(), "recieve error. err=%v:%v", reflect.TypeOf(err), err)
+				ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "internal error"), time.Now().Add(time.Second))
+				break
+			}
 
-				contents := []umodel.Content{}
-				for _, content := range message.Contents {
-					_, bin, err := ToMetaAndBin(content)
-					if err != nil {
-						return err
-					}
+			llog.Info(ctx.Request().Context(), "reject message. err=%v", err)
+		} else {
+			if messagesToSend, err = NewMessagesToSend(*ack); err != nil {
+				return err
+			}
+		}
 
-					contents = append(contents, umodel.Content{
-						Type: string(content.Type),
-						Bin:  bin,
-					})
-				}
+		messagesToSendBin, err := json.Marshal(&messagesToSend)
+		if err != nil {
+			return err
+		}
 
-				var property *umodel.LineProperty
-				if message.Property == nil {
-					property = nil
-				} else {
-					property = &umodel.LineProperty{
-						Type: string(message.Property.Type),
-					}
-				}
+		if err := ws.WriteMessage(websocket.TextMessage, messagesToSendBin); err != nil {
+			llog.Error(ctx.Request().Context(), "send error. err=%v:%v", reflect.TypeOf(err), err)
+			break
+		}
 
-				if err := h.noteUsecase.UpdateLine(ctx.Request().Context(), umodel.Line{
-					NoteID:   id,
-					Order:    message.Order,
-					Property: property,
-					Contents: contents,
-				}); err != nil {
-					return h.handle(err)
-				}
+		if time.Since(snapshotAt) >= snapshotInterval {
+			if err := h.noteUsecase.Snapshot(ctx.Request().Context(), id, string(v1.Periodic)); err != nil {
+				llog.Error(ctx.Request().Context(), "failed to save note version. note_id=%v err=%v", id, err)
+			}
 
-				return nil
-			},
-			v1.RecieveTypeDelete: func(v v1.MessagesToRecieve_Entity) error {
-				message, err := v.AsDeletedLineMessage()
+			snapshotAt = time.Now()
+		}
+	}
 
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-				if err := h.noteUsecase.DeleteLine(ctx.Request().Context(), id, message.To); err != nil {
-					return h.handle(err)
-				}
+func toULine(noteID uuid.UUID, order int, property *v1.LineProperty, contents []v1.Content) (*umodel.Line, error) {
+	uContents := []umodel.Content{}
+	for _, content := range contents {
+		_, bin, err := ToMetaAndBin(content)
+		if err != nil {
+			return nil, err
+		}
 
-				return nil
-			},
-		}); err != nil {
-			llog.Error(ctx.Request().Context(), "recieve error. err=%v:%v", reflect.TypeOf(err), err)
-			break
+		uContents = append(uContents, umodel.Content{
+			Type: string(content.Type),
+			Bin:  bin,
+		})
+	}
+
+	var uProperty *umodel.LineProperty
+	if property != nil {
+		uProperty = &umodel.LineProperty{
+			Type: string(property.Type),
 		}
 	}
 
-	return nil
+	return &umodel.Line{
+		NoteID:   noteID,
+		Order:    order,
+		Property: uProperty,
+		Contents: uContents,
+	}, nil
 }
 
-func (h *Handler) listActivity(ctx echo.Context, uActivities []umodel.Activity) ([]v1.Activity, error) {
+// トピックとポストのひな形は、作成するときと同じ内容の数の上限を課す
+func toUTemplate(body v1.TemplateBody) (*umodel.Template, error) {
+	maxContents := map[v1.TemplateKind]int{
+		v1.TemplateKindTopic: maxTopicContents,
+		v1.TemplateKindPost:  maxPostContents,
+	}
+	if limit, ok := maxContents[body.Kind]; ok && len(body.Lines) == 1 {
+		if err := validateContents(body.Lines[0].Contents, limit); err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+		}
+	}
+
+	lines := []umodel.Line{}
+	for i, line := range body.Lines {
+		uLine, err := toULine(uuid.Nil, i+1, line.Property, line.Contents)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, *uLine)
+	}
+
+	return &umodel.Template{
+		Name:  body.Name,
+		Kind:  string(body.Kind),
+		Title: body.Title,
+		Fields: lo.Map(body.Fields, func(field v1.TemplateField, _ int) umodel.TemplateField {
+			return umodel.TemplateField{Name: field.Name, Required: field.Required, Default: field.Default}
+		}),
+		Lines: lines,
+	}, nil
+}
+
+// ページは閲覧するユーザーが読めるものだけを返す
+func (h *Handler) listActivity(ctx echo.Context, userID uuid.UUID, uActivities []umodel.Activity) ([]v1.Activity, error) {
 	pActivities := []v1.Activity{}
 	for _, uActivity := range uActivities {
 		when := int(uActivity.At.Unix())
@@ -1286,6 +2992,39 @@ func (h *Handler) listActivity(ctx echo.Context, uActivities []umodel.Activity)
 					return nil, err
 				}
 			}
+		case v1.ResourcePage:
+			// ページはコミュニティの中で探す
+			community, err := h.communityUsecase.GetByMember(ctx.Request().Context(), uActivity.Me)
+			if err != nil {
+				if _, ok := err.(uerror.NotFound); ok {
+					llog.Debug(ctx.Request().Context(), "community not found. id=%v", uActivity.Target)
+					continue
+				}
+
+				return nil, err
+			}
+
+			resource, err := h.communityUsecase.GetPage(ctx.Request().Context(), community.ID, userID, uActivity.Target)
+			if err != nil {
+				if _, ok := err.(uerror.NotFound); ok {
+					llog.Debug(ctx.Request().Context(), "resource not found. id=%v", uActivity.Target)
+					continue
+				}
+
+				return nil, err
+			}
+
+			if err := experienceResource.FromPage(h.buildPage(*resource)); err != nil {
+				return nil, err
+			}
+
+			where = &v1.Activity_Where{}
+			if err := where.FromCommunity(v1.Community{
+				Id:   community.ID,
+				Name: community.Name,
+			}); err != nil {
+				return nil, err
+			}
 		case v1.ResourcePost:
 			resource, err := h.postUsecase.Get(ctx.Request().Context(), uActivity.Target)
 			if err != nil {
@@ -1355,6 +3094,232 @@ func (h *Handler) listActivity(ctx echo.Context, uActivities []umodel.Activity)
 	return pActivities, nil
 }
 
+func (h *Handler) listAllThreadIDs(ctx echo.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID) ([]uuid.UUID, error) {
+	threadIDs := []uuid.UUID{}
+
+	var cursor *string
+	for {
+		threads, next, err := h.communityUsecase.ListThread(ctx.Request().Context(), communityID, userID, topicID, exportPageSize, 0, cursor)
+		if err != nil {
+			return nil, h.handle(err)
+		}
+
+		for _, thread := range threads {
+			threadIDs = append(threadIDs, thread.ID)
+		}
+
+		if next == nil {
+			break
+		}
+
+		cursor = next
+	}
+
+	return threadIDs, nil
+}
+
+func (h *Handler) listAllPosts(ctx echo.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID) ([]umodel.Post, error) {
+	allPosts := []umodel.Post{}
+
+	var cursor *string
+	for {
+		posts, next, err := h.communityUsecase.ListPost(ctx.Request().Context(), communityID, userID, topicID, threadID, exportPageSize, 0, cursor)
+		if err != nil {
+			return nil, h.handle(err)
+		}
+
+		allPosts = append(allPosts, posts...)
+
+		if next == nil {
+			break
+		}
+
+		cursor = next
+	}
+
+	return allPosts, nil
+}
+
+func (h *Handler) threadToMarkdown(posts []umodel.Post) (string, error) {
+	blocks := []string{}
+	for _, post := range posts {
+		md, err := h.contentsToMarkdown(post.Contents)
+		if err != nil {
+			return "", err
+		}
+
+		if len(blocks) > 0 {
+			blocks = append(blocks, postSeparator)
+		}
+		blocks = append(blocks, md)
+	}
+
+	return joinMarkdown(blocks), nil
+}
+
+func (h *Handler) contentsToMarkdown(uContents []umodel.Content) (string, error) {
+	contents := []v1.Content{}
+	for _, content := range uContents {
+		pContent, err := NewContent(content.Type, content.Bin)
+		if err != nil {
+			return "", err
+		}
+
+		contents = append(contents, *pContent)
+	}
+
+	return ContentsToMarkdown(contents)
+}
+
+// 本文の Markdown を内容に変換し、JSON の本文と同じ件数の制限を確認する
+func (h *Handler) bindMarkdown(ctx echo.Context, maxContents int) ([]v1.Content, error) {
+	source, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	contents, err := MarkdownToContents(source)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	if err := validateContents(contents, maxContents); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+
+	return contents, nil
+}
+
+func (h *Handler) buildUser(user umodel.User) v1.User {
+	return v1.User{
+		Id:    user.ID,
+		Name:  user.Name,
+		Image: user.ImageUrl,
+	}
+}
+
+func (h *Handler) buildInviteLink(link umodel.InviteLink) v1.InviteLink {
+	actions := []v1.Action{}
+	for resource, operation := range link.Role.Action {
+		actions = append(actions, v1.Action{
+			Resource:   v1.Resource(resource),
+			Operations: lo.Map(operation, func(op string, _ int) v1.Operation { return v1.Operation(op) }),
+		})
+	}
+
+	return v1.InviteLink{
+		Id: link.ID,
+		Role: v1.Role{
+			Id:      link.Role.ID,
+			Name:    link.Role.Name,
+			Actions: actions,
+		},
+		Token:    link.Token,
+		At:       int(link.At.Unix()),
+		ExpireAt: h.buildUnixTime(link.ExpireAt),
+		MaxUses:  link.MaxUses,
+		Uses:     link.Uses,
+	}
+}
+
+func (h *Handler) buildCommunity(community umodel.Community) *v1.Community {
+	visibility := v1.CommunityVisibility(community.Visibility)
+	status := v1.CommunityStatus(community.Status)
+	return &v1.Community{
+		Id:         community.ID,
+		Name:       community.Name,
+		Invitation: community.Invitation,
+		Visibility: &visibility,
+		Status:     &status,
+	}
+}
+
+func (h *Handler) buildCommunitySummary(summary umodel.CommunitySummary) *v1.CommunitySummary {
+	return &v1.CommunitySummary{
+		Community:   *h.buildCommunity(summary.Community),
+		MemberCount: summary.MemberCount,
+	}
+}
+
+func (h *Handler) buildPage(page umodel.Page) v1.Page {
+	return v1.Page{
+		Id:        page.ID,
+		ParentId:  page.ParentID,
+		Title:     page.Title,
+		Order:     page.Order,
+		CreatedBy: page.Created,
+	}
+}
+
+func (h *Handler) buildTemplate(template umodel.Template) (*v1.Template, error) {
+	lines := []v1.LineBody{}
+	for _, line := range template.Lines {
+		contents := []v1.Content{}
+		for _, content := range line.Contents {
+			pContent, err := NewContent(content.Type, content.Bin)
+			if err != nil {
+				return nil, err
+			}
+
+			contents = append(contents, *pContent)
+		}
+
+		var property *v1.LineProperty
+		if line.Property != nil {
+			property = &v1.LineProperty{
+				Type: v1.LinePropertyType(line.Property.Type),
+			}
+		}
+
+		lines = append(lines, v1.LineBody{
+			Property: property,
+			Contents: contents,
+		})
+	}
+
+	return &v1.Template{
+		Id:    template.ID,
+		Name:  template.Name,
+		Kind:  v1.TemplateKind(template.Kind),
+		Title: template.Title,
+		Fields: lo.Map(template.Fields, func(field umodel.TemplateField, _ int) v1.TemplateField {
+			return v1.TemplateField{Name: field.Name, Required: field.Required, Default: field.Default}
+		}),
+		Lines:     lines,
+		CreatedBy: template.Created,
+	}, nil
+}
+
+// 並び順に並んだページから、parentID の子の木を組み立てる
+func (h *Handler) buildPageTree(pages []umodel.Page, parentID *uuid.UUID) []v1.PageTree {
+	children := lo.Filter(pages, func(page umodel.Page, _ int) bool {
+		if page.ParentID == nil || parentID == nil {
+			return page.ParentID == nil && parentID == nil
+		}
+
+		return *page.ParentID == *parentID
+	})
+	slices.SortFunc(children, func(a umodel.Page, b umodel.Page) int { return a.Order - b.Order })
+
+	return lo.Map(children, func(page umodel.Page, _ int) v1.PageTree {
+		return v1.PageTree{
+			Id:       page.ID,
+			Title:    page.Title,
+			Order:    page.Order,
+			Children: h.buildPageTree(pages, &page.ID),
+		}
+	})
+}
+
+func (h *Handler) buildUnixTime(t *time.Time) *v1.UnixTime {
+	if t == nil {
+		return nil
+	}
+
+	v := int(t.Unix())
+	return &v
+}
+
 func (h *Handler) buildMember(member umodel.Member) *v1.Member {
 	var role *v1.Role
 	if member.Role != nil {
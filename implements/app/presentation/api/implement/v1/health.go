@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"app/infrastructure/adapter/datastore/document"
+	"app/infrastructure/adapter/datastore/kvs"
+	"app/infrastructure/adapter/datastore/rdb"
+	"app/infrastructure/adapter/datastore/searchengine"
+	"app/infrastructure/adapter/datastore/timeseries"
+	"app/infrastructure/adapter/mq"
+	"app/lib/health"
+	"context"
+	"errors"
+
+	"github.com/samber/do"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
+)
+
+type rdbConnection interface {
+	Read() *gorm.DB
+	Write() *gorm.DB
+}
+
+// Checks はハンドラが利用している接続の疎通確認を返す
+func (h *Handler) Checks() []health.Check {
+	return h.checks
+}
+
+func newChecks(i *do.Injector) []health.Check {
+	checks := []health.Check{}
+
+	rdbConnections := []struct {
+		name       string
+		connection rdbConnection
+	}{
+		{name: "note", connection: do.MustInvoke[rdb.NoteStoreConnection](i)},
+		{name: "content", connection: do.MustInvoke[rdb.ContentStoreConnection](i)},
+		{name: "user", connection: do.MustInvoke[rdb.UserStoreConnection](i)},
+		{name: "role", connection: do.MustInvoke[rdb.RoleStoreConnection](i)},
+		{name: "member", connection: do.MustInvoke[rdb.MemberStoreConnection](i)},
+		{name: "community", connection: do.MustInvoke[rdb.CommunityStoreConnection](i)},
+		{name: "invite", connection: do.MustInvoke[rdb.InviteStoreConnection](i)},
+		{name: "topic", connection: do.MustInvoke[rdb.TopicStoreConnection](i)},
+		{name: "thread", connection: do.MustInvoke[rdb.ThreadStoreConnection](i)},
+		{name: "post", connection: do.MustInvoke[rdb.PostStoreConnection](i)},
+		{name: "message", connection: do.MustInvoke[rdb.MessageStoreConnection](i)},
+	}
+	for _, conn := range rdbConnections {
+		checks = append(checks,
+			health.Check{Name: "mysql/" + conn.name + "/read", Ping: pingRDB(conn.connection.Read())},
+			health.Check{Name: "mysql/" + conn.name + "/write", Ping: pingRDB(conn.connection.Write())},
+		)
+	}
+
+	noteStoreConnection := do.MustInvoke[document.NoteStoreConnection](i)
+	roleStoreConnection := do.MustInvoke[document.RoleStoreConnection](i)
+	cacheStoreConnection := do.MustInvoke[kvs.CacheStoreConnection](i)
+	resourceSearchIndexStoreConnection := do.MustInvoke[searchengine.ResourceSearchIndexStoreConnection](i)
+	activityStore := do.MustInvoke[timeseries.TimeseriesStore](i)
+	activityStoreConnection := do.MustInvoke[mq.ActivityStoreConnection](i)
+	resourceSearchIndexStoreConnectionMQ := do.MustInvoke[mq.ResourceSearchIndexStoreConnection](i)
+
+	return append(checks,
+		health.Check{Name: "mongodb/note", Ping: pingDocument(noteStoreConnection.DB())},
+		health.Check{Name: "mongodb/role", Ping: pingDocument(roleStoreConnection.DB())},
+		health.Check{Name: "redis/cache", Ping: func(c context.Context) error {
+			return cacheStoreConnection.Client().Ping(c).Err()
+		}},
+		health.Check{Name: "elasticsearch/resource", Ping: func(c context.Context) error {
+			ok, err := resourceSearchIndexStoreConnection.Client().Ping().IsSuccess(c)
+			if err != nil {
+				return err
+			} else if !ok {
+				return errors.New("ping failed")
+			}
+
+			return nil
+		}},
+		health.Check{Name: "influxdb/activity", Ping: activityStore.Ping},
+		health.Check{Name: "rabbitmq/activity", Ping: activityStoreConnection.Ping},
+		health.Check{Name: "rabbitmq/resource", Ping: resourceSearchIndexStoreConnectionMQ.Ping},
+	)
+}
+
+func pingRDB(db *gorm.DB) func(c context.Context) error {
+	return func(c context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+
+		return sqlDB.PingContext(c)
+	}
+}
+
+func pingDocument(db *mongo.Database) func(c context.Context) error {
+	return func(c context.Context) error {
+		return db.Client().Ping(c, nil)
+	}
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	v1 "app/gen/api/v1"
+	uerror "app/usecase/error"
 	"encoding/json"
 	"fmt"
 	"slices"
@@ -21,6 +22,9 @@ var (
 	// * line - 水平線
 	// * img - 画像
 	// * mention - メンション
+	// * code - コード
+	// * table - 表
+	// * quote - ポストの引用
 	supportedContents = map[v1.ContentType]func(v1.Content_Entity) (*string, []byte, error){
 		v1.ContentTypeText: func(t v1.Content_Entity) (*string, []byte, error) {
 			content, err := t.AsText()
@@ -100,10 +104,56 @@ var (
 			marshaled, err := t.Union().MarshalJSON()
 			return &contentValue, marshaled, err
 		},
+		v1.ContentTypeCode: func(t v1.Content_Entity) (*string, []byte, error) {
+			content, err := t.AsCode()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			contentValue := content.Source
+			marshaled, err := t.Union().MarshalJSON()
+			return &contentValue, marshaled, err
+		},
+		v1.ContentTypeTable: func(t v1.Content_Entity) (*string, []byte, error) {
+			content, err := t.AsTable()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for _, row := range content.Rows {
+				if len(row) != len(content.Header) {
+					return nil, nil, fmt.Errorf("invalid table. header=%v row=%v", len(content.Header), len(row))
+				}
+			}
+
+			cells := lo.Map(content.Header, func(elm v1.Text, _ int) string { return elm.Value })
+			for _, row := range content.Rows {
+				cells = append(cells, lo.Map(row, func(elm v1.Text, _ int) string { return elm.Value })...)
+			}
+
+			contentValue := strings.Join(cells, ",")
+			marshaled, err := t.Union().MarshalJSON()
+			return &contentValue, marshaled, err
+		},
+		v1.ContentTypeQuote: func(t v1.Content_Entity) (*string, []byte, error) {
+			content, err := t.AsQuote()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			contentValue := content.Value
+			marshaled, err := t.Union().MarshalJSON()
+			return &contentValue, marshaled, err
+		},
 	}
 
 	// Type 送信されるメッセージの種類
 	// * current - 現在の全行
+	// * ack - 受け取ったメッセージを適用した
+	// * validation_error - メッセージが不正
+	// * not_found_error - 対象の行が存在しない
+	// * permission_error - 認可しない
+	// * conflict_error - 処理済みのメッセージや他の編集と競合する
 	supportedMessagesToSends = map[v1.SendType]func(v1.MessagesToSend_Entity) error{
 		v1.Current: func(t v1.MessagesToSend_Entity) error {
 			if _, err := t.AsCurrentLinesMessage(); err != nil {
@@ -111,6 +161,36 @@ var (
 			}
 			return nil
 		},
+		v1.Ack: func(t v1.MessagesToSend_Entity) error {
+			if _, err := t.AsAckMessage(); err != nil {
+				return err
+			}
+			return nil
+		},
+		v1.ValidationError: func(t v1.MessagesToSend_Entity) error {
+			if _, err := t.AsErrorMessage(); err != nil {
+				return err
+			}
+			return nil
+		},
+		v1.NotFoundError: func(t v1.MessagesToSend_Entity) error {
+			if _, err := t.AsErrorMessage(); err != nil {
+				return err
+			}
+			return nil
+		},
+		v1.PermissionError: func(t v1.MessagesToSend_Entity) error {
+			if _, err := t.AsErrorMessage(); err != nil {
+				return err
+			}
+			return nil
+		},
+		v1.ConflictError: func(t v1.MessagesToSend_Entity) error {
+			if _, err := t.AsErrorMessage(); err != nil {
+				return err
+			}
+			return nil
+		},
 	}
 
 	supportedResources = []v1.Resource{
@@ -120,6 +200,7 @@ var (
 		v1.ResourceLike,
 		v1.ResourceMember,
 		v1.ResourceMilestone,
+		v1.ResourcePage,
 		v1.ResourcePost,
 		v1.ResourceProject,
 		v1.ResourceRole,
@@ -147,6 +228,9 @@ func NewMessagesToSend(entity any) (*v1.MessagesToSend, error) {
 	case v1.CurrentLinesMessage:
 		message := v1.NewMessageToSend(v1.Current, entityBin)
 		return &message, nil
+	case v1.AckMessage:
+		message := v1.NewMessageToSend(v1.Ack, entityBin)
+		return &message, nil
 	}
 
 	return nil, fmt.Errorf("unsupported message. v=%v", entity)
@@ -201,22 +285,58 @@ func NewContentType(v string) (*v1.ContentType, error) {
 	return nil, fmt.Errorf("invalid argument. v=%v", v)
 }
 
-type MessageReciever func(v1.MessagesToRecieve_Entity) error
+// MessageReciever は複数行の操作の場合に適用後の行番号を返す
+type MessageReciever func(v1.MessagesToRecieve_Entity) ([]int, error)
 
-func HandleRecievedMessage(bytes []byte, consumers map[v1.RecieveType]MessageReciever) error {
+// 解釈できないメッセージは uerror.InvalidParameter として扱う
+func ParseRecievedMessage(bytes []byte) (*v1.MessagesToRecieve, error) {
 	var recievedMessage v1.MessagesToRecieve
 	if err := json.Unmarshal(bytes, &recievedMessage); err != nil {
-		return err
+		return nil, uerror.NewInvalidParameter("failed to parse message", err)
+	}
+
+	if recievedMessage.Seq < 1 {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("invalid seq. v=%v", recievedMessage.Seq), nil)
 	}
 
+	return &recievedMessage, nil
+}
+
+func HandleRecievedMessage(recievedMessage v1.MessagesToRecieve, consumers map[v1.RecieveType]MessageReciever) ([]int, error) {
 	consumer, ok := consumers[recievedMessage.Type]
 	if !ok {
-		return fmt.Errorf("consumer not found. v=%v", recievedMessage.Type)
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("consumer not found. v=%v", recievedMessage.Type), nil)
 	}
 
 	return consumer(recievedMessage.Entity)
 }
 
+// NewErrorMessagesToSend は接続を維持できるエラーの場合のみメッセージを返す
+func NewErrorMessagesToSend(err error, entity v1.ErrorMessage) (*v1.MessagesToSend, bool) {
+	var sendType v1.SendType
+	switch err.(type) {
+	case uerror.InvalidParameter:
+		sendType = v1.ValidationError
+	case uerror.NotFound:
+		sendType = v1.NotFoundError
+	case uerror.PermissionDenied:
+		sendType = v1.PermissionError
+	case uerror.AlreadyExists:
+		sendType = v1.ConflictError
+	default:
+		return nil, false
+	}
+
+	entity.Message = err.Error()
+	entityBin, err := json.Marshal(&entity)
+	if err != nil {
+		return nil, false
+	}
+
+	message := v1.NewMessageToSend(sendType, entityBin)
+	return &message, true
+}
+
 func ToMetaAndBin(content v1.Content) (*string, []byte, error) {
 	supportedContentAs, ok := supportedContents[content.Type]
 
@@ -239,7 +359,7 @@ func ListMention(contents []v1.Content) (v1.Mentions, error) {
 			return nil, err
 		}
 
-		mentions = slices.Concat(content)
+		mentions = slices.Concat(mentions, content)
 	}
 
 	return mentions, nil
@@ -0,0 +1,935 @@
+package v1
+
+import (
+	v1 "app/gen/api/v1"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+const (
+	// メンションは mention://{resource}/{id} へのリンクで表す
+	mentionScheme = "mention://"
+	// 段落の区切りはテキストの中では空行で表す
+	paragraphSeparator = "\n\n"
+	maxTextLength      = 4096
+	maxMentions        = 10
+	maxHeadingLevel    = 4
+
+	mimeTextMarkdown            = "text/markdown"
+	mimeTextMarkdownCharsetUTF8 = mimeTextMarkdown + "; charset=UTF-8"
+
+	maxTopicContents = 10
+	maxPostContents  = 5
+	exportPageSize   = 100
+	// スレッドは --- で、スレッド内のポストは *** で区切る
+	threadSeparator = "---"
+	postSeparator   = "***"
+)
+
+var (
+	markdownParser = goldmark.New(goldmark.WithExtensions(extension.Strikethrough, extension.TaskList, extension.Table)).Parser()
+
+	urlPattern = regexp.MustCompile(`^https?://[\w/:%#\$&\?\(\)~\.=\+\-]+$`)
+
+	markdownEscaper     = strings.NewReplacer(`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `<`, `\<`, `>`, `\>`, `~`, `\~`, `|`, `\|`, `&`, `\&`)
+	markdownBlockMarker = regexp.MustCompile(`^[#+\-=]`)
+	markdownListNumber  = regexp.MustCompile(`^(\d+)([.)])`)
+	markdownFence       = regexp.MustCompile("`{3,}")
+)
+
+// MarkdownToContents は Markdown を内容に変換する
+func MarkdownToContents(source []byte) ([]v1.Content, error) {
+	r := &markdownReader{source: source}
+	if err := r.blocks(markdownParser.Parse(text.NewReader(source))); err != nil {
+		return nil, err
+	}
+
+	return r.result()
+}
+
+// MarkdownToTopic は先頭のレベル1の見出しをトピック名とし、残りを内容に変換する
+func MarkdownToTopic(source []byte) (string, []v1.Content, error) {
+	doc := markdownParser.Parse(text.NewReader(source))
+
+	heading, ok := doc.FirstChild().(*ast.Heading)
+	if !ok || heading.Level != 1 {
+		return "", nil, fmt.Errorf("topic name not found. a level 1 heading is required at the beginning")
+	}
+
+	r := &markdownReader{source: source}
+	name := strings.TrimSpace(r.plainText(heading))
+	doc.RemoveChild(doc, heading)
+
+	if err := r.blocks(doc); err != nil {
+		return "", nil, err
+	}
+
+	contents, err := r.result()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return name, contents, nil
+}
+
+// ContentsToMarkdown は内容を Markdown に変換する
+// テキストとメンションは同じ段落に続けて書き、それ以外の内容は段落を分ける
+func ContentsToMarkdown(contents []v1.Content) (string, error) {
+	blocks := []string{}
+	inline := ""
+
+	flush := func() {
+		if trimmed := strings.Trim(inline, "\n"); trimmed != "" {
+			blocks = append(blocks, trimmed)
+		}
+		inline = ""
+	}
+
+	for _, content := range contents {
+		switch content.Type {
+		case v1.ContentTypeText:
+			t, err := content.Entity.AsText()
+			if err != nil {
+				return "", err
+			}
+
+			inline += textToMarkdown(t)
+		case v1.ContentTypeMentions:
+			mentions, err := content.Entity.AsMentions()
+			if err != nil {
+				return "", err
+			}
+
+			if inline != "" && !strings.HasSuffix(inline, " ") && !strings.HasSuffix(inline, "\n") {
+				inline += " "
+			}
+
+			inline += strings.Join(mentionsToMarkdown(mentions), " ")
+		default:
+			block, err := blockToMarkdown(content)
+			if err != nil {
+				return "", err
+			}
+
+			flush()
+			blocks = append(blocks, block)
+		}
+	}
+
+	flush()
+
+	if len(blocks) == 0 {
+		return "", nil
+	}
+
+	return strings.Join(blocks, paragraphSeparator) + "\n", nil
+}
+
+// LinesToMarkdown はノートの行を Markdown に変換する
+// Markdown には強調とトグルがないため、引用と同じく > で書き出す
+func LinesToMarkdown(lines []v1.Line) (string, error) {
+	blocks := []string{}
+	for _, line := range lines {
+		md, err := ContentsToMarkdown(line.Contents)
+		if err != nil {
+			return "", err
+		}
+
+		md = strings.TrimRight(md, "\n")
+		if md == "" {
+			continue
+		}
+
+		if line.Property != nil {
+			quoted := strings.Split(md, "\n")
+			for i, q := range quoted {
+				quoted[i] = strings.TrimRight("> "+q, " ")
+			}
+			md = strings.Join(quoted, "\n")
+		}
+
+		blocks = append(blocks, md)
+	}
+
+	if len(blocks) == 0 {
+		return "", nil
+	}
+
+	return strings.Join(blocks, paragraphSeparator) + "\n", nil
+}
+
+type markdownInline struct {
+	text    *v1.Text
+	mention *v1.Mention
+	image   *v1.Image
+	// メンションを本文として扱う場合の表示名
+	label string
+}
+
+type markdownReader struct {
+	source   []byte
+	contents []v1.Content
+	// 続けて書かれたテキストやメンションはまとめてから内容にする
+	text      *v1.Text
+	mentions  v1.Mentions
+	separate  bool
+	underline bool
+}
+
+func (r *markdownReader) result() ([]v1.Content, error) {
+	if err := r.flush(); err != nil {
+		return nil, err
+	}
+
+	return r.contents, nil
+}
+
+func (r *markdownReader) blocks(parent ast.Node) error {
+	for n := parent.FirstChild(); n != nil; n = n.NextSibling() {
+		if err := r.block(n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *markdownReader) block(n ast.Node) error {
+	switch n := n.(type) {
+	case *ast.Heading:
+		heading := v1.Heading{
+			Level: min(n.Level, maxHeadingLevel),
+			Value: r.flatten(r.inlines(n, v1.TextProperty{})),
+		}
+
+		return r.add(v1.ContentTypeHeading, func(e *v1.Content_Entity) error { return e.FromHeading(heading) })
+	case *ast.Paragraph, *ast.TextBlock:
+		return r.paragraph(r.inlines(n, v1.TextProperty{}))
+	case *ast.List:
+		return r.list(n)
+	case *ast.ThematicBreak:
+		return r.add(v1.ContentTypeLine, func(e *v1.Content_Entity) error { return e.FromHorizontalLine(v1.HorizontalLine{Size: 1}) })
+	case *ast.FencedCodeBlock:
+		code := v1.Code{Source: strings.TrimSuffix(r.rawLines(n), "\n")}
+		if language := string(n.Language(r.source)); language != "" {
+			code.Language = &language
+		}
+
+		return r.add(v1.ContentTypeCode, func(e *v1.Content_Entity) error { return e.FromCode(code) })
+	case *ast.CodeBlock:
+		code := v1.Code{Source: strings.TrimSuffix(r.rawLines(n), "\n")}
+
+		return r.add(v1.ContentTypeCode, func(e *v1.Content_Entity) error { return e.FromCode(code) })
+	case *east.Table:
+		return r.table(n)
+	case *ast.Blockquote:
+		if quote, ok := r.quote(n); ok {
+			return r.add(v1.ContentTypeQuote, func(e *v1.Content_Entity) error { return e.FromQuote(*quote) })
+		}
+
+		return r.blocks(n)
+	case *ast.HTMLBlock:
+		value := strings.TrimRight(r.rawLines(n), "\n")
+		if value == "" {
+			return nil
+		}
+
+		return r.paragraph([]markdownInline{{text: &v1.Text{Value: value}}})
+	default:
+		return r.blocks(n)
+	}
+}
+
+func (r *markdownReader) paragraph(inlines []markdownInline) error {
+	r.separate = r.text != nil || r.mentions != nil
+
+	for _, inline := range inlines {
+		switch {
+		case inline.text != nil:
+			if err := r.addText(*inline.text); err != nil {
+				return err
+			}
+		case inline.mention != nil:
+			if err := r.addMention(*inline.mention); err != nil {
+				return err
+			}
+		case inline.image != nil:
+			image := *inline.image
+			if err := r.add(v1.ContentTypeImg, func(e *v1.Content_Entity) error { return e.FromImage(image) }); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// 列の数は見出しの行に揃える
+func (r *markdownReader) table(n *east.Table) error {
+	table := v1.Table{Rows: [][]v1.Text{}}
+
+	for row := n.FirstChild(); row != nil; row = row.NextSibling() {
+		cells := []v1.Text{}
+		for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+			cells = append(cells, r.flatten(r.inlines(cell, v1.TextProperty{})))
+		}
+
+		if _, ok := row.(*east.TableHeader); ok {
+			table.Header = cells
+			continue
+		}
+
+		for len(cells) < len(table.Header) {
+			cells = append(cells, v1.Text{})
+		}
+		table.Rows = append(table.Rows, cells[:len(table.Header)])
+	}
+
+	return r.add(v1.ContentTypeTable, func(e *v1.Content_Entity) error { return e.FromTable(table) })
+}
+
+// 最後の段落がポストへのメンションだけの引用はポストの引用として扱う
+func (r *markdownReader) quote(n *ast.Blockquote) (*v1.Quote, bool) {
+	last, ok := n.LastChild().(*ast.Paragraph)
+	if !ok {
+		return nil, false
+	}
+
+	var mention *v1.Mention
+	for _, inline := range r.inlines(last, v1.TextProperty{}) {
+		switch {
+		case inline.mention != nil && mention == nil && inline.mention.Resource == v1.ResourcePost:
+			mention = inline.mention
+		case inline.text != nil && strings.TrimSpace(inline.text.Value) == "":
+			continue
+		default:
+			return nil, false
+		}
+	}
+
+	if mention == nil {
+		return nil, false
+	}
+
+	values := []string{}
+	for child := n.FirstChild(); child != last; child = child.NextSibling() {
+		if value := strings.TrimSpace(r.plainText(child)); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return &v1.Quote{PostId: mention.Id, Value: strings.Join(values, paragraphSeparator)}, true
+}
+
+type markdownListItem struct {
+	indent  int
+	checked *bool
+	value   v1.Text
+}
+
+// すべての項目がタスクであればチェックボックス、それ以外は箇条書きにする
+func (r *markdownReader) list(n *ast.List) error {
+	items := r.listItems(n, 1)
+
+	if len(items) > 0 && allTasks(items) {
+		checkBox := v1.CheckBox{Values: []v1.CheckBoxElement{}}
+		for _, item := range items {
+			checkBox.Values = append(checkBox.Values, v1.CheckBoxElement{Checked: *item.checked, Value: item.value})
+		}
+
+		return r.add(v1.ContentTypeCheckbox, func(e *v1.Content_Entity) error { return e.FromCheckBox(checkBox) })
+	}
+
+	listType := v1.Midpoint
+	if n.IsOrdered() {
+		listType = v1.Integer
+	}
+
+	list := v1.List{Type: listType, Values: []v1.ListElement{}}
+	for _, item := range items {
+		list.Values = append(list.Values, v1.ListElement{Indent: item.indent, Value: item.value})
+	}
+
+	return r.add(v1.ContentTypeList, func(e *v1.Content_Entity) error { return e.FromList(list) })
+}
+
+func (r *markdownReader) listItems(n *ast.List, indent int) []markdownListItem {
+	items := []markdownListItem{}
+
+	for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+		current := markdownListItem{indent: indent}
+		nested := []markdownListItem{}
+		inlines := []markdownInline{}
+
+		for child := item.FirstChild(); child != nil; child = child.NextSibling() {
+			switch child := child.(type) {
+			case *ast.List:
+				nested = append(nested, r.listItems(child, indent+1)...)
+			case *ast.Paragraph, *ast.TextBlock:
+				if checkBox, ok := child.FirstChild().(*east.TaskCheckBox); ok && current.checked == nil {
+					checked := checkBox.IsChecked
+					current.checked = &checked
+				}
+
+				if len(inlines) > 0 {
+					inlines = append(inlines, markdownInline{text: &v1.Text{Value: " "}})
+				}
+				inlines = append(inlines, r.inlines(child, v1.TextProperty{})...)
+			default:
+				if len(inlines) > 0 {
+					inlines = append(inlines, markdownInline{text: &v1.Text{Value: " "}})
+				}
+				inlines = append(inlines, markdownInline{text: &v1.Text{Value: r.plainText(child)}})
+			}
+		}
+
+		current.value = r.flatten(inlines)
+		current.value.Value = strings.TrimSpace(strings.ReplaceAll(current.value.Value, "\n", " "))
+
+		items = append(items, current)
+		items = append(items, nested...)
+	}
+
+	return items
+}
+
+func allTasks(items []markdownListItem) bool {
+	for _, item := range items {
+		if item.checked == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *markdownReader) inlines(parent ast.Node, option v1.TextProperty) []markdownInline {
+	inlines := []markdownInline{}
+
+	appendText := func(value string, option v1.TextProperty) {
+		if value == "" {
+			return
+		}
+
+		option.Underline = option.Underline || r.underline
+		inlines = append(inlines, markdownInline{text: &v1.Text{Value: value, Option: option}})
+	}
+
+	for n := parent.FirstChild(); n != nil; n = n.NextSibling() {
+		switch n := n.(type) {
+		case *ast.Text:
+			value := unescapeMarkdown(n.Segment.Value(r.source))
+			if n.SoftLineBreak() || n.HardLineBreak() {
+				value += "\n"
+			}
+
+			appendText(value, option)
+		case *ast.String:
+			appendText(string(n.Value), option)
+		case *ast.CodeSpan:
+			appendText(r.rawText(n), option)
+		case *ast.Emphasis:
+			emphasized := option
+			if n.Level >= 2 {
+				emphasized.Bold = true
+			} else {
+				emphasized.Oblique = true
+			}
+
+			inlines = append(inlines, r.inlines(n, emphasized)...)
+		case *east.Strikethrough:
+			through := option
+			through.Through = true
+
+			inlines = append(inlines, r.inlines(n, through)...)
+		case *ast.Link:
+			destination := string(n.Destination)
+
+			if mention, ok := parseMention(destination); ok {
+				inlines = append(inlines, markdownInline{mention: mention, label: r.plainText(n)})
+				continue
+			}
+
+			linked := option
+			if urlPattern.MatchString(destination) {
+				linked.Url = &destination
+			}
+
+			inlines = append(inlines, r.inlines(n, linked)...)
+		case *ast.AutoLink:
+			linked := option
+			if url := string(n.URL(r.source)); n.AutoLinkType == ast.AutoLinkURL && urlPattern.MatchString(url) {
+				linked.Url = &url
+			}
+
+			appendText(string(n.Label(r.source)), linked)
+		case *ast.Image:
+			destination := string(n.Destination)
+			if !urlPattern.MatchString(destination) {
+				appendText(r.plainText(n), option)
+				continue
+			}
+
+			inlines = append(inlines, markdownInline{image: &v1.Image{Url: destination}})
+		case *ast.RawHTML:
+			raw := r.rawSegments(n.Segments)
+
+			// 下線は Markdown に記法がないため <u> で表す
+			switch strings.ToLower(raw) {
+			case "<u>":
+				r.underline = true
+			case "</u>":
+				r.underline = false
+			default:
+				appendText(raw, option)
+			}
+		case *east.TaskCheckBox:
+			continue
+		default:
+			inlines = append(inlines, r.inlines(n, option)...)
+		}
+	}
+
+	return inlines
+}
+
+// 見出しや箇条書きの項目は一つのテキストしか持てないため、属性がすべて同じ場合だけ属性を残す
+func (r *markdownReader) flatten(inlines []markdownInline) v1.Text {
+	result := v1.Text{}
+	options := []v1.TextProperty{}
+
+	for _, inline := range inlines {
+		switch {
+		case inline.text != nil:
+			result.Value += inline.text.Value
+			options = append(options, inline.text.Option)
+		case inline.mention != nil:
+			result.Value += inline.label
+			options = append(options, v1.TextProperty{})
+		}
+	}
+
+	if len(options) > 0 {
+		result.Option = options[0]
+		for _, option := range options[1:] {
+			if !sameOption(result.Option, option) {
+				result.Option = v1.TextProperty{}
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func (r *markdownReader) plainText(n ast.Node) string {
+	return r.flatten(r.inlines(n, v1.TextProperty{})).Value
+}
+
+func (r *markdownReader) rawText(n ast.Node) string {
+	value := ""
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		if t, ok := child.(*ast.Text); ok {
+			value += string(t.Segment.Value(r.source))
+		}
+	}
+
+	return value
+}
+
+func (r *markdownReader) rawLines(n ast.Node) string {
+	return r.rawSegments(n.Lines())
+}
+
+func (r *markdownReader) rawSegments(segments *text.Segments) string {
+	value := ""
+	for i := 0; i < segments.Len(); i++ {
+		segment := segments.At(i)
+		value += string(segment.Value(r.source))
+	}
+
+	return value
+}
+
+func (r *markdownReader) addText(t v1.Text) error {
+	if r.separate {
+		r.separate = false
+
+		switch {
+		case r.text != nil:
+			r.text.Value += paragraphSeparator
+		case r.mentions != nil:
+			t.Value = paragraphSeparator + t.Value
+		}
+	}
+
+	if r.text != nil && sameOption(r.text.Option, t.Option) && utf8.RuneCountInString(r.text.Value+t.Value) <= maxTextLength {
+		r.text.Value += t.Value
+		return nil
+	}
+
+	if err := r.flush(); err != nil {
+		return err
+	}
+
+	r.text = &t
+	return nil
+}
+
+func (r *markdownReader) addMention(mention v1.Mention) error {
+	if r.separate {
+		r.separate = false
+
+		switch {
+		case r.text != nil:
+			r.text.Value += paragraphSeparator
+		case r.mentions != nil:
+			if err := r.flush(); err != nil {
+				return err
+			}
+
+			r.text = &v1.Text{Value: paragraphSeparator}
+		}
+	}
+
+	if r.mentions != nil && len(r.mentions) < maxMentions {
+		r.mentions = append(r.mentions, mention)
+		return nil
+	}
+
+	if err := r.flush(); err != nil {
+		return err
+	}
+
+	r.mentions = v1.Mentions{mention}
+	return nil
+}
+
+func (r *markdownReader) add(contentType v1.ContentType, from func(*v1.Content_Entity) error) error {
+	if err := r.flush(); err != nil {
+		return err
+	}
+
+	r.separate = false
+	return r.append(contentType, from)
+}
+
+func (r *markdownReader) flush() error {
+	switch {
+	case r.text != nil:
+		t := *r.text
+		r.text = nil
+
+		return r.append(v1.ContentTypeText, func(e *v1.Content_Entity) error { return e.FromText(t) })
+	case r.mentions != nil:
+		mentions := r.mentions
+		r.mentions = nil
+
+		return r.append(v1.ContentTypeMentions, func(e *v1.Content_Entity) error { return e.FromMentions(mentions) })
+	}
+
+	return nil
+}
+
+func (r *markdownReader) append(contentType v1.ContentType, from func(*v1.Content_Entity) error) error {
+	var entity v1.Content_Entity
+	if err := from(&entity); err != nil {
+		return err
+	}
+
+	r.contents = append(r.contents, v1.Content{Type: contentType, Entity: entity})
+	return nil
+}
+
+func parseMention(destination string) (*v1.Mention, bool) {
+	if !strings.HasPrefix(destination, mentionScheme) {
+		return nil, false
+	}
+
+	resource, id, ok := strings.Cut(strings.TrimPrefix(destination, mentionScheme), "/")
+	if !ok {
+		return nil, false
+	}
+
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, false
+	}
+
+	for _, supportedResource := range supportedResources {
+		if v1.Resource(resource) == supportedResource {
+			return &v1.Mention{Id: parsedID, Resource: supportedResource}, true
+		}
+	}
+
+	return nil, false
+}
+
+func sameOption(a v1.TextProperty, b v1.TextProperty) bool {
+	return a.Bold == b.Bold &&
+		a.Oblique == b.Oblique &&
+		a.Underline == b.Underline &&
+		a.Through == b.Through &&
+		equalPtr(a.Color, b.Color) &&
+		equalPtr(a.Url, b.Url)
+}
+
+func equalPtr(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func unescapeMarkdown(value []byte) string {
+	return string(util.ResolveEntityNames(util.ResolveNumericReferences(util.UnescapePunctuations(value))))
+}
+
+func blockToMarkdown(content v1.Content) (string, error) {
+	switch content.Type {
+	case v1.ContentTypeHeading:
+		heading, err := content.Entity.AsHeading()
+		if err != nil {
+			return "", err
+		}
+
+		return strings.Repeat("#", heading.Level) + " " + singleLine(textToMarkdown(heading.Value)), nil
+	case v1.ContentTypeList:
+		list, err := content.Entity.AsList()
+		if err != nil {
+			return "", err
+		}
+
+		return listToMarkdown(list), nil
+	case v1.ContentTypeCheckbox:
+		checkBox, err := content.Entity.AsCheckBox()
+		if err != nil {
+			return "", err
+		}
+
+		items := []string{}
+		for _, value := range checkBox.Values {
+			items = append(items, taskToMarkdown(value.Checked, value.Value))
+		}
+
+		return strings.Join(items, "\n"), nil
+	case v1.ContentTypeRadiobutton:
+		// Markdown にはラジオボタンがないためタスクリストとして書き出す
+		radioButton, err := content.Entity.AsRadioButton()
+		if err != nil {
+			return "", err
+		}
+
+		items := []string{}
+		for _, value := range radioButton.Values {
+			items = append(items, taskToMarkdown(value.Checked, value.Value))
+		}
+
+		return strings.Join(items, "\n"), nil
+	case v1.ContentTypeLine:
+		return "---", nil
+	case v1.ContentTypeImg:
+		image, err := content.Entity.AsImage()
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("![](<%v>)", image.Url), nil
+	case v1.ContentTypeCode:
+		code, err := content.Entity.AsCode()
+		if err != nil {
+			return "", err
+		}
+
+		return codeToMarkdown(code), nil
+	case v1.ContentTypeTable:
+		table, err := content.Entity.AsTable()
+		if err != nil {
+			return "", err
+		}
+
+		return tableToMarkdown(table), nil
+	case v1.ContentTypeQuote:
+		quote, err := content.Entity.AsQuote()
+		if err != nil {
+			return "", err
+		}
+
+		quoted := []string{}
+		for _, line := range strings.Split(textToMarkdown(v1.Text{Value: quote.Value}), "\n") {
+			quoted = append(quoted, strings.TrimRight("> "+line, " "))
+		}
+		quoted = append(quoted, ">", "> "+mentionsToMarkdown(v1.Mentions{{Id: quote.PostId, Resource: v1.ResourcePost}})[0])
+
+		return strings.Join(quoted, "\n"), nil
+	}
+
+	return "", fmt.Errorf("unsupported type. v=%v", content.Type)
+}
+
+// 入れ子の箇条書きは階層ごとに4文字下げる（飛ばした階層は詰める）
+func listToMarkdown(list v1.List) string {
+	items := []string{}
+	numbers := []int{}
+
+	for _, value := range list.Values {
+		depth := min(max(value.Indent-1, 0), len(numbers))
+		if depth < len(numbers) {
+			numbers = numbers[:depth+1]
+			numbers[depth]++
+		} else {
+			numbers = append(numbers, 1)
+		}
+
+		marker := "-"
+		if list.Type == v1.Integer {
+			marker = strconv.Itoa(numbers[depth]) + "."
+		}
+
+		items = append(items, strings.Repeat("    ", depth)+marker+" "+singleLine(textToMarkdown(value.Value)))
+	}
+
+	return strings.Join(items, "\n")
+}
+
+// フェンスはソースに含まれるどのバッククォートの並びよりも長くする
+func codeToMarkdown(code v1.Code) string {
+	fence := "```"
+	for _, run := range markdownFence.FindAllString(code.Source, -1) {
+		if len(run) >= len(fence) {
+			fence = strings.Repeat("`", len(run)+1)
+		}
+	}
+
+	language := ""
+	if code.Language != nil {
+		language = *code.Language
+	}
+
+	return fence + language + "\n" + strings.TrimSuffix(code.Source, "\n") + "\n" + fence
+}
+
+func tableToMarkdown(table v1.Table) string {
+	row := func(cells []v1.Text) string {
+		values := lo.Map(cells, func(cell v1.Text, _ int) string { return singleLine(textToMarkdown(cell)) })
+		return strings.TrimRight("| "+strings.Join(values, " | ")+" |", " ")
+	}
+
+	rows := []string{row(table.Header), "|" + strings.Repeat(" --- |", len(table.Header))}
+	for _, cells := range table.Rows {
+		rows = append(rows, row(cells))
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func taskToMarkdown(checked bool, value v1.Text) string {
+	mark := " "
+	if checked {
+		mark = "x"
+	}
+
+	return "- [" + mark + "] " + singleLine(textToMarkdown(value))
+}
+
+func mentionsToMarkdown(mentions v1.Mentions) []string {
+	values := []string{}
+	for _, mention := range mentions {
+		values = append(values, fmt.Sprintf("[@%v](%v%v/%v)", mention.Resource, mentionScheme, mention.Resource, mention.Id.String()))
+	}
+
+	return values
+}
+
+// 属性の記法は行ごとに閉じ、前後の空白は記法の外に出す
+func textToMarkdown(t v1.Text) string {
+	lines := strings.Split(t.Value, "\n")
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		leading := line[:strings.Index(line, trimmed)]
+		trailing := line[len(leading)+len(trimmed):]
+
+		value := markdownEscaper.Replace(trimmed)
+		// 行頭の記号が見出しや箇条書きとして解釈されないようにする
+		if markdownBlockMarker.MatchString(value) {
+			value = `\` + value
+		}
+		value = markdownListNumber.ReplaceAllString(value, `$1\$2`)
+
+		if t.Option.Underline {
+			value = "<u>" + value + "</u>"
+		}
+		if t.Option.Oblique {
+			value = "*" + value + "*"
+		}
+		if t.Option.Bold {
+			value = "**" + value + "**"
+		}
+		if t.Option.Through {
+			value = "~~" + value + "~~"
+		}
+		if t.Option.Url != nil {
+			value = "[" + value + "](<" + *t.Option.Url + ">)"
+		}
+
+		lines[i] = leading + value + trailing
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func singleLine(value string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(value, "\n", " ")), " ")
+}
+
+// JSON の本文では OpenAPI の minItems と maxItems で検証している
+func validateContents(contents []v1.Content, maxContents int) error {
+	if len(contents) == 0 {
+		return fmt.Errorf("contents are empty")
+	}
+
+	if len(contents) > maxContents {
+		return fmt.Errorf("too many contents. len=%v max=%v", len(contents), maxContents)
+	}
+
+	return nil
+}
+
+func isMarkdown(ctx echo.Context) bool {
+	return strings.HasPrefix(ctx.Request().Header.Get(echo.HeaderContentType), mimeTextMarkdown)
+}
+
+// ブロックの前後の改行を揃えて結合する
+func joinMarkdown(blocks []string) string {
+	trimmed := []string{}
+	for _, block := range blocks {
+		if block = strings.Trim(block, "\n"); block != "" {
+			trimmed = append(trimmed, block)
+		}
+	}
+
+	if len(trimmed) == 0 {
+		return ""
+	}
+
+	return strings.Join(trimmed, paragraphSeparator) + "\n"
+}
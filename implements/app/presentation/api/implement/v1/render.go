@@ -0,0 +1,185 @@
+package v1
+
+import (
+	v1 "app/gen/api/v1"
+	lrender "app/lib/render"
+	umodel "app/usecase/model"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+)
+
+const (
+	mimeApplicationRSSXMLCharsetUTF8 = "application/rss+xml; charset=UTF-8"
+
+	feedItems       = 20
+	feedTitleLength = 40
+)
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// スレッドの最初のポストを項目にする
+func newRSSItem(threadID uuid.UUID, firstPost umodel.Post) (*rssItem, error) {
+	description, err := renderContents(firstPost.Contents)
+	if err != nil {
+		return nil, err
+	}
+
+	contents := []v1.Content{}
+	for _, content := range firstPost.Contents {
+		pContent, err := NewContent(content.Type, content.Bin)
+		if err != nil {
+			return nil, err
+		}
+
+		contents = append(contents, *pContent)
+	}
+
+	title, err := ToText(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rssItem{
+		Title:       truncate(strings.Join(strings.Fields(*title), " "), feedTitleLength),
+		Description: description,
+		GUID:        rssGUID{Value: threadID.String()},
+		PubDate:     time.Unix(int64(firstPost.At), 0).Format(time.RFC1123Z),
+	}, nil
+}
+
+func truncate(value string, length int) string {
+	if utf8.RuneCountInString(value) <= length {
+		return value
+	}
+
+	return string([]rune(value)[:length]) + "…"
+}
+
+// Accept で text/html が代わりの形式より優先される場合に HTML を返す
+func acceptsHTML(ctx echo.Context, alternative string) bool {
+	htmlQuality, alternativeQuality := 0.0, 0.0
+
+	for _, accept := range strings.Split(ctx.Request().Header.Get(echo.HeaderAccept), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+
+		switch mediaType {
+		case echo.MIMETextHTML:
+			htmlQuality = max(htmlQuality, quality)
+		case alternative, "*/*":
+			alternativeQuality = max(alternativeQuality, quality)
+		}
+	}
+
+	return htmlQuality > alternativeQuality
+}
+
+// HTML では JSON の next_cursor の代わりに Link ヘッダーで続きを示す
+func setNextLink(ctx echo.Context, next *string) {
+	if next == nil {
+		return
+	}
+
+	u := *ctx.Request().URL
+	query := u.Query()
+	query.Set("cursor", *next)
+	query.Del("offset")
+	u.RawQuery = query.Encode()
+
+	ctx.Response().Header().Set("Link", fmt.Sprintf(`<%v>; rel="next"`, u.RequestURI()))
+}
+
+func toRenderContents(contents []umodel.Content) []lrender.Content {
+	return lo.Map(contents, func(content umodel.Content, _ int) lrender.Content {
+		return lrender.Content{Type: content.Type, Bin: content.Bin}
+	})
+}
+
+func renderContents(contents []umodel.Content) (string, error) {
+	return lrender.Contents(toRenderContents(contents))
+}
+
+func renderLines(lines []umodel.Line) (string, error) {
+	return lrender.Lines(lo.Map(lines, func(line umodel.Line, _ int) lrender.Line {
+		property := ""
+		if line.Property != nil {
+			property = line.Property.Type
+		}
+
+		return lrender.Line{Property: property, Contents: toRenderContents(line.Contents)}
+	}))
+}
+
+func renderPost(post umodel.Post) (string, error) {
+	body, err := renderContents(post.Contents)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("<article id=\"post-%v\">%v</article>\n", post.ID.String(), body), nil
+}
+
+func renderTopic(topic umodel.Topic) (string, error) {
+	body, err := renderContents(topic.Contents)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("<article id=\"topic-%v\"><h1>%v</h1>%v</article>\n", topic.ID.String(), html.EscapeString(topic.Name), body), nil
+}
+
+func renderThread(posts []umodel.Post) (string, error) {
+	body := ""
+	for _, post := range posts {
+		article, err := renderPost(post)
+		if err != nil {
+			return "", err
+		}
+
+		body += article
+	}
+
+	return "<section>\n" + body + "</section>\n", nil
+}
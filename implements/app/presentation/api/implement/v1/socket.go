@@ -0,0 +1,86 @@
+package v1
+
+import (
+	lmetrics "app/lib/metrics"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// 接続中の WebSocket を保持し、シャットダウン時にクローズフレームを送る
+type sockets struct {
+	mu    sync.Mutex
+	conns map[*websocket.Conn]struct{}
+	wg    sync.WaitGroup
+}
+
+func (s *sockets) add(ws *websocket.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.conns[ws] = struct{}{}
+	s.wg.Add(1)
+	lmetrics.IncNoteSession()
+}
+
+func (s *sockets) remove(ws *websocket.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.conns[ws]; !ok {
+		return
+	}
+
+	delete(s.conns, ws)
+	s.wg.Done()
+	lmetrics.DecNoteSession()
+}
+
+// Close はクローズフレームを送り、ハンドラが終了するまで待つ
+// 期限までに終了しない場合は接続を切断する
+func (s *sockets) Close(c context.Context) error {
+	s.mu.Lock()
+	deadline, ok := c.Deadline()
+	if !ok {
+		deadline = time.Now().Add(time.Second)
+	}
+
+	message := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server is shutting down")
+	for ws := range s.conns {
+		ws.WriteControl(websocket.CloseMessage, message, deadline)
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-c.Done():
+		s.mu.Lock()
+		for ws := range s.conns {
+			ws.Close()
+		}
+		s.mu.Unlock()
+
+		// 切断後はロックの解放を待つが、処理中のハンドラがあっても長くは待たない
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+		}
+
+		return c.Err()
+	}
+}
+
+func newSockets() *sockets {
+	return &sockets{
+		conns: map[*websocket.Conn]struct{}{},
+	}
+}
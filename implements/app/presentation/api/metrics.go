@@ -0,0 +1,90 @@
+package presentation
+
+import (
+	api "app/gen/api"
+	apiv1 "app/gen/api/v1"
+	lmetrics "app/lib/metrics"
+	"errors"
+	"net/http"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	PathMetrics = "/metrics"
+)
+
+// ルーティングされなかったリクエストはパスごとに系列が増えないようまとめる
+const unknownOperationID = "unknown"
+
+var pathParameter = regexp.MustCompile(`\{([^}]+)\}`)
+
+// echo のルート (メソッドとパス) から OpenAPI の operationId を引けるようにする
+func newOperationIDs() map[string]string {
+	operationIDs := map[string]string{}
+
+	add := func(swagger *openapi3.T, group string) {
+		if swagger == nil {
+			return
+		}
+
+		for path, item := range swagger.Paths.Map() {
+			route := group + pathParameter.ReplaceAllString(path, ":$1")
+			for method, operation := range item.Operations() {
+				operationIDs[method+" "+route] = operation.OperationID
+			}
+		}
+	}
+
+	swagger, _ := api.GetSwagger()
+	add(swagger, os.Getenv("ROUTER_GROUP"))
+
+	swaggerV1, _ := apiv1.GetSwagger()
+	add(swaggerV1, os.Getenv("ROUTER_GROUP_V1"))
+
+	return operationIDs
+}
+
+func metrics() echo.MiddlewareFunc {
+	operationIDs := newOperationIDs()
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if slices.Contains([]string{PathHealthz, PathReadyz, PathMetrics}, c.Path()) {
+				return next(c)
+			}
+
+			start := time.Now()
+			err := next(c)
+
+			operationID, ok := operationIDs[c.Request().Method+" "+c.Path()]
+			if !ok {
+				operationID = unknownOperationID
+			}
+
+			lmetrics.ObserveHTTPRequest(operationID, c.Request().Method, strconv.Itoa(responseStatus(c, err)), time.Since(start))
+
+			return err
+		}
+	}
+}
+
+// エラーがまだレスポンスに書き込まれていない場合は、エラーハンドラが返すステータスを推測する
+func responseStatus(c echo.Context, err error) int {
+	if err == nil || c.Response().Committed {
+		return c.Response().Status
+	}
+
+	var httpError *echo.HTTPError
+	if errors.As(err, &httpError) {
+		return httpError.Code
+	}
+
+	return http.StatusInternalServerError
+}
@@ -31,6 +31,8 @@ func NewMiddlewaresCommon() []echo.MiddlewareFunc {
 		cors(),
 		lsession.SessionStore(),
 		requestID(),
+		tracing(),
+		metrics(),
 		requestLog(),
 		timeout(),
 	}
@@ -139,6 +141,10 @@ func requestLog() echo.MiddlewareFunc {
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
 	return middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		// プローブとメトリクスの収集は定期的に呼ばれるのでログに出力しない
+		Skipper: func(c echo.Context) bool {
+			return slices.Contains([]string{PathHealthz, PathReadyz, PathMetrics}, c.Path())
+		},
 		LogStatus:   true,
 		LogURI:      true,
 		LogError:    true,
@@ -251,6 +257,9 @@ func validator() echo.MiddlewareFunc {
 }
 
 func validatorForV1() echo.MiddlewareFunc {
+	// Markdown の本文は文字列として検証する
+	openapi3filter.RegisterBodyDecoder("text/markdown", openapi3filter.RegisteredBodyDecoder("text/plain"))
+
 	swagger, _ := apiv1.GetSwagger()
 	swagger.Servers = nil // serversの妥当性は検証しない
 
@@ -3,14 +3,18 @@ package presentation
 import (
 	api "app/gen/api"
 	apiv1 "app/gen/api/v1"
+	lmetrics "app/lib/metrics"
 	impl "app/presentation/api/implement"
 	implv1 "app/presentation/api/implement/v1"
+	"context"
+	"errors"
 	"os"
 
 	"github.com/labstack/echo/v4"
 )
 
-func NewRouter() (*echo.Echo, error) {
+// 戻り値の関数はサーバー停止後に呼び出し、WebSocket とハンドラが保持する接続を閉じる
+func NewRouter() (*echo.Echo, func(c context.Context) error, error) {
 	router := echo.New()
 	router.HideBanner = true
 	router.Use(NewMiddlewaresCommon()...)
@@ -25,5 +29,24 @@ func NewRouter() (*echo.Echo, error) {
 	handlerv1 := implv1.NewHandler()
 	apiv1.RegisterHandlers(routerGroupV1, &handlerv1)
 
-	return router, nil
+	healthHandler := newHealthHandler(handlerv1.Checks())
+	router.GET(PathHealthz, healthHandler.Healthz)
+	router.GET(PathReadyz, healthHandler.Readyz)
+	router.GET(PathMetrics, echo.WrapHandler(lmetrics.Handler()))
+
+	adminHandler := newAdminHandler()
+	if adminHandler != nil {
+		router.POST(PathAdminSearchIndexRebuild, adminHandler.RebuildSearchIndex, adminHandler.authorize)
+		router.POST(PathAdminSearchIndexVerify, adminHandler.VerifySearchIndex, adminHandler.authorize)
+	}
+
+	shutdown := func(c context.Context) error {
+		if adminHandler != nil {
+			return errors.Join(handlerv1.Shutdown(c), handler.Shutdown(), adminHandler.Shutdown())
+		}
+
+		return errors.Join(handlerv1.Shutdown(c), handler.Shutdown())
+	}
+
+	return router, shutdown, nil
 }
@@ -2,33 +2,86 @@ package presentation
 
 import (
 	"app/lib/echo/session"
+	ltracing "app/lib/tracing"
+	"app/presentation/scheduler"
 	"app/presentation/subscriber"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
 	"sync"
+	"syscall"
+	"time"
 )
 
+// SIGTERM を受け取ると新規リクエストの受付を止め、処理中のリクエスト・WebSocket・コンシューマ・ジョブを待ってから接続を閉じる
 func Init() error {
 	if err := session.Init(); err != nil {
 		return err
 	}
 
-	router, err := NewRouter()
+	router, shutdownHandlers, err := NewRouter()
 
 	if err != nil {
 		return err
 	}
 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
 	var wg sync.WaitGroup
 	wg.Add(2)
 	go func() {
 		defer wg.Done()
-		subscriber.Start()
+		subscriber.Start(ctx)
 	}()
 	go func() {
 		defer wg.Done()
-		router.Logger.Fatal(router.Start(":" + os.Getenv("SERVER_HOST")))
+		scheduler.Start(ctx)
 	}()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		if err := router.Start(":" + os.Getenv("SERVER_HOST")); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErr <- err
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+	case err := <-serverErr:
+		router.Logger.Fatal(err)
+	}
+	stop()
+
+	timeoutSeconds, err := strconv.Atoi(os.Getenv("TIMEOUT_SECONDS_SHUTDOWN"))
+	if err != nil {
+		timeoutSeconds = 30
+	}
+
+	c, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	fmt.Printf("shutting down. timeout=%vs \n", timeoutSeconds)
+
+	// Hijack された WebSocket は http.Server.Shutdown の対象外なので、ハンドラ側で閉じる
+	if err := router.Shutdown(c); err != nil {
+		fmt.Printf("failed to shutdown server. err=%v \n", err)
+	}
+
+	if err := shutdownHandlers(c); err != nil {
+		fmt.Printf("failed to shutdown handlers. err=%v \n", err)
+	}
+
 	wg.Wait()
 
-	return nil
+	// 終了までに作成されたスパンを送信する
+	if err := ltracing.Shutdown(c); err != nil {
+		fmt.Printf("failed to shutdown tracing. err=%v \n", err)
+	}
+
+	return session.Close()
 }
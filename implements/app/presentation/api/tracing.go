@@ -0,0 +1,62 @@
+package presentation
+
+import (
+	lcontext "app/lib/context"
+	ltracing "app/lib/tracing"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 受信したリクエストの traceparent を引き継ぎ、operationId ごとにスパンを作成する
+func tracing() echo.MiddlewareFunc {
+	operationIDs := newOperationIDs()
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if slices.Contains([]string{PathHealthz, PathReadyz, PathMetrics}, c.Path()) {
+				return next(c)
+			}
+
+			req := c.Request()
+
+			operationID, ok := operationIDs[req.Method+" "+c.Path()]
+			if !ok {
+				operationID = unknownOperationID
+			}
+
+			ctx := ltracing.Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+			ctx, span := ltracing.Start(ctx, operationID, trace.SpanKindServer,
+				semconv.HTTPRequestMethodKey.String(req.Method),
+				semconv.HTTPRoute(c.Path()),
+				semconv.URLPath(req.URL.Path),
+			)
+			defer span.End()
+
+			if requestID, ok := c.Get(lcontext.ContextKeyRequestID.String()).(string); ok {
+				span.SetAttributes(attribute.String(lcontext.ContextKeyRequestID.String(), requestID))
+			}
+
+			c.SetRequest(req.WithContext(ctx))
+
+			err := next(c)
+
+			status := responseStatus(c, err)
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+			if err != nil {
+				span.RecordError(err)
+			}
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+
+			return err
+		}
+	}
+}
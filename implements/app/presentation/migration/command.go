@@ -0,0 +1,146 @@
+package migration
+
+import (
+	imigration "app/infrastructure/migration"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/samber/do"
+	"github.com/samber/lo"
+)
+
+type Target string
+
+func (t Target) String() string {
+	return string(t)
+}
+
+const (
+	TargetRDB          Target = "rdb"
+	TargetDocument     Target = "document"
+	TargetSearchengine Target = "searchengine"
+	TargetTimeseries   Target = "timeseries"
+)
+
+var (
+	Targets = []Target{
+		TargetRDB,
+		TargetDocument,
+		TargetSearchengine,
+		TargetTimeseries,
+	}
+)
+
+const usage = `usage: migrate <up|down|status> [options]
+
+  up      apply pending migrations
+  down    roll back migrations
+  status  show migration status
+
+options:
+`
+
+// Run は migrate サブコマンドを実行する
+// 指定したターゲットの接続のみを確立する
+func Run(args []string) error {
+	command := ""
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	flags := flag.NewFlagSet("migrate "+command, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flags.PrintDefaults()
+	}
+
+	defaultTarget := strings.Join(lo.Map(Targets, func(target Target, _ int) string { return target.String() }), ",")
+	if command == "down" {
+		// 誤ってインデックスなどを削除しないように、down の既定は rdb のみとする
+		defaultTarget = TargetRDB.String()
+	}
+
+	targetsFlag := flags.String("target", defaultTarget, "comma separated targets. (rdb, document, searchengine, timeseries)")
+	steps := flags.Int("steps", 1, "number of migrations to roll back per store. (down only)")
+	if !lo.Contains([]string{"up", "down", "status"}, command) {
+		flags.Usage()
+		return fmt.Errorf("unknown subcommand. subcommand=%v", command)
+	}
+
+	if err := flags.Parse(args[1:]); err == flag.ErrHelp {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if *steps < 1 {
+		return fmt.Errorf("invalid argument. steps=%v", *steps)
+	}
+
+	targets, err := parseTargets(*targetsFlag)
+	if err != nil {
+		return err
+	}
+
+	c := context.Background()
+	i := newInjector()
+
+	for _, target := range targets {
+		migrator, err := do.InvokeNamed[imigration.Migrator](i, target.String())
+		if err != nil {
+			return err
+		}
+
+		switch command {
+		case "up":
+			if err := migrator.Up(c); err != nil {
+				return err
+			}
+		case "down":
+			if err := migrator.Down(c, *steps); err != nil {
+				return err
+			}
+		case "status":
+			statuses, err := migrator.Status(c)
+			if err != nil {
+				return err
+			}
+
+			printStatuses(statuses)
+		}
+	}
+
+	return nil
+}
+
+func parseTargets(value string) ([]Target, error) {
+	targets := []Target{}
+	for _, name := range strings.Split(value, ",") {
+		target, ok := lo.Find(Targets, func(target Target) bool { return target.String() == strings.TrimSpace(name) })
+		if !ok {
+			return nil, fmt.Errorf("invalid argument. target=%v", name)
+		}
+
+		targets = append(targets, target)
+	}
+
+	return lo.Uniq(targets), nil
+}
+
+func printStatuses(statuses []imigration.Status) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	for _, status := range statuses {
+		state := "pending"
+		if status.Applied {
+			state = "applied"
+		}
+
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\n", status.Target, status.Name, state, status.Detail)
+	}
+}
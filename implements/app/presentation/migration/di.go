@@ -0,0 +1,24 @@
+package migration
+
+import (
+	"app/infrastructure/adapter/datastore/document"
+	"app/infrastructure/adapter/datastore/searchengine"
+	imigration "app/infrastructure/migration"
+
+	"github.com/samber/do"
+)
+
+func newInjector() *do.Injector {
+	i := do.New()
+
+	do.Provide(i, document.NewNoteStoreConnection)
+	do.Provide(i, document.NewRoleStoreConnection)
+	do.Provide(i, searchengine.NewResourceSearchIndexStoreConnection)
+
+	do.ProvideNamed(i, TargetRDB.String(), imigration.NewRDBMigrator)
+	do.ProvideNamed(i, TargetDocument.String(), imigration.NewDocumentMigrator)
+	do.ProvideNamed(i, TargetSearchengine.String(), imigration.NewSearchengineMigrator)
+	do.ProvideNamed(i, TargetTimeseries.String(), imigration.NewTimeseriesMigrator)
+
+	return i
+}
@@ -0,0 +1,91 @@
+package reindex
+
+import (
+	umodel "app/usecase/model"
+	uservice "app/usecase/service"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/samber/do"
+	"github.com/samber/lo"
+)
+
+const (
+	Types = "user,community,role,topic,post,page"
+)
+
+const usage = `usage: reindex <rebuild|verify> [options]
+
+  rebuild  rebuild the resource search index from rdb and swap the alias
+  verify   compare ids between rdb and the resource search index
+
+options:
+`
+
+// Run は reindex サブコマンドを実行する
+func Run(args []string) error {
+	command := ""
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	flags := flag.NewFlagSet("reindex "+command, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flags.PrintDefaults()
+	}
+
+	typesFlag := flags.String("type", Types, "comma separated resource types. (verify only)")
+	repair := flags.Bool("repair", false, "create missing and delete extra documents. (verify only)")
+	if !lo.Contains([]string{"rebuild", "verify"}, command) {
+		flags.Usage()
+		return fmt.Errorf("unknown subcommand. subcommand=%v", command)
+	}
+
+	if err := flags.Parse(args[1:]); err == flag.ErrHelp {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	c := context.Background()
+	i := NewInjector()
+	defer i.Shutdown()
+
+	usecase, err := do.Invoke[uservice.ResourceSearchIndexMaintenanceUsecase](i)
+	if err != nil {
+		return err
+	}
+
+	var reports []umodel.ResourceSearchIndexReport
+	switch command {
+	case "rebuild":
+		reports, err = usecase.Rebuild(c)
+	case "verify":
+		reports, err = usecase.Verify(c, ParseTypes(*typesFlag), *repair)
+	}
+	if err != nil {
+		return err
+	}
+
+	printReports(reports)
+	return nil
+}
+
+func ParseTypes(value string) []string {
+	return lo.Uniq(lo.Map(strings.Split(value, ","), func(name string, _ int) string { return strings.TrimSpace(name) }))
+}
+
+func printReports(reports []umodel.ResourceSearchIndexReport) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "type\tsources\tindexed\tmissing\textra\n")
+	for _, report := range reports {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", report.Type, report.Sources, report.Indexed, len(report.Missing), len(report.Extra))
+	}
+}
@@ -0,0 +1,37 @@
+package reindex
+
+import (
+	dservice "app/domain/service"
+	"app/infrastructure/adapter/datastore/rdb"
+	"app/infrastructure/adapter/datastore/searchengine"
+	"app/infrastructure/repository"
+	uservice "app/usecase/service"
+
+	"github.com/samber/do"
+)
+
+// NewInjector は索引の再構築と検証に必要な接続のみを確立する
+// 補修した索引はメッセージを経由せず直接書き込む
+func NewInjector() *do.Injector {
+	i := do.New()
+
+	do.Provide(i, rdb.NewUserStoreConnection)
+	do.Provide(i, rdb.NewCommunityStoreConnection)
+	do.Provide(i, rdb.NewRoleStoreConnection)
+	do.Provide(i, rdb.NewTopicStoreConnection)
+	do.Provide(i, rdb.NewPostStoreConnection)
+	do.Provide(i, rdb.NewNoteStoreConnection)
+	do.Provide(i, rdb.NewContentStoreConnection)
+	do.Provide(i, searchengine.NewResourceSearchIndexStoreConnection)
+
+	do.Provide(i, repository.NewResourceSearchIndexRepositoryForAsync)
+	do.Provide(i, repository.NewResourceSearchIndexSourceRepository)
+	do.Provide(i, repository.NewResourceSearchIndexRebuildRepository)
+
+	do.Provide(i, dservice.NewResourceSearchIndexService)
+	do.Provide(i, dservice.NewResourceSearchIndexRebuildService)
+
+	do.Provide(i, uservice.NewResourceSearchIndexMaintenanceUsecase)
+
+	return i
+}
@@ -0,0 +1,16 @@
+package implement
+
+import (
+	"app/usecase/service"
+	"context"
+)
+
+type communityDeletionJob struct {
+	injector
+	usecase service.CommunityDeletionUsecase
+}
+
+// Run implements interfaces.Job.
+func (co communityDeletionJob) Run(c context.Context) error {
+	return co.usecase.Run(c)
+}
@@ -0,0 +1,118 @@
+package implement
+
+import (
+	dservice "app/domain/service"
+	"app/infrastructure/adapter/datastore/document"
+	"app/infrastructure/adapter/datastore/kvs"
+	"app/infrastructure/adapter/datastore/rdb"
+	"app/infrastructure/adapter/datastore/searchengine"
+	"app/infrastructure/adapter/datastore/timeseries"
+	"app/infrastructure/adapter/mq"
+	"app/infrastructure/repository"
+	"app/presentation/scheduler/interfaces"
+	uservice "app/usecase/service"
+
+	"github.com/samber/do"
+)
+
+// injector は保持している接続を Shutdown で閉じる
+type injector struct {
+	i *do.Injector
+}
+
+// Shutdown implements interfaces.Job.
+func (in injector) Shutdown() error {
+	return in.i.Shutdown()
+}
+
+func NewInviteSweepJob() (interfaces.Job, error) {
+	i := do.New()
+
+	do.Provide(i, rdb.NewInviteStoreConnection)
+	do.Provide(i, repository.NewInviteRepository)
+	do.Provide(i, repository.NewInviteLinkRepository)
+	do.Provide(i, dservice.NewInviteService)
+	do.Provide(i, dservice.NewInviteLinkService)
+	do.Provide(i, uservice.NewInviteUsecase)
+	usecase, err := do.Invoke[uservice.InviteUsecase](i)
+	if err != nil {
+		// 初期化できた接続は閉じる
+		_ = i.Shutdown()
+		return nil, err
+	}
+
+	return inviteSweepJob{
+		injector: injector{i},
+		usecase:  usecase,
+	}, nil
+}
+
+func NewCommunityDeletionJob() (interfaces.Job, error) {
+	i := do.New()
+
+	do.Provide(i, document.NewNoteStoreConnection)
+	do.Provide(i, document.NewRoleStoreConnection)
+	do.Provide(i, rdb.NewNoteStoreConnection)
+	do.Provide(i, rdb.NewContentStoreConnection)
+	do.Provide(i, rdb.NewRoleStoreConnection)
+	do.Provide(i, rdb.NewMemberStoreConnection)
+	do.Provide(i, rdb.NewCommunityStoreConnection)
+	do.Provide(i, rdb.NewInviteStoreConnection)
+	do.Provide(i, rdb.NewTopicStoreConnection)
+	do.Provide(i, rdb.NewThreadStoreConnection)
+	do.Provide(i, rdb.NewPostStoreConnection)
+	do.Provide(i, rdb.NewMessageStoreConnection)
+	do.Provide(i, mq.NewActivityStoreConnection)
+	do.Provide(i, mq.NewResourceSearchIndexStoreConnection)
+	do.Provide(i, searchengine.NewResourceSearchIndexStoreConnection)
+	do.Provide(i, timeseries.NewActivityStore)
+	do.Provide(i, kvs.NewCacheStoreConnection)
+
+	do.Provide(i, repository.NewNoteRepository)
+	do.Provide(i, repository.NewContentRepository)
+	do.Provide(i, repository.NewRoleRepository)
+	do.Provide(i, repository.NewMemberRepository)
+	do.Provide(i, repository.NewBanRepository)
+	do.Provide(i, repository.NewCommunityRepository)
+	do.Provide(i, repository.NewCommunityDeletionRepository)
+	do.Provide(i, repository.NewActivityRepository)
+	do.Provide(i, repository.NewResourceSearchIndexRepository)
+	do.Provide(i, repository.NewInviteRepository)
+	do.Provide(i, repository.NewInviteLinkRepository)
+	do.Provide(i, repository.NewTopicRepository)
+	do.Provide(i, repository.NewPageRepository)
+	do.Provide(i, repository.NewTemplateRepository)
+	do.Provide(i, repository.NewThreadRepository)
+	do.Provide(i, repository.NewPostRepository)
+	do.Provide(i, repository.NewReadMarkerRepository)
+
+	do.Provide(i, dservice.NewNoteService)
+	do.Provide(i, dservice.NewContentService)
+	do.Provide(i, dservice.NewRoleService)
+	do.Provide(i, dservice.NewMemberService)
+	do.Provide(i, dservice.NewBanService)
+	do.Provide(i, dservice.NewCommunityService)
+	do.Provide(i, dservice.NewCommunityDeletionService)
+	do.Provide(i, dservice.NewActivityService)
+	do.Provide(i, dservice.NewResourceSearchIndexService)
+	do.Provide(i, dservice.NewInviteService)
+	do.Provide(i, dservice.NewInviteLinkService)
+	do.Provide(i, dservice.NewTopicService)
+	do.Provide(i, dservice.NewPageService)
+	do.Provide(i, dservice.NewTemplateService)
+	do.Provide(i, dservice.NewThreadService)
+	do.Provide(i, dservice.NewPostService)
+	do.Provide(i, dservice.NewReadMarkerService)
+	do.Provide(i, uservice.NewCommunityDeletionUsecase)
+	usecase, err := do.Invoke[uservice.CommunityDeletionUsecase](i)
+	if err != nil {
+		// 初期化できた接続は閉じる
+		_ = i.Shutdown()
+		return nil, err
+	}
+
+	return communityDeletionJob{
+		injector: injector{i},
+		usecase:  usecase,
+	}, nil
+}
@@ -0,0 +1,16 @@
+package implement
+
+import (
+	"app/usecase/service"
+	"context"
+)
+
+type inviteSweepJob struct {
+	injector
+	usecase service.InviteUsecase
+}
+
+// Run implements interfaces.Job.
+func (i inviteSweepJob) Run(c context.Context) error {
+	return i.usecase.DeleteExpired(c)
+}
@@ -0,0 +1,8 @@
+package interfaces
+
+import "context"
+
+type Job interface {
+	Run(c context.Context) error
+	Shutdown() error
+}
@@ -0,0 +1,100 @@
+package scheduler
+
+import (
+	lcontext "app/lib/context"
+	llog "app/lib/log"
+	ltracing "app/lib/tracing"
+	"app/presentation/scheduler/implement"
+	"app/presentation/scheduler/interfaces"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type JobName string
+
+func (j JobName) String() string {
+	return string(j)
+}
+
+// ジョブはインポート時に接続を確立しないよう、Start で初期化する
+type schedule struct {
+	interval time.Duration
+	newJob   func() (interfaces.Job, error)
+}
+
+var (
+	schedules = map[JobName]schedule{
+		"invite_sweep": {
+			interval: time.Hour,
+			newJob:   implement.NewInviteSweepJob,
+		},
+		"community_deletion": {
+			interval: time.Minute,
+			newJob:   implement.NewCommunityDeletionJob,
+		},
+	}
+)
+
+// ctx がキャンセルされると実行中のジョブの完了を待ってから終了する
+// 初期化に失敗したジョブは実行せず、他のジョブは続ける
+func Start(ctx context.Context) {
+	var wg sync.WaitGroup
+	jobs := []interfaces.Job{}
+
+	for name, s := range schedules {
+		fmt.Printf("start job. name=%v interval=%v \n", name, s.interval)
+		job, err := s.newJob()
+		if err != nil {
+			fmt.Printf("failed to start job. name=%v err=%v \n", name, err)
+			continue
+		}
+		jobs = append(jobs, job)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ticker := time.NewTicker(s.interval)
+			defer ticker.Stop()
+
+			for {
+				c := context.WithValue(context.Background(), lcontext.ContextKeyRequestID, lcontext.CreateRequestID())
+				c, span := ltracing.Start(c, "job "+name.String(), trace.SpanKindInternal)
+				err := func() (err error) {
+					defer func() {
+						if r := recover(); r != nil {
+							err = fmt.Errorf("recovered. from=%v", r)
+						}
+					}()
+
+					llog.Info(c, "run. job=%v", name)
+					return job.Run(c)
+				}()
+				ltracing.End(span, err)
+
+				if err != nil {
+					llog.Error(c, "failed to run. job=%v err=%v", name, err)
+				}
+
+				select {
+				case <-ticker.C:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, job := range jobs {
+		if err := job.Shutdown(); err != nil {
+			fmt.Printf("failed to shutdown job. err=%v \n", err)
+		}
+	}
+
+	fmt.Println("scheduler stopped.")
+}
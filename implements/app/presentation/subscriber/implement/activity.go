@@ -8,6 +8,7 @@ import (
 )
 
 type userLoginActivityHandler struct {
+	injector
 	usecase service.ActivityUsecase
 }
 
@@ -22,6 +23,7 @@ func (u userLoginActivityHandler) Handle(c context.Context, message []byte) erro
 }
 
 type memberActivityHandler struct {
+	injector
 	usecase service.ActivityUsecase
 }
 
@@ -36,6 +38,7 @@ func (me memberActivityHandler) Handle(c context.Context, message []byte) error
 }
 
 type memberLikeActivityHandler struct {
+	injector
 	usecase service.ActivityUsecase
 }
 
@@ -3,9 +3,11 @@ package implement
 import (
 	dservice "app/domain/service"
 	"app/infrastructure/adapter/datastore/document"
+	"app/infrastructure/adapter/datastore/kvs"
 	"app/infrastructure/adapter/datastore/rdb"
 	"app/infrastructure/adapter/datastore/searchengine"
 	"app/infrastructure/adapter/datastore/timeseries"
+	"app/infrastructure/adapter/mail"
 	"app/infrastructure/adapter/mq"
 	"app/infrastructure/repository"
 	"app/presentation/subscriber/interfaces"
@@ -14,6 +16,16 @@ import (
 	"github.com/samber/do"
 )
 
+// injector は保持している接続を Shutdown で閉じる
+type injector struct {
+	i *do.Injector
+}
+
+// Shutdown implements interfaces.Handler.
+func (in injector) Shutdown() error {
+	return in.i.Shutdown()
+}
+
 func NewResourceSearchIndexCreateHandler() interfaces.Handler {
 	i := do.New()
 
@@ -24,7 +36,8 @@ func NewResourceSearchIndexCreateHandler() interfaces.Handler {
 	usecase := do.MustInvoke[uservice.ResourceSearchIndexUsecase](i)
 
 	return resourceSearchIndexCreateHandler{
-		usecase: usecase,
+		injector: injector{i},
+		usecase:  usecase,
 	}
 }
 
@@ -38,7 +51,8 @@ func NewResourceSearchIndexUpdateHandler() interfaces.Handler {
 	usecase := do.MustInvoke[uservice.ResourceSearchIndexUsecase](i)
 
 	return resourceSearchIndexUpdateHandler{
-		usecase: usecase,
+		injector: injector{i},
+		usecase:  usecase,
 	}
 }
 
@@ -52,7 +66,8 @@ func NewResourceSearchIndexDeleteHandler() interfaces.Handler {
 	usecase := do.MustInvoke[uservice.ResourceSearchIndexUsecase](i)
 
 	return resourceSearchIndexDeleteHandler{
-		usecase: usecase,
+		injector: injector{i},
+		usecase:  usecase,
 	}
 }
 
@@ -71,36 +86,59 @@ func NewUserLoginActivityHandler() interfaces.Handler {
 	do.Provide(i, rdb.NewTopicStoreConnection)
 	do.Provide(i, rdb.NewThreadStoreConnection)
 	do.Provide(i, rdb.NewPostStoreConnection)
+	do.Provide(i, rdb.NewMessageStoreConnection)
+	do.Provide(i, mail.NewMailConnection)
 	do.Provide(i, mq.NewActivityStoreConnection)
 	do.Provide(i, mq.NewResourceSearchIndexStoreConnection)
 	do.Provide(i, searchengine.NewResourceSearchIndexStoreConnection)
 	do.Provide(i, timeseries.NewActivityStore)
+	do.Provide(i, kvs.NewCacheStoreConnection)
 
 	do.Provide(i, repository.NewNoteRepository)
 	do.Provide(i, repository.NewContentRepository)
 	do.Provide(i, repository.NewUserRepository)
 	do.Provide(i, repository.NewRoleRepository)
 	do.Provide(i, repository.NewMemberRepository)
+	do.Provide(i, repository.NewBanRepository)
 	do.Provide(i, repository.NewCommunityRepository)
+	do.Provide(i, repository.NewCommunityDeletionRepository)
 	do.Provide(i, repository.NewActivityRepositoryForAsync)
 	do.Provide(i, repository.NewResourceSearchIndexRepository)
 	do.Provide(i, repository.NewInviteRepository)
+	do.Provide(i, repository.NewInviteLinkRepository)
+	do.Provide(i, repository.NewMailRepository)
 	do.Provide(i, repository.NewTopicRepository)
+	do.Provide(i, repository.NewPageRepository)
+	do.Provide(i, repository.NewTemplateRepository)
 	do.Provide(i, repository.NewThreadRepository)
 	do.Provide(i, repository.NewPostRepository)
+	do.Provide(i, repository.NewReadMarkerRepository)
+	do.Provide(i, repository.NewConversationRepository)
+	do.Provide(i, repository.NewMessageRepository)
+	do.Provide(i, repository.NewUserRelationRepository)
 
 	do.Provide(i, dservice.NewNoteService)
 	do.Provide(i, dservice.NewContentService)
 	do.Provide(i, dservice.NewUserService)
 	do.Provide(i, dservice.NewRoleService)
 	do.Provide(i, dservice.NewMemberService)
+	do.Provide(i, dservice.NewBanService)
 	do.Provide(i, dservice.NewCommunityService)
+	do.Provide(i, dservice.NewCommunityDeletionService)
 	do.Provide(i, dservice.NewActivityService)
 	do.Provide(i, dservice.NewResourceSearchIndexService)
 	do.Provide(i, dservice.NewInviteService)
+	do.Provide(i, dservice.NewInviteLinkService)
+	do.Provide(i, dservice.NewMailService)
 	do.Provide(i, dservice.NewTopicService)
+	do.Provide(i, dservice.NewPageService)
+	do.Provide(i, dservice.NewTemplateService)
 	do.Provide(i, dservice.NewThreadService)
 	do.Provide(i, dservice.NewPostService)
+	do.Provide(i, dservice.NewReadMarkerService)
+	do.Provide(i, dservice.NewConversationService)
+	do.Provide(i, dservice.NewMessageService)
+	do.Provide(i, dservice.NewUserRelationService)
 
 	do.Provide(i, uservice.NewNoteUsecase)
 	do.Provide(i, uservice.NewUserUsecase)
@@ -111,7 +149,8 @@ func NewUserLoginActivityHandler() interfaces.Handler {
 	usecase := do.MustInvoke[uservice.ActivityUsecase](i)
 
 	return userLoginActivityHandler{
-		usecase: usecase,
+		injector: injector{i},
+		usecase:  usecase,
 	}
 }
 
@@ -130,36 +169,59 @@ func NewMemberActivityHandler() interfaces.Handler {
 	do.Provide(i, rdb.NewTopicStoreConnection)
 	do.Provide(i, rdb.NewThreadStoreConnection)
 	do.Provide(i, rdb.NewPostStoreConnection)
+	do.Provide(i, rdb.NewMessageStoreConnection)
+	do.Provide(i, mail.NewMailConnection)
 	do.Provide(i, mq.NewActivityStoreConnection)
 	do.Provide(i, mq.NewResourceSearchIndexStoreConnection)
 	do.Provide(i, searchengine.NewResourceSearchIndexStoreConnection)
 	do.Provide(i, timeseries.NewActivityStore)
+	do.Provide(i, kvs.NewCacheStoreConnection)
 
 	do.Provide(i, repository.NewNoteRepository)
 	do.Provide(i, repository.NewContentRepository)
 	do.Provide(i, repository.NewUserRepository)
 	do.Provide(i, repository.NewRoleRepository)
 	do.Provide(i, repository.NewMemberRepository)
+	do.Provide(i, repository.NewBanRepository)
 	do.Provide(i, repository.NewCommunityRepository)
+	do.Provide(i, repository.NewCommunityDeletionRepository)
 	do.Provide(i, repository.NewActivityRepositoryForAsync)
 	do.Provide(i, repository.NewResourceSearchIndexRepository)
 	do.Provide(i, repository.NewInviteRepository)
+	do.Provide(i, repository.NewInviteLinkRepository)
+	do.Provide(i, repository.NewMailRepository)
 	do.Provide(i, repository.NewTopicRepository)
+	do.Provide(i, repository.NewPageRepository)
+	do.Provide(i, repository.NewTemplateRepository)
 	do.Provide(i, repository.NewThreadRepository)
 	do.Provide(i, repository.NewPostRepository)
+	do.Provide(i, repository.NewReadMarkerRepository)
+	do.Provide(i, repository.NewConversationRepository)
+	do.Provide(i, repository.NewMessageRepository)
+	do.Provide(i, repository.NewUserRelationRepository)
 
 	do.Provide(i, dservice.NewNoteService)
 	do.Provide(i, dservice.NewContentService)
 	do.Provide(i, dservice.NewUserService)
 	do.Provide(i, dservice.NewRoleService)
 	do.Provide(i, dservice.NewMemberService)
+	do.Provide(i, dservice.NewBanService)
 	do.Provide(i, dservice.NewCommunityService)
+	do.Provide(i, dservice.NewCommunityDeletionService)
 	do.Provide(i, dservice.NewActivityService)
 	do.Provide(i, dservice.NewResourceSearchIndexService)
 	do.Provide(i, dservice.NewInviteService)
+	do.Provide(i, dservice.NewInviteLinkService)
+	do.Provide(i, dservice.NewMailService)
 	do.Provide(i, dservice.NewTopicService)
+	do.Provide(i, dservice.NewPageService)
+	do.Provide(i, dservice.NewTemplateService)
 	do.Provide(i, dservice.NewThreadService)
 	do.Provide(i, dservice.NewPostService)
+	do.Provide(i, dservice.NewReadMarkerService)
+	do.Provide(i, dservice.NewConversationService)
+	do.Provide(i, dservice.NewMessageService)
+	do.Provide(i, dservice.NewUserRelationService)
 
 	do.Provide(i, uservice.NewNoteUsecase)
 	do.Provide(i, uservice.NewUserUsecase)
@@ -170,7 +232,8 @@ func NewMemberActivityHandler() interfaces.Handler {
 	usecase := do.MustInvoke[uservice.ActivityUsecase](i)
 
 	return memberActivityHandler{
-		usecase: usecase,
+		injector: injector{i},
+		usecase:  usecase,
 	}
 }
 
@@ -189,36 +252,59 @@ func NewMemberLikeActivityHandler() interfaces.Handler {
 	do.Provide(i, rdb.NewTopicStoreConnection)
 	do.Provide(i, rdb.NewThreadStoreConnection)
 	do.Provide(i, rdb.NewPostStoreConnection)
+	do.Provide(i, rdb.NewMessageStoreConnection)
+	do.Provide(i, mail.NewMailConnection)
 	do.Provide(i, mq.NewActivityStoreConnection)
 	do.Provide(i, mq.NewResourceSearchIndexStoreConnection)
 	do.Provide(i, searchengine.NewResourceSearchIndexStoreConnection)
 	do.Provide(i, timeseries.NewActivityStore)
+	do.Provide(i, kvs.NewCacheStoreConnection)
 
 	do.Provide(i, repository.NewNoteRepository)
 	do.Provide(i, repository.NewContentRepository)
 	do.Provide(i, repository.NewUserRepository)
 	do.Provide(i, repository.NewRoleRepository)
 	do.Provide(i, repository.NewMemberRepository)
+	do.Provide(i, repository.NewBanRepository)
 	do.Provide(i, repository.NewCommunityRepository)
+	do.Provide(i, repository.NewCommunityDeletionRepository)
 	do.Provide(i, repository.NewActivityRepositoryForAsync)
 	do.Provide(i, repository.NewResourceSearchIndexRepository)
 	do.Provide(i, repository.NewInviteRepository)
+	do.Provide(i, repository.NewInviteLinkRepository)
+	do.Provide(i, repository.NewMailRepository)
 	do.Provide(i, repository.NewTopicRepository)
+	do.Provide(i, repository.NewPageRepository)
+	do.Provide(i, repository.NewTemplateRepository)
 	do.Provide(i, repository.NewThreadRepository)
 	do.Provide(i, repository.NewPostRepository)
+	do.Provide(i, repository.NewReadMarkerRepository)
+	do.Provide(i, repository.NewConversationRepository)
+	do.Provide(i, repository.NewMessageRepository)
+	do.Provide(i, repository.NewUserRelationRepository)
 
 	do.Provide(i, dservice.NewNoteService)
 	do.Provide(i, dservice.NewContentService)
 	do.Provide(i, dservice.NewUserService)
 	do.Provide(i, dservice.NewRoleService)
 	do.Provide(i, dservice.NewMemberService)
+	do.Provide(i, dservice.NewBanService)
 	do.Provide(i, dservice.NewCommunityService)
+	do.Provide(i, dservice.NewCommunityDeletionService)
 	do.Provide(i, dservice.NewActivityService)
 	do.Provide(i, dservice.NewResourceSearchIndexService)
 	do.Provide(i, dservice.NewInviteService)
+	do.Provide(i, dservice.NewInviteLinkService)
+	do.Provide(i, dservice.NewMailService)
 	do.Provide(i, dservice.NewTopicService)
+	do.Provide(i, dservice.NewPageService)
+	do.Provide(i, dservice.NewTemplateService)
 	do.Provide(i, dservice.NewThreadService)
 	do.Provide(i, dservice.NewPostService)
+	do.Provide(i, dservice.NewReadMarkerService)
+	do.Provide(i, dservice.NewConversationService)
+	do.Provide(i, dservice.NewMessageService)
+	do.Provide(i, dservice.NewUserRelationService)
 
 	do.Provide(i, uservice.NewNoteUsecase)
 	do.Provide(i, uservice.NewUserUsecase)
@@ -229,6 +315,7 @@ func NewMemberLikeActivityHandler() interfaces.Handler {
 	usecase := do.MustInvoke[uservice.ActivityUsecase](i)
 
 	return memberLikeActivityHandler{
-		usecase: usecase,
+		injector: injector{i},
+		usecase:  usecase,
 	}
 }
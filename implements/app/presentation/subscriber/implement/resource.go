@@ -2,14 +2,17 @@ package implement
 
 import (
 	"app/gen/pubsub"
+	umodel "app/usecase/model"
 	"app/usecase/service"
 	"context"
 	"encoding/json"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 type resourceSearchIndexCreateHandler struct {
+	injector
 	usecase service.ResourceSearchIndexUsecase
 }
 
@@ -20,15 +23,16 @@ func (r resourceSearchIndexCreateHandler) Handle(c context.Context, message []by
 		return err
 	}
 
-	resourceID, err := uuid.Parse(m.ResourceId.Value)
+	index, err := toResourceSearchIndex(&m)
 	if err != nil {
 		return err
 	}
 
-	return r.usecase.Create(c, resourceID, m.ResourceType.Value, m.Keyword)
+	return r.usecase.Create(c, *index)
 }
 
 type resourceSearchIndexUpdateHandler struct {
+	injector
 	usecase service.ResourceSearchIndexUsecase
 }
 
@@ -39,15 +43,16 @@ func (r resourceSearchIndexUpdateHandler) Handle(c context.Context, message []by
 		return err
 	}
 
-	resourceID, err := uuid.Parse(m.ResourceId.Value)
+	index, err := toResourceSearchIndex(&m)
 	if err != nil {
 		return err
 	}
 
-	return r.usecase.Update(c, resourceID, m.ResourceType.Value, m.Keyword)
+	return r.usecase.Update(c, *index)
 }
 
 type resourceSearchIndexDeleteHandler struct {
+	injector
 	usecase service.ResourceSearchIndexUsecase
 }
 
@@ -65,3 +70,60 @@ func (r resourceSearchIndexDeleteHandler) Handle(c context.Context, message []by
 
 	return r.usecase.Delete(c, resourceID)
 }
+
+// 本文は API と同じ内容ごとの取り出し方で検索用のテキストにする
+func toResourceSearchIndex(m *pubsub.ResourceSearchIndex) (*umodel.ResourceSearchIndex, error) {
+	resourceID, err := uuid.Parse(m.ResourceId.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	index := umodel.ResourceSearchIndex{
+		ResourceID: resourceID,
+		Type:       m.ResourceType.Value,
+		Keyword:    m.Keyword,
+	}
+
+	if m.CommunityId == nil {
+		return &index, nil
+	}
+
+	if index.CommunityID, err = parseID(m.CommunityId); err != nil {
+		return nil, err
+	}
+
+	if index.TopicID, err = parseID(m.TopicId); err != nil {
+		return nil, err
+	}
+
+	if index.ThreadID, err = parseID(m.ThreadId); err != nil {
+		return nil, err
+	}
+
+	if index.AuthorID, err = parseID(m.AuthorId); err != nil {
+		return nil, err
+	}
+
+	body, err := service.ExtractText(lo.Map(m.Contents, func(content *pubsub.Content, _ int) umodel.Content {
+		return umodel.Content{Type: content.Type, Bin: content.Bin}
+	}))
+	if err != nil {
+		return nil, err
+	}
+	index.Body = body
+
+	return &index, nil
+}
+
+func parseID(id *pubsub.UUID) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(id.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
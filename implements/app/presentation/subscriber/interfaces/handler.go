@@ -4,4 +4,5 @@ import "context"
 
 type Handler interface {
 	Handle(c context.Context, message []byte) error
+	Shutdown() error
 }
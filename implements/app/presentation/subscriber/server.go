@@ -3,14 +3,21 @@ package subscriber
 import (
 	lcontext "app/lib/context"
 	llog "app/lib/log"
+	lmetrics "app/lib/metrics"
+	ltracing "app/lib/tracing"
 	"app/presentation/subscriber/implement"
 	"app/presentation/subscriber/interfaces"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ExchangeName string
@@ -50,22 +57,31 @@ type ConnectionConfig struct {
 	} `json:"echanges"`
 }
 
+// ハンドラはインポート時に接続を確立しないよう、Start で初期化する
 var (
-	handlers = map[ExchangeName]map[QueueName]interfaces.Handler{
+	handlers = map[ExchangeName]map[QueueName]func() interfaces.Handler{
 		"resource_search_index": {
-			"create": implement.NewResourceSearchIndexCreateHandler(),
-			"update": implement.NewResourceSearchIndexUpdateHandler(),
-			"delete": implement.NewResourceSearchIndexDeleteHandler(),
+			"create": implement.NewResourceSearchIndexCreateHandler,
+			"update": implement.NewResourceSearchIndexUpdateHandler,
+			"delete": implement.NewResourceSearchIndexDeleteHandler,
 		},
 		"activity": {
-			"user":        implement.NewUserLoginActivityHandler(),
-			"member":      implement.NewMemberActivityHandler(),
-			"member_like": implement.NewMemberLikeActivityHandler(),
+			"user":        implement.NewUserLoginActivityHandler,
+			"member":      implement.NewMemberActivityHandler,
+			"member_like": implement.NewMemberLikeActivityHandler,
 		},
 	}
 )
 
-func Start() {
+// すべてのコンシューマを登録し終え、接続が維持されている間 true になる
+var ready atomic.Bool
+
+func Ready() bool {
+	return ready.Load()
+}
+
+// ctx がキャンセルされるとコンシューマを止め、処理中のメッセージを待ってから終了する
+func Start(ctx context.Context) {
 	connectionConfig := ConnectionConfig{}
 	if err := json.Unmarshal([]byte(os.Getenv("SUBSCRIBE_CONFIG")), &connectionConfig); err != nil {
 		panic(err)
@@ -92,7 +108,11 @@ func Start() {
 
 	defer channel.Close()
 
-	forever := make(chan bool)
+	var (
+		wg         sync.WaitGroup
+		tags       []string
+		subscribed []interfaces.Handler
+	)
 
 	for _, exchangeConfig := range connectionConfig.Exchanges {
 		if err := channel.ExchangeDeclare(
@@ -108,10 +128,12 @@ func Start() {
 		}
 
 		for _, queueConfig := range exchangeConfig.Queues {
-			handler, ok := handlers[exchangeConfig.Name][queueConfig.Name]
+			newHandler, ok := handlers[exchangeConfig.Name][queueConfig.Name]
 			if !ok {
 				panic(fmt.Errorf("consumer does not exist. queue=%v", queueConfig.Name))
 			}
+			handler := newHandler()
+			subscribed = append(subscribed, handler)
 
 			if _, err := channel.QueueDeclare(
 				queueConfig.Name.String(),
@@ -134,25 +156,49 @@ func Start() {
 				panic(err)
 			}
 
-			messages, err := channel.Consume(queueConfig.Name.String(), "", true, false, false, false, nil)
+			// 処理を終えてから Ack し、停止中に受け取ったメッセージはキューに戻す
+			tag := queueConfig.Name.String()
+			messages, err := channel.Consume(queueConfig.Name.String(), tag, false, false, false, false, nil)
 			if err != nil {
 				panic(err)
 			}
+			tags = append(tags, tag)
 
 			fmt.Printf("start consumer. exchange=%v queue=%v \n", exchangeConfig.Name, queueConfig.Name)
+			wg.Add(1)
 			go func() {
+				defer wg.Done()
+
 				for message := range messages {
 					body := message.Body
 					c := context.Background()
 
+					if ctx.Err() != nil {
+						if err := message.Nack(false, true); err != nil {
+							llog.Error(c, "failed to requeue. exchange=%v queue=%v err=%v", exchangeConfig.Name, queueConfig.Name.String(), err)
+						}
+						continue
+					}
+
 					requestID, ok := message.Headers[lcontext.ContextKeyRequestID.String()].(string)
 					if !ok || requestID == "" {
 						llog.Error(c, "failied to get request id. exchange=%v queue=%v body=%v", exchangeConfig.Name, queueConfig.Name.String(), string(body))
+						if err := message.Ack(false); err != nil {
+							llog.Error(c, "failed to ack. exchange=%v queue=%v err=%v", exchangeConfig.Name, queueConfig.Name.String(), err)
+						}
 						continue
 					}
 
 					c = context.WithValue(c, lcontext.ContextKeyRequestID, requestID)
-					if err := func() error {
+					c = ltracing.Extract(c, ltracing.TableCarrier(message.Headers))
+					c, span := ltracing.Start(c, "consume "+queueConfig.Name.String(), trace.SpanKindConsumer,
+						semconv.MessagingSystemRabbitmq,
+						semconv.MessagingDestinationName(exchangeConfig.Name.String()),
+						semconv.MessagingOperationReceive,
+					)
+
+					start := time.Now()
+					err := func() (err error) {
 						defer func() {
 							if r := recover(); r != nil {
 								err = fmt.Errorf("recovered. from=%v", r)
@@ -161,12 +207,48 @@ func Start() {
 
 						llog.Info(c, "handle. exchange=%v queue=%v body=%v", exchangeConfig.Name, queueConfig.Name.String(), string(body))
 						return handler.Handle(c, body)
-					}(); err != nil {
+					}()
+					ltracing.End(span, err)
+
+					if err != nil {
 						llog.Error(c, "failed to handle. exchange=%v queue=%v body=%v err=%v", exchangeConfig.Name, queueConfig.Name.String(), string(body), err)
+						lmetrics.ObserveSubscriberMessage(exchangeConfig.Name.String(), queueConfig.Name.String(), lmetrics.ResultFailure, time.Since(start))
+					} else {
+						lmetrics.ObserveSubscriberMessage(exchangeConfig.Name.String(), queueConfig.Name.String(), lmetrics.ResultSuccess, time.Since(start))
+					}
+
+					if err := message.Ack(false); err != nil {
+						llog.Error(c, "failed to ack. exchange=%v queue=%v err=%v", exchangeConfig.Name, queueConfig.Name.String(), err)
 					}
 				}
 			}()
 		}
 	}
-	<-forever
+
+	closed := connection.NotifyClose(make(chan *amqp.Error, 1))
+	ready.Store(true)
+	go func() {
+		err := <-closed
+		ready.Store(false)
+		fmt.Printf("connection closed. err=%v \n", err)
+	}()
+
+	<-ctx.Done()
+	ready.Store(false)
+
+	// 配信を止めたうえで、処理中のハンドラの完了を待つ
+	for _, tag := range tags {
+		if err := channel.Cancel(tag, false); err != nil {
+			fmt.Printf("failed to cancel consumer. tag=%v err=%v \n", tag, err)
+		}
+	}
+	wg.Wait()
+
+	for _, handler := range subscribed {
+		if err := handler.Shutdown(); err != nil {
+			fmt.Printf("failed to shutdown handler. err=%v \n", err)
+		}
+	}
+
+	fmt.Println("subscriber stopped.")
 }
@@ -6,4 +6,12 @@ type Community struct {
 	ID         uuid.UUID
 	Name       string
 	Invitation bool
+	Visibility string
+	Status     string
+}
+
+// ディレクトリやユーザーの参加一覧に表示するコミュニティの概要
+type CommunitySummary struct {
+	Community   Community
+	MemberCount int
 }
@@ -0,0 +1,18 @@
+package model
+
+import "github.com/google/uuid"
+
+type Conversation struct {
+	ID     uuid.UUID
+	At     int
+	Users  []User
+	Unread int
+}
+
+type Message struct {
+	ID       uuid.UUID
+	At       int
+	Contents []Content
+	From     *User
+	ReadBy   []User
+}
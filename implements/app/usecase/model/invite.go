@@ -7,11 +7,13 @@ import (
 )
 
 type CommunityInvite struct {
-	ID      uuid.UUID
-	Role    Role
-	Users   []User
-	Message *string
-	At      time.Time
+	ID       uuid.UUID
+	Role     Role
+	Users    []User
+	Emails   []string
+	Message  *string
+	At       time.Time
+	ExpireAt *time.Time
 }
 
 type UserInvite struct {
@@ -20,4 +22,15 @@ type UserInvite struct {
 	Community Community
 	Message   *string
 	At        time.Time
+	ExpireAt  *time.Time
+}
+
+type InviteLink struct {
+	ID       uuid.UUID
+	Role     Role
+	Token    string
+	At       time.Time
+	ExpireAt *time.Time
+	MaxUses  *int
+	Uses     int
 }
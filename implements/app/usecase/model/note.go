@@ -1,6 +1,10 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Note struct {
 	ID uuid.UUID
@@ -16,3 +20,17 @@ type Line struct {
 type LineProperty struct {
 	Type string
 }
+
+type NoteVersion struct {
+	ID        uuid.UUID
+	Reason    string
+	CreatedAt time.Time
+}
+
+// LineDiff の行番号は該当しない側を nil にする
+type LineDiff struct {
+	Operation string
+	From      *int
+	To        *int
+	Line      Line
+}
@@ -0,0 +1,12 @@
+package model
+
+import "github.com/google/uuid"
+
+type Page struct {
+	ID        uuid.UUID
+	ParentID  *uuid.UUID
+	Title     string
+	Order     int
+	Created   *uuid.UUID
+	Backlinks []Page
+}
@@ -8,4 +8,6 @@ type Post struct {
 	Contents []Content
 	Created  *Member
 	Reaction Reaction
+	// ミュートしているユーザーのポスト
+	Collapsed bool
 }
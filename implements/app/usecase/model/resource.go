@@ -3,7 +3,22 @@ package model
 import "github.com/google/uuid"
 
 type ResourceSearchIndex struct {
-	ResourceID uuid.UUID
-	Type       string
-	Keyword    string
+	ResourceID  uuid.UUID
+	Type        string
+	Keyword     string
+	CommunityID *uuid.UUID
+	TopicID     *uuid.UUID
+	ThreadID    *uuid.UUID
+	AuthorID    *uuid.UUID
+	Body        string
+	Highlights  []string
+}
+
+// ResourceSearchIndexReport はリソースの種類ごとの RDB と索引の差分
+type ResourceSearchIndexReport struct {
+	Type    string
+	Sources int
+	Indexed int
+	Missing []uuid.UUID
+	Extra   []uuid.UUID
 }
@@ -0,0 +1,19 @@
+package model
+
+import "github.com/google/uuid"
+
+type Template struct {
+	ID      uuid.UUID
+	Name    string
+	Kind    string
+	Title   *string
+	Fields  []TemplateField
+	Lines   []Line
+	Created *uuid.UUID
+}
+
+type TemplateField struct {
+	Name     string
+	Required bool
+	Default  *string
+}
@@ -3,6 +3,7 @@ package model
 import "github.com/google/uuid"
 
 type Thread struct {
-	ID    uuid.UUID
-	Posts []Post
+	ID     uuid.UUID
+	Posts  []Post
+	Unread *int
 }
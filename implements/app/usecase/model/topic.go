@@ -8,4 +8,5 @@ type Topic struct {
 	Contents []Content
 	Created  *Member
 	LastPost *Post
+	Unread   *int
 }
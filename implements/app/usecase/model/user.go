@@ -9,3 +9,8 @@ type User struct {
 	Name     string
 	ImageUrl *string
 }
+
+type UserRelation struct {
+	User User
+	Type string
+}
@@ -21,9 +21,9 @@ type ActivityUsecase interface {
 	SaveUserLoginActivity(c context.Context, at time.Time, userID string, ipAddress string, operationSystem string, userAgent string) error
 	SaveMemberActivity(c context.Context, at time.Time, member string, target string, resource string, operation string) error
 	SaveMemberLikeActivity(c context.Context, at time.Time, member string, target string, resource string, like bool, comment *string) error
-	ListUserLoginActivity(c context.Context, userID uuid.UUID, limit int, offset int) ([]umodel.Login, error)
-	ListUsersMemberActivity(c context.Context, userID uuid.UUID, limit int, offset int) ([]umodel.Activity, error)
-	ListUsersMemberLikeActivity(c context.Context, userID uuid.UUID, limit int, offset int) ([]umodel.Like, error)
+	ListUserLoginActivity(c context.Context, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Login, *string, error)
+	ListUsersMemberActivity(c context.Context, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Activity, *string, error)
+	ListUsersMemberLikeActivity(c context.Context, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Like, *string, error)
 	ListRecentMemberActivity(c context.Context, memberID uuid.UUID) ([]umodel.Activity, error)
 }
 
@@ -64,15 +64,21 @@ func (a *activityUsecase) ListRecentMemberActivity(c context.Context, memberID u
 }
 
 // ListUsersMemberLikeActivity implements ActivityUsecase.
-func (a *activityUsecase) ListUsersMemberLikeActivity(c context.Context, userID uuid.UUID, limit int, offset int) ([]umodel.Like, error) {
+func (a *activityUsecase) ListUsersMemberLikeActivity(c context.Context, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Like, *string, error) {
 	dMembers, err := a.memberService.ListByUser(c, userID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to list member. user_id=%v", userID.String())
+		return nil, nil, errors.Wrapf(err, "failed to list member. user_id=%v", userID.String())
 	}
 
-	dLikes, err := a.activityService.ListMembersLikeActivity(c, lo.Map(dMembers, func(member dmodel.Member, _ int) uuid.UUID { return member.ID }), dmodel.Range{Limit: limit, Offset: offset})
+	scope := fmt.Sprintf("user_like:%v", userID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	dLikes, next, err := a.activityService.ListMembersLikeActivity(c, lo.Map(dMembers, func(member dmodel.Member, _ int) uuid.UUID { return member.ID }), *dRange)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	uLikes := []umodel.Like{}
@@ -94,7 +100,7 @@ func (a *activityUsecase) ListUsersMemberLikeActivity(c context.Context, userID
 		}(c, dLike.Member)
 
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		var comment *string
@@ -112,23 +118,29 @@ func (a *activityUsecase) ListUsersMemberLikeActivity(c context.Context, userID
 		})
 	}
 
-	return uLikes, nil
+	return uLikes, encodeCursor(scope, next), nil
 }
 
 // ListUsersMemberActivity implements ActivityUsecase.
-func (a *activityUsecase) ListUsersMemberActivity(c context.Context, userID uuid.UUID, limit int, offset int) ([]umodel.Activity, error) {
+func (a *activityUsecase) ListUsersMemberActivity(c context.Context, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Activity, *string, error) {
 	dMembers, err := a.memberService.ListByUser(c, userID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to list member. user_id=%v", userID.String())
+		return nil, nil, errors.Wrapf(err, "failed to list member. user_id=%v", userID.String())
 	}
 
 	if len(dMembers) < 1 {
-		return []umodel.Activity{}, nil
+		return []umodel.Activity{}, nil, nil
 	}
 
-	dActivities, err := a.activityService.ListMembersActivity(c, lo.Map(dMembers, func(member dmodel.Member, _ int) uuid.UUID { return member.ID }), dmodel.Range{Limit: limit, Offset: offset})
+	scope := fmt.Sprintf("user_activity:%v", userID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to list member activity. user_id=%v", userID.String())
+		return nil, nil, err
+	}
+
+	dActivities, next, err := a.activityService.ListMembersActivity(c, lo.Map(dMembers, func(member dmodel.Member, _ int) uuid.UUID { return member.ID }), *dRange)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to list member activity. user_id=%v", userID.String())
 	}
 
 	uActivities := []umodel.Activity{}
@@ -142,14 +154,20 @@ func (a *activityUsecase) ListUsersMemberActivity(c context.Context, userID uuid
 		})
 	}
 
-	return uActivities, nil
+	return uActivities, encodeCursor(scope, next), nil
 }
 
 // ListUserLoginActivity implements ActivityUsecase.
-func (a *activityUsecase) ListUserLoginActivity(c context.Context, userID uuid.UUID, limit int, offset int) ([]umodel.Login, error) {
-	dActivities, err := a.activityService.ListUserLoginActivity(c, userID, dmodel.Range{Limit: limit, Offset: offset})
+func (a *activityUsecase) ListUserLoginActivity(c context.Context, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Login, *string, error) {
+	scope := fmt.Sprintf("user_login:%v", userID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dActivities, next, err := a.activityService.ListUserLoginActivity(c, userID, *dRange)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to list user login activity. id=%v", userID.String())
+		return nil, nil, errors.Wrapf(err, "failed to list user login activity. id=%v", userID.String())
 	}
 
 	uActivities := []umodel.Login{}
@@ -163,7 +181,7 @@ func (a *activityUsecase) ListUserLoginActivity(c context.Context, userID uuid.U
 		})
 	}
 
-	return uActivities, nil
+	return uActivities, encodeCursor(scope, next), nil
 }
 
 // SaveMemberLikeActivity implements ActivityUsecase.
@@ -4,10 +4,13 @@ import (
 	dfactory "app/domain/factory"
 	dmodel "app/domain/model"
 	dservice "app/domain/service"
+	lrender "app/lib/render"
 	uerror "app/usecase/error"
 	umodel "app/usecase/model"
 	"context"
 	"fmt"
+	"html"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,27 +20,57 @@ import (
 )
 
 type CommunityUsecase interface {
-	Create(c context.Context, userID uuid.UUID, name string, invitation bool) (*uuid.UUID, error)
+	Create(c context.Context, userID uuid.UUID, name string, invitation bool, visibility string) (*uuid.UUID, error)
 	Get(c context.Context, id uuid.UUID) (*umodel.Community, error)
+	GetSummary(c context.Context, id uuid.UUID, userID uuid.UUID) (*umodel.CommunitySummary, error)
+	ListPublic(c context.Context, name *string, limit int, offset int, cursor *string) ([]umodel.CommunitySummary, *string, error)
+	ListJoined(c context.Context, userID uuid.UUID) ([]umodel.CommunitySummary, error)
 	GetByMember(c context.Context, memberID uuid.UUID) (*umodel.Community, error)
 	CanUpdate(c context.Context, id uuid.UUID, userID uuid.UUID) (*umodel.Community, error)
-	Update(c context.Context, id uuid.UUID, userID uuid.UUID, name string) error
+	Update(c context.Context, id uuid.UUID, userID uuid.UUID, name string, visibility *string) error
+	Archive(c context.Context, id uuid.UUID, userID uuid.UUID, archived bool) error
+	TransferOwnership(c context.Context, id uuid.UUID, userID uuid.UUID, memberID uuid.UUID) error
+	Delete(c context.Context, id uuid.UUID, userID uuid.UUID) error
 	CreateRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, name string, action map[string][]string) error
-	ListRole(c context.Context, communityID uuid.UUID) ([]umodel.Role, error)
+	ListRole(c context.Context, communityID uuid.UUID, userID uuid.UUID) ([]umodel.Role, error)
 	UpdateRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID, name string, action map[string][]string) error
 	DeleteRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID) error
-	Invite(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID, mention []uuid.UUID, message *string) error
-	ListInvite(c context.Context, communityID uuid.UUID, limit int, offset int) ([]umodel.CommunityInvite, error)
+	Invite(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID, mention []uuid.UUID, emails []string, message *string, expireAt *int) error
+	ListInvite(c context.Context, communityID uuid.UUID, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.CommunityInvite, *string, error)
 	DeleteInvite(c context.Context, communityID uuid.UUID, userID uuid.UUID, inviteID uuid.UUID) error
-	ListMember(c context.Context, communityID uuid.UUID, limit int, offset int) ([]umodel.Member, error)
+	CreateInviteLink(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID, expireAt *int, maxUses *int) (*umodel.InviteLink, error)
+	ListInviteLink(c context.Context, communityID uuid.UUID, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.InviteLink, *string, error)
+	DeleteInviteLink(c context.Context, communityID uuid.UUID, userID uuid.UUID, linkID uuid.UUID) error
+	ListMember(c context.Context, communityID uuid.UUID, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Member, *string, error)
+	Leave(c context.Context, communityID uuid.UUID, userID uuid.UUID) error
+	UpdateMemberRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, memberID uuid.UUID, roleID uuid.UUID) error
+	DeleteMember(c context.Context, communityID uuid.UUID, userID uuid.UUID, memberID uuid.UUID) error
+	BanMember(c context.Context, communityID uuid.UUID, userID uuid.UUID, memberID uuid.UUID, reason *string, expireAt *int) error
+	Unban(c context.Context, communityID uuid.UUID, userID uuid.UUID, bannedUserID uuid.UUID) error
 	CreateTopic(c context.Context, communityID uuid.UUID, userID uuid.UUID, name string, contents []umodel.Content) (*uuid.UUID, error)
-	ListTopic(c context.Context, communityID uuid.UUID, limit int, offset int) ([]umodel.Topic, error)
-	Post(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, contents []umodel.Content, mention []umodel.Mention, searchWord string) error
-	Reply(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, contents []umodel.Content, mention []umodel.Mention, searchWord string) error
-	ListThread(c context.Context, communityID uuid.UUID, topicID uuid.UUID, limit int, offset int) ([]umodel.Thread, error)
-	ListPost(c context.Context, communityID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, limit int, offset int) ([]umodel.Post, error)
+	ListTopic(c context.Context, communityID uuid.UUID, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Topic, *string, error)
+	Post(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, contents []umodel.Content, mention []umodel.Mention) error
+	Reply(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, contents []umodel.Content, mention []umodel.Mention) error
+	ListLatestThread(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, limit int) ([]umodel.Thread, error)
+	ListThread(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Thread, *string, error)
+	ListPost(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Post, *string, error)
+	ReadPost(c context.Context, communityID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, postID uuid.UUID, userID uuid.UUID) error
 	LikePost(c context.Context, communityID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, postID uuid.UUID, userID uuid.UUID, like bool, comment *string) error
-	ListPostLike(c context.Context, communityID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, postID uuid.UUID, like bool, limit int, offset int) ([]umodel.Like, error)
+	ListPostLike(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, postID uuid.UUID, like bool, limit int, offset int, cursor *string) ([]umodel.Like, *string, error)
+	CreatePage(c context.Context, communityID uuid.UUID, userID uuid.UUID, parentID *uuid.UUID, title string, order *int, lines []umodel.Line) (*uuid.UUID, error)
+	ListPage(c context.Context, communityID uuid.UUID, userID uuid.UUID) ([]umodel.Page, error)
+	GetPage(c context.Context, communityID uuid.UUID, userID uuid.UUID, pageID uuid.UUID) (*umodel.Page, error)
+	CanUpdatePage(c context.Context, communityID uuid.UUID, userID uuid.UUID, pageID uuid.UUID) (*umodel.Page, error)
+	RenamePage(c context.Context, communityID uuid.UUID, userID uuid.UUID, pageID uuid.UUID, title string) error
+	MovePage(c context.Context, communityID uuid.UUID, userID uuid.UUID, pageID uuid.UUID, parentID *uuid.UUID, order int) error
+	DeletePage(c context.Context, communityID uuid.UUID, userID uuid.UUID, pageID uuid.UUID) error
+	SyncPage(c context.Context, communityID uuid.UUID, pageID uuid.UUID, mentions []umodel.Mention) error
+	CreateTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID, template umodel.Template) (*uuid.UUID, error)
+	ListTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID) ([]umodel.Template, error)
+	GetTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID, templateID uuid.UUID) (*umodel.Template, error)
+	UpdateTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID, templateID uuid.UUID, template umodel.Template) error
+	DeleteTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID, templateID uuid.UUID) error
+	FillTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID, templateID uuid.UUID, kind string, values map[string]string) (*umodel.Template, error)
 }
 
 type communityUsecase struct {
@@ -48,11 +81,19 @@ type communityUsecase struct {
 	resourceSearchIndexService dservice.ResourceSearchIndexService
 	activityService            dservice.ActivityService
 	inviteService              dservice.InviteService
+	inviteLinkService          dservice.InviteLinkService
+	mailService                dservice.MailService
 	userService                dservice.UserService
 	topicService               dservice.TopicService
 	threadService              dservice.ThreadService
 	postService                dservice.PostService
 	contentService             dservice.ContentService
+	readMarkerService          dservice.ReadMarkerService
+	userRelationService        dservice.UserRelationService
+	banService                 dservice.BanService
+	communityDeletionService   dservice.CommunityDeletionService
+	pageService                dservice.PageService
+	templateService            dservice.TemplateService
 }
 
 // GetByMember implements CommunityUsecase.
@@ -71,51 +112,61 @@ func (co *communityUsecase) GetByMember(c context.Context, memberID uuid.UUID) (
 		return nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", memberID.String()), nil)
 	}
 
-	return &umodel.Community{
-		ID:   community.ID,
-		Name: community.Name.String(),
-	}, nil
+	return co.toCommunity(*community), nil
 }
 
 // ListPostLike implements CommunityUsecase.
-func (co *communityUsecase) ListPostLike(c context.Context, communityID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, postID uuid.UUID, like bool, limit int, offset int) ([]umodel.Like, error) {
+func (co *communityUsecase) ListPostLike(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, postID uuid.UUID, like bool, limit int, offset int, cursor *string) ([]umodel.Like, *string, error) {
 	community, roles, err := co.get(c, communityID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	} else if community == nil {
-		return nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+		return nil, nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, nil, err
 	}
 
 	dMention, err := dmodel.NewMention(postID.String(), dmodel.ResourcePost.String())
 	if err != nil {
-		return nil, uerror.NewInvalidParameter("failed to parse mention", err)
+		return nil, nil, uerror.NewInvalidParameter("failed to parse mention", err)
 	}
 
-	dLikes, err := co.activityService.ListMemberLikeActivity(c, *dMention, like, dmodel.Range{Limit: limit, Offset: offset})
+	scope := fmt.Sprintf("post_like:%v:%v", postID.String(), like)
+	dRange, err := newRange(scope, limit, offset, cursor)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	dLikes, next, err := co.activityService.ListMemberLikeActivity(c, *dMention, like, *dRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := co.newLoader()
+	if err := l.LoadMembers(c, lo.Map(dLikes, func(dLike dmodel.MemberLikeActivity, _ int) uuid.UUID { return dLike.Member })); err != nil {
+		return nil, nil, err
 	}
 
 	uLikes := []umodel.Like{}
 	for _, dLike := range dLikes {
-		by, err := func(c context.Context, memberID uuid.UUID, roles []dmodel.Role) (*umodel.Member, error) {
-			dMember, err := co.memberService.Get(c, dLike.Member)
-			if err != nil {
-				return nil, err
-			} else if dMember == nil {
+		by, err := func(memberID uuid.UUID, roles []dmodel.Role) (*umodel.Member, error) {
+			dMember, ok := l.Member(memberID)
+			if !ok {
 				return nil, nil
 			}
 
-			uMember, err := co.toMember(c, &roles, nil, dMember)
+			uMember, err := co.toMember(c, &roles, l.Users(), dMember)
 			if err != nil {
 				return nil, err
 			}
 
 			return uMember, nil
-		}(c, dLike.Member, roles)
+		}(dLike.Member, roles)
 
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		var comment *string
@@ -133,7 +184,54 @@ func (co *communityUsecase) ListPostLike(c context.Context, communityID uuid.UUI
 		})
 	}
 
-	return uLikes, nil
+	return uLikes, encodeCursor(scope, next), nil
+}
+
+// ReadPost implements CommunityUsecase.
+func (co *communityUsecase) ReadPost(c context.Context, communityID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, postID uuid.UUID, userID uuid.UUID) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	myMember, _, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return err
+	}
+
+	if err := co.checkTopic(c, communityID, topicID); err != nil {
+		return err
+	}
+
+	dPost, err := co.postService.GetInThread(c, topicID, threadID, postID)
+	if err != nil {
+		return err
+	} else if dPost == nil {
+		return uerror.NewNotFound(fmt.Sprintf("post not found. id=%v", postID.String()), nil)
+	}
+
+	if err := co.readMarkerService.SaveThread(c, dmodel.ThreadReadMarker{
+		MemberID: myMember.ID,
+		ThreadID: threadID,
+		PostID:   dPost.ID,
+		At:       dPost.At,
+	}); err != nil {
+		return err
+	}
+
+	// 読んだポストの日時までに更新されたスレッドを既読とする
+	if err := co.readMarkerService.SaveTopic(c, dmodel.TopicReadMarker{
+		MemberID: myMember.ID,
+		TopicID:  topicID,
+		ThreadID: threadID,
+		At:       dPost.At,
+	}); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // Like implements CommunityUsecase.
@@ -145,6 +243,10 @@ func (co *communityUsecase) LikePost(c context.Context, communityID uuid.UUID, t
 		return uerror.NewNotFound("community not found", nil)
 	}
 
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
 	myMember, _, err := co.getMymemberAndRole(c, communityID, userID, roles)
 	if err != nil {
 		return err
@@ -172,6 +274,10 @@ func (co *communityUsecase) CreateTopic(c context.Context, communityID uuid.UUID
 		return nil, uerror.NewNotFound("community not found", nil)
 	}
 
+	if err := co.checkWritable(*community); err != nil {
+		return nil, err
+	}
+
 	var myMemberID *string
 	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
 	if err != nil {
@@ -183,16 +289,7 @@ func (co *communityUsecase) CreateTopic(c context.Context, communityID uuid.UUID
 		myMemberID = &v
 	}
 
-	newTopicID := uuid.New()
-	newTopic, err := dfactory.NewTopic(newTopicID.String(), name, myMemberID)
-	if err != nil {
-		return nil, uerror.NewInvalidParameter("failed to parse topic", err)
-	}
-
-	if err := co.topicService.Create(c, *newTopic, communityID); err != nil {
-		return nil, errors.Wrapf(err, "failed to create topic. community_id=%v member_id=%v name=%v", communityID.String(), myMember.ID.String(), name)
-	}
-
+	// 内容が不正な場合にトピックだけが作られないよう、先に検証する
 	newContents := []dmodel.Content{}
 	for _, content := range contents {
 		newContentID := uuid.New()
@@ -204,6 +301,20 @@ func (co *communityUsecase) CreateTopic(c context.Context, communityID uuid.UUID
 		newContents = append(newContents, *newContent)
 	}
 
+	if err := co.checkQuotedPosts(c, newContents); err != nil {
+		return nil, err
+	}
+
+	newTopicID := uuid.New()
+	newTopic, err := dfactory.NewTopic(newTopicID.String(), name, myMemberID)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse topic", err)
+	}
+
+	if err := co.topicService.Create(c, *newTopic, communityID); err != nil {
+		return nil, errors.Wrapf(err, "failed to create topic. community_id=%v member_id=%v name=%v", communityID.String(), myMember.ID.String(), name)
+	}
+
 	newMention, err := dmodel.NewMention(newTopicID.String(), dmodel.ResourceTopic.String())
 	if err != nil {
 		return nil, uerror.NewInvalidParameter("failed to parse mention", err)
@@ -213,608 +324,2371 @@ func (co *communityUsecase) CreateTopic(c context.Context, communityID uuid.UUID
 		return nil, errors.Wrapf(err, "failed to create content. community_id=%v member_id=%v name=%v", communityID.String(), myMember.ID.String(), name)
 	}
 
-	if err := co.saveIndexAndActivity(c, myMember.ID, newTopicID, name, dmodel.ResourceTopic, dmodel.OperationCreate); err != nil {
+	dIndex, err := dfactory.NewContentSearchIndex(newTopicID.String(), dmodel.ResourceTopic.String(), name, communityID.String(), lo.ToPtr(newTopicID.String()), nil, myMemberID)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse resource search index", err)
+	}
+	dIndex.Contents = newContents
+
+	if err := co.saveContentIndexAndActivity(c, myMember.ID, *dIndex, dmodel.OperationCreate); err != nil {
 		return nil, err
 	}
 
 	return &newTopicID, nil
 }
 
-// ListPost implements CommunityUsecase.
-func (co *communityUsecase) ListPost(c context.Context, communityID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, limit int, offset int) ([]umodel.Post, error) {
+// CreatePage implements CommunityUsecase.
+// 本文の行を書き終えてからページを作り、途中で失敗したら本文を消す
+func (co *communityUsecase) CreatePage(c context.Context, communityID uuid.UUID, userID uuid.UUID, parentID *uuid.UUID, title string, order *int, lines []umodel.Line) (*uuid.UUID, error) {
 	community, roles, err := co.get(c, communityID)
 	if err != nil {
 		return nil, err
 	} else if community == nil {
-		return nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+		return nil, uerror.NewNotFound("community not found", nil)
 	}
 
-	dPosts, err := co.postService.ListByThread(c, threadID, dmodel.Range{Limit: limit, Offset: offset})
-	if err != nil {
+	if err := co.checkWritable(*community); err != nil {
 		return nil, err
 	}
 
-	uPosts := []umodel.Post{}
-	for _, dPost := range dPosts {
-		uPost, err := co.toPost(c, dPost, roles)
-		if err != nil {
-			return nil, err
-		}
-
-		uPosts = append(uPosts, *uPost)
+	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return nil, err
+	} else if !myRole.CanCreate(dmodel.ResourcePage) {
+		return nil, uerror.NewNewPermissionDenied("cannot create", nil)
 	}
 
-	return uPosts, nil
-}
-
-// ListThread implements CommunityUsecase.
-func (co *communityUsecase) ListThread(c context.Context, communityID uuid.UUID, topicID uuid.UUID, limit int, offset int) ([]umodel.Thread, error) {
-	community, roles, err := co.get(c, communityID)
+	dPages, err := co.pageService.ListByCommunity(c, communityID)
 	if err != nil {
 		return nil, err
-	} else if community == nil {
-		return nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
 	}
 
-	dThreads, err := co.threadService.ListByTopic(c, topicID, dmodel.Range{Limit: limit, Offset: offset})
+	if parentID != nil && !lo.ContainsBy(dPages, func(dPage dmodel.Page) bool { return dPage.ID == *parentID }) {
+		return nil, uerror.NewNotFound(fmt.Sprintf("parent page not found. id=%v", parentID.String()), nil)
+	}
+
+	newOrder, err := co.pageOrder(dPages, uuid.Nil, parentID, order)
 	if err != nil {
 		return nil, err
 	}
 
-	uThreads := []umodel.Thread{}
-	for _, dThread := range dThreads {
-		dPosts, err := co.postService.ListByThread(c, dThread.ID, dmodel.Range{Limit: 2, Offset: 0})
-		if err != nil {
-			return nil, err
-		}
-
-		uPosts := []umodel.Post{}
-		for _, dPost := range dPosts {
-			uPost, err := co.toPost(c, dPost, roles)
-			if err != nil {
-				return nil, err
-			}
+	var newParentID *string
+	if parentID != nil {
+		newParentID = lo.ToPtr(parentID.String())
+	}
 
-			uPosts = append(uPosts, *uPost)
-		}
+	newPageID := uuid.New()
+	newPage, err := dfactory.NewPage(newPageID.String(), newParentID, title, newOrder, lo.ToPtr(myMember.ID.String()))
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse page", err)
+	}
 
-		uThreads = append(uThreads, umodel.Thread{
-			ID:    dThread.ID,
-			Posts: uPosts,
-		})
+	if len(lines) > maxBatchLines {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("too many lines. len=%v max=%v", len(lines), maxBatchLines), nil)
 	}
 
-	return uThreads, nil
-}
+	newNote, err := dfactory.NewNote(uuid.NewString())
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse note", err)
+	}
 
-// ListTopic implements CommunityUsecase.
-func (co *communityUsecase) ListTopic(c context.Context, communityID uuid.UUID, limit int, offset int) ([]umodel.Topic, error) {
-	community, roles, err := co.get(c, communityID)
+	dLines, contents, err := co.newPageLines(newNote.ID, lines)
 	if err != nil {
 		return nil, err
-	} else if community == nil {
-		return nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
 	}
 
-	dTopics, err := co.topicService.ListByCommunity(c, communityID, dmodel.Range{Limit: limit, Offset: offset})
+	pageMention, err := dmodel.NewMention(newPageID.String(), dmodel.ResourcePage.String())
 	if err != nil {
 		return nil, err
 	}
 
-	uTopics := []umodel.Topic{}
-	for _, dTopic := range dTopics {
-		dContents, err := co.contentService.ListByTopic(c, dTopic.ID)
-		if err != nil {
-			return nil, err
-		}
+	if err := co.noteService.Create(c, *newNote, *pageMention); err != nil {
+		return nil, errors.Wrapf(err, "failed to create page note. page_id=%v", newPageID.String())
+	}
 
-		uContents := lo.Map(dContents, func(dContent dmodel.Content, _ int) umodel.Content {
-			return umodel.Content{
-				Type: dContent.Type.String(),
-				Bin:  dContent.Value,
+	if err := func() error {
+		if len(dLines) > 0 {
+			if err := co.contentService.ReplaceByLines(c, contents); err != nil {
+				return err
 			}
-		})
 
-		var uPost *umodel.Post
-		if dLastPost, err := co.postService.Last(c, dTopic.ID); err != nil {
-			return nil, err
-		} else if dLastPost != nil {
-			uPost, err = co.toPost(c, *dLastPost, roles)
-			if err != nil {
-				return nil, err
+			if _, err := co.noteService.ReplaceLines(c, newNote.ID, dLines); err != nil {
+				return err
 			}
 		}
 
-		var uCreated *umodel.Member
-		if dTopic.Created != nil {
-			dMember, err := co.memberService.Get(c, *dTopic.Created)
-			if err != nil {
-				return nil, err
-			} else if dMember == nil {
-				return nil, uerror.NewNotFound(fmt.Sprintf("member not found. id=%v", *dTopic.Created), nil)
-			}
-
-			created, err := co.toMember(c, &roles, nil, dMember)
-			if err != nil {
-				return nil, err
-			}
+		if err := co.pageService.Create(c, *newPage, communityID); err != nil {
+			return errors.Wrapf(err, "failed to create page. community_id=%v member_id=%v title=%v", communityID.String(), myMember.ID.String(), title)
+		}
 
-			uCreated = created
+		return nil
+	}(); err != nil {
+		if deleteErr := co.contentService.DeleteByLines(c, lo.Keys(contents)); deleteErr != nil {
+			return nil, errors.Wrapf(err, "failed to create page and delete line contents. page_id=%v delete_err=%v", newPageID.String(), deleteErr)
 		}
+		if deleteErr := co.noteService.Delete(c, newNote.ID); deleteErr != nil {
+			return nil, errors.Wrapf(err, "failed to create page and delete page note. page_id=%v delete_err=%v", newPageID.String(), deleteErr)
+		}
+		return nil, err
+	}
 
-		uTopics = append(uTopics, umodel.Topic{
-			ID:       dTopic.ID,
-			Name:     dTopic.Name.String(),
-			Contents: uContents,
-			Created:  uCreated,
-			LastPost: uPost,
-		})
+	dIndex, err := dfactory.NewContentSearchIndex(newPageID.String(), dmodel.ResourcePage.String(), title, communityID.String(), nil, nil, lo.ToPtr(myMember.ID.String()))
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse resource search index", err)
 	}
 
-	return uTopics, nil
-}
+	if err := co.saveContentIndexAndActivity(c, myMember.ID, *dIndex, dmodel.OperationCreate); err != nil {
+		return nil, err
+	}
 
-// Post implements CommunityUsecase.
-func (co *communityUsecase) Post(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, contents []umodel.Content, mention []umodel.Mention, searchWord string) error {
-	return co.post(c, communityID, userID, topicID, nil, contents, mention, searchWord)
+	return &newPageID, nil
 }
 
-// Reply implements CommunityUsecase.
-func (co *communityUsecase) Reply(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, contents []umodel.Content, mention []umodel.Mention, searchWord string) error {
-	return co.post(c, communityID, userID, topicID, &threadID, contents, mention, searchWord)
+// ListPage implements CommunityUsecase.
+func (co *communityUsecase) ListPage(c context.Context, communityID uuid.UUID, userID uuid.UUID) ([]umodel.Page, error) {
+	community, _, err := co.get(c, communityID)
+	if err != nil {
+		return nil, err
+	} else if community == nil {
+		return nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, err
+	}
+
+	dPages, err := co.pageService.ListByCommunity(c, communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(dPages, func(dPage dmodel.Page, _ int) umodel.Page { return *co.toPage(dPage) }), nil
 }
 
-// ListMember implements CommunityUsecase.
-func (co *communityUsecase) ListMember(c context.Context, communityID uuid.UUID, limit int, offset int) ([]umodel.Member, error) {
-	community, roles, err := co.get(c, communityID)
+// GetPage implements CommunityUsecase.
+func (co *communityUsecase) GetPage(c context.Context, communityID uuid.UUID, userID uuid.UUID, pageID uuid.UUID) (*umodel.Page, error) {
+	community, _, err := co.get(c, communityID)
 	if err != nil {
 		return nil, err
 	} else if community == nil {
-		return nil, uerror.NewNotFound("community not found", nil)
+		return nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, err
 	}
 
-	members, err := co.memberService.ListByCommunity(c, communityID, dmodel.Range{Limit: limit, Offset: offset})
+	dPage, err := co.getPage(c, communityID, pageID)
 	if err != nil {
 		return nil, err
 	}
 
-	users, err := co.userService.List(c, lo.Map(members, func(member dmodel.Member, _ int) uuid.UUID { return member.UserID }))
+	dBacklinks, err := co.pageService.ListBacklinks(c, pageID)
 	if err != nil {
 		return nil, err
 	}
 
-	uMembers := []umodel.Member{}
-	for _, member := range members {
-		uMember, err := co.toMember(c, &roles, &users, &member)
-		if err != nil {
-			return nil, err
-		}
+	uPage := co.toPage(*dPage)
+	uPage.Backlinks = lo.Map(dBacklinks, func(dPage dmodel.Page, _ int) umodel.Page { return *co.toPage(dPage) })
 
-		uMembers = append(uMembers, *uMember)
+	return uPage, nil
+}
+
+// CanUpdatePage implements CommunityUsecase.
+func (co *communityUsecase) CanUpdatePage(c context.Context, communityID uuid.UUID, userID uuid.UUID, pageID uuid.UUID) (*umodel.Page, error) {
+	dPage, _, err := co.getWritablePage(c, communityID, userID, pageID, dmodel.OperationUpdate)
+	if err != nil {
+		return nil, err
 	}
 
-	return uMembers, nil
+	return co.toPage(*dPage), nil
 }
 
-// DeleteInvite implements CommunityUsecase.
-func (co *communityUsecase) DeleteInvite(c context.Context, communityID uuid.UUID, userID uuid.UUID, inviteID uuid.UUID) error {
-	community, roles, err := co.get(c, communityID)
+// RenamePage implements CommunityUsecase.
+func (co *communityUsecase) RenamePage(c context.Context, communityID uuid.UUID, userID uuid.UUID, pageID uuid.UUID, title string) error {
+	dPage, myMember, err := co.getWritablePage(c, communityID, userID, pageID, dmodel.OperationUpdate)
 	if err != nil {
 		return err
-	} else if community == nil {
-		return uerror.NewNotFound("community not found", nil)
 	}
 
-	if _, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles); err != nil {
+	parsedTitle, err := dmodel.NewName(title)
+	if err != nil {
+		return uerror.NewInvalidParameter("failed to parse title", err)
+	}
+
+	if err := co.pageService.Rename(c, dPage.ID, *parsedTitle); err != nil {
+		return errors.Wrapf(err, "failed to rename page. id=%v", dPage.ID.String())
+	}
+
+	dPage.Title = *parsedTitle
+	dIndex, err := co.newPageIndex(c, communityID, *dPage)
+	if err != nil {
 		return err
-	} else if !myRole.CanCreate(dmodel.ResourceMember) {
-		return uerror.NewNewPermissionDenied("cannot de;ete", nil)
 	}
 
-	return co.inviteService.Delete(c, inviteID)
+	return co.saveContentIndexAndActivity(c, myMember.ID, *dIndex, dmodel.OperationUpdate)
 }
 
-// ListInvite implements CommunityUsecase.
-func (co *communityUsecase) ListInvite(c context.Context, communityID uuid.UUID, limit int, offset int) ([]umodel.CommunityInvite, error) {
-	community, roles, err := co.get(c, communityID)
+// MovePage implements CommunityUsecase.
+// 自分の下にあるページの子にはできない
+func (co *communityUsecase) MovePage(c context.Context, communityID uuid.UUID, userID uuid.UUID, pageID uuid.UUID, parentID *uuid.UUID, order int) error {
+	dPage, myMember, err := co.getWritablePage(c, communityID, userID, pageID, dmodel.OperationUpdate)
 	if err != nil {
-		return nil, err
-	} else if community == nil {
-		return nil, uerror.NewNotFound("community not found", nil)
+		return err
 	}
 
-	invites, err := co.inviteService.ListByRole(c, lo.Map(roles, func(role dmodel.Role, _ int) uuid.UUID { return role.ID }), dmodel.Range{Limit: limit, Offset: offset})
+	dPages, err := co.pageService.ListByCommunity(c, communityID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	uInvites := []umodel.CommunityInvite{}
-	for _, invite := range invites {
-		role, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID == invite.RoleID })
-		if !ok {
-			return nil, uerror.NewNotFound("role not found", nil)
+	if parentID != nil {
+		if !lo.ContainsBy(dPages, func(dPage dmodel.Page) bool { return dPage.ID == *parentID }) {
+			return uerror.NewNotFound(fmt.Sprintf("parent page not found. id=%v", parentID.String()), nil)
 		}
 
-		users, err := co.userService.List(c, invite.Users)
-		if err != nil {
-			return nil, err
+		if lo.Contains(dmodel.PageDescendantIDs(dPages, dPage.ID), *parentID) {
+			return uerror.NewInvalidParameter(fmt.Sprintf("cannot move page under itself. id=%v parent_id=%v", dPage.ID.String(), parentID.String()), nil)
 		}
+	}
 
-		var message *string
-		if invite.Message != nil {
-			v := invite.Message.String()
-			message = &v
-		} else {
-			message = nil
-		}
+	newOrder, err := co.pageOrder(dPages, dPage.ID, parentID, &order)
+	if err != nil {
+		return err
+	}
 
-		uInvites = append(uInvites, umodel.CommunityInvite{
-			ID: invite.ID,
-			Role: umodel.Role{
-				ID:     role.ID,
-				Name:   role.Name.String(),
-				Action: role.Action.Strings(),
-			},
-			Users: lo.Map(users, func(user dmodel.User, _ int) umodel.User {
-				var imageURL *string
-				if user.ImageURL != nil {
-					v := user.ImageURL.String()
+	parsedOrder, err := dmodel.NewOrderNumber(newOrder)
+	if err != nil {
+		return uerror.NewInvalidParameter("failed to parse order", err)
+	}
+
+	if err := co.pageService.Move(c, communityID, dPage.ID, parentID, *parsedOrder); err != nil {
+		return errors.Wrapf(err, "failed to move page. id=%v", dPage.ID.String())
+	}
+
+	if err := co.saveMemberActivity(c, myMember.ID, dPage.ID, dmodel.ResourcePage, dmodel.OperationUpdate); err != nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeletePage implements CommunityUsecase.
+// 子のページも本文ごと削除する
+func (co *communityUsecase) DeletePage(c context.Context, communityID uuid.UUID, userID uuid.UUID, pageID uuid.UUID) error {
+	dPage, myMember, err := co.getWritablePage(c, communityID, userID, pageID, dmodel.OperationDelete)
+	if err != nil {
+		return err
+	}
+
+	dPages, err := co.pageService.ListByCommunity(c, communityID)
+	if err != nil {
+		return err
+	}
+
+	// 本文だけが消えたページが残らないよう、先にページを消す
+	pageIDs := dmodel.PageDescendantIDs(dPages, dPage.ID)
+	if err := co.pageService.Delete(c, communityID, pageIDs); err != nil {
+		return errors.Wrapf(err, "failed to delete page. id=%v", dPage.ID.String())
+	}
+
+	// 本文と索引はページから辿れなくなり、索引は索引の検証で補修できるため、失敗しても残りのページの分を消してから返す
+	var deleteErr error
+	for _, id := range pageIDs {
+		if err := co.deletePageNote(c, id); err != nil && deleteErr == nil {
+			deleteErr = errors.Wrapf(err, "failed to delete page note. id=%v", id.String())
+		}
+
+		if err := co.deleteIndex(c, id); err != nil && deleteErr == nil {
+			deleteErr = err
+		}
+	}
+	if deleteErr != nil {
+		return deleteErr
+	}
+
+	return co.saveMemberActivity(c, myMember.ID, dPage.ID, dmodel.ResourcePage, dmodel.OperationDelete)
+}
+
+// SyncPage implements CommunityUsecase.
+// 本文を編集した後に、本文中のページへのメンションをリンクとして保存し、索引を更新する
+func (co *communityUsecase) SyncPage(c context.Context, communityID uuid.UUID, pageID uuid.UUID, mentions []umodel.Mention) error {
+	dPages, err := co.pageService.ListByCommunity(c, communityID)
+	if err != nil {
+		return err
+	}
+
+	dPage, ok := lo.Find(dPages, func(dPage dmodel.Page) bool { return dPage.ID == pageID })
+	if !ok {
+		return uerror.NewNotFound(fmt.Sprintf("page not found. id=%v", pageID.String()), nil)
+	}
+
+	// 同じコミュニティにあるページへのリンクのみを保存する
+	targetIDs := []uuid.UUID{}
+	for _, mention := range mentions {
+		if mention.ResourceType != dmodel.ResourcePage.String() || mention.ID == pageID {
+			continue
+		}
+
+		if lo.ContainsBy(dPages, func(dPage dmodel.Page) bool { return dPage.ID == mention.ID }) {
+			targetIDs = append(targetIDs, mention.ID)
+		}
+	}
+
+	if err := co.pageService.SaveLinks(c, pageID, targetIDs); err != nil {
+		return errors.Wrapf(err, "failed to save page links. id=%v", pageID.String())
+	}
+
+	dIndex, err := co.newPageIndex(c, communityID, dPage)
+	if err != nil {
+		return err
+	}
+
+	if err := co.resourceSearchIndexService.Update(c, *dIndex); err != nil {
+		return nil
+	}
+
+	return nil
+}
+
+func (co *communityUsecase) getPage(c context.Context, communityID uuid.UUID, pageID uuid.UUID) (*dmodel.Page, error) {
+	dPage, err := co.pageService.Get(c, communityID, pageID)
+	if err != nil {
+		return nil, err
+	} else if dPage == nil {
+		return nil, uerror.NewNotFound(fmt.Sprintf("page not found. id=%v", pageID.String()), nil)
+	}
+
+	return dPage, nil
+}
+
+// ページを変更できるメンバーのみを対象にする
+func (co *communityUsecase) getWritablePage(c context.Context, communityID uuid.UUID, userID uuid.UUID, pageID uuid.UUID, operation dmodel.Operation) (*dmodel.Page, *dmodel.Member, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, nil, err
+	} else if community == nil {
+		return nil, nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return nil, nil, err
+	}
+
+	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if operation == dmodel.OperationDelete && !myRole.CanDelete(dmodel.ResourcePage) {
+		return nil, nil, uerror.NewNewPermissionDenied("cannot delete", nil)
+	} else if operation == dmodel.OperationUpdate && !myRole.CanUpdate(dmodel.ResourcePage) {
+		return nil, nil, uerror.NewNewPermissionDenied("cannot update", nil)
+	}
+
+	dPage, err := co.getPage(c, communityID, pageID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dPage, myMember, nil
+}
+
+// 並び順を省略した場合は末尾にする
+// 移動する場合は自分を除いた兄弟の数から上限を決める
+func (co *communityUsecase) pageOrder(dPages []dmodel.Page, pageID uuid.UUID, parentID *uuid.UUID, order *int) (int, error) {
+	siblings := lo.CountBy(dPages, func(dPage dmodel.Page) bool {
+		if dPage.ID == pageID {
+			return false
+		}
+
+		if dPage.ParentID == nil || parentID == nil {
+			return dPage.ParentID == nil && parentID == nil
+		}
+
+		return *dPage.ParentID == *parentID
+	})
+
+	if order == nil {
+		return siblings + 1, nil
+	}
+
+	if *order < 1 || *order > siblings+1 {
+		return 0, uerror.NewInvalidParameter(fmt.Sprintf("invalid order. order=%v siblings=%v", *order, siblings), nil)
+	}
+
+	return *order, nil
+}
+
+// 索引の本文にはノートのすべての行の内容を使う
+func (co *communityUsecase) newPageIndex(c context.Context, communityID uuid.UUID, dPage dmodel.Page) (*dmodel.ResourceSearchIndex, error) {
+	var created *string
+	if dPage.Created != nil {
+		created = lo.ToPtr(dPage.Created.String())
+	}
+
+	dIndex, err := dfactory.NewContentSearchIndex(dPage.ID.String(), dmodel.ResourcePage.String(), dPage.Title.String(), communityID.String(), nil, nil, created)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse resource search index", err)
+	}
+
+	dNote, err := co.noteService.GetbyResource(c, dmodel.Mention{ID: dPage.ID, Resource: dmodel.ResourcePage})
+	if err != nil {
+		return nil, err
+	} else if dNote == nil {
+		return dIndex, nil
+	}
+
+	dLines, err := co.noteService.ListLines(c, dNote.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dLine := range dLines {
+		dContents, err := co.contentService.ListByLine(c, dLine.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		dIndex.Contents = append(dIndex.Contents, dContents...)
+	}
+
+	return dIndex, nil
+}
+
+// 本文の行は先頭から順に並べる
+func (co *communityUsecase) newPageLines(noteID uuid.UUID, lines []umodel.Line) ([]dmodel.Line, map[uuid.UUID][]dmodel.Content, error) {
+	dLines := []dmodel.Line{}
+	contents := map[uuid.UUID][]dmodel.Content{}
+	for i, line := range lines {
+		var propertyType *string
+		if line.Property != nil {
+			propertyType = &line.Property.Type
+		}
+
+		dLine, err := dfactory.NewLine(uuid.NewString(), noteID.String(), i+1, propertyType)
+		if err != nil {
+			return nil, nil, uerror.NewInvalidParameter("failed to parse line", err)
+		}
+
+		lineContents := []dmodel.Content{}
+		for _, content := range line.Contents {
+			dContent, err := dfactory.NewContent(uuid.NewString(), content.Type, content.Bin)
+			if err != nil {
+				return nil, nil, uerror.NewInvalidParameter(fmt.Sprintf("failed to parse content. type=%v", content.Type), err)
+			}
+
+			lineContents = append(lineContents, *dContent)
+		}
+
+		dLines = append(dLines, *dLine)
+		contents[dLine.ID] = lineContents
+	}
+
+	return dLines, contents, nil
+}
+
+func (co *communityUsecase) deletePageNote(c context.Context, pageID uuid.UUID) error {
+	dNote, err := co.noteService.GetbyResource(c, dmodel.Mention{ID: pageID, Resource: dmodel.ResourcePage})
+	if err != nil {
+		return err
+	} else if dNote == nil {
+		return nil
+	}
+
+	dLines, err := co.noteService.ListLines(c, dNote.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, dLine := range dLines {
+		if err := co.contentService.DeleteByResource(c, dmodel.Mention{ID: dLine.ID, Resource: dmodel.ResourceLine}); err != nil {
+			return err
+		}
+	}
+
+	return co.noteService.Delete(c, dNote.ID)
+}
+
+func (co *communityUsecase) toPage(dPage dmodel.Page) *umodel.Page {
+	return &umodel.Page{
+		ID:        dPage.ID,
+		ParentID:  dPage.ParentID,
+		Title:     dPage.Title.String(),
+		Order:     dPage.Order.Int(),
+		Created:   dPage.Created,
+		Backlinks: []umodel.Page{},
+	}
+}
+
+// CreateTemplate implements CommunityUsecase.
+func (co *communityUsecase) CreateTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID, template umodel.Template) (*uuid.UUID, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, err
+	} else if community == nil {
+		return nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return nil, err
+	}
+
+	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return nil, err
+	} else if !myRole.CanCreate(dmodel.ResourceTemplate) {
+		return nil, uerror.NewNewPermissionDenied("cannot create", nil)
+	}
+
+	newTemplateID := uuid.New()
+	newTemplate, err := co.newTemplate(newTemplateID, template, &myMember.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := co.templateService.Create(c, *newTemplate, communityID); err != nil {
+		return nil, errors.Wrapf(err, "failed to create template. community_id=%v member_id=%v name=%v", communityID.String(), myMember.ID.String(), template.Name)
+	}
+
+	if err := co.saveMemberActivity(c, myMember.ID, newTemplateID, dmodel.ResourceTemplate, dmodel.OperationCreate); err != nil {
+		return nil, err
+	}
+
+	return &newTemplateID, nil
+}
+
+// ListTemplate implements CommunityUsecase.
+func (co *communityUsecase) ListTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID) ([]umodel.Template, error) {
+	community, _, err := co.get(c, communityID)
+	if err != nil {
+		return nil, err
+	} else if community == nil {
+		return nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, err
+	}
+
+	dTemplates, err := co.templateService.ListByCommunity(c, communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(dTemplates, func(dTemplate dmodel.Template, _ int) umodel.Template { return *co.toTemplate(dTemplate) }), nil
+}
+
+// GetTemplate implements CommunityUsecase.
+func (co *communityUsecase) GetTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID, templateID uuid.UUID) (*umodel.Template, error) {
+	community, _, err := co.get(c, communityID)
+	if err != nil {
+		return nil, err
+	} else if community == nil {
+		return nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, err
+	}
+
+	dTemplate, err := co.getTemplate(c, communityID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return co.toTemplate(*dTemplate), nil
+}
+
+// UpdateTemplate implements CommunityUsecase.
+func (co *communityUsecase) UpdateTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID, templateID uuid.UUID, template umodel.Template) error {
+	dTemplate, myMember, err := co.getWritableTemplate(c, communityID, userID, templateID, dmodel.OperationUpdate)
+	if err != nil {
+		return err
+	}
+
+	newTemplate, err := co.newTemplate(templateID, template, dTemplate.Created)
+	if err != nil {
+		return err
+	}
+
+	if err := co.templateService.Update(c, *newTemplate); err != nil {
+		return errors.Wrapf(err, "failed to update template. id=%v", templateID.String())
+	}
+
+	return co.saveMemberActivity(c, myMember.ID, templateID, dmodel.ResourceTemplate, dmodel.OperationUpdate)
+}
+
+// DeleteTemplate implements CommunityUsecase.
+func (co *communityUsecase) DeleteTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID, templateID uuid.UUID) error {
+	_, myMember, err := co.getWritableTemplate(c, communityID, userID, templateID, dmodel.OperationDelete)
+	if err != nil {
+		return err
+	}
+
+	if err := co.templateService.Delete(c, templateID); err != nil {
+		return errors.Wrapf(err, "failed to delete template. id=%v", templateID.String())
+	}
+
+	return co.saveMemberActivity(c, myMember.ID, templateID, dmodel.ResourceTemplate, dmodel.OperationDelete)
+}
+
+// FillTemplate implements CommunityUsecase.
+// 置き換えた本文は、その種類のリソースを作成できるメンバーにだけ返す
+func (co *communityUsecase) FillTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID, templateID uuid.UUID, kind string, values map[string]string) (*umodel.Template, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, err
+	} else if community == nil {
+		return nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, err
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return nil, err
+	}
+
+	_, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, ok := templateResources[dmodel.TemplateKind(kind)]
+	if !ok {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("invalid template kind. kind=%v", kind), nil)
+	} else if !myRole.CanCreate(resource) {
+		return nil, uerror.NewNewPermissionDenied("cannot create", nil)
+	}
+
+	dTemplate, err := co.getTemplate(c, communityID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if dTemplate.Kind.String() != kind {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("template kind mismatch. kind=%v expected=%v", dTemplate.Kind.String(), kind), nil)
+	}
+
+	resolved, err := dTemplate.Resolve(values)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to resolve template values", err)
+	}
+
+	filled, err := dTemplate.Fill(resolved)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to fill template", err)
+	}
+
+	return co.toTemplate(*filled), nil
+}
+
+// ひな形の種類ごとに、作成するリソース
+var templateResources = map[dmodel.TemplateKind]dmodel.Resource{
+	dmodel.TemplateKindTopic: dmodel.ResourceTopic,
+	dmodel.TemplateKindPost:  dmodel.ResourcePost,
+	dmodel.TemplateKindNote:  dmodel.ResourcePage,
+}
+
+func (co *communityUsecase) getTemplate(c context.Context, communityID uuid.UUID, templateID uuid.UUID) (*dmodel.Template, error) {
+	dTemplate, err := co.templateService.Get(c, communityID, templateID)
+	if err != nil {
+		return nil, err
+	} else if dTemplate == nil {
+		return nil, uerror.NewNotFound(fmt.Sprintf("template not found. id=%v", templateID.String()), nil)
+	}
+
+	return dTemplate, nil
+}
+
+// ひな形を変更できるメンバーのみを対象にする
+func (co *communityUsecase) getWritableTemplate(c context.Context, communityID uuid.UUID, userID uuid.UUID, templateID uuid.UUID, operation dmodel.Operation) (*dmodel.Template, *dmodel.Member, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, nil, err
+	} else if community == nil {
+		return nil, nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return nil, nil, err
+	}
+
+	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if operation == dmodel.OperationDelete && !myRole.CanDelete(dmodel.ResourceTemplate) {
+		return nil, nil, uerror.NewNewPermissionDenied("cannot delete", nil)
+	} else if operation == dmodel.OperationUpdate && !myRole.CanUpdate(dmodel.ResourceTemplate) {
+		return nil, nil, uerror.NewNewPermissionDenied("cannot update", nil)
+	}
+
+	dTemplate, err := co.getTemplate(c, communityID, templateID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dTemplate, myMember, nil
+}
+
+func (co *communityUsecase) newTemplate(templateID uuid.UUID, template umodel.Template, created *uuid.UUID) (*dmodel.Template, error) {
+	if len(template.Lines) > maxBatchLines {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("too many lines. len=%v max=%v", len(template.Lines), maxBatchLines), nil)
+	}
+
+	fields := []dmodel.TemplateField{}
+	for _, field := range template.Fields {
+		dField, err := dfactory.NewTemplateField(field.Name, field.Required, field.Default)
+		if err != nil {
+			return nil, uerror.NewInvalidParameter(fmt.Sprintf("failed to parse template field. name=%v", field.Name), err)
+		}
+
+		fields = append(fields, *dField)
+	}
+
+	lines := []dmodel.TemplateLine{}
+	for _, line := range template.Lines {
+		var property *dmodel.LineProperty
+		if line.Property != nil {
+			propertyType, err := dmodel.NewLinePropertyType(line.Property.Type)
+			if err != nil {
+				return nil, uerror.NewInvalidParameter("failed to parse line property", err)
+			}
+
+			property = &dmodel.LineProperty{Type: *propertyType}
+		}
+
+		contents := []dmodel.Content{}
+		for _, content := range line.Contents {
+			dContent, err := dfactory.NewContent(uuid.Nil.String(), content.Type, content.Bin)
+			if err != nil {
+				return nil, uerror.NewInvalidParameter(fmt.Sprintf("failed to parse content. type=%v", content.Type), err)
+			}
+
+			contents = append(contents, *dContent)
+		}
+
+		lines = append(lines, dmodel.TemplateLine{
+			Property: property,
+			Contents: contents,
+		})
+	}
+
+	var createdID *string
+	if created != nil {
+		createdID = lo.ToPtr(created.String())
+	}
+
+	dTemplate, err := dfactory.NewTemplate(templateID.String(), template.Name, template.Kind, template.Title, fields, lines, createdID)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse template", err)
+	}
+
+	return dTemplate, nil
+}
+
+func (co *communityUsecase) toTemplate(dTemplate dmodel.Template) *umodel.Template {
+	var title *string
+	if dTemplate.Title != nil {
+		title = lo.ToPtr(dTemplate.Title.String())
+	}
+
+	lines := []umodel.Line{}
+	for i, line := range dTemplate.Lines {
+		var property *umodel.LineProperty
+		if line.Property != nil {
+			property = &umodel.LineProperty{Type: line.Property.Type.String()}
+		}
+
+		lines = append(lines, umodel.Line{
+			Order:    i + 1,
+			Property: property,
+			Contents: lo.Map(line.Contents, func(content dmodel.Content, _ int) umodel.Content {
+				return umodel.Content{Type: content.Type.String(), Bin: content.Value}
+			}),
+		})
+	}
+
+	return &umodel.Template{
+		ID:    dTemplate.ID,
+		Name:  dTemplate.Name.String(),
+		Kind:  dTemplate.Kind.String(),
+		Title: title,
+		Fields: lo.Map(dTemplate.Fields, func(field dmodel.TemplateField, _ int) umodel.TemplateField {
+			return umodel.TemplateField{Name: field.Name.String(), Required: field.Required, Default: field.Default}
+		}),
+		Lines:   lines,
+		Created: dTemplate.Created,
+	}
+}
+
+// ListPost implements CommunityUsecase.
+func (co *communityUsecase) ListPost(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Post, *string, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, nil, err
+	} else if community == nil {
+		return nil, nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, nil, err
+	}
+
+	if err := co.checkTopic(c, communityID, topicID); err != nil {
+		return nil, nil, err
+	}
+
+	scope := fmt.Sprintf("post:%v", threadID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dPosts, next, err := co.postService.ListByThread(c, threadID, *dRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := co.newLoader()
+	if err := l.LoadPosts(c, dPosts); err != nil {
+		return nil, nil, err
+	}
+
+	filter, err := newRelationFilter(c, co.userRelationService, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uPosts := []umodel.Post{}
+	for _, dPost := range dPosts {
+		if filter.HiddenPost(l, dPost) {
+			continue
+		}
+
+		uPost, err := co.toPost(c, l, dPost, roles)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		uPost.Collapsed = filter.MutedPost(l, dPost)
+		uPosts = append(uPosts, *uPost)
+	}
+
+	return uPosts, encodeCursor(scope, next), nil
+}
+
+// ListThread implements CommunityUsecase.
+func (co *communityUsecase) ListThread(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Thread, *string, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, nil, err
+	} else if community == nil {
+		return nil, nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, nil, err
+	}
+
+	if err := co.checkTopic(c, communityID, topicID); err != nil {
+		return nil, nil, err
+	}
+
+	scope := fmt.Sprintf("thread:%v", topicID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dThreads, next, err := co.threadService.ListByTopic(c, topicID, *dRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uThreads, err := co.toThreads(c, communityID, userID, roles, dThreads)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return uThreads, encodeCursor(scope, next), nil
+}
+
+// ListLatestThread implements CommunityUsecase.
+func (co *communityUsecase) ListLatestThread(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, limit int) ([]umodel.Thread, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, err
+	} else if community == nil {
+		return nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, err
+	}
+
+	if err := co.checkTopic(c, communityID, topicID); err != nil {
+		return nil, err
+	}
+
+	dThreads, err := co.threadService.ListLatestByTopic(c, topicID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return co.toThreads(c, communityID, userID, roles, dThreads)
+}
+
+func (co *communityUsecase) toThreads(c context.Context, communityID uuid.UUID, userID uuid.UUID, roles []dmodel.Role, dThreads []dmodel.Thread) ([]umodel.Thread, error) {
+	dThreadPosts, err := co.postService.ListByThreads(c, lo.Map(dThreads, func(dThread dmodel.Thread, _ int) uuid.UUID { return dThread.ID }), 2)
+	if err != nil {
+		return nil, err
+	}
+
+	l := co.newLoader()
+	if err := l.LoadPosts(c, lo.Flatten(lo.Values(dThreadPosts))); err != nil {
+		return nil, err
+	}
+
+	unreads, err := co.countUnreadPosts(c, communityID, userID, lo.Map(dThreads, func(dThread dmodel.Thread, _ int) uuid.UUID { return dThread.ID }))
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := newRelationFilter(c, co.userRelationService, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	uThreads := []umodel.Thread{}
+	for _, dThread := range dThreads {
+		// ブロックの関係にあるユーザーが始めたスレッドは表示しない
+		if dFirstPost, ok := lo.First(dThreadPosts[dThread.ID]); ok && filter.HiddenPost(l, dFirstPost) {
+			continue
+		}
+
+		uPosts := []umodel.Post{}
+		for _, dPost := range dThreadPosts[dThread.ID] {
+			if filter.HiddenPost(l, dPost) {
+				continue
+			}
+
+			uPost, err := co.toPost(c, l, dPost, roles)
+			if err != nil {
+				return nil, err
+			}
+
+			uPost.Collapsed = filter.MutedPost(l, dPost)
+			uPosts = append(uPosts, *uPost)
+		}
+
+		var unread *int
+		if count, ok := unreads[dThread.ID]; ok {
+			unread = &count
+		}
+
+		uThreads = append(uThreads, umodel.Thread{
+			ID:     dThread.ID,
+			Posts:  uPosts,
+			Unread: unread,
+		})
+	}
+
+	return uThreads, nil
+}
+
+// ListTopic implements CommunityUsecase.
+func (co *communityUsecase) ListTopic(c context.Context, communityID uuid.UUID, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Topic, *string, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, nil, err
+	} else if community == nil {
+		return nil, nil, uerror.NewNotFound(fmt.Sprintf("community not found. id=%v", communityID.String()), nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, nil, err
+	}
+
+	scope := fmt.Sprintf("topic:%v", communityID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dTopics, next, err := co.topicService.ListByCommunity(c, communityID, *dRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dLastPosts, err := co.postService.LastByTopics(c, lo.Map(dTopics, func(dTopic dmodel.Topic, _ int) uuid.UUID { return dTopic.ID }))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := co.newLoader()
+	if err := l.LoadTopics(c, dTopics); err != nil {
+		return nil, nil, err
+	}
+
+	if err := l.LoadPosts(c, lo.Values(dLastPosts)); err != nil {
+		return nil, nil, err
+	}
+
+	unreads, err := co.countUnreadThreads(c, communityID, userID, lo.Map(dTopics, func(dTopic dmodel.Topic, _ int) uuid.UUID { return dTopic.ID }))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter, err := newRelationFilter(c, co.userRelationService, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uTopics := []umodel.Topic{}
+	for _, dTopic := range dTopics {
+		uContents := lo.Map(l.TopicContents(dTopic.ID), func(dContent dmodel.Content, _ int) umodel.Content {
+			return umodel.Content{
+				Type: dContent.Type.String(),
+				Bin:  dContent.Value,
+			}
+		})
+
+		var uPost *umodel.Post
+		if dLastPost, ok := dLastPosts[dTopic.ID]; ok && !filter.HiddenPost(l, dLastPost) {
+			uPost, err = co.toPost(c, l, dLastPost, roles)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			uPost.Collapsed = filter.MutedPost(l, dLastPost)
+		}
+
+		var uCreated *umodel.Member
+		if dTopic.Created != nil {
+			dMember, ok := l.Member(*dTopic.Created)
+			if !ok {
+				return nil, nil, uerror.NewNotFound(fmt.Sprintf("member not found. id=%v", *dTopic.Created), nil)
+			}
+
+			created, err := co.toMember(c, &roles, l.Users(), dMember)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			uCreated = created
+		}
+
+		var unread *int
+		if count, ok := unreads[dTopic.ID]; ok {
+			unread = &count
+		}
+
+		uTopics = append(uTopics, umodel.Topic{
+			ID:       dTopic.ID,
+			Name:     dTopic.Name.String(),
+			Contents: uContents,
+			Created:  uCreated,
+			LastPost: uPost,
+			Unread:   unread,
+		})
+	}
+
+	return uTopics, encodeCursor(scope, next), nil
+}
+
+// Post implements CommunityUsecase.
+func (co *communityUsecase) Post(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, contents []umodel.Content, mention []umodel.Mention) error {
+	return co.post(c, communityID, userID, topicID, nil, contents, mention)
+}
+
+// Reply implements CommunityUsecase.
+func (co *communityUsecase) Reply(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, threadID uuid.UUID, contents []umodel.Content, mention []umodel.Mention) error {
+	return co.post(c, communityID, userID, topicID, &threadID, contents, mention)
+}
+
+// ListMember implements CommunityUsecase.
+func (co *communityUsecase) ListMember(c context.Context, communityID uuid.UUID, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Member, *string, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, nil, err
+	} else if community == nil {
+		return nil, nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, nil, err
+	}
+
+	scope := fmt.Sprintf("member:%v", communityID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members, next, err := co.memberService.ListByCommunity(c, communityID, *dRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users, err := co.userService.List(c, lo.Map(members, func(member dmodel.Member, _ int) uuid.UUID { return member.UserID }))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uMembers := []umodel.Member{}
+	for _, member := range members {
+		uMember, err := co.toMember(c, &roles, &users, &member)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		uMembers = append(uMembers, *uMember)
+	}
+
+	return uMembers, encodeCursor(scope, next), nil
+}
+
+// Leave implements CommunityUsecase.
+func (co *communityUsecase) Leave(c context.Context, communityID uuid.UUID, userID uuid.UUID) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	myMember, _, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return err
+	}
+
+	if err := co.checkOwner(*community, *myMember); err != nil {
+		return err
+	}
+
+	if err := co.deleteMember(c, myMember.ID, *myMember, roles); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateMemberRole implements CommunityUsecase.
+func (co *communityUsecase) UpdateMemberRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, memberID uuid.UUID, roleID uuid.UUID) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
+	role, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID.String() == roleID.String() })
+	if !ok {
+		return uerror.NewNotFound("role not found", nil)
+	}
+
+	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return err
+	} else if !myRole.CanUpdate(dmodel.ResourceMember) {
+		return uerror.NewNewPermissionDenied("cannot update", nil)
+	}
+
+	member, err := co.getMember(c, communityID, memberID)
+	if err != nil {
+		return err
+	} else if member.RoleID == role.ID {
+		return nil
+	}
+
+	if member.ID == myMember.ID {
+		return uerror.NewNewPermissionDenied("cannot update own role", nil)
+	}
+
+	if err := co.checkOwner(*community, *member); err != nil {
+		return err
+	}
+
+	if err := co.checkGrantable(*myRole, role); err != nil {
+		return err
+	}
+
+	// オーナーのロールはオーナーのみが与えられる
+	if !community.IsOwner(myMember.ID) {
+		if isOwnerRole, err := co.isOwnerRole(c, *community, role); err != nil {
+			return err
+		} else if isOwnerRole {
+			return uerror.NewNewPermissionDenied("only owner can grant owner role", nil)
+		}
+	}
+
+	// 降格によってコミュニティを管理できるメンバーがいなくならないようにする
+	if ok, err := co.memberService.UpdateRole(c, member.ID, role.ID, co.managerRoleIDs(roles)); err != nil {
+		return errors.Wrapf(err, "failed to update member role. id=%v", member.ID.String())
+	} else if !ok {
+		return uerror.NewNewPermissionDenied("last member who can update community", nil)
+	}
+
+	if err := co.saveMemberActivity(c, myMember.ID, member.ID, dmodel.ResourceMember, dmodel.OperationUpdate); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteMember implements CommunityUsecase.
+func (co *communityUsecase) DeleteMember(c context.Context, communityID uuid.UUID, userID uuid.UUID, memberID uuid.UUID) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
+	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return err
+	} else if !myRole.CanDelete(dmodel.ResourceMember) {
+		return uerror.NewNewPermissionDenied("cannot delete", nil)
+	}
+
+	member, err := co.getMember(c, communityID, memberID)
+	if err != nil {
+		return err
+	}
+
+	if err := co.checkOwner(*community, *member); err != nil {
+		return err
+	}
+
+	if err := co.checkSuperior(*myRole, *member, roles); err != nil {
+		return err
+	}
+
+	if err := co.deleteMember(c, myMember.ID, *member, roles); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BanMember implements CommunityUsecase.
+func (co *communityUsecase) BanMember(c context.Context, communityID uuid.UUID, userID uuid.UUID, memberID uuid.UUID, reason *string, expireAt *int) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
+	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return err
+	} else if !myRole.CanDelete(dmodel.ResourceMember) {
+		return uerror.NewNewPermissionDenied("cannot ban", nil)
+	}
+
+	member, err := co.getMember(c, communityID, memberID)
+	if err != nil {
+		return err
+	}
+
+	if err := co.checkOwner(*community, *member); err != nil {
+		return err
+	}
+
+	if err := co.checkSuperior(*myRole, *member, roles); err != nil {
+		return err
+	}
+
+	dBan, err := dfactory.NewBan(communityID.String(), member.UserID.String(), reason, time.Now(), toTime(expireAt))
+	if err != nil {
+		return uerror.NewInvalidParameter("failed to parse ban", err)
+	}
+
+	// 退会させてから保存に失敗すると再参加できるため、先に保存する
+	if err := co.banService.Save(c, *dBan); err != nil {
+		return errors.Wrapf(err, "failed to save ban. community_id=%v user_id=%v", communityID.String(), member.UserID.String())
+	}
+
+	if err := co.deleteMember(c, myMember.ID, *member, roles); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Unban implements CommunityUsecase.
+func (co *communityUsecase) Unban(c context.Context, communityID uuid.UUID, userID uuid.UUID, bannedUserID uuid.UUID) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
+	if _, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles); err != nil {
+		return err
+	} else if !myRole.CanDelete(dmodel.ResourceMember) {
+		return uerror.NewNewPermissionDenied("cannot unban", nil)
+	}
+
+	if ban, err := co.banService.Get(c, communityID, bannedUserID); err != nil {
+		return err
+	} else if ban == nil {
+		return uerror.NewNotFound("ban not found", nil)
+	}
+
+	return co.banService.Delete(c, communityID, bannedUserID)
+}
+
+// DeleteInvite implements CommunityUsecase.
+func (co *communityUsecase) DeleteInvite(c context.Context, communityID uuid.UUID, userID uuid.UUID, inviteID uuid.UUID) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
+	if _, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles); err != nil {
+		return err
+	} else if !myRole.CanCreate(dmodel.ResourceMember) {
+		return uerror.NewNewPermissionDenied("cannot de;ete", nil)
+	}
+
+	return co.inviteService.Delete(c, inviteID)
+}
+
+// ListInvite implements CommunityUsecase.
+func (co *communityUsecase) ListInvite(c context.Context, communityID uuid.UUID, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.CommunityInvite, *string, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, nil, err
+	} else if community == nil {
+		return nil, nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, nil, err
+	}
+
+	scope := fmt.Sprintf("community_invite:%v", communityID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invites, next, err := co.inviteService.ListByRole(c, lo.Map(roles, func(role dmodel.Role, _ int) uuid.UUID { return role.ID }), *dRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uInvites := []umodel.CommunityInvite{}
+	for _, invite := range invites {
+		role, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID == invite.RoleID })
+		if !ok {
+			return nil, nil, uerror.NewNotFound("role not found", nil)
+		}
+
+		users, err := co.userService.List(c, invite.Users)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var message *string
+		if invite.Message != nil {
+			v := invite.Message.String()
+			message = &v
+		} else {
+			message = nil
+		}
+
+		uInvites = append(uInvites, umodel.CommunityInvite{
+			ID: invite.ID,
+			Role: umodel.Role{
+				ID:     role.ID,
+				Name:   role.Name.String(),
+				Action: role.Action.Strings(),
+			},
+			Users: lo.Map(users, func(user dmodel.User, _ int) umodel.User {
+				var imageURL *string
+				if user.ImageURL != nil {
+					v := user.ImageURL.String()
 					imageURL = &v
 				} else {
 					imageURL = nil
 				}
 
-				return umodel.User{
-					ID:       user.ID,
-					Subject:  user.Subject.String(),
-					Email:    user.Email.String(),
-					Name:     user.Name.String(),
-					ImageUrl: imageURL,
-				}
-			}),
-			At:      invite.At,
-			Message: message,
-		})
+				return umodel.User{
+					ID:       user.ID,
+					Subject:  user.Subject.String(),
+					Email:    user.Email.String(),
+					Name:     user.Name.String(),
+					ImageUrl: imageURL,
+				}
+			}),
+			Emails:   lo.Map(invite.Emails, func(email dmodel.EmailAddress, _ int) string { return email.String() }),
+			At:       invite.At,
+			ExpireAt: invite.ExpireAt,
+			Message:  message,
+		})
+	}
+
+	return uInvites, encodeCursor(scope, next), nil
+}
+
+// Invite implements CommunityUsecase.
+func (co *communityUsecase) Invite(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID, mention []uuid.UUID, emails []string, message *string, expireAt *int) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
+	role, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID.String() == roleID.String() })
+	if !ok {
+		return uerror.NewNotFound("role not found", nil)
+	}
+
+	if _, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles); err != nil {
+		return err
+	} else if !myRole.CanCreate(dmodel.ResourceMember) {
+		return uerror.NewNewPermissionDenied("cannot create", nil)
+	} else if err := co.checkGrantable(*myRole, role); err != nil {
+		return err
+	}
+
+	// オーナーのロールは譲渡でのみ与える
+	if isOwnerRole, err := co.isOwnerRole(c, *community, role); err != nil {
+		return err
+	} else if isOwnerRole {
+		return uerror.NewNewPermissionDenied("cannot invite with owner role", nil)
+	}
+
+	// 登録済みのユーザーはメールアドレスではなくユーザーとして招待する
+	invitedUserIDs := mention
+	invitedEmails := []dmodel.EmailAddress{}
+	for _, email := range emails {
+		dEmail, err := dmodel.NewEmailAddress(email)
+		if err != nil {
+			return uerror.NewInvalidParameter(fmt.Sprintf("failed to parse email. v=%v", email), err)
+		}
+
+		if user, err := co.userService.GetByEmail(c, *dEmail); err != nil {
+			return err
+		} else if user != nil {
+			invitedUserIDs = append(invitedUserIDs, user.ID)
+		} else {
+			invitedEmails = append(invitedEmails, *dEmail)
+		}
+	}
+
+	invitedUserIDs = lo.Uniq(invitedUserIDs)
+	invitedEmails = lo.Uniq(invitedEmails)
+	if len(invitedUserIDs)+len(invitedEmails) == 0 {
+		return uerror.NewInvalidParameter("no user to invite", nil)
+	}
+
+	// 招待済みのメールアドレスには招待を作り直さず、メールだけ送り直す（送信に失敗した招待もやり直せる）
+	resendInvites := []dmodel.Invite{}
+	newEmails := []dmodel.EmailAddress{}
+	for _, invitedEmail := range invitedEmails {
+		if invite, err := co.inviteService.GetByRoleAndEmail(c, roleID, invitedEmail); err != nil {
+			return err
+		} else if invite != nil && !invite.Expired(time.Now()) {
+			invite.Emails = []dmodel.EmailAddress{invitedEmail}
+			resendInvites = append(resendInvites, *invite)
+		} else {
+			newEmails = append(newEmails, invitedEmail)
+		}
+	}
+
+	newUserIDs := []uuid.UUID{}
+	for _, mentionedUserID := range invitedUserIDs {
+		if ban, err := co.banService.Get(c, communityID, mentionedUserID); err != nil {
+			return err
+		} else if ban != nil && ban.Active(time.Now()) {
+			return uerror.NewNewPermissionDenied(fmt.Sprintf("user is banned. user_id=%v", mentionedUserID.String()), nil)
+		}
+
+		if member, err := co.memberService.GetByCommunityAndUser(c, communityID, mentionedUserID); err != nil {
+			return err
+		} else if member != nil {
+			return uerror.NewAlreadyExists(fmt.Sprintf("member already exists. user_id=%v", mentionedUserID.String()), nil)
+		}
+
+		if invite, err := co.inviteService.GetByRoleAndUser(c, roleID, mentionedUserID); err != nil {
+			return err
+		} else if invite == nil || invite.Expired(time.Now()) {
+			newUserIDs = append(newUserIDs, mentionedUserID)
+		}
+	}
+
+	if len(newUserIDs)+len(newEmails)+len(resendInvites) == 0 {
+		return uerror.NewAlreadyExists("invite already exists", nil)
+	}
+
+	if len(newUserIDs)+len(newEmails) > 0 {
+		inviteID := uuid.NewString()
+		dInvite, err := dfactory.NewInvite(inviteID, roleID.String(), message, time.Now(), toTime(expireAt),
+			lo.Map(newUserIDs, func(userID uuid.UUID, _ int) string { return userID.String() }),
+			lo.Map(newEmails, func(email dmodel.EmailAddress, _ int) string { return email.String() }))
+		if err != nil {
+			return uerror.NewInvalidParameter("failed to parse invite", err)
+		}
+
+		if err := co.inviteService.Create(c, *dInvite); err != nil {
+			return errors.Wrapf(err, "failed to create invite. community_id=%v role_id=%v", communityID.String(), roleID.String())
+		}
+
+		resendInvites = append(resendInvites, *dInvite)
+	}
+
+	for _, invite := range resendInvites {
+		if err := co.sendInviteMails(c, userID, *community, role, invite); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateInviteLink implements CommunityUsecase.
+func (co *communityUsecase) CreateInviteLink(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID, expireAt *int, maxUses *int) (*umodel.InviteLink, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, err
+	} else if community == nil {
+		return nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return nil, err
+	}
+
+	role, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID.String() == roleID.String() })
+	if !ok {
+		return nil, uerror.NewNotFound("role not found", nil)
+	}
+
+	if _, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles); err != nil {
+		return nil, err
+	} else if !myRole.CanCreate(dmodel.ResourceMember) {
+		return nil, uerror.NewNewPermissionDenied("cannot create", nil)
+	} else if err := co.checkGrantable(*myRole, role); err != nil {
+		return nil, err
+	}
+
+	// オーナーのロールは譲渡でのみ与える
+	if isOwnerRole, err := co.isOwnerRole(c, *community, role); err != nil {
+		return nil, err
+	} else if isOwnerRole {
+		return nil, uerror.NewNewPermissionDenied("cannot invite with owner role", nil)
+	}
+
+	token, err := dmodel.GenerateInviteToken()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate invite token")
+	}
+
+	linkID := uuid.NewString()
+	dLink, err := dfactory.NewInviteLink(linkID, role.ID.String(), token.String(), time.Now(), toTime(expireAt), maxUses, 0)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse invite link", err)
+	}
+
+	if err := co.inviteLinkService.Create(c, *dLink); err != nil {
+		return nil, errors.Wrapf(err, "failed to create invite link. community_id=%v role_id=%v", communityID.String(), roleID.String())
+	}
+
+	uLink := toInviteLink(*dLink, role)
+	return &uLink, nil
+}
+
+// ListInviteLink implements CommunityUsecase.
+func (co *communityUsecase) ListInviteLink(c context.Context, communityID uuid.UUID, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.InviteLink, *string, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, nil, err
+	} else if community == nil {
+		return nil, nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	// トークンを知れば誰でも参加できるため、招待できるメンバーにのみ公開する
+	if _, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles); err != nil {
+		return nil, nil, err
+	} else if !myRole.CanCreate(dmodel.ResourceMember) {
+		return nil, nil, uerror.NewNewPermissionDenied("cannot read", nil)
+	}
+
+	scope := fmt.Sprintf("invite_link:%v", communityID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	links, next, err := co.inviteLinkService.ListByRole(c, lo.Map(roles, func(role dmodel.Role, _ int) uuid.UUID { return role.ID }), *dRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uLinks := []umodel.InviteLink{}
+	for _, link := range links {
+		role, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID == link.RoleID })
+		if !ok {
+			return nil, nil, uerror.NewNotFound("role not found", nil)
+		}
+
+		uLinks = append(uLinks, toInviteLink(link, role))
+	}
+
+	return uLinks, encodeCursor(scope, next), nil
+}
+
+// DeleteInviteLink implements CommunityUsecase.
+func (co *communityUsecase) DeleteInviteLink(c context.Context, communityID uuid.UUID, userID uuid.UUID, linkID uuid.UUID) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
+	if _, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles); err != nil {
+		return err
+	} else if !myRole.CanCreate(dmodel.ResourceMember) {
+		return uerror.NewNewPermissionDenied("cannot delete", nil)
+	}
+
+	link, err := co.inviteLinkService.Get(c, linkID)
+	if err != nil {
+		return err
+	} else if link == nil || !lo.ContainsBy(roles, func(role dmodel.Role) bool { return role.ID == link.RoleID }) {
+		return uerror.NewNotFound("invite link not found", nil)
+	}
+
+	return co.inviteLinkService.Delete(c, linkID)
+}
+
+// ListRole implements CommunityUsecase.
+func (co *communityUsecase) ListRole(c context.Context, communityID uuid.UUID, userID uuid.UUID) ([]umodel.Role, error) {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return nil, err
+	} else if community == nil {
+		return nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, err
+	}
+
+	uRoles := lo.Map(roles, func(role dmodel.Role, _ int) umodel.Role {
+		return umodel.Role{
+			ID:     role.ID,
+			Name:   role.Name.String(),
+			Action: role.Action.Strings(),
+		}
+	})
+
+	return uRoles, nil
+}
+
+// DeleteRole implements CommunityUsecase.
+func (co *communityUsecase) DeleteRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
+	if _, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID.String() == roleID.String() }); !ok {
+		return uerror.NewNotFound("role not found", nil)
+	}
+
+	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return err
+	} else if !myRole.CanDelete(dmodel.ResourceRole) {
+		return uerror.NewNewPermissionDenied("cannot delete", nil)
+	}
+
+	if err := co.roleService.Delete(c, roleID); err != nil {
+		return errors.Wrapf(err, "failed to delete role. id=%v", roleID.String())
+	}
+
+	if err := co.deleteIndexAndSaveActivity(c, myMember.ID, roleID, dmodel.ResourceRole); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateRole implements CommunityUsecase.
+func (co *communityUsecase) UpdateRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID, name string, action map[string][]string) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
+	if _, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID.String() == roleID.String() }); !ok {
+		return uerror.NewNotFound("role not found", nil)
+	}
+
+	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return err
+	}
+
+	if !myRole.CanUpdate(dmodel.ResourceRole) {
+		return uerror.NewNewPermissionDenied("cannot update", nil)
+	}
+
+	dRole, err := dfactory.NewRole(roleID.String(), name, action)
+	if err != nil {
+		return uerror.NewInvalidParameter("failed to parse role", err)
+	}
+
+	if err := co.roleService.Update(c, *dRole); err != nil {
+		return errors.Wrapf(err, "failed to update role. id=%v", dRole.ID.String())
+	}
+
+	if err := co.saveIndexAndActivity(c, myMember.ID, roleID, name, dmodel.ResourceRole, dmodel.OperationUpdate); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateRole implements CommunityUsecase.
+func (co *communityUsecase) CreateRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, name string, action map[string][]string) error {
+	community, roles, err := co.get(c, communityID)
+	if err != nil {
+		return err
+	} else if community == nil {
+		return uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
+	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if err != nil {
+		return err
+	}
+
+	if !myRole.CanCreate(dmodel.ResourceRole) {
+		return uerror.NewNewPermissionDenied("cannot create", nil)
+	}
+
+	roleID := uuid.New()
+	role, err := dfactory.NewRole(roleID.String(), name, action)
+	if err != nil {
+		return uerror.NewInvalidParameter("failed to parse role", err)
+	}
+
+	roleMention, err := dmodel.NewMention(communityID.String(), dmodel.ResourceCommunity.String())
+	if err != nil {
+		return err
+	}
+
+	if err := co.roleService.Create(c, *role, *roleMention); err != nil {
+		return errors.Wrapf(err, "failed to create role. id=%v", role.ID.String())
+	}
+
+	if err := co.saveIndexAndActivity(c, myMember.ID, roleID, name, dmodel.ResourceRole, dmodel.OperationCreate); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Get implements CommunityUsecase.
+func (co *communityUsecase) Get(c context.Context, id uuid.UUID) (*umodel.Community, error) {
+	community, _, err := co.get(c, id)
+	if err != nil {
+		return nil, err
+	} else if community == nil {
+		return nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	return co.toCommunity(*community), nil
+}
+
+// GetSummary implements CommunityUsecase.
+func (co *communityUsecase) GetSummary(c context.Context, id uuid.UUID, userID uuid.UUID) (*umodel.CommunitySummary, error) {
+	community, err := co.communityService.Get(c, id)
+	if err != nil {
+		return nil, err
+	} else if community == nil || community.Deleting() {
+		return nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	if err := co.checkReadableByUser(c, *community, userID); err != nil {
+		return nil, err
 	}
 
-	return uInvites, nil
+	summaries, err := co.toCommunitySummaries(c, []dmodel.Community{*community})
+	if err != nil {
+		return nil, err
+	}
+
+	return &summaries[0], nil
 }
 
-// Invite implements CommunityUsecase.
-func (co *communityUsecase) Invite(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID, mention []uuid.UUID, message *string) error {
-	community, roles, err := co.get(c, communityID)
+// ListPublic implements CommunityUsecase.
+func (co *communityUsecase) ListPublic(c context.Context, name *string, limit int, offset int, cursor *string) ([]umodel.CommunitySummary, *string, error) {
+	scope := "community"
+	dRange, err := newRange(scope, limit, offset, cursor)
 	if err != nil {
-		return err
-	} else if community == nil {
-		return uerror.NewNotFound("community not found", nil)
+		return nil, nil, err
 	}
 
-	if _, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID.String() == roleID.String() }); !ok {
-		return uerror.NewNotFound("role not found", nil)
+	communities, next, err := co.communityService.ListPublic(c, name, *dRange)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if _, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles); err != nil {
-		return err
-	} else if !myRole.CanCreate(dmodel.ResourceMember) {
-		return uerror.NewNewPermissionDenied("cannot create", nil)
+	summaries, err := co.toCommunitySummaries(c, communities)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	for _, mentionedUserID := range mention {
-		if invite, err := co.inviteService.GetByRoleAndUser(c, roleID, mentionedUserID); err != nil {
-			return err
-		} else if invite != nil {
-			return uerror.NewAlreadyExists(fmt.Sprintf("invite already exists. user_id=%v", mentionedUserID.String()), nil)
+	return summaries, encodeCursor(scope, next), nil
+}
+
+// ListJoined implements CommunityUsecase.
+func (co *communityUsecase) ListJoined(c context.Context, userID uuid.UUID) ([]umodel.CommunitySummary, error) {
+	members, err := co.memberService.ListByUser(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	communityIDs := []uuid.UUID{}
+	for _, member := range members {
+		communityID, err := co.memberService.GetJoinedCommunityID(c, member.ID)
+		if err != nil {
+			return nil, err
+		} else if communityID == nil {
+			continue
 		}
+
+		communityIDs = append(communityIDs, *communityID)
 	}
 
-	if member, err := co.memberService.GetByCommunityAndUser(c, communityID, userID); err != nil {
-		return err
-	} else if member != nil {
-		return uerror.NewAlreadyExists(fmt.Sprintf("member already exists. user_id=%v", userID.String()), nil)
+	if len(communityIDs) == 0 {
+		return []umodel.CommunitySummary{}, nil
 	}
 
-	inviteID := uuid.NewString()
-	dInvite, err := dfactory.NewInvite(inviteID, roleID.String(), message, time.Now(),
-		lo.Map(mention, func(userID uuid.UUID, _ int) string { return userID.String() }))
+	communities, err := co.communityService.List(c, lo.Uniq(communityIDs))
 	if err != nil {
-		return uerror.NewInvalidParameter("failed to parse invite", err)
+		return nil, err
 	}
 
-	if err := co.inviteService.Create(c, *dInvite); err != nil {
-		return errors.Wrapf(err, "failed to create invite. community_id=%v role_id=%v", communityID.String(), roleID.String())
-	}
+	communities = lo.Filter(communities, func(community dmodel.Community, _ int) bool { return !community.Deleting() })
 
-	return nil
+	return co.toCommunitySummaries(c, communities)
 }
 
-// ListRole implements CommunityUsecase.
-func (co *communityUsecase) ListRole(c context.Context, communityID uuid.UUID) ([]umodel.Role, error) {
-	community, roles, err := co.get(c, communityID)
+// CanUpdate implements CommunityUsecase.
+func (co *communityUsecase) CanUpdate(c context.Context, id uuid.UUID, userID uuid.UUID) (*umodel.Community, error) {
+	community, roles, err := co.get(c, id)
 	if err != nil {
 		return nil, err
 	} else if community == nil {
 		return nil, uerror.NewNotFound("community not found", nil)
 	}
 
-	uRoles := lo.Map(roles, func(role dmodel.Role, _ int) umodel.Role {
-		return umodel.Role{
-			ID:     role.ID,
-			Name:   role.Name.String(),
-			Action: role.Action.Strings(),
-		}
-	})
+	if err := co.checkWritable(*community); err != nil {
+		return nil, err
+	}
 
-	return uRoles, nil
+	_, myRole, err := co.getMymemberAndRole(c, id, userID, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	if !myRole.CanUpdate(dmodel.ResourceCommunity) {
+		return nil, uerror.NewNewPermissionDenied("cannot update", nil)
+	}
+
+	return co.toCommunity(*community), nil
 }
 
-// DeleteRole implements CommunityUsecase.
-func (co *communityUsecase) DeleteRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID) error {
-	community, roles, err := co.get(c, communityID)
+// Update implements CommunityUsecase.
+func (co *communityUsecase) Update(c context.Context, id uuid.UUID, userID uuid.UUID, name string, visibility *string) error {
+	parsedName, err := dmodel.NewName(name)
+	if err != nil {
+		return uerror.NewInvalidParameter("failed to parse name", err)
+	}
+
+	var parsedVisibility *dmodel.CommunityVisibility
+	if visibility != nil {
+		parsedVisibility, err = dmodel.NewCommunityVisibility(*visibility)
+		if err != nil {
+			return uerror.NewInvalidParameter("failed to parse visibility", err)
+		}
+	}
+
+	community, roles, err := co.get(c, id)
 	if err != nil {
 		return err
 	} else if community == nil {
 		return uerror.NewNotFound("community not found", nil)
 	}
 
-	if _, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID.String() == roleID.String() }); !ok {
-		return uerror.NewNotFound("role not found", nil)
+	if err := co.checkWritable(*community); err != nil {
+		return err
 	}
 
-	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	myMember, myRole, err := co.getMymemberAndRole(c, id, userID, roles)
 	if err != nil {
 		return err
-	} else if !myRole.CanDelete(dmodel.ResourceRole) {
-		return uerror.NewNewPermissionDenied("cannot delete", nil)
 	}
 
-	if err := co.roleService.Delete(c, roleID); err != nil {
-		return errors.Wrapf(err, "failed to delete role. id=%v", roleID.String())
+	if !myRole.CanUpdate(dmodel.ResourceCommunity) {
+		return uerror.NewNewPermissionDenied("cannot update", nil)
 	}
 
-	if err := co.deleteIndexAndSaveActivity(c, myMember.ID, roleID, dmodel.ResourceRole); err != nil {
+	community.Name = *parsedName
+	if parsedVisibility != nil {
+		community.Visibility = *parsedVisibility
+	}
+
+	if err := co.communityService.Update(c, *community); err != nil {
+		return errors.Wrapf(err, "failed to update community. id=%v", community.ID.String())
+	}
+
+	if err := co.saveIndexAndActivity(c, myMember.ID, community.ID, name, dmodel.ResourceCommunity, dmodel.OperationUpdate); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// UpdateRole implements CommunityUsecase.
-func (co *communityUsecase) UpdateRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, roleID uuid.UUID, name string, action map[string][]string) error {
-	community, roles, err := co.get(c, communityID)
+// Archive implements CommunityUsecase.
+func (co *communityUsecase) Archive(c context.Context, id uuid.UUID, userID uuid.UUID, archived bool) error {
+	community, myMember, err := co.getAdministrable(c, id, userID)
 	if err != nil {
 		return err
-	} else if community == nil {
-		return uerror.NewNotFound("community not found", nil)
 	}
 
-	if _, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID.String() == roleID.String() }); !ok {
-		return uerror.NewNotFound("role not found", nil)
+	status := dmodel.CommunityStatusActive
+	if archived {
+		status = dmodel.CommunityStatusArchived
 	}
 
-	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	if community.Status == status {
+		return nil
+	}
+
+	community.Status = status
+	if err := co.communityService.Update(c, *community); err != nil {
+		return errors.Wrapf(err, "failed to update community. id=%v", community.ID.String())
+	}
+
+	if err := co.saveMemberActivity(c, myMember.ID, community.ID, dmodel.ResourceCommunity, dmodel.OperationUpdate); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TransferOwnership implements CommunityUsecase.
+func (co *communityUsecase) TransferOwnership(c context.Context, id uuid.UUID, userID uuid.UUID, memberID uuid.UUID) error {
+	community, myMember, err := co.getAdministrable(c, id, userID)
 	if err != nil {
 		return err
 	}
 
-	if !myRole.CanUpdate(dmodel.ResourceRole) {
-		return uerror.NewNewPermissionDenied("cannot update", nil)
+	if err := co.checkWritable(*community); err != nil {
+		return err
 	}
 
-	dRole, err := dfactory.NewRole(roleID.String(), name, action)
+	member, err := co.getMember(c, id, memberID)
 	if err != nil {
-		return uerror.NewInvalidParameter("failed to parse role", err)
+		return err
+	} else if member.ID == myMember.ID {
+		return nil
 	}
 
-	if err := co.roleService.Update(c, *dRole); err != nil {
-		return errors.Wrapf(err, "failed to update role. id=%v", dRole.ID.String())
+	// 譲渡先にはオーナーと同じロールを付与する。元のオーナーのロールはそのまま残す
+	if member.RoleID != myMember.RoleID {
+		if _, err := co.memberService.UpdateRole(c, member.ID, myMember.RoleID, nil); err != nil {
+			return errors.Wrapf(err, "failed to update member role. id=%v", member.ID.String())
+		}
 	}
 
-	if err := co.saveIndexAndActivity(c, myMember.ID, roleID, name, dmodel.ResourceRole, dmodel.OperationUpdate); err != nil {
+	community.OwnerID = &member.ID
+	if err := co.communityService.Update(c, *community); err != nil {
+		return errors.Wrapf(err, "failed to update community. id=%v", community.ID.String())
+	}
+
+	if err := co.saveMemberActivity(c, myMember.ID, member.ID, dmodel.ResourceMember, dmodel.OperationUpdate); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// CreateRole implements CommunityUsecase.
-func (co *communityUsecase) CreateRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, name string, action map[string][]string) error {
-	community, roles, err := co.get(c, communityID)
+// Delete implements CommunityUsecase.
+func (co *communityUsecase) Delete(c context.Context, id uuid.UUID, userID uuid.UUID) error {
+	community, _, err := co.getAdministrable(c, id, userID)
 	if err != nil {
 		return err
-	} else if community == nil {
-		return uerror.NewNotFound("community not found", nil)
 	}
 
-	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
+	// 削除は関連データが多いため、削除ジョブに登録して非同期に行う
+	// 状態の更新に失敗しても削除ジョブは実行されるよう、先に登録する
+	deletion := dmodel.CommunityDeletion{
+		CommunityID: community.ID,
+		Step:        dmodel.CommunityDeletionSteps[0],
+		At:          time.Now(),
+	}
+	if err := co.communityDeletionService.Save(c, deletion); err != nil {
+		return errors.Wrapf(err, "failed to save community deletion. id=%v", community.ID.String())
+	}
+
+	community.Status = dmodel.CommunityStatusDeleting
+	if err := co.communityService.Update(c, *community); err != nil {
+		return errors.Wrapf(err, "failed to update community. id=%v", community.ID.String())
+	}
+
+	return nil
+}
+
+// Create implements CommunityUsecase.
+func (co *communityUsecase) Create(c context.Context, userID uuid.UUID, name string, invitation bool, visibility string) (*uuid.UUID, error) {
+	communityID := uuid.New()
+	ownerID := uuid.New()
+	ownerIDString := ownerID.String()
+	community, err := dfactory.NewCommunity(communityID.String(), name, invitation, visibility, dmodel.CommunityStatusActive.String(), &ownerIDString)
 	if err != nil {
-		return err
+		return nil, uerror.NewInvalidParameter("failed to parse community", err)
 	}
 
-	if !myRole.CanCreate(dmodel.ResourceRole) {
-		return uerror.NewNewPermissionDenied("cannot create", nil)
+	if err := co.communityService.Create(c, *community); err != nil {
+		return nil, errors.Wrapf(err, "failed to create community. id=%v", community.ID.String())
 	}
 
-	roleID := uuid.New()
-	role, err := dfactory.NewRole(roleID.String(), name, action)
+	ownerRoleID := uuid.New()
+	ownerAction := dmodel.CommunityMemberAction
+	ownerRole, err := dfactory.NewRole(ownerRoleID.String(), name, ownerAction.Strings())
 	if err != nil {
-		return uerror.NewInvalidParameter("failed to parse role", err)
+		return nil, uerror.NewInvalidParameter("failed to parse role", err)
 	}
 
-	roleMention, err := dmodel.NewMention(communityID.String(), dmodel.ResourceCommunity.String())
+	communityMention, err := dmodel.NewMention(communityID.String(), dmodel.ResourceCommunity.String())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := co.roleService.Create(c, *role, *roleMention); err != nil {
-		return errors.Wrapf(err, "failed to create role. id=%v", role.ID.String())
+	if err := co.roleService.Create(c, *ownerRole, *communityMention); err != nil {
+		return nil, errors.Wrapf(err, "failed to create role. id=%v", ownerRole.ID.String())
 	}
 
-	if err := co.saveIndexAndActivity(c, myMember.ID, roleID, name, dmodel.ResourceRole, dmodel.OperationCreate); err != nil {
-		return err
+	owner, err := dfactory.NewMember(ownerID.String(), userID.String(), ownerRoleID.String())
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse member", err)
 	}
 
-	return nil
-}
+	if err := co.memberService.Create(c, *owner, *communityMention); err != nil {
+		return nil, errors.Wrapf(err, "failed to create member. id=%v", owner.ID.String())
+	}
 
-// Get implements CommunityUsecase.
-func (co *communityUsecase) Get(c context.Context, id uuid.UUID) (*umodel.Community, error) {
-	community, _, err := co.get(c, id)
+	descriptionID := uuid.NewString()
+	description, err := dfactory.NewNote(descriptionID)
 	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse description", err)
+	}
+
+	if err := co.noteService.Create(c, *description, *communityMention); err != nil {
+		return nil, errors.Wrapf(err, "failed to create description. id=%v", description.ID.String())
+	}
+
+	if err := co.saveIndexAndActivity(c, ownerID, communityID, name, dmodel.ResourceCommunity, dmodel.OperationCreate); err != nil {
 		return nil, err
-	} else if community == nil {
-		return nil, uerror.NewNotFound("community not found", nil)
 	}
 
+	return &community.ID, nil
+}
+
+func (co *communityUsecase) toCommunity(community dmodel.Community) *umodel.Community {
 	return &umodel.Community{
-		ID:   community.ID,
-		Name: community.Name.String(),
-	}, nil
+		ID:         community.ID,
+		Name:       community.Name.String(),
+		Invitation: community.Invitation,
+		Visibility: community.Visibility.String(),
+		Status:     community.Status.String(),
+	}
 }
 
-// CanUpdate implements CommunityUsecase.
-func (co *communityUsecase) CanUpdate(c context.Context, id uuid.UUID, userID uuid.UUID) (*umodel.Community, error) {
-	community, roles, err := co.get(c, id)
+func (co *communityUsecase) toCommunitySummaries(c context.Context, communities []dmodel.Community) ([]umodel.CommunitySummary, error) {
+	if len(communities) == 0 {
+		return []umodel.CommunitySummary{}, nil
+	}
+
+	counts, err := co.memberService.CountByCommunities(c, lo.Map(communities, func(community dmodel.Community, _ int) uuid.UUID { return community.ID }))
 	if err != nil {
 		return nil, err
-	} else if community == nil {
-		return nil, uerror.NewNotFound("community not found", nil)
 	}
 
-	_, myRole, err := co.getMymemberAndRole(c, id, userID, roles)
+	return lo.Map(communities, func(community dmodel.Community, _ int) umodel.CommunitySummary {
+		return umodel.CommunitySummary{
+			Community:   *co.toCommunity(community),
+			MemberCount: counts[community.ID],
+		}
+	}), nil
+}
+
+func (co *communityUsecase) get(c context.Context, id uuid.UUID) (*dmodel.Community, []dmodel.Role, error) {
+	community, err := co.communityService.Get(c, id)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	} else if community == nil || community.Deleting() {
+		return nil, nil, nil
 	}
 
-	if !myRole.CanUpdate(dmodel.ResourceCommunity) {
-		return nil, uerror.NewNewPermissionDenied("cannot update", nil)
+	roles, err := co.roleService.ListByCommunity(c, community.ID)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return &umodel.Community{
-		ID:   community.ID,
-		Name: community.Name.String(),
-	}, nil
+	return community, roles, nil
 }
 
-// Update implements CommunityUsecase.
-func (co *communityUsecase) Update(c context.Context, id uuid.UUID, userID uuid.UUID, name string) error {
-	parsedName, err := dmodel.NewName(name)
+func (co *communityUsecase) getMymemberAndRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, roles []dmodel.Role) (*dmodel.Member, *dmodel.Role, error) {
+	member, err := co.memberService.GetByCommunityAndUser(c, communityID, userID)
 	if err != nil {
-		return uerror.NewInvalidParameter("failed to parse name", err)
+		return nil, nil, err
+	} else if member == nil {
+		return nil, nil, uerror.NewNewPermissionDenied("member not found", nil)
+	}
+
+	role, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID.String() == member.RoleID.String() })
+	if !ok {
+		return nil, nil, uerror.NewNewPermissionDenied("role not found", nil)
 	}
 
+	return member, &role, nil
+}
+
+// アーカイブ・削除・オーナーの譲渡を行えるメンバーのみを対象にする
+func (co *communityUsecase) getAdministrable(c context.Context, id uuid.UUID, userID uuid.UUID) (*dmodel.Community, *dmodel.Member, error) {
 	community, roles, err := co.get(c, id)
 	if err != nil {
-		return err
+		return nil, nil, err
 	} else if community == nil {
+		return nil, nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	myMember, myRole, err := co.getMymemberAndRole(c, id, userID, roles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !community.CanAdminister(*myMember, *myRole) {
+		return nil, nil, uerror.NewNewPermissionDenied("only owner can administer community", nil)
+	}
+
+	return community, myMember, nil
+}
+
+// アーカイブされたコミュニティは読み取り専用にする
+func (co *communityUsecase) checkWritable(community dmodel.Community) error {
+	if !community.Writable() {
+		return uerror.NewNewPermissionDenied(fmt.Sprintf("community is not writable. status=%v", community.Status.String()), nil)
+	}
+
+	return nil
+}
+
+// 非公開コミュニティはメンバー以外には存在自体を明かさない
+func (co *communityUsecase) checkReadable(community dmodel.Community, member *dmodel.Member) error {
+	if !community.Visible() && member == nil {
 		return uerror.NewNotFound("community not found", nil)
 	}
 
-	myMember, myRole, err := co.getMymemberAndRole(c, id, userID, roles)
+	return nil
+}
+
+func (co *communityUsecase) checkReadableByUser(c context.Context, community dmodel.Community, userID uuid.UUID) error {
+	if community.Visible() {
+		return nil
+	}
+
+	member, err := co.memberService.GetByCommunityAndUser(c, community.ID, userID)
 	if err != nil {
 		return err
 	}
 
-	if !myRole.CanUpdate(dmodel.ResourceCommunity) {
-		return uerror.NewNewPermissionDenied("cannot update", nil)
-	}
+	return co.checkReadable(community, member)
+}
 
-	community.Name = *parsedName
-	if err := co.communityService.Update(c, *community); err != nil {
+// 引用元のポストが存在することを確認する
+func (co *communityUsecase) checkQuotedPosts(c context.Context, contents []dmodel.Content) error {
+	for _, content := range contents {
+		postID, err := content.QuotedPostID()
+		if err != nil {
+			return uerror.NewInvalidParameter("failed to parse quote", err)
+		} else if postID == nil {
+			continue
+		}
 
+		if post, err := co.postService.Get(c, *postID); err != nil {
+			return err
+		} else if post == nil {
+			return uerror.NewInvalidParameter(fmt.Sprintf("quoted post not found. post_id=%v", postID.String()), nil)
+		}
 	}
 
-	if err := co.saveIndexAndActivity(c, myMember.ID, community.ID, name, dmodel.ResourceCommunity, dmodel.OperationUpdate); err != nil {
+	return nil
+}
+
+// コミュニティに所属するトピックのみを対象にする
+func (co *communityUsecase) checkTopic(c context.Context, communityID uuid.UUID, topicID uuid.UUID) error {
+	topicCommunityID, err := co.topicService.GetCommunityID(c, topicID)
+	if err != nil {
 		return err
+	} else if topicCommunityID == nil || *topicCommunityID != communityID {
+		return uerror.NewNotFound(fmt.Sprintf("topic not found. id=%v", topicID.String()), nil)
 	}
 
 	return nil
 }
 
-// Create implements CommunityUsecase.
-func (co *communityUsecase) Create(c context.Context, userID uuid.UUID, name string, invitation bool) (*uuid.UUID, error) {
-	communityID := uuid.New()
-	community, err := dfactory.NewCommunity(communityID.String(), name, invitation)
-	if err != nil {
-		return nil, uerror.NewInvalidParameter("failed to parse community", err)
+// オーナーは譲渡するまで抜けたり外されたりできない
+func (co *communityUsecase) checkOwner(community dmodel.Community, member dmodel.Member) error {
+	if community.IsOwner(member.ID) {
+		return uerror.NewNewPermissionDenied("owner must transfer ownership first", nil)
 	}
 
-	if err := co.communityService.Create(c, *community); err != nil {
-		return nil, errors.Wrapf(err, "failed to create community. id=%v", community.ID.String())
-	}
+	return nil
+}
 
-	ownerRoleID := uuid.New()
-	ownerAction := dmodel.CommunityMemberAction
-	ownerRole, err := dfactory.NewRole(ownerRoleID.String(), name, ownerAction.Strings())
+// コミュニティに所属するメンバーのみを対象にする
+func (co *communityUsecase) getMember(c context.Context, communityID uuid.UUID, memberID uuid.UUID) (*dmodel.Member, error) {
+	member, err := co.memberService.Get(c, memberID)
 	if err != nil {
-		return nil, uerror.NewInvalidParameter("failed to parse role", err)
+		return nil, err
+	} else if member == nil {
+		return nil, uerror.NewNotFound("member not found", nil)
 	}
 
-	communityMention, err := dmodel.NewMention(communityID.String(), dmodel.ResourceCommunity.String())
+	joinedCommunityID, err := co.memberService.GetJoinedCommunityID(c, member.ID)
 	if err != nil {
 		return nil, err
+	} else if joinedCommunityID == nil || *joinedCommunityID != communityID {
+		return nil, uerror.NewNotFound("member not found", nil)
 	}
 
-	if err := co.roleService.Create(c, *ownerRole, *communityMention); err != nil {
-		return nil, errors.Wrapf(err, "failed to create role. id=%v", ownerRole.ID.String())
-	}
-
-	ownerID := uuid.New()
-	owner, err := dfactory.NewMember(ownerID.String(), userID.String(), ownerRoleID.String())
-	if err != nil {
-		return nil, uerror.NewInvalidParameter("failed to parse member", err)
-	}
+	return member, nil
+}
 
-	if err := co.memberService.Create(c, *owner, *communityMention); err != nil {
-		return nil, errors.Wrapf(err, "failed to create member. id=%v", owner.ID.String())
+// 自分のロールを超える権限は与えられない
+func (co *communityUsecase) checkGrantable(myRole dmodel.Role, role dmodel.Role) error {
+	if !myRole.Includes(role) {
+		return uerror.NewNewPermissionDenied("cannot grant role beyond own role", nil)
 	}
 
-	descriptionID := uuid.NewString()
-	description, err := dfactory.NewNote(descriptionID)
-	if err != nil {
-		return nil, uerror.NewInvalidParameter("failed to parse description", err)
-	}
+	return nil
+}
 
-	if err := co.noteService.Create(c, *description, *communityMention); err != nil {
-		return nil, errors.Wrapf(err, "failed to create description. id=%v", description.ID.String())
+// オーナーと同じロールかどうか
+func (co *communityUsecase) isOwnerRole(c context.Context, community dmodel.Community, role dmodel.Role) (bool, error) {
+	if community.OwnerID == nil {
+		return false, nil
 	}
 
-	if err := co.saveIndexAndActivity(c, ownerID, communityID, name, dmodel.ResourceCommunity, dmodel.OperationCreate); err != nil {
-		return nil, err
+	owner, err := co.memberService.Get(c, *community.OwnerID)
+	if err != nil {
+		return false, err
 	}
 
-	return &community.ID, nil
+	return owner != nil && owner.RoleID == role.ID, nil
 }
 
-func (co *communityUsecase) get(c context.Context, id uuid.UUID) (*dmodel.Community, []dmodel.Role, error) {
-	community, err := co.communityService.Get(c, id)
-	if err != nil {
-		return nil, nil, err
-	} else if community == nil {
-		return nil, nil, nil
+// 自分のロールを超える権限を持つメンバーは外せない
+func (co *communityUsecase) checkSuperior(myRole dmodel.Role, member dmodel.Member, roles []dmodel.Role) error {
+	role, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID == member.RoleID })
+	if !ok {
+		return uerror.NewNotFound("role not found", nil)
 	}
 
-	roles, err := co.roleService.ListByCommunity(c, community.ID)
-	if err != nil {
-		return nil, nil, err
+	if !myRole.Includes(role) {
+		return uerror.NewNewPermissionDenied("cannot delete member beyond own role", nil)
 	}
 
-	return community, roles, nil
+	return nil
 }
 
-func (co *communityUsecase) getMymemberAndRole(c context.Context, communityID uuid.UUID, userID uuid.UUID, roles []dmodel.Role) (*dmodel.Member, *dmodel.Role, error) {
-	member, err := co.memberService.GetByCommunityAndUser(c, communityID, userID)
-	if err != nil {
-		return nil, nil, err
-	} else if member == nil {
-		return nil, nil, uerror.NewNewPermissionDenied("member not found", nil)
+// コミュニティを更新できるロール
+func (co *communityUsecase) managerRoleIDs(roles []dmodel.Role) []uuid.UUID {
+	return lo.FilterMap(roles, func(role dmodel.Role, _ int) (uuid.UUID, bool) {
+		return role.ID, role.CanUpdate(dmodel.ResourceCommunity)
+	})
+}
+
+// コミュニティを更新できる最後のメンバーは抜けたり外されたりできない
+func (co *communityUsecase) deleteMember(c context.Context, myMemberID uuid.UUID, member dmodel.Member, roles []dmodel.Role) error {
+	if ok, err := co.memberService.Delete(c, member.ID, co.managerRoleIDs(roles)); err != nil {
+		return errors.Wrapf(err, "failed to delete member. id=%v", member.ID.String())
+	} else if !ok {
+		return uerror.NewNewPermissionDenied("last member who can update community", nil)
 	}
 
-	role, ok := lo.Find(roles, func(role dmodel.Role) bool { return role.ID.String() == member.RoleID.String() })
-	if !ok {
-		return nil, nil, uerror.NewNewPermissionDenied("role not found", nil)
+	if err := co.saveMemberActivity(c, myMemberID, member.ID, dmodel.ResourceMember, dmodel.OperationDelete); err != nil {
+		return err
 	}
 
-	return member, &role, nil
+	return nil
 }
 
 func (co *communityUsecase) toMember(c context.Context, roles *[]dmodel.Role, users *[]dmodel.User, member *dmodel.Member) (*umodel.Member, error) {
@@ -828,12 +2702,14 @@ func (co *communityUsecase) toMember(c context.Context, roles *[]dmodel.Role, us
 				return nil, uerror.NewNotFound(fmt.Sprintf("user not found. id=%v", id), nil)
 			}
 		} else {
-			user, err := co.userService.Get(c, id)
+			dUser, err := co.userService.Get(c, id)
 			if err != nil {
 				return nil, err
-			} else if user == nil {
+			} else if dUser == nil {
 				return nil, uerror.NewNotFound(fmt.Sprintf("user not found. id=%v", id), nil)
 			}
+
+			user = *dUser
 		}
 
 		var imageURL *string
@@ -865,12 +2741,14 @@ func (co *communityUsecase) toMember(c context.Context, roles *[]dmodel.Role, us
 				return nil, nil
 			}
 		} else {
-			role, err := co.roleService.Get(c, id)
+			dRole, err := co.roleService.Get(c, id)
 			if err != nil {
 				return nil, err
-			} else if role == nil {
+			} else if dRole == nil {
 				return nil, nil
 			}
+
+			role = *dRole
 		}
 
 		return &umodel.Role{
@@ -891,13 +2769,8 @@ func (co *communityUsecase) toMember(c context.Context, roles *[]dmodel.Role, us
 	}, nil
 }
 
-func (co *communityUsecase) toPost(c context.Context, post dmodel.Post, roles []dmodel.Role) (*umodel.Post, error) {
-	dContents, err := co.contentService.ListByPost(c, post.ID)
-	if err != nil {
-		return nil, err
-	}
-
-	uContents := lo.Map(dContents, func(dContent dmodel.Content, _ int) umodel.Content {
+func (co *communityUsecase) toPost(c context.Context, l *loader, post dmodel.Post, roles []dmodel.Role) (*umodel.Post, error) {
+	uContents := lo.Map(l.PostContents(post.ID), func(dContent dmodel.Content, _ int) umodel.Content {
 		return umodel.Content{
 			Type: dContent.Type.String(),
 			Bin:  dContent.Value,
@@ -906,14 +2779,12 @@ func (co *communityUsecase) toPost(c context.Context, post dmodel.Post, roles []
 
 	var uCreated *umodel.Member
 	if post.From != nil {
-		dMember, err := co.memberService.Get(c, *post.From)
-		if err != nil {
-			return nil, err
-		} else if dMember == nil {
+		dMember, ok := l.Member(*post.From)
+		if !ok {
 			return nil, uerror.NewNotFound(fmt.Sprintf("member not found. id=%v", post.From.String()), nil)
 		}
 
-		created, err := co.toMember(c, &roles, nil, dMember)
+		created, err := co.toMember(c, &roles, l.Users(), dMember)
 		if err != nil {
 			return nil, err
 		}
@@ -921,20 +2792,7 @@ func (co *communityUsecase) toPost(c context.Context, post dmodel.Post, roles []
 		uCreated = created
 	}
 
-	likeMention, err := dmodel.NewMention(post.ID.String(), dmodel.ResourcePost.String())
-	if err != nil {
-		return nil, err
-	}
-
-	likes, err := co.activityService.CountMemberLikeActivity(c, *likeMention, true)
-	if err != nil {
-		return nil, err
-	}
-
-	dislikes, err := co.activityService.CountMemberLikeActivity(c, *likeMention, false)
-	if err != nil {
-		return nil, err
-	}
+	likes, dislikes := l.Reaction(post.ID)
 
 	return &umodel.Post{
 		ID:       post.ID,
@@ -942,13 +2800,13 @@ func (co *communityUsecase) toPost(c context.Context, post dmodel.Post, roles []
 		Contents: uContents,
 		Created:  uCreated,
 		Reaction: umodel.Reaction{
-			Likes:    *likes,
-			Dislikes: *dislikes,
+			Likes:    likes,
+			Dislikes: dislikes,
 		},
 	}, nil
 }
 
-func (co *communityUsecase) post(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, threadID *uuid.UUID, contents []umodel.Content, mention []umodel.Mention, searchWord string) error {
+func (co *communityUsecase) post(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicID uuid.UUID, threadID *uuid.UUID, contents []umodel.Content, mention []umodel.Mention) error {
 	community, roles, err := co.get(c, communityID)
 	if err != nil {
 		return err
@@ -956,6 +2814,10 @@ func (co *communityUsecase) post(c context.Context, communityID uuid.UUID, userI
 		return uerror.NewNotFound("community not found", nil)
 	}
 
+	if err := co.checkWritable(*community); err != nil {
+		return err
+	}
+
 	var myMemberID *string
 	myMember, myRole, err := co.getMymemberAndRole(c, communityID, userID, roles)
 	if err != nil {
@@ -967,6 +2829,22 @@ func (co *communityUsecase) post(c context.Context, communityID uuid.UUID, userI
 		myMemberID = &v
 	}
 
+	// 内容が不正な場合にスレッドやポストだけが作られないよう、先に検証する
+	newContents := []dmodel.Content{}
+	for _, content := range contents {
+		newContentID := uuid.New()
+		newContent, err := dfactory.NewContent(newContentID.String(), content.Type, content.Bin)
+		if err != nil {
+			return uerror.NewInvalidParameter(fmt.Sprintf("failed to parse content. type=%v", content.Type), err)
+		}
+
+		newContents = append(newContents, *newContent)
+	}
+
+	if err := co.checkQuotedPosts(c, newContents); err != nil {
+		return err
+	}
+
 	if threadID == nil {
 		newThreadID := uuid.New()
 		dThread, err := dfactory.NewThread(newThreadID.String())
@@ -991,6 +2869,10 @@ func (co *communityUsecase) post(c context.Context, communityID uuid.UUID, userI
 		dMention = append(dMention, *dTo)
 	}
 
+	if err := co.checkMentionBlocked(c, userID, dMention); err != nil {
+		return err
+	}
+
 	newPostID := uuid.New()
 	dPost, err := dfactory.NewPost(newPostID.String(), myMemberID, dMention, int(time.Now().Unix()))
 	if err != nil {
@@ -1001,17 +2883,6 @@ func (co *communityUsecase) post(c context.Context, communityID uuid.UUID, userI
 		return errors.Wrapf(err, "failed to create post. topic_id=%v", topicID.String())
 	}
 
-	newContents := []dmodel.Content{}
-	for _, content := range contents {
-		newContentID := uuid.New()
-		newContent, err := dfactory.NewContent(newContentID.String(), content.Type, content.Bin)
-		if err != nil {
-			return uerror.NewInvalidParameter(fmt.Sprintf("failed to parse content. type=%v", content.Type), err)
-		}
-
-		newContents = append(newContents, *newContent)
-	}
-
 	newMention, err := dmodel.NewMention(newPostID.String(), dmodel.ResourcePost.String())
 	if err != nil {
 		return uerror.NewInvalidParameter("failed to parse mention", err)
@@ -1021,13 +2892,47 @@ func (co *communityUsecase) post(c context.Context, communityID uuid.UUID, userI
 		return errors.Wrapf(err, "failed to create content. community_id=%v member_id=%v topic_id=%v", communityID.String(), myMember.ID.String(), topicID.String())
 	}
 
-	if err := co.saveIndexAndActivity(c, myMember.ID, newPostID, searchWord, dmodel.ResourcePost, dmodel.OperationCreate); err != nil {
+	dIndex, err := dfactory.NewContentSearchIndex(newPostID.String(), dmodel.ResourcePost.String(), "", communityID.String(), lo.ToPtr(topicID.String()), lo.ToPtr(threadID.String()), myMemberID)
+	if err != nil {
+		return uerror.NewInvalidParameter("failed to parse resource search index", err)
+	}
+	dIndex.Contents = newContents
+
+	if err := co.saveContentIndexAndActivity(c, myMember.ID, *dIndex, dmodel.OperationCreate); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// ブロックの関係にあるユーザーのメンバーにはメンションできない
+func (co *communityUsecase) checkMentionBlocked(c context.Context, userID uuid.UUID, mention []dmodel.Mention) error {
+	memberIDs := lo.FilterMap(mention, func(to dmodel.Mention, _ int) (uuid.UUID, bool) {
+		return to.ID, to.Resource == dmodel.ResourceMember
+	})
+	if len(memberIDs) == 0 {
+		return nil
+	}
+
+	dMembers, err := co.memberService.List(c, memberIDs)
+	if err != nil {
+		return err
+	}
+
+	filter, err := newRelationFilter(c, co.userRelationService, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, dMember := range dMembers {
+		if filter.Hidden(dMember.UserID) {
+			return uerror.NewNewPermissionDenied(fmt.Sprintf("cannot mention blocked member. id=%v", dMember.ID.String()), nil)
+		}
+	}
+
+	return nil
+}
+
 func (co *communityUsecase) deleteIndexAndSaveActivity(c context.Context, memberID uuid.UUID, resourceID uuid.UUID, resource dmodel.Resource) error {
 	if err := co.deleteIndex(c, resourceID); err != nil {
 		return nil
@@ -1061,6 +2966,24 @@ func (co *communityUsecase) saveIndexAndActivity(c context.Context, memberID uui
 	return nil
 }
 
+// 本文は索引を作る側で内容から取り出すため、内容ごと保存する
+func (co *communityUsecase) saveContentIndexAndActivity(c context.Context, memberID uuid.UUID, index dmodel.ResourceSearchIndex, operation dmodel.Operation) error {
+	saveIndex := co.resourceSearchIndexService.Update
+	if operation == dmodel.OperationCreate {
+		saveIndex = co.resourceSearchIndexService.Create
+	}
+
+	if err := saveIndex(c, index); err != nil {
+		return nil
+	}
+
+	if err := co.saveMemberActivity(c, memberID, index.ResourceID, index.Type, operation); err != nil {
+		return nil
+	}
+
+	return nil
+}
+
 func (co *communityUsecase) createIndex(c context.Context, resourceID uuid.UUID, name string, resource dmodel.Resource) error {
 	dIndex, err := dfactory.NewResourceSearchIndex(resourceID.String(), resource.String(), name)
 	if err != nil {
@@ -1095,6 +3018,96 @@ func (co *communityUsecase) deleteIndex(c context.Context, resourceID uuid.UUID)
 	return nil
 }
 
+// まだログインしたことがないユーザーにメールで招待を知らせる
+func (co *communityUsecase) sendInviteMails(c context.Context, userID uuid.UUID, community dmodel.Community, role dmodel.Role, invite dmodel.Invite) error {
+	if len(invite.Emails) == 0 {
+		return nil
+	}
+
+	inviter, err := co.userService.Get(c, userID)
+	if err != nil {
+		return err
+	} else if inviter == nil {
+		return uerror.NewNotFound("user not found", nil)
+	}
+
+	subject := fmt.Sprintf("コミュニティ「%v」に招待されました", community.Name.String())
+	body := fmt.Sprintf("%vさんがあなたをコミュニティ「%v」のロール「%v」に招待しました。\nこのメールアドレスでログインすると招待を確認できます。\n", inviter.Name.String(), community.Name.String(), role.Name.String())
+	if invite.Message != nil {
+		body += fmt.Sprintf("\n%v\n", invite.Message.String())
+	}
+	if invite.ExpireAt != nil {
+		body += fmt.Sprintf("\n招待の有効期限: %v\n", invite.ExpireAt.Format(time.RFC3339))
+	}
+
+	description, err := co.renderDescription(c, community.ID)
+	if err != nil {
+		return err
+	}
+
+	htmlBody := lrender.Document(subject, "<p>"+strings.ReplaceAll(html.EscapeString(strings.TrimSpace(body)), "\n", "<br>")+"</p>\n<hr>\n"+description)
+
+	for _, email := range invite.Emails {
+		dMail, err := dfactory.NewMail(email.String(), subject, body, htmlBody)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse mail. to=%v", email.String())
+		}
+
+		if err := co.mailService.Send(c, *dMail); err != nil {
+			return errors.Wrapf(err, "failed to send invite mail. id=%v", invite.ID.String())
+		}
+	}
+
+	return nil
+}
+
+// 招待メールに載せるため、コミュニティの説明を HTML にする
+func (co *communityUsecase) renderDescription(c context.Context, communityID uuid.UUID) (string, error) {
+	mention, err := dmodel.NewMention(communityID.String(), dmodel.ResourceCommunity.String())
+	if err != nil {
+		return "", err
+	}
+
+	dNote, err := co.noteService.GetbyResource(c, *mention)
+	if err != nil {
+		return "", err
+	} else if dNote == nil {
+		return "", nil
+	}
+
+	dLines, err := co.noteService.ListLines(c, dNote.ID)
+	if err != nil {
+		return "", err
+	}
+
+	lines := []lrender.Line{}
+	for _, dLine := range dLines {
+		dContents, err := co.contentService.ListByLine(c, dLine.ID)
+		if err != nil {
+			return "", err
+		}
+
+		property := ""
+		if dLine.Property != nil {
+			property = dLine.Property.Type.String()
+		}
+
+		lines = append(lines, lrender.Line{
+			Property: property,
+			Contents: lo.Map(dContents, func(dContent dmodel.Content, _ int) lrender.Content {
+				return lrender.Content{Type: dContent.Type.String(), Bin: dContent.Value}
+			}),
+		})
+	}
+
+	description, err := lrender.Lines(lines)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to render description. id=%v", communityID.String())
+	}
+
+	return description, nil
+}
+
 func (co *communityUsecase) saveMemberActivity(c context.Context, memberID uuid.UUID, resourceID uuid.UUID, resource dmodel.Resource, operation dmodel.Operation) error {
 	dActivity, err := dfactory.NewMemberActivity(time.Now(), memberID.String(), resourceID.String(), resource.String(), operation.String())
 	if err != nil {
@@ -1108,6 +3121,64 @@ func (co *communityUsecase) saveMemberActivity(c context.Context, memberID uuid.
 	return nil
 }
 
+// メンバーでない場合は未読数を算出しない
+func (co *communityUsecase) countUnreadPosts(c context.Context, communityID uuid.UUID, userID uuid.UUID, threadIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	member, err := co.memberService.GetByCommunityAndUser(c, communityID, userID)
+	if err != nil {
+		return nil, err
+	} else if member == nil || len(threadIDs) == 0 {
+		return map[uuid.UUID]int{}, nil
+	}
+
+	markers, err := co.readMarkerService.ListThreadByMember(c, member.ID, threadIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	after := map[uuid.UUID]dmodel.UnixTime{}
+	for _, threadID := range threadIDs {
+		after[threadID] = markers[threadID].At
+	}
+
+	counts, err := co.postService.CountAfterByThreads(c, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.SliceToMap(threadIDs, func(threadID uuid.UUID) (uuid.UUID, int) { return threadID, counts[threadID] }), nil
+}
+
+// メンバーでない場合は未読数を算出しない
+func (co *communityUsecase) countUnreadThreads(c context.Context, communityID uuid.UUID, userID uuid.UUID, topicIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	member, err := co.memberService.GetByCommunityAndUser(c, communityID, userID)
+	if err != nil {
+		return nil, err
+	} else if member == nil || len(topicIDs) == 0 {
+		return map[uuid.UUID]int{}, nil
+	}
+
+	markers, err := co.readMarkerService.ListTopicByMember(c, member.ID, topicIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	after := map[uuid.UUID]dmodel.UnixTime{}
+	for _, topicID := range topicIDs {
+		after[topicID] = markers[topicID].At
+	}
+
+	counts, err := co.postService.CountThreadAfterByTopics(c, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.SliceToMap(topicIDs, func(topicID uuid.UUID) (uuid.UUID, int) { return topicID, counts[topicID] }), nil
+}
+
+func (co *communityUsecase) newLoader() *loader {
+	return newLoader(co.memberService, co.userService, co.contentService, co.activityService)
+}
+
 func NewCommunityUsecase(i *do.Injector) (CommunityUsecase, error) {
 	roleService := do.MustInvoke[dservice.RoleService](i)
 	memberService := do.MustInvoke[dservice.MemberService](i)
@@ -1116,11 +3187,19 @@ func NewCommunityUsecase(i *do.Injector) (CommunityUsecase, error) {
 	resourceSearchIndexService := do.MustInvoke[dservice.ResourceSearchIndexService](i)
 	activityService := do.MustInvoke[dservice.ActivityService](i)
 	inviteService := do.MustInvoke[dservice.InviteService](i)
+	inviteLinkService := do.MustInvoke[dservice.InviteLinkService](i)
+	mailService := do.MustInvoke[dservice.MailService](i)
 	userService := do.MustInvoke[dservice.UserService](i)
 	topicService := do.MustInvoke[dservice.TopicService](i)
 	threadService := do.MustInvoke[dservice.ThreadService](i)
 	postService := do.MustInvoke[dservice.PostService](i)
 	contentService := do.MustInvoke[dservice.ContentService](i)
+	readMarkerService := do.MustInvoke[dservice.ReadMarkerService](i)
+	userRelationService := do.MustInvoke[dservice.UserRelationService](i)
+	banService := do.MustInvoke[dservice.BanService](i)
+	communityDeletionService := do.MustInvoke[dservice.CommunityDeletionService](i)
+	pageService := do.MustInvoke[dservice.PageService](i)
+	templateService := do.MustInvoke[dservice.TemplateService](i)
 	return &communityUsecase{
 		roleService:                roleService,
 		memberService:              memberService,
@@ -1129,10 +3208,18 @@ func NewCommunityUsecase(i *do.Injector) (CommunityUsecase, error) {
 		resourceSearchIndexService: resourceSearchIndexService,
 		activityService:            activityService,
 		inviteService:              inviteService,
+		inviteLinkService:          inviteLinkService,
+		mailService:                mailService,
 		userService:                userService,
 		topicService:               topicService,
 		threadService:              threadService,
 		postService:                postService,
 		contentService:             contentService,
+		readMarkerService:          readMarkerService,
+		userRelationService:        userRelationService,
+		banService:                 banService,
+		communityDeletionService:   communityDeletionService,
+		pageService:                pageService,
+		templateService:            templateService,
 	}, nil
 }
@@ -0,0 +1,371 @@
+package service
+
+import (
+	dfactory "app/domain/factory"
+	dmodel "app/domain/model"
+	dservice "app/domain/service"
+	uerror "app/usecase/error"
+	umodel "app/usecase/model"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+	"github.com/samber/lo"
+)
+
+type ConversationUsecase interface {
+	Create(c context.Context, userID uuid.UUID, userIDs []uuid.UUID) (*uuid.UUID, error)
+	List(c context.Context, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Conversation, *string, error)
+	ListMessage(c context.Context, conversationID uuid.UUID, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Message, *string, error)
+	SendMessage(c context.Context, conversationID uuid.UUID, userID uuid.UUID, contents []umodel.Content) (*uuid.UUID, error)
+	ReadMessage(c context.Context, conversationID uuid.UUID, messageID uuid.UUID, userID uuid.UUID) error
+}
+
+type conversationUsecase struct {
+	memberService       dservice.MemberService
+	userService         dservice.UserService
+	contentService      dservice.ContentService
+	conversationService dservice.ConversationService
+	messageService      dservice.MessageService
+	readMarkerService   dservice.ReadMarkerService
+	userRelationService dservice.UserRelationService
+}
+
+// Create implements ConversationUsecase.
+func (co *conversationUsecase) Create(c context.Context, userID uuid.UUID, userIDs []uuid.UUID) (*uuid.UUID, error) {
+	users, err := dmodel.NewConversationUsers(append([]uuid.UUID{userID}, userIDs...))
+	if err != nil {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("failed to parse users. max=%v", dmodel.MaxConversationUsers), err)
+	}
+
+	dUsers, err := co.userService.List(c, users)
+	if err != nil {
+		return nil, err
+	} else if len(dUsers) != len(users) {
+		return nil, uerror.NewNotFound("user not found", nil)
+	}
+
+	// 作成者と同じコミュニティに参加しているユーザーとのみ会話できる
+	communityIDs, err := co.memberService.ListJoinedCommunityIDByUsers(c, users)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range users {
+		if id == userID {
+			continue
+		}
+
+		if len(lo.Intersect(communityIDs[userID], communityIDs[id])) == 0 {
+			return nil, uerror.NewNewPermissionDenied(fmt.Sprintf("user does not share a community. user_id=%v", id.String()), nil)
+		}
+	}
+
+	if err := co.checkBlocked(c, userID, users); err != nil {
+		return nil, err
+	}
+
+	// 同じ参加者の会話が既にあればそれを使う
+	current, err := co.conversationService.GetByUsers(c, users)
+	if err != nil {
+		return nil, err
+	} else if current != nil {
+		return &current.ID, nil
+	}
+
+	newConversationID := uuid.New()
+	dConversation, err := dfactory.NewConversation(newConversationID.String(), lo.Map(users, func(id uuid.UUID, _ int) string { return id.String() }), int(time.Now().Unix()))
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse conversation", err)
+	}
+
+	if err := co.conversationService.Create(c, *dConversation); err != nil {
+		return nil, errors.Wrapf(err, "failed to create conversation. user_id=%v", userID.String())
+	}
+
+	return &newConversationID, nil
+}
+
+// List implements ConversationUsecase.
+func (co *conversationUsecase) List(c context.Context, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Conversation, *string, error) {
+	scope := fmt.Sprintf("conversation:%v", userID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dConversations, next, err := co.conversationService.ListByUser(c, userID, *dRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conversationIDs := lo.Map(dConversations, func(dConversation dmodel.Conversation, _ int) uuid.UUID { return dConversation.ID })
+
+	markers, err := co.readMarkerService.ListMessageByUser(c, userID, conversationIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 一度も読んでいない会話は全てのメッセージを未読とする
+	after := map[uuid.UUID]dmodel.UnixTime{}
+	for _, conversationID := range conversationIDs {
+		if marker, ok := markers[conversationID]; ok {
+			after[conversationID] = marker.At
+		} else {
+			after[conversationID] = dmodel.UnixTime(0)
+		}
+	}
+
+	unread, err := co.messageService.CountAfterByConversations(c, userID, after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users, err := co.listUser(c, lo.FlatMap(dConversations, func(dConversation dmodel.Conversation, _ int) []uuid.UUID { return dConversation.Users }))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uConversations := []umodel.Conversation{}
+	for _, dConversation := range dConversations {
+		uConversations = append(uConversations, umodel.Conversation{
+			ID: dConversation.ID,
+			At: dConversation.At.Int(),
+			Users: lo.FilterMap(dConversation.Users, func(id uuid.UUID, _ int) (umodel.User, bool) {
+				user, ok := users[id]
+				return user, ok
+			}),
+			Unread: unread[dConversation.ID],
+		})
+	}
+
+	return uConversations, encodeCursor(scope, next), nil
+}
+
+// ListMessage implements ConversationUsecase.
+func (co *conversationUsecase) ListMessage(c context.Context, conversationID uuid.UUID, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.Message, *string, error) {
+	dConversation, err := co.getJoined(c, conversationID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scope := fmt.Sprintf("message:%v", conversationID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dMessages, next, err := co.messageService.ListByConversation(c, conversationID, *dRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contents, err := co.contentService.ListByMessages(c, lo.Map(dMessages, func(dMessage dmodel.Message, _ int) uuid.UUID { return dMessage.ID }))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	markers, err := co.readMarkerService.ListMessageByConversation(c, conversationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users, err := co.listUser(c, dConversation.Users)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uMessages := []umodel.Message{}
+	for _, dMessage := range dMessages {
+		var uFrom *umodel.User
+		if user, ok := users[dMessage.From]; ok {
+			uFrom = &user
+		}
+
+		// 送信者以外で、このメッセージ以降まで既読にしたユーザー
+		readBy := lo.FilterMap(markers, func(marker dmodel.MessageReadMarker, _ int) (umodel.User, bool) {
+			if marker.UserID == dMessage.From || marker.At < dMessage.At {
+				return umodel.User{}, false
+			}
+
+			user, ok := users[marker.UserID]
+			return user, ok
+		})
+
+		uMessages = append(uMessages, umodel.Message{
+			ID: dMessage.ID,
+			At: dMessage.At.Int(),
+			Contents: lo.Map(contents[dMessage.ID], func(dContent dmodel.Content, _ int) umodel.Content {
+				return umodel.Content{
+					Type: dContent.Type.String(),
+					Bin:  dContent.Value,
+				}
+			}),
+			From:   uFrom,
+			ReadBy: readBy,
+		})
+	}
+
+	return uMessages, encodeCursor(scope, next), nil
+}
+
+// SendMessage implements ConversationUsecase.
+func (co *conversationUsecase) SendMessage(c context.Context, conversationID uuid.UUID, userID uuid.UUID, contents []umodel.Content) (*uuid.UUID, error) {
+	dConversation, err := co.getJoined(c, conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := co.checkBlocked(c, userID, dConversation.Users); err != nil {
+		return nil, err
+	}
+
+	if len(contents) == 0 {
+		return nil, uerror.NewInvalidParameter("contents is empty", nil)
+	}
+
+	newMessageID := uuid.New()
+	dMessage, err := dfactory.NewMessage(newMessageID.String(), userID.String(), int(time.Now().Unix()))
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse message", err)
+	}
+
+	newContents := []dmodel.Content{}
+	for _, content := range contents {
+		newContentID := uuid.New()
+		newContent, err := dfactory.NewContent(newContentID.String(), content.Type, content.Bin)
+		if err != nil {
+			return nil, uerror.NewInvalidParameter(fmt.Sprintf("failed to parse content. type=%v", content.Type), err)
+		}
+
+		newContents = append(newContents, *newContent)
+	}
+
+	// 内容はメッセージと別のストアにあるため先に作り、メッセージを作れなかった場合は消す
+	mention := dmodel.Mention{ID: newMessageID, Resource: dmodel.ResourceMessage}
+	if err := co.contentService.Create(c, newContents, mention); err != nil {
+		return nil, errors.Wrapf(err, "failed to create content. conversation_id=%v message_id=%v", conversationID.String(), newMessageID.String())
+	}
+
+	// 送信者の既読位置もメッセージと同じトランザクションで保存される
+	if err := co.messageService.Create(c, *dMessage, conversationID); err != nil {
+		if deleteErr := co.contentService.DeleteByResource(c, mention); deleteErr != nil {
+			return nil, errors.Wrapf(err, "failed to create message and delete content. conversation_id=%v message_id=%v delete_err=%v", conversationID.String(), newMessageID.String(), deleteErr)
+		}
+		return nil, errors.Wrapf(err, "failed to create message. conversation_id=%v", conversationID.String())
+	}
+
+	return &newMessageID, nil
+}
+
+// ReadMessage implements ConversationUsecase.
+func (co *conversationUsecase) ReadMessage(c context.Context, conversationID uuid.UUID, messageID uuid.UUID, userID uuid.UUID) error {
+	if _, err := co.getJoined(c, conversationID, userID); err != nil {
+		return err
+	}
+
+	dMessage, err := co.messageService.GetByConversation(c, conversationID, messageID)
+	if err != nil {
+		return err
+	} else if dMessage == nil {
+		return uerror.NewNotFound(fmt.Sprintf("message not found. id=%v", messageID.String()), nil)
+	}
+
+	return co.saveReadMarker(c, conversationID, userID, *dMessage)
+}
+
+// 参加していない会話は存在しないものとして扱う
+func (co *conversationUsecase) getJoined(c context.Context, conversationID uuid.UUID, userID uuid.UUID) (*dmodel.Conversation, error) {
+	dConversation, err := co.conversationService.Get(c, conversationID)
+	if err != nil {
+		return nil, err
+	} else if dConversation == nil || !dConversation.Joined(userID) {
+		return nil, uerror.NewNotFound(fmt.Sprintf("conversation not found. id=%v", conversationID.String()), nil)
+	}
+
+	return dConversation, nil
+}
+
+func (co *conversationUsecase) checkBlocked(c context.Context, userID uuid.UUID, userIDs []uuid.UUID) error {
+	relations, err := co.userRelationService.ListBetween(c, userIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range userIDs {
+		if id == userID {
+			continue
+		}
+
+		if lo.ContainsBy(relations, func(relation dmodel.UserRelation) bool { return relation.Blocks(userID, id) }) {
+			return uerror.NewNewPermissionDenied(fmt.Sprintf("user is blocked. user_id=%v", id.String()), nil)
+		}
+	}
+
+	return nil
+}
+
+func (co *conversationUsecase) saveReadMarker(c context.Context, conversationID uuid.UUID, userID uuid.UUID, message dmodel.Message) error {
+	dMarker, err := dfactory.NewMessageReadMarker(conversationID.String(), userID.String(), message.ID.String(), message.At.Int())
+	if err != nil {
+		return uerror.NewInvalidParameter("failed to parse read marker", err)
+	}
+
+	if err := co.readMarkerService.SaveMessage(c, *dMarker); err != nil {
+		return errors.Wrapf(err, "failed to save read marker. conversation_id=%v user_id=%v", conversationID.String(), userID.String())
+	}
+
+	return nil
+}
+
+func (co *conversationUsecase) listUser(c context.Context, ids []uuid.UUID) (map[uuid.UUID]umodel.User, error) {
+	uUsers := map[uuid.UUID]umodel.User{}
+
+	ids = lo.Uniq(ids)
+	if len(ids) == 0 {
+		return uUsers, nil
+	}
+
+	dUsers, err := co.userService.List(c, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dUser := range dUsers {
+		var imageURL *string
+		if dUser.ImageURL != nil {
+			v := dUser.ImageURL.String()
+			imageURL = &v
+		}
+
+		uUsers[dUser.ID] = umodel.User{
+			ID:       dUser.ID,
+			Name:     dUser.Name.String(),
+			ImageUrl: imageURL,
+		}
+	}
+
+	return uUsers, nil
+}
+
+func NewConversationUsecase(i *do.Injector) (ConversationUsecase, error) {
+	memberService := do.MustInvoke[dservice.MemberService](i)
+	userService := do.MustInvoke[dservice.UserService](i)
+	contentService := do.MustInvoke[dservice.ContentService](i)
+	conversationService := do.MustInvoke[dservice.ConversationService](i)
+	messageService := do.MustInvoke[dservice.MessageService](i)
+	readMarkerService := do.MustInvoke[dservice.ReadMarkerService](i)
+	userRelationService := do.MustInvoke[dservice.UserRelationService](i)
+	return &conversationUsecase{
+		memberService:       memberService,
+		userService:         userService,
+		contentService:      contentService,
+		conversationService: conversationService,
+		messageService:      messageService,
+		readMarkerService:   readMarkerService,
+		userRelationService: userRelationService,
+	}, nil
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	dmodel "app/domain/model"
+	dservice "app/domain/service"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/samber/do"
+	"github.com/samber/lo"
+)
+
+// 1回の実行で処理する削除ジョブの件数
+const communityDeletionBatchSize = 10
+
+type CommunityDeletionUsecase interface {
+	Run(c context.Context) error
+}
+
+type communityDeletionUsecase struct {
+	communityDeletionService   dservice.CommunityDeletionService
+	communityService           dservice.CommunityService
+	roleService                dservice.RoleService
+	memberService              dservice.MemberService
+	banService                 dservice.BanService
+	noteService                dservice.NoteService
+	contentService             dservice.ContentService
+	topicService               dservice.TopicService
+	threadService              dservice.ThreadService
+	postService                dservice.PostService
+	readMarkerService          dservice.ReadMarkerService
+	inviteService              dservice.InviteService
+	inviteLinkService          dservice.InviteLinkService
+	activityService            dservice.ActivityService
+	resourceSearchIndexService dservice.ResourceSearchIndexService
+	pageService                dservice.PageService
+	templateService            dservice.TemplateService
+}
+
+// Run implements CommunityDeletionUsecase.
+// 各ステップは冪等なので、失敗したステップから次回の実行で再開する
+func (co *communityDeletionUsecase) Run(c context.Context) error {
+	deletions, err := co.communityDeletionService.ListPending(c, communityDeletionBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for _, deletion := range deletions {
+		if err := co.run(c, deletion); err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+func (co *communityDeletionUsecase) run(c context.Context, deletion dmodel.CommunityDeletion) error {
+	for {
+		if err := co.runStep(c, deletion.CommunityID, deletion.Step); err != nil {
+			message := err.Error()
+			deletion.Attempts++
+			deletion.LastError = &message
+
+			if err := co.communityDeletionService.Save(c, deletion); err != nil {
+				return errors.Wrapf(err, "failed to save community deletion. community_id=%v", deletion.CommunityID.String())
+			}
+
+			return errors.Wrapf(err, "failed to delete community. community_id=%v step=%v attempts=%v", deletion.CommunityID.String(), deletion.Step.String(), deletion.Attempts)
+		}
+
+		if !deletion.Next() {
+			return co.communityDeletionService.Delete(c, deletion.CommunityID)
+		}
+
+		if err := co.communityDeletionService.Save(c, deletion); err != nil {
+			return errors.Wrapf(err, "failed to save community deletion. community_id=%v", deletion.CommunityID.String())
+		}
+	}
+}
+
+func (co *communityDeletionUsecase) runStep(c context.Context, communityID uuid.UUID, step dmodel.CommunityDeletionStep) error {
+	switch step {
+	case dmodel.CommunityDeletionStepSearchIndex:
+		topicIDs, err := co.topicService.ListIDsByCommunity(c, communityID)
+		if err != nil {
+			return err
+		}
+
+		postIDs, err := co.postService.ListIDsByTopics(c, topicIDs)
+		if err != nil {
+			return err
+		}
+
+		roles, err := co.roleService.ListByCommunity(c, communityID)
+		if err != nil {
+			return err
+		}
+
+		pageIDs, err := co.pageService.ListIDsByCommunity(c, communityID)
+		if err != nil {
+			return err
+		}
+
+		resourceIDs := append([]uuid.UUID{communityID}, topicIDs...)
+		resourceIDs = append(resourceIDs, postIDs...)
+		resourceIDs = append(resourceIDs, pageIDs...)
+		resourceIDs = append(resourceIDs, lo.Map(roles, func(role dmodel.Role, _ int) uuid.UUID { return role.ID })...)
+		for _, resourceID := range resourceIDs {
+			if err := co.resourceSearchIndexService.Delete(c, resourceID); err != nil {
+				return err
+			}
+		}
+	case dmodel.CommunityDeletionStepActivity:
+		memberIDs, err := co.memberService.ListIDsByCommunity(c, communityID)
+		if err != nil {
+			return err
+		}
+
+		return co.activityService.DeleteMemberActivities(c, memberIDs)
+	case dmodel.CommunityDeletionStepReadMarker:
+		memberIDs, err := co.memberService.ListIDsByCommunity(c, communityID)
+		if err != nil {
+			return err
+		}
+
+		return co.readMarkerService.DeleteByMembers(c, memberIDs)
+	case dmodel.CommunityDeletionStepContent:
+		topicIDs, err := co.topicService.ListIDsByCommunity(c, communityID)
+		if err != nil {
+			return err
+		}
+
+		postIDs, err := co.postService.ListIDsByTopics(c, topicIDs)
+		if err != nil {
+			return err
+		}
+
+		mentions := lo.Map(topicIDs, func(id uuid.UUID, _ int) dmodel.Mention { return dmodel.Mention{ID: id, Resource: dmodel.ResourceTopic} })
+		mentions = append(mentions, lo.Map(postIDs, func(id uuid.UUID, _ int) dmodel.Mention { return dmodel.Mention{ID: id, Resource: dmodel.ResourcePost} })...)
+		for _, mention := range mentions {
+			if err := co.contentService.DeleteByResource(c, mention); err != nil {
+				return err
+			}
+		}
+	case dmodel.CommunityDeletionStepPost:
+		topicIDs, err := co.topicService.ListIDsByCommunity(c, communityID)
+		if err != nil {
+			return err
+		}
+
+		return co.postService.DeleteByTopics(c, topicIDs)
+	case dmodel.CommunityDeletionStepThread:
+		topicIDs, err := co.topicService.ListIDsByCommunity(c, communityID)
+		if err != nil {
+			return err
+		}
+
+		return co.threadService.DeleteByTopics(c, topicIDs)
+	case dmodel.CommunityDeletionStepTopic:
+		return co.topicService.DeleteByCommunity(c, communityID)
+	case dmodel.CommunityDeletionStepPage:
+		pageIDs, err := co.pageService.ListIDsByCommunity(c, communityID)
+		if err != nil {
+			return err
+		}
+
+		for _, pageID := range pageIDs {
+			if err := co.deleteNote(c, dmodel.Mention{ID: pageID, Resource: dmodel.ResourcePage}); err != nil {
+				return err
+			}
+		}
+
+		return co.pageService.DeleteByCommunity(c, communityID)
+	case dmodel.CommunityDeletionStepTemplate:
+		return co.templateService.DeleteByCommunity(c, communityID)
+	case dmodel.CommunityDeletionStepNote:
+		return co.deleteNote(c, dmodel.Mention{ID: communityID, Resource: dmodel.ResourceCommunity})
+	case dmodel.CommunityDeletionStepInvite:
+		roles, err := co.roleService.ListByCommunity(c, communityID)
+		if err != nil {
+			return err
+		}
+
+		roleIDs := lo.Map(roles, func(role dmodel.Role, _ int) uuid.UUID { return role.ID })
+		if err := co.inviteService.DeleteByRoles(c, roleIDs); err != nil {
+			return err
+		}
+
+		return co.inviteLinkService.DeleteByRoles(c, roleIDs)
+	case dmodel.CommunityDeletionStepMember:
+		if err := co.memberService.DeleteByCommunity(c, communityID); err != nil {
+			return err
+		}
+
+		return co.banService.DeleteByCommunity(c, communityID)
+	case dmodel.CommunityDeletionStepRole:
+		return co.roleService.DeleteByCommunity(c, communityID)
+	case dmodel.CommunityDeletionStepCommunity:
+		return co.communityService.Delete(c, communityID)
+	}
+
+	return nil
+}
+
+// 行の内容を消してからノートを消す
+func (co *communityDeletionUsecase) deleteNote(c context.Context, mention dmodel.Mention) error {
+	note, err := co.noteService.GetbyResource(c, mention)
+	if err != nil {
+		return err
+	} else if note == nil {
+		return nil
+	}
+
+	lines, err := co.noteService.ListLines(c, note.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		if err := co.contentService.DeleteByResource(c, dmodel.Mention{ID: line.ID, Resource: dmodel.ResourceLine}); err != nil {
+			return err
+		}
+	}
+
+	return co.noteService.Delete(c, note.ID)
+}
+
+func NewCommunityDeletionUsecase(i *do.Injector) (CommunityDeletionUsecase, error) {
+	communityDeletionService := do.MustInvoke[dservice.CommunityDeletionService](i)
+	communityService := do.MustInvoke[dservice.CommunityService](i)
+	roleService := do.MustInvoke[dservice.RoleService](i)
+	memberService := do.MustInvoke[dservice.MemberService](i)
+	banService := do.MustInvoke[dservice.BanService](i)
+	noteService := do.MustInvoke[dservice.NoteService](i)
+	contentService := do.MustInvoke[dservice.ContentService](i)
+	topicService := do.MustInvoke[dservice.TopicService](i)
+	threadService := do.MustInvoke[dservice.ThreadService](i)
+	postService := do.MustInvoke[dservice.PostService](i)
+	readMarkerService := do.MustInvoke[dservice.ReadMarkerService](i)
+	inviteService := do.MustInvoke[dservice.InviteService](i)
+	inviteLinkService := do.MustInvoke[dservice.InviteLinkService](i)
+	activityService := do.MustInvoke[dservice.ActivityService](i)
+	resourceSearchIndexService := do.MustInvoke[dservice.ResourceSearchIndexService](i)
+	pageService := do.MustInvoke[dservice.PageService](i)
+	templateService := do.MustInvoke[dservice.TemplateService](i)
+	return &communityDeletionUsecase{
+		communityDeletionService:   communityDeletionService,
+		communityService:           communityService,
+		roleService:                roleService,
+		memberService:              memberService,
+		banService:                 banService,
+		noteService:                noteService,
+		contentService:             contentService,
+		topicService:               topicService,
+		threadService:              threadService,
+		postService:                postService,
+		readMarkerService:          readMarkerService,
+		inviteService:              inviteService,
+		inviteLinkService:          inviteLinkService,
+		activityService:            activityService,
+		resourceSearchIndexService: resourceSearchIndexService,
+		pageService:                pageService,
+		templateService:            templateService,
+	}, nil
+}
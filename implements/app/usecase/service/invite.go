@@ -0,0 +1,78 @@
+package service
+
+import (
+	dmodel "app/domain/model"
+	dservice "app/domain/service"
+	umodel "app/usecase/model"
+	"context"
+	"time"
+
+	"github.com/samber/do"
+)
+
+type InviteUsecase interface {
+	DeleteExpired(c context.Context) error
+}
+
+type inviteUsecase struct {
+	inviteService     dservice.InviteService
+	inviteLinkService dservice.InviteLinkService
+}
+
+// DeleteExpired implements InviteUsecase.
+func (i *inviteUsecase) DeleteExpired(c context.Context) error {
+	now := time.Now()
+
+	if _, err := i.inviteService.DeleteExpired(c, now); err != nil {
+		return err
+	}
+
+	if _, err := i.inviteLinkService.DeleteExpired(c, now); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewInviteUsecase(i *do.Injector) (InviteUsecase, error) {
+	inviteService := do.MustInvoke[dservice.InviteService](i)
+	inviteLinkService := do.MustInvoke[dservice.InviteLinkService](i)
+	return &inviteUsecase{
+		inviteService:     inviteService,
+		inviteLinkService: inviteLinkService,
+	}, nil
+}
+
+// UNIX時間（秒単位）を変換する（nilの場合は期限なし）
+func toTime(v *int) *time.Time {
+	if v == nil {
+		return nil
+	}
+
+	t := time.Unix(int64(*v), 0)
+	return &t
+}
+
+func toInviteLink(link dmodel.InviteLink, role dmodel.Role) umodel.InviteLink {
+	var maxUses *int
+	if link.MaxUses != nil {
+		v := link.MaxUses.Int()
+		maxUses = &v
+	} else {
+		maxUses = nil
+	}
+
+	return umodel.InviteLink{
+		ID: link.ID,
+		Role: umodel.Role{
+			ID:     role.ID,
+			Name:   role.Name.String(),
+			Action: role.Action.Strings(),
+		},
+		Token:    link.Token.String(),
+		At:       link.At,
+		ExpireAt: link.ExpireAt,
+		MaxUses:  maxUses,
+		Uses:     link.Uses,
+	}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	dmodel "app/domain/model"
+	dservice "app/domain/service"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// 一覧取得時に関連リソースをまとめて取得し、リクエスト内で使い回す
+type loader struct {
+	memberService   dservice.MemberService
+	userService     dservice.UserService
+	contentService  dservice.ContentService
+	activityService dservice.ActivityService
+
+	members       map[uuid.UUID]dmodel.Member
+	users         []dmodel.User
+	topicContents map[uuid.UUID][]dmodel.Content
+	postContents  map[uuid.UUID][]dmodel.Content
+	likes         map[uuid.UUID]int
+	dislikes      map[uuid.UUID]int
+}
+
+func newLoader(memberService dservice.MemberService, userService dservice.UserService, contentService dservice.ContentService, activityService dservice.ActivityService) *loader {
+	return &loader{
+		memberService:   memberService,
+		userService:     userService,
+		contentService:  contentService,
+		activityService: activityService,
+		members:         map[uuid.UUID]dmodel.Member{},
+		users:           []dmodel.User{},
+		topicContents:   map[uuid.UUID][]dmodel.Content{},
+		postContents:    map[uuid.UUID][]dmodel.Content{},
+		likes:           map[uuid.UUID]int{},
+		dislikes:        map[uuid.UUID]int{},
+	}
+}
+
+func (l *loader) LoadMembers(c context.Context, ids []uuid.UUID) error {
+	missingIDs := lo.Uniq(lo.Filter(ids, func(id uuid.UUID, _ int) bool {
+		_, ok := l.members[id]
+		return !ok
+	}))
+	if len(missingIDs) == 0 {
+		return nil
+	}
+
+	members, err := l.memberService.List(c, missingIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		l.members[member.ID] = member
+	}
+
+	missingUserIDs := lo.Uniq(lo.FilterMap(members, func(member dmodel.Member, _ int) (uuid.UUID, bool) {
+		return member.UserID, !lo.ContainsBy(l.users, func(user dmodel.User) bool { return user.ID == member.UserID })
+	}))
+	if len(missingUserIDs) == 0 {
+		return nil
+	}
+
+	users, err := l.userService.List(c, missingUserIDs)
+	if err != nil {
+		return err
+	}
+
+	l.users = append(l.users, users...)
+
+	return nil
+}
+
+func (l *loader) LoadTopics(c context.Context, topics []dmodel.Topic) error {
+	topicIDs := lo.Map(topics, func(topic dmodel.Topic, _ int) uuid.UUID { return topic.ID })
+	if len(topicIDs) == 0 {
+		return nil
+	}
+
+	contents, err := l.contentService.ListByTopics(c, topicIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, topicID := range topicIDs {
+		l.topicContents[topicID] = contents[topicID]
+	}
+
+	return l.LoadMembers(c, lo.FilterMap(topics, func(topic dmodel.Topic, _ int) (uuid.UUID, bool) {
+		if topic.Created == nil {
+			return uuid.Nil, false
+		}
+
+		return *topic.Created, true
+	}))
+}
+
+func (l *loader) LoadPosts(c context.Context, posts []dmodel.Post) error {
+	postIDs := lo.Map(posts, func(post dmodel.Post, _ int) uuid.UUID { return post.ID })
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	contents, err := l.contentService.ListByPosts(c, postIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, postID := range postIDs {
+		l.postContents[postID] = contents[postID]
+	}
+
+	likeMentions := lo.Map(postIDs, func(postID uuid.UUID, _ int) dmodel.Mention {
+		return dmodel.Mention{ID: postID, Resource: dmodel.ResourcePost}
+	})
+
+	likes, err := l.activityService.CountMemberLikeActivityByTargets(c, likeMentions, true)
+	if err != nil {
+		return err
+	}
+
+	dislikes, err := l.activityService.CountMemberLikeActivityByTargets(c, likeMentions, false)
+	if err != nil {
+		return err
+	}
+
+	for _, postID := range postIDs {
+		l.likes[postID] = likes[postID]
+		l.dislikes[postID] = dislikes[postID]
+	}
+
+	return l.LoadMembers(c, lo.FilterMap(posts, func(post dmodel.Post, _ int) (uuid.UUID, bool) {
+		if post.From == nil {
+			return uuid.Nil, false
+		}
+
+		return *post.From, true
+	}))
+}
+
+func (l *loader) Member(id uuid.UUID) (*dmodel.Member, bool) {
+	member, ok := l.members[id]
+	if !ok {
+		return nil, false
+	}
+
+	return &member, true
+}
+
+func (l *loader) Users() *[]dmodel.User {
+	return &l.users
+}
+
+func (l *loader) TopicContents(topicID uuid.UUID) []dmodel.Content {
+	return l.topicContents[topicID]
+}
+
+func (l *loader) PostContents(postID uuid.UUID) []dmodel.Content {
+	return l.postContents[postID]
+}
+
+func (l *loader) Reaction(postID uuid.UUID) (int, int) {
+	return l.likes[postID], l.dislikes[postID]
+}
@@ -8,23 +8,37 @@ import (
 	umodel "app/usecase/model"
 
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/samber/do"
+	"github.com/samber/lo"
 )
 
 type NoteUsecase interface {
 	Get(c context.Context, id uuid.UUID) (*umodel.Note, error)
 	GetUserProfile(c context.Context, userID uuid.UUID) (*umodel.Note, error)
 	GetCommunityDescription(c context.Context, communityID uuid.UUID) (*umodel.Note, error)
+	GetPageBody(c context.Context, pageID uuid.UUID) (*umodel.Note, error)
 	InsertLine(c context.Context, noteID uuid.UUID, order int) error
 	ListLines(c context.Context, noteID uuid.UUID) ([]umodel.Line, error)
 	MoveLine(c context.Context, noteID uuid.UUID, src int, dst int) error
 	UpdateLine(c context.Context, line umodel.Line) error
 	DeleteLine(c context.Context, noteID uuid.UUID, order int) error
+	InsertLines(c context.Context, noteID uuid.UUID, order int, lines []umodel.Line) ([]int, error)
+	UpdateLines(c context.Context, noteID uuid.UUID, lines []umodel.Line) ([]int, error)
+	DeleteLines(c context.Context, noteID uuid.UUID, from int, to int) ([]int, error)
+	Snapshot(c context.Context, noteID uuid.UUID, reason string) error
+	ListVersions(c context.Context, noteID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.NoteVersion, *string, error)
+	DiffVersions(c context.Context, noteID uuid.UUID, from uuid.UUID, to *uuid.UUID) ([]umodel.LineDiff, error)
+	RestoreVersion(c context.Context, noteID uuid.UUID, versionID uuid.UUID) error
 }
 
+// 1 つのメッセージで操作できる行数の上限
+const maxBatchLines = 500
+
 type noteUsecase struct {
 	noteService    dservice.NoteService
 	contentService dservice.ContentService
@@ -50,6 +64,26 @@ func (n *noteUsecase) GetCommunityDescription(c context.Context, communityID uui
 	}, nil
 }
 
+// GetPageBody implements NoteUsecase.
+func (n *noteUsecase) GetPageBody(c context.Context, pageID uuid.UUID) (*umodel.Note, error) {
+	mention, err := dmodel.NewMention(pageID.String(), dmodel.ResourcePage.String())
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse mention", err)
+	}
+
+	note, err := n.noteService.GetbyResource(c, *mention)
+	if err != nil {
+		return nil, err
+	}
+	if note == nil {
+		return nil, uerror.NewNotFound("note not found", nil)
+	}
+
+	return &umodel.Note{
+		ID: note.ID,
+	}, nil
+}
+
 // GetUserProfile implements NoteUsecase.
 func (n *noteUsecase) GetUserProfile(c context.Context, userID uuid.UUID) (*umodel.Note, error) {
 	mention, err := dmodel.NewMention(userID.String(), dmodel.ResourceUser.String())
@@ -85,18 +119,6 @@ func (n *noteUsecase) UpdateLine(c context.Context, line umodel.Line) error {
 		return uerror.NewNotFound("line not found", nil)
 	}
 
-	newContents := []dmodel.Content{}
-	for _, content := range line.Contents {
-		newContentID := uuid.New()
-		newContent, err := dfactory.NewContent(newContentID.String(), content.Type, content.Bin)
-
-		if err != nil {
-			return uerror.NewInvalidParameter("failed to parse content", err)
-		}
-
-		newContents = append(newContents, *newContent)
-	}
-
 	var updatePropertyType *string
 	if line.Property == nil {
 		updatePropertyType = nil
@@ -104,6 +126,16 @@ func (n *noteUsecase) UpdateLine(c context.Context, line umodel.Line) error {
 		updatePropertyType = &line.Property.Type
 	}
 
+	return n.updateLine(c, *currentLine, updatePropertyType, line.Contents)
+}
+
+// updateLine は行の内容を置き換えてから属性を更新する
+func (n *noteUsecase) updateLine(c context.Context, currentLine dmodel.Line, updatePropertyType *string, contents []umodel.Content) error {
+	newContents, err := n.newContents(contents)
+	if err != nil {
+		return err
+	}
+
 	updateLine, err := dfactory.NewLine(currentLine.ID.String(), currentLine.NoteID.String(), currentLine.Order.Int(), updatePropertyType)
 	if err != nil {
 		return uerror.NewInvalidParameter("failed to parse line", err)
@@ -115,7 +147,7 @@ func (n *noteUsecase) UpdateLine(c context.Context, line umodel.Line) error {
 	}
 
 	if err := n.contentService.DeleteAndCreate(c, newContents, *mention); err != nil {
-		return nil
+		return err
 	}
 
 	return n.noteService.UpdateLine(c, *updateLine)
@@ -240,6 +272,367 @@ func (n *noteUsecase) Get(c context.Context, id uuid.UUID) (*umodel.Note, error)
 	}, nil
 }
 
+// InsertLines implements NoteUsecase.
+// 行番号は挿入後のものを返す
+func (n *noteUsecase) InsertLines(c context.Context, noteID uuid.UUID, order int, lines []umodel.Line) ([]int, error) {
+	if len(lines) < 1 || len(lines) > maxBatchLines {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("lines must be between 1 and %v", maxBatchLines), nil)
+	}
+
+	parsedOrder, err := dmodel.NewOrderNumber(order)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse order", err)
+	}
+
+	dLines := []dmodel.Line{}
+	contents := map[uuid.UUID][]dmodel.Content{}
+	for _, line := range lines {
+		dLine, err := n.newLine(uuid.NewString(), noteID, *parsedOrder, line)
+		if err != nil {
+			return nil, err
+		}
+
+		if contents[dLine.ID], err = n.newContents(line.Contents); err != nil {
+			return nil, err
+		}
+
+		dLines = append(dLines, *dLine)
+	}
+
+	// 内容は別のストアにあるため、行をロックしたまま書き込む
+	orders, err := n.noteService.InsertLines(c, noteID, *parsedOrder, dLines, func() error {
+		return n.contentService.ReplaceByLines(c, contents)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(orders, func(order dmodel.OrderNumber, _ int) int { return order.Int() }), nil
+}
+
+// UpdateLines implements NoteUsecase.
+func (n *noteUsecase) UpdateLines(c context.Context, noteID uuid.UUID, lines []umodel.Line) ([]int, error) {
+	if len(lines) < 1 || len(lines) > maxBatchLines {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("lines must be between 1 and %v", maxBatchLines), nil)
+	}
+
+	currentLines, err := n.noteService.ListLines(c, noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentLinesByOrder := lo.KeyBy(currentLines, func(line dmodel.Line) int { return line.Order.Int() })
+
+	dLines := []dmodel.Line{}
+	contents := map[uuid.UUID][]dmodel.Content{}
+	for _, line := range lines {
+		currentLine, ok := currentLinesByOrder[line.Order]
+		if !ok {
+			return nil, uerror.NewNotFound(fmt.Sprintf("line not found. order=%v", line.Order), nil)
+		}
+
+		dLine, err := n.newLine(currentLine.ID.String(), noteID, currentLine.Order, line)
+		if err != nil {
+			return nil, err
+		}
+
+		if contents[dLine.ID], err = n.newContents(line.Contents); err != nil {
+			return nil, err
+		}
+
+		dLines = append(dLines, *dLine)
+	}
+
+	if updated, err := n.noteService.UpdateLines(c, noteID, dLines, func() error {
+		return n.contentService.ReplaceByLines(c, contents)
+	}); err != nil {
+		return nil, err
+	} else if !updated {
+		return nil, uerror.NewNotFound("line not found", nil)
+	}
+
+	return lo.Map(dLines, func(line dmodel.Line, _ int) int { return line.Order.Int() }), nil
+}
+
+// DeleteLines implements NoteUsecase.
+// 行番号は削除前のものを返す
+func (n *noteUsecase) DeleteLines(c context.Context, noteID uuid.UUID, from int, to int) ([]int, error) {
+	fromOrder, err := dmodel.NewOrderNumber(from)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse from order", err)
+	}
+
+	toOrder, err := dmodel.NewOrderNumber(to)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse to order", err)
+	}
+
+	if from > to || to-from+1 > maxBatchLines {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("invalid range. from=%v to=%v", from, to), nil)
+	}
+
+	// 行数は削除と同じトランザクションでロックしてから確かめる
+	deletedLines, err := n.noteService.DeleteLines(c, noteID, *fromOrder, *toOrder, func(count int) error {
+		if to > count {
+			return uerror.NewNotFound(fmt.Sprintf("line not found. order=%v", to), nil)
+		}
+
+		// 行の挿入は最後の行を基準にするため、空のノートでも 1 行は残す
+		if from == 1 && to == count {
+			return uerror.NewInvalidParameter(fmt.Sprintf("cannot delete all lines. from=%v to=%v", from, to), nil)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := n.contentService.DeleteByLines(c, lo.Map(deletedLines, func(line dmodel.Line, _ int) uuid.UUID { return line.ID })); err != nil {
+		return nil, errors.Wrapf(err, "failed to delete contents. note_id=%v", noteID)
+	}
+
+	return lo.Map(deletedLines, func(line dmodel.Line, _ int) int { return line.Order.Int() }), nil
+}
+
+func (n *noteUsecase) newLine(id string, noteID uuid.UUID, order dmodel.OrderNumber, line umodel.Line) (*dmodel.Line, error) {
+	var propertyType *string
+	if line.Property != nil {
+		propertyType = &line.Property.Type
+	}
+
+	dLine, err := dfactory.NewLine(id, noteID.String(), order.Int(), propertyType)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse line", err)
+	}
+
+	return dLine, nil
+}
+
+func (n *noteUsecase) newContents(contents []umodel.Content) ([]dmodel.Content, error) {
+	newContents := []dmodel.Content{}
+	for _, content := range contents {
+		newContent, err := dfactory.NewContent(uuid.NewString(), content.Type, content.Bin)
+		if err != nil {
+			return nil, uerror.NewInvalidParameter("failed to parse content", err)
+		}
+
+		newContents = append(newContents, *newContent)
+	}
+
+	return newContents, nil
+}
+
+// Snapshot implements NoteUsecase.
+func (n *noteUsecase) Snapshot(c context.Context, noteID uuid.UUID, reason string) error {
+	parsedReason, err := dmodel.NewNoteVersionReason(reason)
+	if err != nil {
+		return uerror.NewInvalidParameter("failed to parse reason", err)
+	}
+
+	return n.snapshot(c, noteID, *parsedReason)
+}
+
+// 最新の版から変わっていない場合は保存しない
+func (n *noteUsecase) snapshot(c context.Context, noteID uuid.UUID, reason dmodel.NoteVersionReason) error {
+	lines, err := n.listVersionLines(c, noteID)
+	if err != nil {
+		return err
+	}
+
+	latest, err := n.noteService.GetLatestVersion(c, noteID)
+	if err != nil {
+		return err
+	}
+
+	if latest != nil && len(latest.Lines) == len(lines) {
+		if lo.EveryBy(lo.Range(len(lines)), func(i int) bool { return latest.Lines[i].Equal(lines[i]) }) {
+			return nil
+		}
+	}
+
+	version, err := dfactory.NewNoteVersion(uuid.NewString(), noteID.String(), reason.String(), lines, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return n.noteService.CreateVersion(c, *version)
+}
+
+// ListVersions implements NoteUsecase.
+func (n *noteUsecase) ListVersions(c context.Context, noteID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.NoteVersion, *string, error) {
+	scope := fmt.Sprintf("note_version:%v", noteID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	versions, next, err := n.noteService.ListVersions(c, noteID, *dRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return lo.Map(versions, func(version dmodel.NoteVersion, _ int) umodel.NoteVersion {
+		return umodel.NoteVersion{
+			ID:        version.ID,
+			Reason:    version.Reason.String(),
+			CreatedAt: version.CreatedAt,
+		}
+	}), encodeCursor(scope, next), nil
+}
+
+// DiffVersions implements NoteUsecase.
+// to を省略した場合は現在の行と比較する
+func (n *noteUsecase) DiffVersions(c context.Context, noteID uuid.UUID, from uuid.UUID, to *uuid.UUID) ([]umodel.LineDiff, error) {
+	fromVersion, err := n.noteService.GetVersion(c, noteID, from)
+	if err != nil {
+		return nil, err
+	}
+	if fromVersion == nil {
+		return nil, uerror.NewNotFound("version not found", nil)
+	}
+
+	var toLines []dmodel.NoteVersionLine
+	if to == nil {
+		toLines, err = n.listVersionLines(c, noteID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		toVersion, err := n.noteService.GetVersion(c, noteID, *to)
+		if err != nil {
+			return nil, err
+		}
+		if toVersion == nil {
+			return nil, uerror.NewNotFound("version not found", nil)
+		}
+
+		toLines = toVersion.Lines
+	}
+
+	return lo.Map(dmodel.DiffLines(fromVersion.Lines, toLines), func(diff dmodel.LineDiff, _ int) umodel.LineDiff {
+		var fromOrder, toOrder *int
+		if diff.From != nil {
+			fromOrder = lo.ToPtr(diff.From.Int())
+		}
+		if diff.To != nil {
+			toOrder = lo.ToPtr(diff.To.Int())
+		}
+
+		var property *umodel.LineProperty
+		if diff.Line.Property != nil {
+			property = &umodel.LineProperty{
+				Type: diff.Line.Property.Type.String(),
+			}
+		}
+
+		return umodel.LineDiff{
+			Operation: diff.Operation.String(),
+			From:      fromOrder,
+			To:        toOrder,
+			Line: umodel.Line{
+				NoteID:   noteID,
+				Order:    *lo.CoalesceOrEmpty(toOrder, fromOrder),
+				Property: property,
+				Contents: toUContents(diff.Line.Contents),
+			},
+		}
+	}), nil
+}
+
+// RestoreVersion implements NoteUsecase.
+// 戻す前の内容を版として保存し、すべての行を版の行に置き換える
+func (n *noteUsecase) RestoreVersion(c context.Context, noteID uuid.UUID, versionID uuid.UUID) error {
+	version, err := n.noteService.GetVersion(c, noteID, versionID)
+	if err != nil {
+		return err
+	}
+	if version == nil {
+		return uerror.NewNotFound("version not found", nil)
+	}
+
+	if err := n.snapshot(c, noteID, dmodel.NoteVersionReasonBeforeRestore); err != nil {
+		return err
+	}
+
+	// 空のノートでも 1 行は残す
+	versionLines := version.Lines
+	if len(versionLines) == 0 {
+		versionLines = []dmodel.NoteVersionLine{{}}
+	}
+
+	dLines := []dmodel.Line{}
+	contents := map[uuid.UUID][]dmodel.Content{}
+	for i, versionLine := range versionLines {
+		var propertyType *string
+		if versionLine.Property != nil {
+			propertyType = lo.ToPtr(versionLine.Property.Type.String())
+		}
+
+		dLine, err := dfactory.NewLine(uuid.NewString(), noteID.String(), i+1, propertyType)
+		if err != nil {
+			return uerror.NewInvalidParameter("failed to parse line", err)
+		}
+
+		if contents[dLine.ID], err = n.newContents(toUContents(versionLine.Contents)); err != nil {
+			return err
+		}
+
+		dLines = append(dLines, *dLine)
+	}
+
+	// 内容は行と別のストアにあるため、まだ参照されない新しい行の内容として先に作る
+	if err := n.contentService.ReplaceByLines(c, contents); err != nil {
+		return err
+	}
+
+	newLineIDs := lo.Map(dLines, func(line dmodel.Line, _ int) uuid.UUID { return line.ID })
+	replacedLines, err := n.noteService.ReplaceLines(c, noteID, dLines)
+	if err != nil {
+		if deleteErr := n.contentService.DeleteByLines(c, newLineIDs); deleteErr != nil {
+			return errors.Wrapf(err, "failed to replace lines and delete contents. note_id=%v delete_err=%v", noteID, deleteErr)
+		}
+		return err
+	}
+
+	if err := n.contentService.DeleteByLines(c, lo.Map(replacedLines, func(line dmodel.Line, _ int) uuid.UUID { return line.ID })); err != nil {
+		return errors.Wrapf(err, "failed to delete contents. note_id=%v", noteID)
+	}
+
+	return nil
+}
+
+func (n *noteUsecase) listVersionLines(c context.Context, noteID uuid.UUID) ([]dmodel.NoteVersionLine, error) {
+	lines, err := n.noteService.ListLines(c, noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	versionLines := []dmodel.NoteVersionLine{}
+	for _, line := range lines {
+		contents, err := n.contentService.ListByLine(c, line.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		versionLines = append(versionLines, dmodel.NoteVersionLine{
+			Property: line.Property,
+			Contents: contents,
+		})
+	}
+
+	return versionLines, nil
+}
+
+func toUContents(contents []dmodel.Content) []umodel.Content {
+	return lo.Map(contents, func(content dmodel.Content, _ int) umodel.Content {
+		return umodel.Content{
+			Type: content.Type.String(),
+			Bin:  content.Value,
+		}
+	})
+}
+
 func NewNoteUsecase(i *do.Injector) (NoteUsecase, error) {
 	noteService := do.MustInvoke[dservice.NoteService](i)
 	contentService := do.MustInvoke[dservice.ContentService](i)
@@ -0,0 +1,45 @@
+package service
+
+import (
+	dmodel "app/domain/model"
+	lcursor "app/lib/cursor"
+	uerror "app/usecase/error"
+	"fmt"
+)
+
+// カーソル指定時は署名を検証し、オフセットの代わりにカーソル以降を取得する範囲にする
+// scope は一覧の種類と対象を表し、他の一覧で発行したカーソルを受け付けないようにする
+func newRange(scope string, limit int, offset int, cursor *string) (*dmodel.Range, error) {
+	dRange, err := dmodel.NewRange(limit, offset)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("failed to parse range. limit=%v offset=%v", limit, offset), err)
+	}
+
+	if cursor == nil {
+		return dRange, nil
+	}
+
+	at, id, key, err := lcursor.Decode(scope, *cursor)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("failed to decode cursor. v=%v", *cursor), err)
+	}
+
+	dCursor, err := dmodel.NewCursor(at, id)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter(fmt.Sprintf("failed to parse cursor. v=%v", *cursor), err)
+	}
+
+	dCursor.Key = key
+	dRange.Cursor = dCursor
+
+	return dRange, nil
+}
+
+func encodeCursor(scope string, dCursor *dmodel.Cursor) *string {
+	if dCursor == nil {
+		return nil
+	}
+
+	cursor := lcursor.Encode(scope, dCursor.At, dCursor.ID.String(), dCursor.Key)
+	return &cursor
+}
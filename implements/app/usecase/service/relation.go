@@ -0,0 +1,59 @@
+package service
+
+import (
+	dmodel "app/domain/model"
+	dservice "app/domain/service"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// 閲覧するユーザーとのブロック/ミュートの関係から、ポストを隠すか折りたたむかを判定する
+type relationFilter struct {
+	userID    uuid.UUID
+	relations []dmodel.UserRelation
+}
+
+func newRelationFilter(c context.Context, userRelationService dservice.UserRelationService, userID uuid.UUID) (*relationFilter, error) {
+	relations, err := userRelationService.ListInvolving(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &relationFilter{
+		userID:    userID,
+		relations: relations,
+	}, nil
+}
+
+func (f *relationFilter) Hidden(userID uuid.UUID) bool {
+	return lo.ContainsBy(f.relations, func(relation dmodel.UserRelation) bool { return relation.Blocks(f.userID, userID) })
+}
+
+func (f *relationFilter) Muted(userID uuid.UUID) bool {
+	return lo.ContainsBy(f.relations, func(relation dmodel.UserRelation) bool { return relation.Mutes(f.userID, userID) })
+}
+
+func (f *relationFilter) HiddenPost(l *loader, post dmodel.Post) bool {
+	userID, ok := f.author(l, post)
+	return ok && f.Hidden(userID)
+}
+
+func (f *relationFilter) MutedPost(l *loader, post dmodel.Post) bool {
+	userID, ok := f.author(l, post)
+	return ok && f.Muted(userID)
+}
+
+func (f *relationFilter) author(l *loader, post dmodel.Post) (uuid.UUID, bool) {
+	if post.From == nil {
+		return uuid.Nil, false
+	}
+
+	member, ok := l.Member(*post.From)
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	return member.UserID, true
+}
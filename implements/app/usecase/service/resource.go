@@ -6,19 +6,21 @@ import (
 	dservice "app/domain/service"
 	uerror "app/usecase/error"
 	umodel "app/usecase/model"
-	"fmt"
-
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/samber/do"
+	"github.com/samber/lo"
 )
 
 type ResourceSearchIndexUsecase interface {
-	Create(c context.Context, resourceID uuid.UUID, resourceType string, keyword string) error
+	Create(c context.Context, index umodel.ResourceSearchIndex) error
 	List(c context.Context, resourceTypes []string, freeword string, limit int, offset int) ([]umodel.ResourceSearchIndex, error)
-	Update(c context.Context, resourceID uuid.UUID, resourceType string, keyword string) error
+	Update(c context.Context, index umodel.ResourceSearchIndex) error
 	Delete(c context.Context, resourceID uuid.UUID) error
 }
 
@@ -32,14 +34,14 @@ func (r *resourceSearchIndexUsecase) Delete(c context.Context, resourceID uuid.U
 }
 
 // Create implements ResourceSearchIndexUsecase.
-func (r *resourceSearchIndexUsecase) Create(c context.Context, resourceID uuid.UUID, resourceType string, keyword string) error {
-	dIndex, err := dfactory.NewResourceSearchIndex(resourceID.String(), resourceType, keyword)
+func (r *resourceSearchIndexUsecase) Create(c context.Context, index umodel.ResourceSearchIndex) error {
+	dIndex, err := r.toDomain(index)
 	if err != nil {
-		return uerror.NewInvalidParameter(fmt.Sprintf("failed to parse resource search index. id=%v", resourceID.String()), err)
+		return uerror.NewInvalidParameter(fmt.Sprintf("failed to parse resource search index. id=%v", index.ResourceID.String()), err)
 	}
 
 	if err := r.resourceSearchIndexService.Create(c, *dIndex); err != nil {
-		return errors.Wrapf(err, "failed to create resource search index. id=%v", resourceID.String())
+		return errors.Wrapf(err, "failed to create resource search index. id=%v", index.ResourceID.String())
 	}
 
 	return nil
@@ -70,9 +72,15 @@ func (r *resourceSearchIndexUsecase) List(c context.Context, resourceTypes []str
 	indexes := []umodel.ResourceSearchIndex{}
 	for _, dIndex := range dIndexes {
 		indexes = append(indexes, umodel.ResourceSearchIndex{
-			ResourceID: dIndex.ResourceID,
-			Type:       dIndex.Type.String(),
-			Keyword:    dIndex.Keyword.String(),
+			ResourceID:  dIndex.ResourceID,
+			Type:        dIndex.Type.String(),
+			Keyword:     dIndex.Keyword.String(),
+			CommunityID: dIndex.CommunityID,
+			TopicID:     dIndex.TopicID,
+			ThreadID:    dIndex.ThreadID,
+			AuthorID:    dIndex.AuthorID,
+			Body:        dIndex.Body.String(),
+			Highlights:  dIndex.Highlights,
 		})
 	}
 
@@ -80,22 +88,437 @@ func (r *resourceSearchIndexUsecase) List(c context.Context, resourceTypes []str
 }
 
 // Update implements ResourceSearchIndexUsecase.
-func (r *resourceSearchIndexUsecase) Update(c context.Context, resourceID uuid.UUID, resourceType string, keyword string) error {
-	dIndex, err := dfactory.NewResourceSearchIndex(resourceID.String(), resourceType, keyword)
+func (r *resourceSearchIndexUsecase) Update(c context.Context, index umodel.ResourceSearchIndex) error {
+	dIndex, err := r.toDomain(index)
 	if err != nil {
-		return uerror.NewInvalidParameter(fmt.Sprintf("failed to parse resource search index. id=%v", resourceID.String()), err)
+		return uerror.NewInvalidParameter(fmt.Sprintf("failed to parse resource search index. id=%v", index.ResourceID.String()), err)
 	}
 
 	if err := r.resourceSearchIndexService.Update(c, *dIndex); err != nil {
-		return errors.Wrapf(err, "failed to update resource search index. id=%v", resourceID.String())
+		return errors.Wrapf(err, "failed to update resource search index. id=%v", index.ResourceID.String())
 	}
 
 	return nil
 }
 
+func (r *resourceSearchIndexUsecase) toDomain(index umodel.ResourceSearchIndex) (*dmodel.ResourceSearchIndex, error) {
+	if index.CommunityID == nil {
+		return dfactory.NewResourceSearchIndex(index.ResourceID.String(), index.Type, index.Keyword)
+	}
+
+	toString := func(id *uuid.UUID) *string {
+		if id == nil {
+			return nil
+		}
+
+		v := id.String()
+		return &v
+	}
+
+	dIndex, err := dfactory.NewContentSearchIndex(index.ResourceID.String(), index.Type, index.Keyword, index.CommunityID.String(), toString(index.TopicID), toString(index.ThreadID), toString(index.AuthorID))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := dmodel.NewSearchBody(index.Body)
+	if err != nil {
+		return nil, err
+	}
+	dIndex.Body = *body
+
+	return dIndex, nil
+}
+
 func NewResourceSearchIndexUsecase(i *do.Injector) (ResourceSearchIndexUsecase, error) {
 	resourceSearchIndexService := do.MustInvoke[dservice.ResourceSearchIndexService](i)
 	return &resourceSearchIndexUsecase{
 		resourceSearchIndexService: resourceSearchIndexService,
 	}, nil
 }
+
+type textValue struct {
+	Value string `json:"value"`
+}
+
+type textElement struct {
+	Value textValue `json:"value"`
+}
+
+type textValues struct {
+	Values []textElement `json:"values"`
+}
+
+func (m textValues) join() string {
+	return strings.Join(lo.Map(m.Values, func(elm textElement, _ int) string { return elm.Value.Value }), ",")
+}
+
+type mentionValue struct {
+	ID string `json:"id"`
+}
+
+var (
+	// 内容の種類ごとに検索用のテキストを取り出す
+	// 水平線と画像はテキストを持たない
+	textExtractors = map[dmodel.ContentType]func(b []byte) (string, error){
+		dmodel.ContentTypeText: func(b []byte) (string, error) {
+			var content textValue
+			err := json.Unmarshal(b, &content)
+			return content.Value, err
+		},
+		dmodel.ContentTypeHeading: func(b []byte) (string, error) {
+			var content textElement
+			err := json.Unmarshal(b, &content)
+			return content.Value.Value, err
+		},
+		dmodel.ContentTypeList: func(b []byte) (string, error) {
+			var content textValues
+			err := json.Unmarshal(b, &content)
+			return content.join(), err
+		},
+		dmodel.ContentTypeCheckbox: func(b []byte) (string, error) {
+			var content textValues
+			err := json.Unmarshal(b, &content)
+			return content.join(), err
+		},
+		dmodel.ContentTypeRadiobutton: func(b []byte) (string, error) {
+			var content textValues
+			err := json.Unmarshal(b, &content)
+			return content.join(), err
+		},
+		dmodel.ContentTypeLine: func(b []byte) (string, error) {
+			return "", nil
+		},
+		dmodel.ContentTypeImg: func(b []byte) (string, error) {
+			return "", nil
+		},
+		dmodel.ContentTypeMentions: func(b []byte) (string, error) {
+			var content []mentionValue
+			err := json.Unmarshal(b, &content)
+			return strings.Join(lo.Map(content, func(elm mentionValue, _ int) string { return elm.ID }), ","), err
+		},
+		dmodel.ContentTypeCode: func(b []byte) (string, error) {
+			var content struct {
+				Source string `json:"source"`
+			}
+			err := json.Unmarshal(b, &content)
+			return content.Source, err
+		},
+		dmodel.ContentTypeTable: func(b []byte) (string, error) {
+			var content struct {
+				Header []textValue   `json:"header"`
+				Rows   [][]textValue `json:"rows"`
+			}
+			if err := json.Unmarshal(b, &content); err != nil {
+				return "", err
+			}
+
+			cells := lo.Map(content.Header, func(elm textValue, _ int) string { return elm.Value })
+			for _, row := range content.Rows {
+				cells = append(cells, lo.Map(row, func(elm textValue, _ int) string { return elm.Value })...)
+			}
+
+			return strings.Join(cells, ","), nil
+		},
+		dmodel.ContentTypeQuote: func(b []byte) (string, error) {
+			var content textValue
+			err := json.Unmarshal(b, &content)
+			return content.Value, err
+		},
+	}
+)
+
+// ExtractText は保存された内容から検索用のテキストを取り出す
+// 内容の間は改行で区切る
+func ExtractText(contents []umodel.Content) (string, error) {
+	values := []string{}
+	for _, content := range contents {
+		extract, ok := textExtractors[dmodel.ContentType(content.Type)]
+		if !ok {
+			return "", fmt.Errorf("unsupported type. v=%v", content.Type)
+		}
+
+		value, err := extract(content.Bin)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to parse content. type=%v", content.Type)
+		}
+
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return strings.Join(values, "\n"), nil
+}
+
+const (
+	maintenancePageSize = 500
+)
+
+var (
+	// 索引を作っているリソース
+	indexedResources = []dmodel.Resource{
+		dmodel.ResourceUser,
+		dmodel.ResourceCommunity,
+		dmodel.ResourceRole,
+		dmodel.ResourceTopic,
+		dmodel.ResourcePost,
+		dmodel.ResourcePage,
+	}
+)
+
+type ResourceSearchIndexMaintenanceUsecase interface {
+	Rebuild(c context.Context) ([]umodel.ResourceSearchIndexReport, error)
+	Verify(c context.Context, resourceTypes []string, repair bool) ([]umodel.ResourceSearchIndexReport, error)
+}
+
+type resourceSearchIndexMaintenanceUsecase struct {
+	resourceSearchIndexService        dservice.ResourceSearchIndexService
+	resourceSearchIndexRebuildService dservice.ResourceSearchIndexRebuildService
+}
+
+// Rebuild implements ResourceSearchIndexMaintenanceUsecase.
+// RDB のすべてのリソースを新しいインデックスに書き込み、エイリアスを付け替える
+// 書き込み中に届いた更新は新しいインデックスにも入り、削除の取りこぼしは付け替えた後に補修する
+func (r *resourceSearchIndexMaintenanceUsecase) Rebuild(c context.Context) ([]umodel.ResourceSearchIndexReport, error) {
+	name, err := r.resourceSearchIndexRebuildService.Begin(c)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin rebuilding resource search index")
+	}
+
+	if err := r.rebuild(c, name); err != nil {
+		if abortErr := r.resourceSearchIndexRebuildService.Abort(c, name); abortErr != nil {
+			return nil, errors.Wrapf(err, "failed to abort rebuilding resource search index. name=%v abort_err=%v", name, abortErr)
+		}
+
+		return nil, err
+	}
+
+	if err := r.resourceSearchIndexRebuildService.Commit(c, name); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit resource search index. name=%v", name)
+	}
+
+	return r.Verify(c, lo.Map(indexedResources, func(resource dmodel.Resource, _ int) string { return resource.String() }), true)
+}
+
+// Verify implements ResourceSearchIndexMaintenanceUsecase.
+// RDB と索引の ID を昇順に突き合わせ、repair の場合は足りない索引を作り余分な索引を消す
+func (r *resourceSearchIndexMaintenanceUsecase) Verify(c context.Context, resourceTypes []string, repair bool) ([]umodel.ResourceSearchIndexReport, error) {
+	dResourceTypes := []dmodel.Resource{}
+	for _, resourceType := range resourceTypes {
+		dResourceType, err := dmodel.NewResource(resourceType)
+		if err != nil || !lo.Contains(indexedResources, *dResourceType) {
+			return nil, uerror.NewInvalidParameter(fmt.Sprintf("failed to parse resource type. v=%v", resourceType), err)
+		}
+
+		dResourceTypes = append(dResourceTypes, *dResourceType)
+	}
+
+	reports := []umodel.ResourceSearchIndexReport{}
+	for _, dResourceType := range lo.Uniq(dResourceTypes) {
+		report, err := r.verify(c, dResourceType, repair)
+		if err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, *report)
+	}
+
+	return reports, nil
+}
+
+func (r *resourceSearchIndexMaintenanceUsecase) rebuild(c context.Context, name string) error {
+	for _, resourceType := range indexedResources {
+		var after *uuid.UUID
+		for {
+			dIndexes, next, err := r.resourceSearchIndexRebuildService.ListSource(c, resourceType, after, maintenancePageSize)
+			if err != nil {
+				return errors.Wrapf(err, "failed to list resource. type=%v", resourceType.String())
+			}
+
+			if err := r.fillBodies(dIndexes); err != nil {
+				return err
+			}
+
+			if err := r.resourceSearchIndexRebuildService.Save(c, name, dIndexes); err != nil {
+				return errors.Wrapf(err, "failed to save resource search index. type=%v", resourceType.String())
+			}
+
+			if next == nil {
+				break
+			}
+			after = next
+		}
+	}
+
+	return nil
+}
+
+func (r *resourceSearchIndexMaintenanceUsecase) verify(c context.Context, resourceType dmodel.Resource, repair bool) (*umodel.ResourceSearchIndexReport, error) {
+	report := umodel.ResourceSearchIndexReport{
+		Type:    resourceType.String(),
+		Missing: []uuid.UUID{},
+		Extra:   []uuid.UUID{},
+	}
+
+	sources := &sourceReader{
+		list: func(after *uuid.UUID) ([]dmodel.ResourceSearchIndex, *uuid.UUID, error) {
+			return r.resourceSearchIndexRebuildService.ListSource(c, resourceType, after, maintenancePageSize)
+		},
+	}
+	indexed := &indexedReader{
+		list: func(after *uuid.UUID) ([]uuid.UUID, error) {
+			return r.resourceSearchIndexService.ListIDs(c, resourceType, after, maintenancePageSize)
+		},
+	}
+
+	for {
+		source, err := sources.peek()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list resource. type=%v", resourceType.String())
+		}
+
+		indexedID, err := indexed.peek()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list resource search index. type=%v", resourceType.String())
+		}
+
+		switch {
+		case source == nil && indexedID == nil:
+			return &report, nil
+		case indexedID == nil || (source != nil && source.ResourceID.String() < indexedID.String()):
+			report.Sources++
+			report.Missing = append(report.Missing, source.ResourceID)
+			sources.next()
+
+			if repair {
+				if err := r.create(c, *source); err != nil {
+					return nil, err
+				}
+			}
+		case source == nil || indexedID.String() < source.ResourceID.String():
+			report.Indexed++
+			report.Extra = append(report.Extra, *indexedID)
+			indexed.next()
+
+			if repair {
+				if err := r.resourceSearchIndexService.Delete(c, *indexedID); err != nil {
+					return nil, errors.Wrapf(err, "failed to delete resource search index. id=%v", indexedID.String())
+				}
+			}
+		default:
+			report.Sources++
+			report.Indexed++
+			sources.next()
+			indexed.next()
+		}
+	}
+}
+
+func (r *resourceSearchIndexMaintenanceUsecase) create(c context.Context, index dmodel.ResourceSearchIndex) error {
+	indexes := []dmodel.ResourceSearchIndex{index}
+	if err := r.fillBodies(indexes); err != nil {
+		return err
+	}
+
+	if err := r.resourceSearchIndexService.Create(c, indexes[0]); err != nil {
+		return errors.Wrapf(err, "failed to create resource search index. id=%v", index.ResourceID.String())
+	}
+
+	return nil
+}
+
+// 本文は非同期で索引を作る時と同じく、内容から取り出す
+func (r *resourceSearchIndexMaintenanceUsecase) fillBodies(indexes []dmodel.ResourceSearchIndex) error {
+	for i, index := range indexes {
+		if len(index.Contents) == 0 {
+			continue
+		}
+
+		text, err := ExtractText(lo.Map(index.Contents, func(content dmodel.Content, _ int) umodel.Content {
+			return umodel.Content{Type: string(content.Type), Bin: content.Value}
+		}))
+		if err != nil {
+			return errors.Wrapf(err, "failed to extract text. id=%v", index.ResourceID.String())
+		}
+
+		body, err := dmodel.NewSearchBody(text)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse search body. id=%v", index.ResourceID.String())
+		}
+
+		indexes[i].Body = *body
+	}
+
+	return nil
+}
+
+// RDB のリソースをページごとに読み、一件ずつ取り出す
+type sourceReader struct {
+	list  func(after *uuid.UUID) ([]dmodel.ResourceSearchIndex, *uuid.UUID, error)
+	page  []dmodel.ResourceSearchIndex
+	after *uuid.UUID
+	done  bool
+}
+
+func (s *sourceReader) peek() (*dmodel.ResourceSearchIndex, error) {
+	for len(s.page) == 0 && !s.done {
+		page, next, err := s.list(s.after)
+		if err != nil {
+			return nil, err
+		}
+
+		s.page = page
+		s.after = next
+		s.done = next == nil
+	}
+
+	if len(s.page) == 0 {
+		return nil, nil
+	}
+
+	return &s.page[0], nil
+}
+
+func (s *sourceReader) next() {
+	s.page = s.page[1:]
+}
+
+// 索引済みの ID をページごとに読み、一件ずつ取り出す
+type indexedReader struct {
+	list func(after *uuid.UUID) ([]uuid.UUID, error)
+	page []uuid.UUID
+	last *uuid.UUID
+	done bool
+}
+
+func (s *indexedReader) peek() (*uuid.UUID, error) {
+	if len(s.page) == 0 && !s.done {
+		page, err := s.list(s.last)
+		if err != nil {
+			return nil, err
+		}
+
+		s.page = page
+		s.done = len(page) < maintenancePageSize
+	}
+
+	if len(s.page) == 0 {
+		return nil, nil
+	}
+
+	return &s.page[0], nil
+}
+
+func (s *indexedReader) next() {
+	s.last = &s.page[0]
+	s.page = s.page[1:]
+}
+
+func NewResourceSearchIndexMaintenanceUsecase(i *do.Injector) (ResourceSearchIndexMaintenanceUsecase, error) {
+	resourceSearchIndexService := do.MustInvoke[dservice.ResourceSearchIndexService](i)
+	resourceSearchIndexRebuildService := do.MustInvoke[dservice.ResourceSearchIndexRebuildService](i)
+	return &resourceSearchIndexMaintenanceUsecase{
+		resourceSearchIndexService:        resourceSearchIndexService,
+		resourceSearchIndexRebuildService: resourceSearchIndexRebuildService,
+	}, nil
+}
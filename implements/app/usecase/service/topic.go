@@ -15,6 +15,7 @@ import (
 
 type TopicUsecase interface {
 	Get(c context.Context, id uuid.UUID) (*umodel.Topic, error)
+	GetInCommunity(c context.Context, communityID uuid.UUID, id uuid.UUID) (*umodel.Topic, error)
 }
 
 type topicUsecase struct {
@@ -32,6 +33,18 @@ type topicUsecase struct {
 	contentService             dservice.ContentService
 }
 
+// GetInCommunity implements TopicUsecase.
+func (t *topicUsecase) GetInCommunity(c context.Context, communityID uuid.UUID, id uuid.UUID) (*umodel.Topic, error) {
+	// 別のコミュニティのトピックは存在しないものとして扱う
+	if topicCommunityID, err := t.topicService.GetCommunityID(c, id); err != nil {
+		return nil, err
+	} else if topicCommunityID == nil || *topicCommunityID != communityID {
+		return nil, uerror.NewNotFound(fmt.Sprintf("topic not found. id=%v", id.String()), nil)
+	}
+
+	return t.Get(c, id)
+}
+
 // Get implements TopicUsecase.
 func (t *topicUsecase) Get(c context.Context, id uuid.UUID) (*umodel.Topic, error) {
 	dTopic, err := t.topicService.Get(c, id)
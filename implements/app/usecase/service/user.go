@@ -6,6 +6,7 @@ import (
 	dservice "app/domain/service"
 	uerror "app/usecase/error"
 	umodel "app/usecase/model"
+	"fmt"
 	"time"
 
 	"context"
@@ -13,13 +14,18 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/samber/do"
+	"github.com/samber/lo"
 )
 
 type UserUsecase interface {
 	Get(c context.Context, id uuid.UUID) (*umodel.User, error)
 	Save(c context.Context, subject string, email string, name string, issuer string, imageURL *string) (*uuid.UUID, error)
-	ListInvite(c context.Context, userID uuid.UUID, limit int, offset int) ([]umodel.UserInvite, error)
+	ListInvite(c context.Context, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.UserInvite, *string, error)
 	ReplyInvite(c context.Context, userID uuid.UUID, inviteID uuid.UUID, agree bool) error
+	RedeemInviteLink(c context.Context, userID uuid.UUID, token string) (*umodel.Community, error)
+	ListRelation(c context.Context, userID uuid.UUID) ([]umodel.UserRelation, error)
+	SaveRelation(c context.Context, userID uuid.UUID, targetID uuid.UUID, relationType string) error
+	DeleteRelation(c context.Context, userID uuid.UUID, targetID uuid.UUID) error
 }
 
 type userUsecase struct {
@@ -27,10 +33,13 @@ type userUsecase struct {
 	noteService                dservice.NoteService
 	resourceSearchIndexService dservice.ResourceSearchIndexService
 	inviteService              dservice.InviteService
+	inviteLinkService          dservice.InviteLinkService
 	activityService            dservice.ActivityService
 	communityService           dservice.CommunityService
 	roleService                dservice.RoleService
 	memberService              dservice.MemberService
+	userRelationService        dservice.UserRelationService
+	banService                 dservice.BanService
 }
 
 // ReplyInvite implements UserUsecase.
@@ -40,112 +49,122 @@ func (u *userUsecase) ReplyInvite(c context.Context, userID uuid.UUID, inviteID
 		return err
 	}
 
-	if invite == nil {
+	if invite == nil || invite.Expired(time.Now()) {
 		return uerror.NewNotFound("invite not found", nil)
 	}
 
-	role, err := u.roleService.Get(c, invite.RoleID)
-	if err != nil {
-		return err
-	}
-
-	if role == nil {
-		return uerror.NewNotFound("role not found", nil)
-	}
-
 	if !agree {
 		return u.inviteService.DeleteInvitedUser(c, inviteID, userID)
 	}
 
-	communityID, err := u.roleService.GetRelatedCommunity(c, role.ID)
+	community, role, err := u.getCommunityByRole(c, invite.RoleID)
 	if err != nil {
 		return err
 	}
 
-	if communityID == nil {
-		return uerror.NewNotFound("community not found", nil)
+	if err := u.checkJoinable(c, community.ID, userID); err != nil {
+		return err
 	}
 
-	community, err := u.communityService.Get(c, *communityID)
-	if err != nil {
+	// 参加と招待の消費を同じトランザクションで確定する
+	memberID := uuid.New()
+	if err := u.inviteService.Accept(c, inviteID, userID, func() error {
+		return u.join(c, community.ID, role.ID, userID, memberID)
+	}); err != nil {
 		return err
 	}
 
-	if community == nil {
-		return uerror.NewNotFound("community not found", nil)
-	}
+	return u.saveJoinActivity(c, memberID)
+}
 
-	if member, err := u.memberService.GetByCommunityAndUser(c, community.ID, userID); err != nil {
-		return err
-	} else if member != nil {
-		return uerror.NewAlreadyExists("member already exists", nil)
+// RedeemInviteLink implements UserUsecase.
+func (u *userUsecase) RedeemInviteLink(c context.Context, userID uuid.UUID, token string) (*umodel.Community, error) {
+	dToken, err := dmodel.NewInviteToken(token)
+	if err != nil {
+		return nil, uerror.NewInvalidParameter("failed to parse invite token", err)
 	}
 
-	memberID := uuid.NewString()
-	member, err := dfactory.NewMember(memberID, userID.String(), role.ID.String())
+	link, err := u.inviteLinkService.GetByToken(c, *dToken)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	memberMention, err := dmodel.NewMention(community.ID.String(), dmodel.ResourceCommunity.String())
-	if err != nil {
-		return err
+	if link == nil || !link.Available(time.Now()) {
+		return nil, uerror.NewNotFound("invite link not found", nil)
 	}
 
-	if err := u.memberService.Create(c, *member, *memberMention); err != nil {
-		return err
+	community, role, err := u.getCommunityByRole(c, link.RoleID)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := u.inviteService.DeleteInvitedUser(c, inviteID, userID); err != nil {
-		return err
+	if err := u.checkJoinable(c, community.ID, userID); err != nil {
+		return nil, err
 	}
 
-	dActivity, err := dfactory.NewMemberActivity(time.Now(), memberID, member.ID.String(), dmodel.ResourceMember.String(), dmodel.OperationCreate.String())
-	if err != nil {
-		return errors.Wrapf(err, "failed to parse member activity. id=%v", memberID)
+	// 同時に使われても上限を超えないように、使用回数の更新と参加を同じトランザクションで確定する
+	memberID := uuid.New()
+	if used, err := u.inviteLinkService.Use(c, link.ID, time.Now(), func() error {
+		return u.join(c, community.ID, role.ID, userID, memberID)
+	}); err != nil {
+		return nil, err
+	} else if !used {
+		return nil, uerror.NewNotFound("invite link not found", nil)
 	}
 
-	if err := u.activityService.SaveMemberActivity(c, *dActivity); err != nil {
-		return errors.Wrapf(err, "failed to save member activity. id=%v", memberID)
+	if err := u.saveJoinActivity(c, memberID); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &umodel.Community{
+		ID:         community.ID,
+		Name:       community.Name.String(),
+		Invitation: community.Invitation,
+		Visibility: community.Visibility.String(),
+		Status:     community.Status.String(),
+	}, nil
 }
 
 // ListInvite implements UserUsecase.
-func (u *userUsecase) ListInvite(c context.Context, userID uuid.UUID, limit int, offset int) ([]umodel.UserInvite, error) {
-	invites, err := u.inviteService.ListByUser(c, userID, dmodel.Range{Limit: limit, Offset: offset})
+func (u *userUsecase) ListInvite(c context.Context, userID uuid.UUID, limit int, offset int, cursor *string) ([]umodel.UserInvite, *string, error) {
+	scope := fmt.Sprintf("user_invite:%v", userID.String())
+	dRange, err := newRange(scope, limit, offset, cursor)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	invites, next, err := u.inviteService.ListByUser(c, userID, *dRange)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	uInvites := []umodel.UserInvite{}
 	for _, invite := range invites {
 		role, err := u.roleService.Get(c, invite.RoleID)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		if role == nil {
-			return nil, uerror.NewNotFound("role not found", nil)
+			return nil, nil, uerror.NewNotFound("role not found", nil)
 		}
 
 		communityID, err := u.roleService.GetRelatedCommunity(c, role.ID)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		if communityID == nil {
-			return nil, uerror.NewNotFound("community not found", nil)
+			return nil, nil, uerror.NewNotFound("community not found", nil)
 		}
 
 		community, err := u.communityService.Get(c, *communityID)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		if community == nil {
-			return nil, uerror.NewNotFound("community not found", nil)
+			return nil, nil, uerror.NewNotFound("community not found", nil)
 		}
 
 		var message *string
@@ -167,12 +186,86 @@ func (u *userUsecase) ListInvite(c context.Context, userID uuid.UUID, limit int,
 				Name:   role.Name.String(),
 				Action: role.Action.Strings(),
 			},
-			At:      invite.At,
-			Message: message,
+			At:       invite.At,
+			ExpireAt: invite.ExpireAt,
+			Message:  message,
 		})
 	}
 
-	return uInvites, nil
+	return uInvites, encodeCursor(scope, next), nil
+}
+
+// ListRelation implements UserUsecase.
+func (u *userUsecase) ListRelation(c context.Context, userID uuid.UUID) ([]umodel.UserRelation, error) {
+	dRelations, err := u.userRelationService.ListByUser(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dUsers, err := u.userService.List(c, lo.Map(dRelations, func(dRelation dmodel.UserRelation, _ int) uuid.UUID { return dRelation.TargetID }))
+	if err != nil {
+		return nil, err
+	}
+
+	uRelations := []umodel.UserRelation{}
+	for _, dRelation := range dRelations {
+		dUser, ok := lo.Find(dUsers, func(dUser dmodel.User) bool { return dUser.ID == dRelation.TargetID })
+		if !ok {
+			continue
+		}
+
+		uRelations = append(uRelations, umodel.UserRelation{
+			User: umodel.User{
+				ID:       dUser.ID,
+				Name:     dUser.Name.String(),
+				ImageUrl: (*string)(dUser.ImageURL),
+			},
+			Type: dRelation.Type.String(),
+		})
+	}
+
+	return uRelations, nil
+}
+
+// SaveRelation implements UserUsecase.
+func (u *userUsecase) SaveRelation(c context.Context, userID uuid.UUID, targetID uuid.UUID, relationType string) error {
+	if userID == targetID {
+		return uerror.NewInvalidParameter("cannot block or mute yourself", nil)
+	}
+
+	target, err := u.userService.Get(c, targetID)
+	if err != nil {
+		return err
+	} else if target == nil {
+		return uerror.NewNotFound(fmt.Sprintf("user not found. id=%v", targetID.String()), nil)
+	}
+
+	dRelation, err := dfactory.NewUserRelation(userID.String(), targetID.String(), relationType)
+	if err != nil {
+		return uerror.NewInvalidParameter(fmt.Sprintf("failed to parse relation. type=%v", relationType), err)
+	}
+
+	if err := u.userRelationService.Save(c, *dRelation); err != nil {
+		return errors.Wrapf(err, "failed to save relation. user_id=%v target_id=%v", userID.String(), targetID.String())
+	}
+
+	return nil
+}
+
+// DeleteRelation implements UserUsecase.
+func (u *userUsecase) DeleteRelation(c context.Context, userID uuid.UUID, targetID uuid.UUID) error {
+	dRelation, err := u.userRelationService.Get(c, userID, targetID)
+	if err != nil {
+		return err
+	} else if dRelation == nil {
+		return uerror.NewNotFound(fmt.Sprintf("relation not found. target_id=%v", targetID.String()), nil)
+	}
+
+	if err := u.userRelationService.Delete(c, userID, targetID); err != nil {
+		return errors.Wrapf(err, "failed to delete relation. user_id=%v target_id=%v", userID.String(), targetID.String())
+	}
+
+	return nil
 }
 
 // Get implements UserUsecase.
@@ -266,26 +359,116 @@ func (u *userUsecase) Save(c context.Context, subject string, email string, name
 		return nil, errors.Wrapf(err, "failed to save user. id=%v", userID.String())
 	}
 
+	// メールアドレス宛ての招待を引き継ぐ（失敗しても次のログインでやり直せるよう毎回行う）
+	if err := u.inviteService.AttachEmail(c, saveUser.Email, userID); err != nil {
+		return nil, errors.Wrapf(err, "failed to attach invite. id=%v", userID.String())
+	}
+
 	return &userID, nil
 }
 
+func (u *userUsecase) getCommunityByRole(c context.Context, roleID uuid.UUID) (*dmodel.Community, *dmodel.Role, error) {
+	role, err := u.roleService.Get(c, roleID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if role == nil {
+		return nil, nil, uerror.NewNotFound("role not found", nil)
+	}
+
+	communityID, err := u.roleService.GetRelatedCommunity(c, role.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if communityID == nil {
+		return nil, nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	community, err := u.communityService.Get(c, *communityID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if community == nil || community.Deleting() {
+		return nil, nil, uerror.NewNotFound("community not found", nil)
+	}
+
+	// アーカイブされたコミュニティには参加できない
+	if !community.Writable() {
+		return nil, nil, uerror.NewNewPermissionDenied("community is not writable", nil)
+	}
+
+	return community, role, nil
+}
+
+func (u *userUsecase) checkJoinable(c context.Context, communityID uuid.UUID, userID uuid.UUID) error {
+	if member, err := u.memberService.GetByCommunityAndUser(c, communityID, userID); err != nil {
+		return err
+	} else if member != nil {
+		return uerror.NewAlreadyExists("member already exists", nil)
+	}
+
+	if ban, err := u.banService.Get(c, communityID, userID); err != nil {
+		return err
+	} else if ban != nil && ban.Active(time.Now()) {
+		return uerror.NewNewPermissionDenied("user is banned", nil)
+	}
+
+	return nil
+}
+
+func (u *userUsecase) join(c context.Context, communityID uuid.UUID, roleID uuid.UUID, userID uuid.UUID, memberID uuid.UUID) error {
+	member, err := dfactory.NewMember(memberID.String(), userID.String(), roleID.String())
+	if err != nil {
+		return err
+	}
+
+	memberMention, err := dmodel.NewMention(communityID.String(), dmodel.ResourceCommunity.String())
+	if err != nil {
+		return err
+	}
+
+	return u.memberService.Create(c, *member, *memberMention)
+}
+
+func (u *userUsecase) saveJoinActivity(c context.Context, memberID uuid.UUID) error {
+	dActivity, err := dfactory.NewMemberActivity(time.Now(), memberID.String(), memberID.String(), dmodel.ResourceMember.String(), dmodel.OperationCreate.String())
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse member activity. id=%v", memberID.String())
+	}
+
+	if err := u.activityService.SaveMemberActivity(c, *dActivity); err != nil {
+		return errors.Wrapf(err, "failed to save member activity. id=%v", memberID.String())
+	}
+
+	return nil
+}
+
 func NewUserUsecase(i *do.Injector) (UserUsecase, error) {
 	userService := do.MustInvoke[dservice.UserService](i)
 	noteService := do.MustInvoke[dservice.NoteService](i)
 	resourceSearchIndexService := do.MustInvoke[dservice.ResourceSearchIndexService](i)
 	activityService := do.MustInvoke[dservice.ActivityService](i)
 	inviteService := do.MustInvoke[dservice.InviteService](i)
+	inviteLinkService := do.MustInvoke[dservice.InviteLinkService](i)
 	communityService := do.MustInvoke[dservice.CommunityService](i)
 	roleService := do.MustInvoke[dservice.RoleService](i)
 	memberService := do.MustInvoke[dservice.MemberService](i)
+	userRelationService := do.MustInvoke[dservice.UserRelationService](i)
+	banService := do.MustInvoke[dservice.BanService](i)
 	return &userUsecase{
 		userService:                userService,
 		noteService:                noteService,
 		resourceSearchIndexService: resourceSearchIndexService,
 		activityService:            activityService,
 		inviteService:              inviteService,
+		inviteLinkService:          inviteLinkService,
 		communityService:           communityService,
 		roleService:                roleService,
 		memberService:              memberService,
+		userRelationService:        userRelationService,
+		banService:                 banService,
 	}, nil
 }